@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"crypto/tls"
 	"embed"
 	"errors"
@@ -10,21 +11,26 @@ import (
 	"log"
 	"net"
 	"net/http"
+	"net/url"
 	"os"
 	"strings"
+	"time"
 
-	"github.com/99designs/gqlgen/graphql/handler"
-	"github.com/99designs/gqlgen/graphql/playground"
-	badgerdb "github.com/dgraph-io/badger/v3"
 	"github.com/gorilla/mux"
 	"github.com/mitchellh/go-homedir"
 
 	"github.com/dstotijn/hetty/pkg/api"
-	"github.com/dstotijn/hetty/pkg/db/badger"
+	"github.com/dstotijn/hetty/pkg/db"
+	"github.com/dstotijn/hetty/pkg/fuzz"
+	"github.com/dstotijn/hetty/pkg/intercept"
 	"github.com/dstotijn/hetty/pkg/proj"
 	"github.com/dstotijn/hetty/pkg/proxy"
 	"github.com/dstotijn/hetty/pkg/reqlog"
+	"github.com/dstotijn/hetty/pkg/rewrite"
+	"github.com/dstotijn/hetty/pkg/scan"
 	"github.com/dstotijn/hetty/pkg/scope"
+	"github.com/dstotijn/hetty/pkg/sender"
+	"github.com/dstotijn/hetty/pkg/wslog"
 )
 
 var version = "0.0.0"
@@ -34,7 +40,15 @@ var (
 	caCertFile string
 	caKeyFile  string
 	dbPath     string
+	dbDriver   string
 	addr       string
+
+	bodyFileDir       string
+	bodyFileThreshold int64
+	captureJA3        bool
+	enableHTTP2       bool
+	upstreamProxy     string
+	apiToken          string
 )
 
 //go:embed admin
@@ -54,8 +68,19 @@ func run() error {
 		"CA certificate filepath. Creates a new CA certificate if file doesn't exist")
 	flag.StringVar(&caKeyFile, "key", "~/.hetty/hetty_key.pem",
 		"CA private key filepath. Creates a new CA private key if file doesn't exist")
-	flag.StringVar(&dbPath, "db", "~/.hetty/db", "Database directory path")
+	flag.StringVar(&dbPath, "db", "~/.hetty/db",
+		"Database path: a directory for the badger driver, or a file for the sqlite driver")
+	flag.StringVar(&dbDriver, "dbDriver", db.DriverBadger, "Database driver: badger or sqlite")
 	flag.StringVar(&addr, "addr", ":8080", "TCP address to listen on, in the form \"host:port\"")
+	flag.StringVar(&bodyFileDir, "bodyDir", "~/.hetty/bodies", "Directory path for response bodies stored on disk")
+	flag.Int64Var(&bodyFileThreshold, "bodyThreshold", 0,
+		"Size in bytes above which response bodies are stored on disk instead of in the database. Zero disables")
+	flag.BoolVar(&captureJA3, "ja3", false, "Compute JA3 fingerprints of TLS client connections")
+	flag.BoolVar(&enableHTTP2, "http2", true, "Offer HTTP/2 to clients on intercepted TLS connections")
+	flag.StringVar(&upstreamProxy, "upstreamProxy", "",
+		"URL of an upstream proxy to send outbound traffic through, e.g. \"socks5://localhost:9050\"")
+	flag.StringVar(&apiToken, "apiToken", "",
+		"Token that requests to the GraphQL and REST APIs must have as bearer token. Empty disables authentication")
 	flag.Parse()
 
 	// Expand `~` in filepaths.
@@ -74,6 +99,11 @@ func run() error {
 		return fmt.Errorf("could not parse projects filepath: %w", err)
 	}
 
+	bodyFileDir, err := homedir.Expand(bodyFileDir)
+	if err != nil {
+		return fmt.Errorf("could not parse body directory path: %w", err)
+	}
+
 	// Load existing CA certificate and key from disk, or generate and write
 	// to disk if no files exist yet.
 	caCert, caKey, err := proxy.LoadOrCreateCA(caKeyFile, caCertFile)
@@ -81,35 +111,95 @@ func run() error {
 		return fmt.Errorf("could not create/load CA key pair: %w", err)
 	}
 
-	badger, err := badger.OpenDatabase(badgerdb.DefaultOptions(dbPath))
+	repo, err := db.Open(dbDriver, dbPath)
 	if err != nil {
-		return fmt.Errorf("could not open badger database: %w", err)
+		return fmt.Errorf("could not open database: %w", err)
 	}
-	defer badger.Close()
+	defer repo.Close()
 
 	scope := &scope.Scope{}
+	rewriter := &rewrite.Rewriter{}
 
 	reqLogService := reqlog.NewService(reqlog.Config{
-		Scope:      scope,
-		Repository: badger,
+		Scope:             scope,
+		Repository:        repo,
+		BodyFileThreshold: bodyFileThreshold,
+		BodyFileDir:       bodyFileDir,
 	})
 
+	p, err := proxy.NewProxy(caCert, caKey)
+	if err != nil {
+		return fmt.Errorf("could not create proxy: %w", err)
+	}
+
 	projService, err := proj.NewService(proj.Config{
-		Repository:    badger,
+		Repository:    repo,
 		ReqLogService: reqLogService,
 		Scope:         scope,
+		Rewriter:      rewriter,
+		Proxy:         p,
 	})
 	if err != nil {
 		return fmt.Errorf("could not create new project service: %w", err)
 	}
 
-	p, err := proxy.NewProxy(caCert, caKey)
-	if err != nil {
-		return fmt.Errorf("could not create proxy: %w", err)
+	// The CA can be changed at runtime, via the API.
+	p.SetCAFiles(caKeyFile, caCertFile)
+	p.SetJA3Capture(captureJA3)
+	p.SetHTTP2(enableHTTP2)
+
+	if upstreamProxy != "" {
+		u, err := url.Parse(upstreamProxy)
+		if err != nil {
+			return fmt.Errorf("could not parse upstream proxy URL: %w", err)
+		}
+
+		if err := p.SetUpstream(proxy.UpstreamConfig{Default: u}); err != nil {
+			return fmt.Errorf("could not set upstream proxy: %w", err)
+		}
 	}
 
-	p.UseRequestModifier(reqLogService.RequestModifier)
-	p.UseResponseModifier(reqLogService.ResponseModifier)
+	interceptService := intercept.NewService()
+	wsLogService := wslog.NewService(wslog.Config{
+		Repository: repo,
+	})
+	senderService := sender.NewService(sender.Config{
+		Repository:    repo,
+		ReqLogService: reqLogService,
+		HTTPClient:    p.HTTPClient(),
+	})
+	fuzzService := fuzz.NewService(fuzz.Config{
+		SenderService: senderService,
+	})
+	scanService := scan.NewService(scan.Config{
+		Repository:    repo,
+		ReqLogService: reqLogService,
+	})
+
+	// Logged traffic is scanned passively, off the goroutine that stored it.
+	reqLogService.OnResponseStored(func(reqLog reqlog.RequestLog) {
+		go func() {
+			if _, err := scanService.Scan(context.Background(), reqLog); err != nil {
+				log.Printf("[ERROR] Could not scan request log (id: %v): %v", reqLog.ID, err)
+			}
+		}()
+	})
+
+	// Request logs that exceed the retention policy of the active project are
+	// pruned in the background.
+	go reqLogService.RunPruning(context.Background(), time.Minute)
+
+	// Requests and responses are rewritten before they're intercepted, and
+	// logged as they're forwarded, after any modifications.
+	p.UseRequestModifier(reqLogService.RequestModifier, interceptService.RequestModifier, rewriter.RequestModifier)
+	p.UseResponseModifier(
+		reqLogService.ResponseModifier,
+		interceptService.ResponseModifier,
+		wsLogService.ResponseModifier,
+		rewriter.ResponseModifier,
+	)
+	p.OnTLSError(reqLogService.HandleTLSError)
+	p.OnMalformedRequest(reqLogService.HandleMalformedRequest)
 
 	fsSub, err := fs.Sub(adminContent, "admin")
 	if err != nil {
@@ -124,13 +214,23 @@ func run() error {
 		return strings.EqualFold(host, hostname) || (req.Host == "hetty.proxy" || req.Host == "localhost:8080")
 	}).Subrouter().StrictSlash(true)
 
-	// GraphQL server.
-	adminRouter.Path("/api/playground/").Handler(playground.Handler("GraphQL Playground", "/api/graphql/"))
-	adminRouter.Path("/api/graphql/").Handler(
-		handler.NewDefaultServer(api.NewExecutableSchema(api.Config{Resolvers: &api.Resolver{
-			RequestLogService: reqLogService,
-			ProjectService:    projService,
-		}})))
+	resolver := &api.Resolver{
+		RequestLogService: reqLogService,
+		ProjectService:    projService,
+		InterceptService:  interceptService,
+		WSLogService:      wsLogService,
+		SenderService:     senderService,
+		FuzzService:       fuzzService,
+		ScanService:       scanService,
+		Proxy:             p,
+	}
+
+	// GraphQL server and playground, and REST API for scripts and CI pipelines.
+	adminRouter.PathPrefix("/api/").Handler(api.NewHandler(resolver, apiToken))
+
+	// CA certificate, for installing it on clients, e.g. via
+	// `http://hetty.proxy/ca.crt`.
+	adminRouter.Path("/ca.{format:crt|pem|p12}").Handler(p.CAHandler())
 
 	// Admin interface.
 	adminRouter.PathPrefix("").Handler(adminHandler)
@@ -142,11 +242,19 @@ func run() error {
 		Addr:         addr,
 		Handler:      router,
 		TLSNextProto: map[string]func(*http.Server, *tls.Conn, http.Handler){}, // Disable HTTP/2
+		ConnContext:  proxy.ConnContext,
+	}
+
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("could not listen on %v: %w", addr, err)
 	}
 
 	log.Printf("[INFO] Hetty (v%v) is running on %v ...", version, addr)
 
-	err = s.ListenAndServe()
+	// Track pipelined requests, for the `req.pipelined` search key, and report
+	// malformed requests to the proxy, for the `req.malformedProtocol` key.
+	err = s.Serve(proxy.PipelineListener{Listener: l, Proxy: p})
 	if err != nil && errors.Is(err, http.ErrServerClosed) {
 		return fmt.Errorf("http server closed unexpected: %w", err)
 	}
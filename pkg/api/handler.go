@@ -0,0 +1,28 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/playground"
+	"github.com/gorilla/mux"
+)
+
+// NewHandler returns a handler for the APIs of Hetty, to be mounted at the root
+// of the admin interface: the GraphQL API at `/api/graphql/`, its playground
+// at `/api/playground/` and the REST API at `/api/v1/`.
+// If token isn't empty, requests to any of them must be authenticated with it,
+// using an `Authorization: Bearer <token>` header.
+func NewHandler(resolver *Resolver, token string) http.Handler {
+	router := mux.NewRouter().StrictSlash(true)
+
+	router.Path("/api/playground/").Handler(playground.Handler("GraphQL Playground", "/api/graphql/"))
+	router.Path("/api/graphql/").Handler(handler.NewDefaultServer(NewExecutableSchema(Config{Resolvers: resolver})))
+	router.PathPrefix("/api/v1/").Handler(http.StripPrefix("/api/v1", NewRESTHandler(resolver)))
+
+	if token == "" {
+		return router
+	}
+
+	return tokenAuth(router, token)
+}
@@ -0,0 +1,144 @@
+package api_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/dstotijn/hetty/pkg/api"
+)
+
+func TestHandlerTokenAuth(t *testing.T) {
+	t.Parallel()
+
+	const token = "s3cr3t"
+
+	// Requests are chosen so they're handled without any of the services of
+	// the resolver: a GraphQL introspection query and a REST request with an
+	// invalid ID.
+	newGraphQLRequest := func() *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/api/graphql/", strings.NewReader(`{"query": "{ __typename }"}`))
+		req.Header.Set("Content-Type", "application/json")
+
+		return req
+	}
+	newRESTRequest := func() *http.Request {
+		return httptest.NewRequest(http.MethodGet, "/api/v1/requests/foo", nil)
+	}
+	newPlaygroundRequest := func() *http.Request {
+		return httptest.NewRequest(http.MethodGet, "/api/playground/", nil)
+	}
+
+	tests := []struct {
+		name          string
+		token         string
+		newRequest    func() *http.Request
+		authorization string
+		expStatus     int
+	}{
+		{
+			name:       "REST API, missing token",
+			token:      token,
+			newRequest: newRESTRequest,
+			expStatus:  http.StatusUnauthorized,
+		},
+		{
+			name:          "REST API, wrong token",
+			token:         token,
+			newRequest:    newRESTRequest,
+			authorization: "Bearer foobar",
+			expStatus:     http.StatusUnauthorized,
+		},
+		{
+			name:          "REST API, token without scheme",
+			token:         token,
+			newRequest:    newRESTRequest,
+			authorization: token,
+			expStatus:     http.StatusUnauthorized,
+		},
+		{
+			name:          "REST API, token with other scheme",
+			token:         token,
+			newRequest:    newRESTRequest,
+			authorization: "Basic " + token,
+			expStatus:     http.StatusUnauthorized,
+		},
+		{
+			name:          "REST API, correct token",
+			token:         token,
+			newRequest:    newRESTRequest,
+			authorization: "Bearer " + token,
+			expStatus:     http.StatusBadRequest,
+		},
+		{
+			name:          "REST API, correct token with lowercase scheme",
+			token:         token,
+			newRequest:    newRESTRequest,
+			authorization: "bearer " + token,
+			expStatus:     http.StatusBadRequest,
+		},
+		{
+			name:       "GraphQL API, missing token",
+			token:      token,
+			newRequest: newGraphQLRequest,
+			expStatus:  http.StatusUnauthorized,
+		},
+		{
+			name:          "GraphQL API, wrong token",
+			token:         token,
+			newRequest:    newGraphQLRequest,
+			authorization: "Bearer foobar",
+			expStatus:     http.StatusUnauthorized,
+		},
+		{
+			name:          "GraphQL API, correct token",
+			token:         token,
+			newRequest:    newGraphQLRequest,
+			authorization: "Bearer " + token,
+			expStatus:     http.StatusOK,
+		},
+		{
+			name:       "GraphQL playground, missing token",
+			token:      token,
+			newRequest: newPlaygroundRequest,
+			expStatus:  http.StatusUnauthorized,
+		},
+		{
+			name:       "GraphQL API, authentication disabled",
+			newRequest: newGraphQLRequest,
+			expStatus:  http.StatusOK,
+		},
+		{
+			name:       "REST API, authentication disabled",
+			newRequest: newRESTRequest,
+			expStatus:  http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := api.NewHandler(&api.Resolver{}, tt.token)
+
+			req := tt.newRequest()
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+
+			if rec.Code != tt.expStatus {
+				t.Fatalf("incorrect status code (expected: %v, got: %v, body: %q)", tt.expStatus, rec.Code, rec.Body)
+			}
+
+			if tt.expStatus == http.StatusUnauthorized && rec.Header().Get("WWW-Authenticate") != "Bearer" {
+				t.Fatalf("expected `WWW-Authenticate: Bearer` header, got: %q", rec.Header().Get("WWW-Authenticate"))
+			}
+		})
+	}
+}
@@ -29,3 +29,12 @@ func (u *ULID) UnmarshalGQL(v interface{}) (err error) {
 func (u ULID) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(ulid.ULID(u).String()))
 }
+
+// MarshalText encodes the ULID as a string, e.g. for use in JSON.
+func (u ULID) MarshalText() ([]byte, error) {
+	return ulid.ULID(u).MarshalText()
+}
+
+func (u *ULID) UnmarshalText(text []byte) error {
+	return (*ulid.ULID)(u).UnmarshalText(text)
+}
@@ -9,6 +9,10 @@ import (
 	"time"
 )
 
+type ClearFindingsResult struct {
+	Success bool `json:"success"`
+}
+
 type ClearHTTPRequestLogResult struct {
 	Success bool `json:"success"`
 }
@@ -17,24 +21,143 @@ type CloseProjectResult struct {
 	Success bool `json:"success"`
 }
 
+type DeleteFuzzAttackResult struct {
+	Success bool `json:"success"`
+}
+
 type DeleteProjectResult struct {
 	Success bool `json:"success"`
 }
 
+type DeleteSearchPresetResult struct {
+	Success bool `json:"success"`
+}
+
+type DeleteSenderRequestsResult struct {
+	Success bool `json:"success"`
+}
+
+type DropInterceptedItemResult struct {
+	Success bool `json:"success"`
+}
+
+type ExportHARResult struct {
+	Har string `json:"har"`
+}
+
+type Finding struct {
+	ID           ULID            `json:"id"`
+	RequestLogID ULID            `json:"requestLogId"`
+	Check        string          `json:"check"`
+	Title        string          `json:"title"`
+	Severity     FindingSeverity `json:"severity"`
+	Evidence     *string         `json:"evidence"`
+	Dismissed    bool            `json:"dismissed"`
+	Timestamp    time.Time       `json:"timestamp"`
+}
+
+type ForwardInterceptedItemResult struct {
+	Success bool `json:"success"`
+}
+
+type FuzzAttack struct {
+	ID        ULID           `json:"id"`
+	Mode      FuzzAttackMode `json:"mode"`
+	Total     int            `json:"total"`
+	Completed int            `json:"completed"`
+	Done      bool           `json:"done"`
+	Timestamp time.Time      `json:"timestamp"`
+}
+
+type FuzzBruteForceInput struct {
+	Charset   string `json:"charset"`
+	MinLength int    `json:"minLength"`
+	MaxLength int    `json:"maxLength"`
+}
+
+type FuzzNumberRangeInput struct {
+	From   int     `json:"from"`
+	To     int     `json:"to"`
+	Step   *int    `json:"step"`
+	Format *string `json:"format"`
+}
+
+type FuzzPayloadSourceInput struct {
+	List         []string              `json:"list"`
+	WordlistPath *string               `json:"wordlistPath"`
+	NumberRange  *FuzzNumberRangeInput `json:"numberRange"`
+	BruteForce   *FuzzBruteForceInput  `json:"bruteForce"`
+}
+
+type FuzzResult struct {
+	ID         ULID            `json:"id"`
+	AttackID   ULID            `json:"attackId"`
+	Index      int             `json:"index"`
+	Payloads   []string        `json:"payloads"`
+	Request    *HTTPRequestLog `json:"request"`
+	Error      *string         `json:"error"`
+	DurationMs int             `json:"durationMs"`
+}
+
+type HTTPBodyByteRangeDiff struct {
+	OriginalOffset int `json:"originalOffset"`
+	OriginalLength int `json:"originalLength"`
+	ModifiedOffset int `json:"modifiedOffset"`
+	ModifiedLength int `json:"modifiedLength"`
+}
+
+type HTTPBodyDiff struct {
+	Binary       bool                    `json:"binary"`
+	OriginalSize int                     `json:"originalSize"`
+	ModifiedSize int                     `json:"modifiedSize"`
+	Lines        []HTTPBodyLineDiff      `json:"lines"`
+	ByteRanges   []HTTPBodyByteRangeDiff `json:"byteRanges"`
+}
+
+type HTTPBodyLineDiff struct {
+	Op           DiffOperation `json:"op"`
+	Text         string        `json:"text"`
+	OriginalLine *int          `json:"originalLine"`
+	ModifiedLine *int          `json:"modifiedLine"`
+}
+
 type HTTPHeader struct {
 	Key   string `json:"key"`
 	Value string `json:"value"`
 }
 
+type HTTPHeaderDiff struct {
+	Key      string        `json:"key"`
+	Op       DiffOperation `json:"op"`
+	Original []string      `json:"original"`
+	Modified []string      `json:"modified"`
+}
+
+type HTTPHeaderInput struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+type HTTPRequestDiff struct {
+	Method  *StringDiff      `json:"method"`
+	URL     *StringDiff      `json:"url"`
+	Proto   *StringDiff      `json:"proto"`
+	Headers []HTTPHeaderDiff `json:"headers"`
+	Body    *HTTPBodyDiff    `json:"body"`
+}
+
 type HTTPRequestLog struct {
-	ID        ULID             `json:"id"`
-	URL       string           `json:"url"`
-	Method    HTTPMethod       `json:"method"`
-	Proto     string           `json:"proto"`
-	Headers   []HTTPHeader     `json:"headers"`
-	Body      *string          `json:"body"`
-	Timestamp time.Time        `json:"timestamp"`
-	Response  *HTTPResponseLog `json:"response"`
+	ID             ULID             `json:"id"`
+	URL            string           `json:"url"`
+	Method         HTTPMethod       `json:"method"`
+	Proto          string           `json:"proto"`
+	Headers        []HTTPHeader     `json:"headers"`
+	Body           *string          `json:"body"`
+	Timestamp      time.Time        `json:"timestamp"`
+	Response       *HTTPResponseLog `json:"response"`
+	Note           *string          `json:"note"`
+	Tags           []string         `json:"tags"`
+	HighlightColor *HighlightColor  `json:"highlightColor"`
 }
 
 type HTTPRequestLogFilter struct {
@@ -47,18 +170,129 @@ type HTTPRequestLogFilterInput struct {
 	SearchExpression *string `json:"searchExpression"`
 }
 
+type HTTPRequestLogPage struct {
+	RequestLogs []HTTPRequestLog `json:"requestLogs"`
+	HasNextPage bool             `json:"hasNextPage"`
+	EndCursor   *ULID            `json:"endCursor"`
+}
+
+type HTTPResponseBody struct {
+	Body            string  `json:"body"`
+	Base64          bool    `json:"base64"`
+	ContentEncoding *string `json:"contentEncoding"`
+}
+
 type HTTPResponseLog struct {
-	Proto        string       `json:"proto"`
-	StatusCode   int          `json:"statusCode"`
-	StatusReason string       `json:"statusReason"`
-	Body         *string      `json:"body"`
-	Headers      []HTTPHeader `json:"headers"`
+	Proto           string       `json:"proto"`
+	StatusCode      int          `json:"statusCode"`
+	StatusReason    string       `json:"statusReason"`
+	Body            *string      `json:"body"`
+	Headers         []HTTPHeader `json:"headers"`
+	Timing          *HTTPTiming  `json:"timing"`
+	DurationMs      int          `json:"durationMs"`
+	Size            int          `json:"size"`
+	ContentEncoding *string      `json:"contentEncoding"`
+}
+
+type HTTPTiming struct {
+	DNSMs     int `json:"dnsMs"`
+	ConnectMs int `json:"connectMs"`
+	TLSMs     int `json:"tlsMs"`
+	WaitMs    int `json:"waitMs"`
+	TtfbMs    int `json:"ttfbMs"`
+}
+
+type ImportHARResult struct {
+	Count int `json:"count"`
+}
+
+type InterceptSettings struct {
+	RequestsEnabled  bool    `json:"requestsEnabled"`
+	ResponsesEnabled bool    `json:"responsesEnabled"`
+	RequestFilter    *string `json:"requestFilter"`
+	ResponseFilter   *string `json:"responseFilter"`
+}
+
+type InterceptedItem struct {
+	ID      ULID                `json:"id"`
+	Kind    InterceptedItemKind `json:"kind"`
+	Request *HTTPRequestLog     `json:"request"`
+}
+
+type ModifyInterceptedRequestInput struct {
+	ID      ULID              `json:"id"`
+	URL     string            `json:"url"`
+	Method  HTTPMethod        `json:"method"`
+	Proto   string            `json:"proto"`
+	Headers []HTTPHeaderInput `json:"headers"`
+	Body    *string           `json:"body"`
+}
+
+type ModifyInterceptedResponseInput struct {
+	ID         ULID              `json:"id"`
+	Proto      string            `json:"proto"`
+	StatusCode int               `json:"statusCode"`
+	Headers    []HTTPHeaderInput `json:"headers"`
+	Body       *string           `json:"body"`
 }
 
 type Project struct {
-	ID       ULID   `json:"id"`
-	Name     string `json:"name"`
-	IsActive bool   `json:"isActive"`
+	ID        ULID   `json:"id"`
+	Name      string `json:"name"`
+	IsActive  bool   `json:"isActive"`
+	Encrypted bool   `json:"encrypted"`
+}
+
+type ProxyCa struct {
+	Subject           string    `json:"subject"`
+	NotBefore         time.Time `json:"notBefore"`
+	NotAfter          time.Time `json:"notAfter"`
+	Sha256Fingerprint string    `json:"sha256Fingerprint"`
+	Certificate       string    `json:"certificate"`
+}
+
+type ProxyCaInput struct {
+	Certificate string `json:"certificate"`
+	PrivateKey  string `json:"privateKey"`
+}
+
+type RawHTTPMessage struct {
+	Message string `json:"message"`
+	Base64  bool   `json:"base64"`
+}
+
+type RawHTTPRequestLog struct {
+	Request  *RawHTTPMessage `json:"request"`
+	Response *RawHTTPMessage `json:"response"`
+}
+
+type RetentionSettings struct {
+	MaxEntries      int        `json:"maxEntries"`
+	MaxAgeSeconds   int        `json:"maxAgeSeconds"`
+	MaxBodyBytes    int        `json:"maxBodyBytes"`
+	OnlyInScope     bool       `json:"onlyInScope"`
+	PrunedEntries   int        `json:"prunedEntries"`
+	PrunedBodyBytes int        `json:"prunedBodyBytes"`
+	LastPrunedAt    *time.Time `json:"lastPrunedAt"`
+}
+
+type RetentionSettingsInput struct {
+	MaxEntries    int  `json:"maxEntries"`
+	MaxAgeSeconds int  `json:"maxAgeSeconds"`
+	MaxBodyBytes  int  `json:"maxBodyBytes"`
+	OnlyInScope   bool `json:"onlyInScope"`
+}
+
+type RewriteRule struct {
+	Target  RewriteTarget `json:"target"`
+	Match   string        `json:"match"`
+	Replace string        `json:"replace"`
+}
+
+type RewriteRuleInput struct {
+	Target  RewriteTarget `json:"target"`
+	Match   string        `json:"match"`
+	Replace string        `json:"replace"`
 }
 
 type ScopeHeader struct {
@@ -71,16 +305,372 @@ type ScopeHeaderInput struct {
 	Value *string `json:"value"`
 }
 
+type ScopePortRange struct {
+	Min int  `json:"min"`
+	Max *int `json:"max"`
+}
+
+type ScopePortRangeInput struct {
+	Min int  `json:"min"`
+	Max *int `json:"max"`
+}
+
 type ScopeRule struct {
-	URL    *string      `json:"url"`
-	Header *ScopeHeader `json:"header"`
-	Body   *string      `json:"body"`
+	URL        *string          `json:"url"`
+	Header     *ScopeHeader     `json:"header"`
+	Body       *string          `json:"body"`
+	Method     *string          `json:"method"`
+	StatusCode *ScopeStatusCode `json:"statusCode"`
+	Port       *ScopePortRange  `json:"port"`
+	Exclude    bool             `json:"exclude"`
 }
 
 type ScopeRuleInput struct {
-	URL    *string           `json:"url"`
-	Header *ScopeHeaderInput `json:"header"`
-	Body   *string           `json:"body"`
+	URL        *string               `json:"url"`
+	Header     *ScopeHeaderInput     `json:"header"`
+	Body       *string               `json:"body"`
+	Method     *string               `json:"method"`
+	StatusCode *ScopeStatusCodeInput `json:"statusCode"`
+	Port       *ScopePortRangeInput  `json:"port"`
+	Exclude    *bool                 `json:"exclude"`
+}
+
+type ScopeStatusCode struct {
+	Min int  `json:"min"`
+	Max *int `json:"max"`
+}
+
+type ScopeStatusCodeInput struct {
+	Min int  `json:"min"`
+	Max *int `json:"max"`
+}
+
+type SearchPreset struct {
+	Name       string `json:"name"`
+	Expression string `json:"expression"`
+}
+
+type SenderRequest struct {
+	ID                 ULID                   `json:"id"`
+	SourceRequestLogID *ULID                  `json:"sourceRequestLogID"`
+	URL                string                 `json:"url"`
+	Method             HTTPMethod             `json:"method"`
+	Proto              string                 `json:"proto"`
+	Headers            []HTTPHeader           `json:"headers"`
+	Body               *string                `json:"body"`
+	Timestamp          time.Time              `json:"timestamp"`
+	Response           *HTTPResponseLog       `json:"response"`
+	Attempts           []SenderRequestAttempt `json:"attempts"`
+}
+
+type SenderRequestAttempt struct {
+	ID        ULID             `json:"id"`
+	URL       string           `json:"url"`
+	Method    HTTPMethod       `json:"method"`
+	Proto     string           `json:"proto"`
+	Headers   []HTTPHeader     `json:"headers"`
+	Body      *string          `json:"body"`
+	Timestamp time.Time        `json:"timestamp"`
+	Response  *HTTPResponseLog `json:"response"`
+	Error     *string          `json:"error"`
+}
+
+type SenderRequestInput struct {
+	ID      *ULID             `json:"id"`
+	URL     string            `json:"url"`
+	Method  HTTPMethod        `json:"method"`
+	Proto   *string           `json:"proto"`
+	Headers []HTTPHeaderInput `json:"headers"`
+	Body    *string           `json:"body"`
+}
+
+type SetLoginHTTPRequestLogResult struct {
+	Success bool `json:"success"`
+}
+
+type SiteMapMethod struct {
+	Method   string        `json:"method"`
+	Hits     int           `json:"hits"`
+	Statuses []StatusCount `json:"statuses"`
+}
+
+type SiteMapNode struct {
+	Host       string          `json:"host"`
+	Path       string          `json:"path"`
+	Hits       int             `json:"hits"`
+	Statuses   []StatusCount   `json:"statuses"`
+	Methods    []SiteMapMethod `json:"methods"`
+	ChildCount int             `json:"childCount"`
+}
+
+type StartFuzzAttackInput struct {
+	Method         HTTPMethod               `json:"method"`
+	URL            string                   `json:"url"`
+	Headers        []HTTPHeaderInput        `json:"headers"`
+	Body           *string                  `json:"body"`
+	Mode           FuzzAttackMode           `json:"mode"`
+	PayloadSources []FuzzPayloadSourceInput `json:"payloadSources"`
+	Concurrency    *int                     `json:"concurrency"`
+}
+
+type StatusCount struct {
+	StatusCode int `json:"statusCode"`
+	Count      int `json:"count"`
+}
+
+type StringDiff struct {
+	Original string `json:"original"`
+	Modified string `json:"modified"`
+	Changed  bool   `json:"changed"`
+}
+
+type TLSClientCert struct {
+	Host        string `json:"host"`
+	Certificate string `json:"certificate"`
+}
+
+type TLSClientCertInput struct {
+	Host        string `json:"host"`
+	Certificate string `json:"certificate"`
+	PrivateKey  string `json:"privateKey"`
+}
+
+type TLSPolicy struct {
+	PassthroughHosts []string        `json:"passthroughHosts"`
+	ClientCerts      []TLSClientCert `json:"clientCerts"`
+	MinVersion       *TLSVersion     `json:"minVersion"`
+	MaxVersion       *TLSVersion     `json:"maxVersion"`
+}
+
+type TLSPolicyInput struct {
+	PassthroughHosts []string             `json:"passthroughHosts"`
+	ClientCerts      []TLSClientCertInput `json:"clientCerts"`
+	MinVersion       *TLSVersion          `json:"minVersion"`
+	MaxVersion       *TLSVersion          `json:"maxVersion"`
+}
+
+type UpdateInterceptSettingsInput struct {
+	RequestsEnabled  bool    `json:"requestsEnabled"`
+	ResponsesEnabled bool    `json:"responsesEnabled"`
+	RequestFilter    *string `json:"requestFilter"`
+	ResponseFilter   *string `json:"responseFilter"`
+}
+
+type UpstreamProxyRule struct {
+	Host     string  `json:"host"`
+	ProxyURL *string `json:"proxyUrl"`
+}
+
+type UpstreamProxyRuleInput struct {
+	Host     string  `json:"host"`
+	ProxyURL *string `json:"proxyUrl"`
+}
+
+type UpstreamProxySettings struct {
+	DefaultProxyURL *string             `json:"defaultProxyUrl"`
+	Rules           []UpstreamProxyRule `json:"rules"`
+}
+
+type UpstreamProxySettingsInput struct {
+	DefaultProxyURL *string                  `json:"defaultProxyUrl"`
+	Rules           []UpstreamProxyRuleInput `json:"rules"`
+}
+
+type WebSocketMessage struct {
+	ID           ULID               `json:"id"`
+	ConnectionID ULID               `json:"connectionId"`
+	Direction    WebSocketDirection `json:"direction"`
+	Opcode       WebSocketOpcode    `json:"opcode"`
+	Payload      string             `json:"payload"`
+	Compressed   bool               `json:"compressed"`
+	Truncated    bool               `json:"truncated"`
+	Timestamp    time.Time          `json:"timestamp"`
+}
+
+type DiffOperation string
+
+const (
+	DiffOperationEqual   DiffOperation = "EQUAL"
+	DiffOperationAdded   DiffOperation = "ADDED"
+	DiffOperationRemoved DiffOperation = "REMOVED"
+	DiffOperationChanged DiffOperation = "CHANGED"
+)
+
+var AllDiffOperation = []DiffOperation{
+	DiffOperationEqual,
+	DiffOperationAdded,
+	DiffOperationRemoved,
+	DiffOperationChanged,
+}
+
+func (e DiffOperation) IsValid() bool {
+	switch e {
+	case DiffOperationEqual, DiffOperationAdded, DiffOperationRemoved, DiffOperationChanged:
+		return true
+	}
+	return false
+}
+
+func (e DiffOperation) String() string {
+	return string(e)
+}
+
+func (e *DiffOperation) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = DiffOperation(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid DiffOperation", str)
+	}
+	return nil
+}
+
+func (e DiffOperation) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type FindingSeverity string
+
+const (
+	FindingSeverityInfo   FindingSeverity = "INFO"
+	FindingSeverityLow    FindingSeverity = "LOW"
+	FindingSeverityMedium FindingSeverity = "MEDIUM"
+	FindingSeverityHigh   FindingSeverity = "HIGH"
+)
+
+var AllFindingSeverity = []FindingSeverity{
+	FindingSeverityInfo,
+	FindingSeverityLow,
+	FindingSeverityMedium,
+	FindingSeverityHigh,
+}
+
+func (e FindingSeverity) IsValid() bool {
+	switch e {
+	case FindingSeverityInfo, FindingSeverityLow, FindingSeverityMedium, FindingSeverityHigh:
+		return true
+	}
+	return false
+}
+
+func (e FindingSeverity) String() string {
+	return string(e)
+}
+
+func (e *FindingSeverity) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = FindingSeverity(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid FindingSeverity", str)
+	}
+	return nil
+}
+
+func (e FindingSeverity) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type FuzzAttackMode string
+
+const (
+	FuzzAttackModeSniper       FuzzAttackMode = "SNIPER"
+	FuzzAttackModeBatteringRAM FuzzAttackMode = "BATTERING_RAM"
+	FuzzAttackModeClusterBomb  FuzzAttackMode = "CLUSTER_BOMB"
+)
+
+var AllFuzzAttackMode = []FuzzAttackMode{
+	FuzzAttackModeSniper,
+	FuzzAttackModeBatteringRAM,
+	FuzzAttackModeClusterBomb,
+}
+
+func (e FuzzAttackMode) IsValid() bool {
+	switch e {
+	case FuzzAttackModeSniper, FuzzAttackModeBatteringRAM, FuzzAttackModeClusterBomb:
+		return true
+	}
+	return false
+}
+
+func (e FuzzAttackMode) String() string {
+	return string(e)
+}
+
+func (e *FuzzAttackMode) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = FuzzAttackMode(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid FuzzAttackMode", str)
+	}
+	return nil
+}
+
+func (e FuzzAttackMode) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type HighlightColor string
+
+const (
+	HighlightColorRed    HighlightColor = "RED"
+	HighlightColorOrange HighlightColor = "ORANGE"
+	HighlightColorYellow HighlightColor = "YELLOW"
+	HighlightColorGreen  HighlightColor = "GREEN"
+	HighlightColorCyan   HighlightColor = "CYAN"
+	HighlightColorBlue   HighlightColor = "BLUE"
+	HighlightColorPurple HighlightColor = "PURPLE"
+	HighlightColorGray   HighlightColor = "GRAY"
+)
+
+var AllHighlightColor = []HighlightColor{
+	HighlightColorRed,
+	HighlightColorOrange,
+	HighlightColorYellow,
+	HighlightColorGreen,
+	HighlightColorCyan,
+	HighlightColorBlue,
+	HighlightColorPurple,
+	HighlightColorGray,
+}
+
+func (e HighlightColor) IsValid() bool {
+	switch e {
+	case HighlightColorRed, HighlightColorOrange, HighlightColorYellow, HighlightColorGreen, HighlightColorCyan, HighlightColorBlue, HighlightColorPurple, HighlightColorGray:
+		return true
+	}
+	return false
+}
+
+func (e HighlightColor) String() string {
+	return string(e)
+}
+
+func (e *HighlightColor) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = HighlightColor(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid HighlightColor", str)
+	}
+	return nil
+}
+
+func (e HighlightColor) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
 type HTTPMethod string
@@ -137,3 +727,353 @@ func (e *HTTPMethod) UnmarshalGQL(v interface{}) error {
 func (e HTTPMethod) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
+
+type HTTPRequestLogSortField string
+
+const (
+	HTTPRequestLogSortFieldTimestamp  HTTPRequestLogSortField = "TIMESTAMP"
+	HTTPRequestLogSortFieldStatusCode HTTPRequestLogSortField = "STATUS_CODE"
+	HTTPRequestLogSortFieldDuration   HTTPRequestLogSortField = "DURATION"
+)
+
+var AllHTTPRequestLogSortField = []HTTPRequestLogSortField{
+	HTTPRequestLogSortFieldTimestamp,
+	HTTPRequestLogSortFieldStatusCode,
+	HTTPRequestLogSortFieldDuration,
+}
+
+func (e HTTPRequestLogSortField) IsValid() bool {
+	switch e {
+	case HTTPRequestLogSortFieldTimestamp, HTTPRequestLogSortFieldStatusCode, HTTPRequestLogSortFieldDuration:
+		return true
+	}
+	return false
+}
+
+func (e HTTPRequestLogSortField) String() string {
+	return string(e)
+}
+
+func (e *HTTPRequestLogSortField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = HTTPRequestLogSortField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid HttpRequestLogSortField", str)
+	}
+	return nil
+}
+
+func (e HTTPRequestLogSortField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type InterceptedItemKind string
+
+const (
+	InterceptedItemKindRequest  InterceptedItemKind = "REQUEST"
+	InterceptedItemKindResponse InterceptedItemKind = "RESPONSE"
+)
+
+var AllInterceptedItemKind = []InterceptedItemKind{
+	InterceptedItemKindRequest,
+	InterceptedItemKindResponse,
+}
+
+func (e InterceptedItemKind) IsValid() bool {
+	switch e {
+	case InterceptedItemKindRequest, InterceptedItemKindResponse:
+		return true
+	}
+	return false
+}
+
+func (e InterceptedItemKind) String() string {
+	return string(e)
+}
+
+func (e *InterceptedItemKind) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = InterceptedItemKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid InterceptedItemKind", str)
+	}
+	return nil
+}
+
+func (e InterceptedItemKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type RewriteTarget string
+
+const (
+	RewriteTargetRequestURL     RewriteTarget = "REQUEST_URL"
+	RewriteTargetRequestHeader  RewriteTarget = "REQUEST_HEADER"
+	RewriteTargetRequestBody    RewriteTarget = "REQUEST_BODY"
+	RewriteTargetResponseHeader RewriteTarget = "RESPONSE_HEADER"
+	RewriteTargetResponseBody   RewriteTarget = "RESPONSE_BODY"
+)
+
+var AllRewriteTarget = []RewriteTarget{
+	RewriteTargetRequestURL,
+	RewriteTargetRequestHeader,
+	RewriteTargetRequestBody,
+	RewriteTargetResponseHeader,
+	RewriteTargetResponseBody,
+}
+
+func (e RewriteTarget) IsValid() bool {
+	switch e {
+	case RewriteTargetRequestURL, RewriteTargetRequestHeader, RewriteTargetRequestBody, RewriteTargetResponseHeader, RewriteTargetResponseBody:
+		return true
+	}
+	return false
+}
+
+func (e RewriteTarget) String() string {
+	return string(e)
+}
+
+func (e *RewriteTarget) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = RewriteTarget(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RewriteTarget", str)
+	}
+	return nil
+}
+
+func (e RewriteTarget) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type ScopeMatchMode string
+
+const (
+	ScopeMatchModeAny ScopeMatchMode = "ANY"
+	ScopeMatchModeAll ScopeMatchMode = "ALL"
+)
+
+var AllScopeMatchMode = []ScopeMatchMode{
+	ScopeMatchModeAny,
+	ScopeMatchModeAll,
+}
+
+func (e ScopeMatchMode) IsValid() bool {
+	switch e {
+	case ScopeMatchModeAny, ScopeMatchModeAll:
+		return true
+	}
+	return false
+}
+
+func (e ScopeMatchMode) String() string {
+	return string(e)
+}
+
+func (e *ScopeMatchMode) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ScopeMatchMode(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ScopeMatchMode", str)
+	}
+	return nil
+}
+
+func (e ScopeMatchMode) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type SortDirection string
+
+const (
+	SortDirectionAsc  SortDirection = "ASC"
+	SortDirectionDesc SortDirection = "DESC"
+)
+
+var AllSortDirection = []SortDirection{
+	SortDirectionAsc,
+	SortDirectionDesc,
+}
+
+func (e SortDirection) IsValid() bool {
+	switch e {
+	case SortDirectionAsc, SortDirectionDesc:
+		return true
+	}
+	return false
+}
+
+func (e SortDirection) String() string {
+	return string(e)
+}
+
+func (e *SortDirection) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SortDirection(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SortDirection", str)
+	}
+	return nil
+}
+
+func (e SortDirection) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type TLSVersion string
+
+const (
+	TLSVersionTLS10 TLSVersion = "TLS10"
+	TLSVersionTLS11 TLSVersion = "TLS11"
+	TLSVersionTLS12 TLSVersion = "TLS12"
+	TLSVersionTLS13 TLSVersion = "TLS13"
+)
+
+var AllTLSVersion = []TLSVersion{
+	TLSVersionTLS10,
+	TLSVersionTLS11,
+	TLSVersionTLS12,
+	TLSVersionTLS13,
+}
+
+func (e TLSVersion) IsValid() bool {
+	switch e {
+	case TLSVersionTLS10, TLSVersionTLS11, TLSVersionTLS12, TLSVersionTLS13:
+		return true
+	}
+	return false
+}
+
+func (e TLSVersion) String() string {
+	return string(e)
+}
+
+func (e *TLSVersion) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TLSVersion(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TlsVersion", str)
+	}
+	return nil
+}
+
+func (e TLSVersion) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type WebSocketDirection string
+
+const (
+	WebSocketDirectionClientToServer WebSocketDirection = "CLIENT_TO_SERVER"
+	WebSocketDirectionServerToClient WebSocketDirection = "SERVER_TO_CLIENT"
+)
+
+var AllWebSocketDirection = []WebSocketDirection{
+	WebSocketDirectionClientToServer,
+	WebSocketDirectionServerToClient,
+}
+
+func (e WebSocketDirection) IsValid() bool {
+	switch e {
+	case WebSocketDirectionClientToServer, WebSocketDirectionServerToClient:
+		return true
+	}
+	return false
+}
+
+func (e WebSocketDirection) String() string {
+	return string(e)
+}
+
+func (e *WebSocketDirection) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = WebSocketDirection(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid WebSocketDirection", str)
+	}
+	return nil
+}
+
+func (e WebSocketDirection) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type WebSocketOpcode string
+
+const (
+	WebSocketOpcodeContinuation WebSocketOpcode = "CONTINUATION"
+	WebSocketOpcodeText         WebSocketOpcode = "TEXT"
+	WebSocketOpcodeBinary       WebSocketOpcode = "BINARY"
+	WebSocketOpcodeClose        WebSocketOpcode = "CLOSE"
+	WebSocketOpcodePing         WebSocketOpcode = "PING"
+	WebSocketOpcodePong         WebSocketOpcode = "PONG"
+	WebSocketOpcodeReserved     WebSocketOpcode = "RESERVED"
+)
+
+var AllWebSocketOpcode = []WebSocketOpcode{
+	WebSocketOpcodeContinuation,
+	WebSocketOpcodeText,
+	WebSocketOpcodeBinary,
+	WebSocketOpcodeClose,
+	WebSocketOpcodePing,
+	WebSocketOpcodePong,
+	WebSocketOpcodeReserved,
+}
+
+func (e WebSocketOpcode) IsValid() bool {
+	switch e {
+	case WebSocketOpcodeContinuation, WebSocketOpcodeText, WebSocketOpcodeBinary, WebSocketOpcodeClose, WebSocketOpcodePing, WebSocketOpcodePong, WebSocketOpcodeReserved:
+		return true
+	}
+	return false
+}
+
+func (e WebSocketOpcode) String() string {
+	return string(e)
+}
+
+func (e *WebSocketOpcode) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = WebSocketOpcode(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid WebSocketOpcode", str)
+	}
+	return nil
+}
+
+func (e WebSocketOpcode) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
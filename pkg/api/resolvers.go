@@ -4,33 +4,58 @@ package api
 
 import (
 	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
 	"errors"
 	"fmt"
+	"net/http"
+	"net/url"
 	"regexp"
+	"sort"
 	"strings"
+	"time"
+	"unicode/utf8"
 
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/oklog/ulid"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 
+	"github.com/dstotijn/hetty/pkg/fuzz"
+	"github.com/dstotijn/hetty/pkg/intercept"
 	"github.com/dstotijn/hetty/pkg/proj"
+	"github.com/dstotijn/hetty/pkg/proxy"
 	"github.com/dstotijn/hetty/pkg/reqlog"
+	"github.com/dstotijn/hetty/pkg/rewrite"
+	"github.com/dstotijn/hetty/pkg/scan"
 	"github.com/dstotijn/hetty/pkg/scope"
 	"github.com/dstotijn/hetty/pkg/search"
+	"github.com/dstotijn/hetty/pkg/sender"
+	"github.com/dstotijn/hetty/pkg/wslog"
 )
 
 type Resolver struct {
 	ProjectService    proj.Service
 	RequestLogService *reqlog.Service
+	InterceptService  *intercept.Service
+	WSLogService      *wslog.Service
+	SenderService     *sender.Service
+	FuzzService       *fuzz.Service
+	ScanService       *scan.Service
+	Proxy             *proxy.Proxy
 }
 
 type (
-	queryResolver    struct{ *Resolver }
-	mutationResolver struct{ *Resolver }
+	queryResolver        struct{ *Resolver }
+	mutationResolver     struct{ *Resolver }
+	subscriptionResolver struct{ *Resolver }
 )
 
-func (r *Resolver) Query() QueryResolver       { return &queryResolver{r} }
-func (r *Resolver) Mutation() MutationResolver { return &mutationResolver{r} }
+func (r *Resolver) Query() QueryResolver               { return &queryResolver{r} }
+func (r *Resolver) Mutation() MutationResolver         { return &mutationResolver{r} }
+func (r *Resolver) Subscription() SubscriptionResolver { return &subscriptionResolver{r} }
 
 func (r *queryResolver) HTTPRequestLogs(ctx context.Context) ([]HTTPRequestLog, error) {
 	reqs, err := r.RequestLogService.FindRequests(ctx)
@@ -43,7 +68,9 @@ func (r *queryResolver) HTTPRequestLogs(ctx context.Context) ([]HTTPRequestLog,
 	logs := make([]HTTPRequestLog, len(reqs))
 
 	for i, req := range reqs {
-		req, err := parseRequestLog(req)
+		// Bodies stored on disk aren't loaded when listing requests, to keep
+		// memory usage low. They're loaded when querying a single request.
+		req, err := parseRequestLog(req, false)
 		if err != nil {
 			return nil, err
 		}
@@ -54,6 +81,74 @@ func (r *queryResolver) HTTPRequestLogs(ctx context.Context) ([]HTTPRequestLog,
 	return logs, nil
 }
 
+var reqLogSortFields = map[HTTPRequestLogSortField]reqlog.SortField{
+	HTTPRequestLogSortFieldTimestamp:  reqlog.SortByTimestamp,
+	HTTPRequestLogSortFieldStatusCode: reqlog.SortByStatusCode,
+	HTTPRequestLogSortFieldDuration:   reqlog.SortByDuration,
+}
+
+func (r *queryResolver) HTTPRequestLogsPage(
+	ctx context.Context,
+	first *int,
+	after *ULID,
+	sortBy *HTTPRequestLogSortField,
+	sortDirection *SortDirection,
+) (*HTTPRequestLogPage, error) {
+	opts := reqlog.PageOptions{}
+
+	if first != nil {
+		if *first < 0 {
+			return nil, errors.New("first must not be negative")
+		}
+
+		opts.First = *first
+	}
+
+	if after != nil {
+		opts.After = ulid.ULID(*after)
+	}
+
+	if sortBy != nil {
+		opts.SortBy = reqLogSortFields[*sortBy]
+	}
+
+	if sortDirection != nil {
+		opts.Descending = *sortDirection == SortDirectionDesc
+	}
+
+	page, err := r.RequestLogService.FindRequestsPage(ctx, opts)
+	if errors.Is(err, proj.ErrNoProject) {
+		return nil, noActiveProjectErr(ctx)
+	} else if errors.Is(err, reqlog.ErrRequestNotFound) {
+		return nil, reqLogCursorNotFoundErr(ctx)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not query repository for requests: %w", err)
+	}
+
+	reqLogPage := &HTTPRequestLogPage{
+		RequestLogs: make([]HTTPRequestLog, len(page.RequestLogs)),
+		HasNextPage: page.HasNextPage,
+	}
+
+	for i, reqLog := range page.RequestLogs {
+		// Like when listing all request logs, bodies stored on disk aren't
+		// loaded.
+		httpReqLog, err := parseRequestLog(reqLog, false)
+		if err != nil {
+			return nil, err
+		}
+
+		reqLogPage.RequestLogs[i] = httpReqLog
+	}
+
+	if n := len(page.RequestLogs); n > 0 {
+		endCursor := ULID(page.RequestLogs[n-1].ID)
+		reqLogPage.EndCursor = &endCursor
+	}
+
+	return reqLogPage, nil
+}
+
 func (r *queryResolver) HTTPRequestLog(ctx context.Context, id ULID) (*HTTPRequestLog, error) {
 	log, err := r.RequestLogService.FindRequestLogByID(ctx, ulid.ULID(id))
 	if errors.Is(err, reqlog.ErrRequestNotFound) {
@@ -62,7 +157,7 @@ func (r *queryResolver) HTTPRequestLog(ctx context.Context, id ULID) (*HTTPReque
 		return nil, fmt.Errorf("could not get request by ID: %w", err)
 	}
 
-	req, err := parseRequestLog(log)
+	req, err := parseRequestLog(log, true)
 	if err != nil {
 		return nil, err
 	}
@@ -70,7 +165,197 @@ func (r *queryResolver) HTTPRequestLog(ctx context.Context, id ULID) (*HTTPReque
 	return &req, nil
 }
 
-func parseRequestLog(reqLog reqlog.RequestLog) (HTTPRequestLog, error) {
+// HTTPResponseBody returns the response body of a request log. Bodies that
+// were decoded when stored are returned decoded, unless raw is true. Bodies
+// that aren't valid UTF-8 are base64 encoded.
+func (r *queryResolver) HTTPResponseBody(ctx context.Context, requestLogID ULID, raw *bool) (*HTTPResponseBody, error) {
+	reqLog, err := r.RequestLogService.FindRequestLogByID(ctx, ulid.ULID(requestLogID))
+	if errors.Is(err, reqlog.ErrRequestNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("could not get request by ID: %w", err)
+	}
+
+	if reqLog.Response == nil {
+		return nil, nil
+	}
+
+	readBody := reqLog.Response.ReadBody
+	if raw != nil && *raw {
+		readBody = reqLog.Response.ReadRawBody
+	}
+
+	body, err := readBody()
+	if err != nil {
+		return nil, fmt.Errorf("could not read response body: %w", err)
+	}
+
+	resBody := &HTTPResponseBody{}
+
+	if reqLog.Response.ContentEncoding != "" {
+		resBody.ContentEncoding = &reqLog.Response.ContentEncoding
+	}
+
+	if utf8.Valid(body) {
+		resBody.Body = string(body)
+	} else {
+		resBody.Body = base64.StdEncoding.EncodeToString(body)
+		resBody.Base64 = true
+	}
+
+	return resBody, nil
+}
+
+// HTTPRequestLogCurl returns a curl command that sends the request of a request
+// log.
+func (r *queryResolver) HTTPRequestLogCurl(ctx context.Context, id ULID) (*string, error) {
+	reqLog, err := r.RequestLogService.FindRequestLogByID(ctx, ulid.ULID(id))
+	if errors.Is(err, reqlog.ErrRequestNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("could not get request by ID: %w", err)
+	}
+
+	cmd := reqLog.Curl()
+
+	return &cmd, nil
+}
+
+// HTTPRequestLogRaw returns the request and response of a request log as raw
+// HTTP/1.1 messages.
+func (r *queryResolver) HTTPRequestLogRaw(ctx context.Context, id ULID) (*RawHTTPRequestLog, error) {
+	reqLog, err := r.RequestLogService.FindRequestLogByID(ctx, ulid.ULID(id))
+	if errors.Is(err, reqlog.ErrRequestNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("could not get request by ID: %w", err)
+	}
+
+	raw := &RawHTTPRequestLog{
+		Request: parseRawHTTPMessage(reqLog.Raw()),
+	}
+
+	if reqLog.Response != nil {
+		raw.Response = parseRawHTTPMessage(reqLog.Response.Raw())
+	}
+
+	return raw, nil
+}
+
+func (r *queryResolver) HTTPRequestDiff(ctx context.Context, originalID, modifiedID ULID) (*HTTPRequestDiff, error) {
+	original, ok, err := r.findDiffRequest(ctx, ulid.ULID(originalID))
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	modified, ok, err := r.findDiffRequest(ctx, ulid.ULID(modifiedID))
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	return parseRequestDiff(reqlog.DiffRequests(original, modified)), nil
+}
+
+// findDiffRequest finds a request log, or else a sender request, by ID, as a
+// request log to diff. It returns false if neither exists.
+func (r *queryResolver) findDiffRequest(ctx context.Context, id ulid.ULID) (reqlog.RequestLog, bool, error) {
+	reqLog, err := r.RequestLogService.FindRequestLogByID(ctx, id)
+	if err == nil {
+		return reqLog, true, nil
+	} else if !errors.Is(err, reqlog.ErrRequestNotFound) {
+		return reqlog.RequestLog{}, false, fmt.Errorf("could not get request by ID: %w", err)
+	}
+
+	req, err := r.SenderService.FindRequestByID(ctx, id)
+	if errors.Is(err, sender.ErrRequestNotFound) {
+		return reqlog.RequestLog{}, false, nil
+	} else if err != nil {
+		return reqlog.RequestLog{}, false, fmt.Errorf("could not get sender request by ID: %w", err)
+	}
+
+	return reqlog.RequestLog{
+		ID:     req.ID,
+		URL:    req.URL,
+		Method: req.Method,
+		Proto:  req.Proto,
+		Header: req.Header,
+		Body:   req.Body,
+	}, true, nil
+}
+
+var diffOperations = map[reqlog.DiffOp]DiffOperation{
+	reqlog.DiffOpEqual:   DiffOperationEqual,
+	reqlog.DiffOpAdded:   DiffOperationAdded,
+	reqlog.DiffOpRemoved: DiffOperationRemoved,
+	reqlog.DiffOpChanged: DiffOperationChanged,
+}
+
+func parseRequestDiff(diff reqlog.RequestDiff) *HTTPRequestDiff {
+	parseStringDiff := func(sd reqlog.StringDiff) *StringDiff {
+		return &StringDiff{Original: sd.Original, Modified: sd.Modified, Changed: sd.Changed()}
+	}
+
+	// Line numbers are zero for lines the respective body doesn't have.
+	lineNumber := func(n int) *int {
+		if n == 0 {
+			return nil
+		}
+
+		return &n
+	}
+
+	reqDiff := &HTTPRequestDiff{
+		Method:  parseStringDiff(diff.Method),
+		URL:     parseStringDiff(diff.URL),
+		Proto:   parseStringDiff(diff.Proto),
+		Headers: make([]HTTPHeaderDiff, len(diff.Headers)),
+		Body: &HTTPBodyDiff{
+			Binary:       diff.Body.Binary,
+			OriginalSize: diff.Body.OriginalSize,
+			ModifiedSize: diff.Body.ModifiedSize,
+			Lines:        make([]HTTPBodyLineDiff, len(diff.Body.Lines)),
+			ByteRanges:   make([]HTTPBodyByteRangeDiff, len(diff.Body.ByteRanges)),
+		},
+	}
+
+	for i, header := range diff.Headers {
+		reqDiff.Headers[i] = HTTPHeaderDiff{
+			Key:      header.Name,
+			Op:       diffOperations[header.Op],
+			Original: header.Original,
+			Modified: header.Modified,
+		}
+	}
+
+	for i, line := range diff.Body.Lines {
+		reqDiff.Body.Lines[i] = HTTPBodyLineDiff{
+			Op:           diffOperations[line.Op],
+			Text:         line.Text,
+			OriginalLine: lineNumber(line.OriginalLine),
+			ModifiedLine: lineNumber(line.ModifiedLine),
+		}
+	}
+
+	for i, byteRange := range diff.Body.ByteRanges {
+		reqDiff.Body.ByteRanges[i] = HTTPBodyByteRangeDiff(byteRange)
+	}
+
+	return reqDiff
+}
+
+// parseRawHTTPMessage converts a raw message. Messages that aren't valid UTF-8
+// (e.g. because of a binary body) are base64 encoded.
+func parseRawHTTPMessage(msg []byte) *RawHTTPMessage {
+	if utf8.Valid(msg) {
+		return &RawHTTPMessage{Message: string(msg)}
+	}
+
+	return &RawHTTPMessage{Message: base64.StdEncoding.EncodeToString(msg), Base64: true}
+}
+
+// parseRequestLog converts a request log to its API representation. If
+// loadBodyFile is false, response bodies that are stored on disk are omitted.
+func parseRequestLog(reqLog reqlog.RequestLog, loadBodyFile bool) (HTTPRequestLog, error) {
 	method := HTTPMethod(reqLog.Method)
 	if method != "" && !method.IsValid() {
 		return HTTPRequestLog{}, fmt.Errorf("request has invalid method: %v", method)
@@ -93,49 +378,102 @@ func parseRequestLog(reqLog reqlog.RequestLog) (HTTPRequestLog, error) {
 	}
 
 	if reqLog.Header != nil {
-		log.Headers = make([]HTTPHeader, 0)
-
-		for key, values := range reqLog.Header {
-			for _, value := range values {
-				log.Headers = append(log.Headers, HTTPHeader{
-					Key:   key,
-					Value: value,
-				})
-			}
-		}
+		log.Headers = parseHeader(reqLog.Header)
 	}
 
 	if reqLog.Response != nil {
-		log.Response = &HTTPResponseLog{
-			Proto:      reqLog.Response.Proto,
-			StatusCode: reqLog.Response.StatusCode,
+		res, err := parseResponseLog(*reqLog.Response, loadBodyFile)
+		if err != nil {
+			return HTTPRequestLog{}, err
 		}
-		statusReasonSubs := strings.SplitN(reqLog.Response.Status, " ", 2)
 
-		if len(statusReasonSubs) == 2 {
-			log.Response.StatusReason = statusReasonSubs[1]
+		log.Response = &res
+	}
+
+	if reqLog.Annotations.Note != "" {
+		note := reqLog.Annotations.Note
+		log.Note = &note
+	}
+
+	log.Tags = make([]string, len(reqLog.Annotations.Tags))
+	copy(log.Tags, reqLog.Annotations.Tags)
+
+	for color, c := range highlightColors {
+		if c == reqLog.Annotations.HighlightColor {
+			color := color
+			log.HighlightColor = &color
 		}
+	}
+
+	return log, nil
+}
+
+func parseResponseLog(resLog reqlog.ResponseLog, loadBodyFile bool) (HTTPResponseLog, error) {
+	res := HTTPResponseLog{
+		Proto:      resLog.Proto,
+		StatusCode: resLog.StatusCode,
+		Timing: &HTTPTiming{
+			DNSMs:     int(resLog.Timing.DNS.Milliseconds()),
+			ConnectMs: int(resLog.Timing.Connect.Milliseconds()),
+			TLSMs:     int(resLog.Timing.TLSHandshake.Milliseconds()),
+			WaitMs:    int(resLog.Timing.Response.Milliseconds()),
+			TtfbMs:    int(resLog.Timing.Total.Milliseconds()),
+		},
+		DurationMs: int(resLog.Duration.Milliseconds()),
+		Size:       int(resLog.Size),
+	}
+	statusReasonSubs := strings.SplitN(resLog.Status, " ", 2)
+
+	if len(statusReasonSubs) == 2 {
+		res.StatusReason = statusReasonSubs[1]
+	}
+
+	body := resLog.Body
 
-		if len(reqLog.Response.Body) > 0 {
-			bodyStr := string(reqLog.Response.Body)
-			log.Response.Body = &bodyStr
+	if resLog.BodyFile != "" && loadBodyFile {
+		var err error
+
+		body, err = resLog.ReadBody()
+		if err != nil {
+			return HTTPResponseLog{}, fmt.Errorf("could not read response body: %w", err)
 		}
+	}
+
+	if len(body) > 0 {
+		bodyStr := string(body)
+		res.Body = &bodyStr
+	}
 
-		if reqLog.Response.Header != nil {
-			log.Response.Headers = make([]HTTPHeader, 0)
+	// Response logs stored before the size as received was recorded only
+	// have the decoded body.
+	if res.Size == 0 {
+		res.Size = len(body)
+	}
 
-			for key, values := range reqLog.Response.Header {
-				for _, value := range values {
-					log.Response.Headers = append(log.Response.Headers, HTTPHeader{
-						Key:   key,
-						Value: value,
-					})
-				}
-			}
+	if resLog.ContentEncoding != "" {
+		res.ContentEncoding = &resLog.ContentEncoding
+	}
+
+	if resLog.Header != nil {
+		res.Headers = parseHeader(resLog.Header)
+	}
+
+	return res, nil
+}
+
+func parseHeader(header http.Header) []HTTPHeader {
+	headers := make([]HTTPHeader, 0)
+
+	for key, values := range header {
+		for _, value := range values {
+			headers = append(headers, HTTPHeader{
+				Key:   key,
+				Value: value,
+			})
 		}
 	}
 
-	return log, nil
+	return headers
 }
 
 func (r *mutationResolver) CreateProject(ctx context.Context, name string) (*Project, error) {
@@ -147,27 +485,120 @@ func (r *mutationResolver) CreateProject(ctx context.Context, name string) (*Pro
 	}
 
 	return &Project{
-		ID:       ULID(p.ID),
-		Name:     p.Name,
-		IsActive: r.ProjectService.IsProjectActive(p.ID),
+		ID:        ULID(p.ID),
+		Name:      p.Name,
+		IsActive:  r.ProjectService.IsProjectActive(p.ID),
+		Encrypted: p.Settings.Encryption != nil,
 	}, nil
 }
 
-func (r *mutationResolver) OpenProject(ctx context.Context, id ULID) (*Project, error) {
-	p, err := r.ProjectService.OpenProject(ctx, ulid.ULID(id))
+func (r *mutationResolver) OpenProject(ctx context.Context, id ULID, passphrase *string) (*Project, error) {
+	var pass string
+	if passphrase != nil {
+		pass = *passphrase
+	}
+
+	p, err := r.ProjectService.OpenProject(ctx, ulid.ULID(id), pass)
 	if errors.Is(err, proj.ErrInvalidName) {
 		return nil, gqlerror.Errorf("Project name must only contain alphanumeric or space chars.")
+	} else if encErr := projectEncryptionErr(ctx, err); encErr != nil {
+		return nil, encErr
 	} else if err != nil {
 		return nil, fmt.Errorf("could not open project: %w", err)
 	}
 
 	return &Project{
-		ID:       ULID(p.ID),
-		Name:     p.Name,
-		IsActive: r.ProjectService.IsProjectActive(p.ID),
+		ID:        ULID(p.ID),
+		Name:      p.Name,
+		IsActive:  r.ProjectService.IsProjectActive(p.ID),
+		Encrypted: p.Settings.Encryption != nil,
+	}, nil
+}
+
+func (r *mutationResolver) EnableProjectEncryption(ctx context.Context, passphrase string) (*Project, error) {
+	err := r.ProjectService.EnableEncryption(ctx, passphrase)
+	if err != nil {
+		return nil, projectEncryptionMutationErr(ctx, err, "could not enable project encryption")
+	}
+
+	return r.activeProject(ctx)
+}
+
+func (r *mutationResolver) DisableProjectEncryption(ctx context.Context, passphrase string) (*Project, error) {
+	err := r.ProjectService.DisableEncryption(ctx, passphrase)
+	if err != nil {
+		return nil, projectEncryptionMutationErr(ctx, err, "could not disable project encryption")
+	}
+
+	return r.activeProject(ctx)
+}
+
+func (r *mutationResolver) ChangeProjectPassphrase(
+	ctx context.Context,
+	passphrase string,
+	newPassphrase string,
+) (*Project, error) {
+	err := r.ProjectService.ChangePassphrase(ctx, passphrase, newPassphrase)
+	if err != nil {
+		return nil, projectEncryptionMutationErr(ctx, err, "could not change project passphrase")
+	}
+
+	return r.activeProject(ctx)
+}
+
+func (r *mutationResolver) activeProject(ctx context.Context) (*Project, error) {
+	p, err := r.ProjectService.ActiveProject(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get active project: %w", err)
+	}
+
+	return &Project{
+		ID:        ULID(p.ID),
+		Name:      p.Name,
+		IsActive:  true,
+		Encrypted: p.Settings.Encryption != nil,
 	}, nil
 }
 
+func projectEncryptionMutationErr(ctx context.Context, err error, msg string) error {
+	if errors.Is(err, proj.ErrNoProject) {
+		return noActiveProjectErr(ctx)
+	}
+
+	if encErr := projectEncryptionErr(ctx, err); encErr != nil {
+		return encErr
+	}
+
+	return fmt.Errorf("%v: %w", msg, err)
+}
+
+// projectEncryptionErr returns a GraphQL error for errors about the passphrase
+// or encryption state of a project, or nil for other errors.
+func projectEncryptionErr(ctx context.Context, err error) error {
+	var msg, code string
+
+	switch {
+	case errors.Is(err, proj.ErrPassphraseRequired):
+		msg, code = "Passphrase is required.", "passphrase_required"
+	case errors.Is(err, proj.ErrInvalidPassphrase):
+		msg, code = "Invalid passphrase.", "invalid_passphrase"
+	case errors.Is(err, proj.ErrAlreadyEncrypted):
+		msg, code = "Project is already encrypted.", "already_encrypted"
+	case errors.Is(err, proj.ErrNotEncrypted):
+		msg, code = "Project is not encrypted.", "not_encrypted"
+	default:
+		return nil
+	}
+
+	return &gqlerror.Error{
+		Path:    graphql.GetPath(ctx),
+		Message: msg,
+		Extensions: map[string]interface{}{
+			"code": code,
+		},
+	}
+}
+
 func (r *queryResolver) ActiveProject(ctx context.Context) (*Project, error) {
 	p, err := r.ProjectService.ActiveProject(ctx)
 	if errors.Is(err, proj.ErrNoProject) {
@@ -177,9 +608,10 @@ func (r *queryResolver) ActiveProject(ctx context.Context) (*Project, error) {
 	}
 
 	return &Project{
-		ID:       ULID(p.ID),
-		Name:     p.Name,
-		IsActive: r.ProjectService.IsProjectActive(p.ID),
+		ID:        ULID(p.ID),
+		Name:      p.Name,
+		IsActive:  r.ProjectService.IsProjectActive(p.ID),
+		Encrypted: p.Settings.Encryption != nil,
 	}, nil
 }
 
@@ -192,9 +624,10 @@ func (r *queryResolver) Projects(ctx context.Context) ([]Project, error) {
 	projects := make([]Project, len(p))
 	for i, proj := range p {
 		projects[i] = Project{
-			ID:       ULID(proj.ID),
-			Name:     proj.Name,
-			IsActive: r.ProjectService.IsProjectActive(proj.ID),
+			ID:        ULID(proj.ID),
+			Name:      proj.Name,
+			IsActive:  r.ProjectService.IsProjectActive(proj.ID),
+			Encrypted: proj.Settings.Encryption != nil,
 		}
 	}
 
@@ -246,9 +679,49 @@ func (r *mutationResolver) ClearHTTPRequestLog(ctx context.Context) (*ClearHTTPR
 		return nil, fmt.Errorf("could not clear request log: %w", err)
 	}
 
+	if err := r.WSLogService.ClearMessages(ctx, project.ID); err != nil {
+		return nil, fmt.Errorf("could not clear WebSocket messages: %w", err)
+	}
+
 	return &ClearHTTPRequestLogResult{true}, nil
 }
 
+func (r *mutationResolver) ExportHTTPRequestLogHar(ctx context.Context, query *string) (*ExportHARResult, error) {
+	if _, err := r.ProjectService.ActiveProject(ctx); errors.Is(err, proj.ErrNoProject) {
+		return nil, noActiveProjectErr(ctx)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not get active project: %w", err)
+	}
+
+	expr, err := parseSearchExpression(query)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse search query: %w", err)
+	}
+
+	buf := strings.Builder{}
+
+	if err := r.RequestLogService.ExportHAR(ctx, &buf, expr); err != nil {
+		return nil, fmt.Errorf("could not export request log: %w", err)
+	}
+
+	return &ExportHARResult{Har: buf.String()}, nil
+}
+
+func (r *mutationResolver) ImportHTTPRequestLogHar(ctx context.Context, har string) (*ImportHARResult, error) {
+	if _, err := r.ProjectService.ActiveProject(ctx); errors.Is(err, proj.ErrNoProject) {
+		return nil, noActiveProjectErr(ctx)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not get active project: %w", err)
+	}
+
+	n, err := r.RequestLogService.ImportHAR(ctx, strings.NewReader(har))
+	if err != nil {
+		return nil, fmt.Errorf("could not import HAR file: %w", err)
+	}
+
+	return &ImportHARResult{Count: n}, nil
+}
+
 func (r *mutationResolver) SetScope(ctx context.Context, input []ScopeRuleInput) ([]ScopeRule, error) {
 	rules := make([]scope.Rule, len(input))
 
@@ -266,7 +739,7 @@ func (r *mutationResolver) SetScope(ctx context.Context, input []ScopeRuleInput)
 				return nil, fmt.Errorf("invalid header key in scope rule: %w", err)
 			}
 
-			headerValue, err = stringPtrToRegexp(rule.Header.Key)
+			headerValue, err = stringPtrToRegexp(rule.Header.Value)
 			if err != nil {
 				return nil, fmt.Errorf("invalid header value in scope rule: %w", err)
 			}
@@ -277,13 +750,40 @@ func (r *mutationResolver) SetScope(ctx context.Context, input []ScopeRuleInput)
 			return nil, fmt.Errorf("invalid body in scope rule: %w", err)
 		}
 
+		method, err := stringPtrToRegexp(rule.Method)
+		if err != nil {
+			return nil, fmt.Errorf("invalid method in scope rule: %w", err)
+		}
+
+		var statusCode scope.StatusCodeRange
+
+		if rule.StatusCode != nil {
+			statusCode.Min = rule.StatusCode.Min
+			if rule.StatusCode.Max != nil {
+				statusCode.Max = *rule.StatusCode.Max
+			}
+		}
+
+		var port scope.PortRange
+
+		if rule.Port != nil {
+			port.Min = rule.Port.Min
+			if rule.Port.Max != nil {
+				port.Max = *rule.Port.Max
+			}
+		}
+
 		rules[i] = scope.Rule{
 			URL: u,
 			Header: scope.Header{
 				Key:   headerKey,
 				Value: headerValue,
 			},
-			Body: body,
+			Body:       body,
+			Method:     method,
+			StatusCode: statusCode,
+			Port:       port,
+			Exclude:    rule.Exclude != nil && *rule.Exclude,
 		}
 	}
 
@@ -295,99 +795,1640 @@ func (r *mutationResolver) SetScope(ctx context.Context, input []ScopeRuleInput)
 	return scopeToScopeRules(rules), nil
 }
 
-func (r *queryResolver) HTTPRequestLogFilter(ctx context.Context) (*HTTPRequestLogFilter, error) {
-	return findReqFilterToHTTPReqLogFilter(r.RequestLogService.FindReqsFilter), nil
+func (r *queryResolver) ScopeMatchMode(ctx context.Context) (ScopeMatchMode, error) {
+	return scopeMatchModeFromScope(r.ProjectService.Scope().MatchMode()), nil
 }
 
-func (r *mutationResolver) SetHTTPRequestLogFilter(
-	ctx context.Context,
-	input *HTTPRequestLogFilterInput,
-) (*HTTPRequestLogFilter, error) {
-	filter, err := findRequestsFilterFromInput(input)
-	if err != nil {
-		return nil, fmt.Errorf("could not parse request log filter: %w", err)
+func (r *mutationResolver) SetScopeMatchMode(ctx context.Context, mode ScopeMatchMode) (ScopeMatchMode, error) {
+	scopeMode := scope.MatchAny
+	if mode == ScopeMatchModeAll {
+		scopeMode = scope.MatchAll
 	}
 
-	err = r.ProjectService.SetRequestLogFindFilter(ctx, filter)
+	err := r.ProjectService.SetScopeMatchMode(ctx, scopeMode)
 	if errors.Is(err, proj.ErrNoProject) {
-		return nil, noActiveProjectErr(ctx)
+		return "", noActiveProjectErr(ctx)
 	} else if err != nil {
-		return nil, fmt.Errorf("could not set request log filter: %w", err)
+		return "", fmt.Errorf("could not set scope match mode: %w", err)
 	}
 
-	return findReqFilterToHTTPReqLogFilter(filter), nil
+	return mode, nil
 }
 
-func stringPtrToRegexp(s *string) (*regexp.Regexp, error) {
-	if s == nil {
-		return nil, nil
+func scopeMatchModeFromScope(mode scope.MatchMode) ScopeMatchMode {
+	if mode == scope.MatchAll {
+		return ScopeMatchModeAll
 	}
 
-	return regexp.Compile(*s)
+	return ScopeMatchModeAny
 }
 
-func scopeToScopeRules(rules []scope.Rule) []ScopeRule {
-	scopeRules := make([]ScopeRule, len(rules))
-	for i, rule := range rules {
-		scopeRules[i].URL = regexpToStringPtr(rule.URL)
-		if rule.Header.Key != nil || rule.Header.Value != nil {
-			scopeRules[i].Header = &ScopeHeader{
-				Key:   regexpToStringPtr(rule.Header.Key),
-				Value: regexpToStringPtr(rule.Header.Value),
-			}
-		}
-
-		scopeRules[i].Body = regexpToStringPtr(rule.Body)
+func (r *queryResolver) HTTPRequestLogFilter(ctx context.Context) (*HTTPRequestLogFilter, error) {
+	return findReqFilterToHTTPReqLogFilter(r.RequestLogService.FindReqsFilter), nil
+}
+
+func (r *queryResolver) SiteMapHosts(ctx context.Context) ([]SiteMapNode, error) {
+	nodes, err := r.RequestLogService.SiteMapHosts(ctx)
+	if errors.Is(err, reqlog.ErrProjectIDMustBeSet) {
+		return nil, noActiveProjectErr(ctx)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not get site map hosts: %w", err)
+	}
+
+	return parseSiteMapNodes(nodes), nil
+}
+
+func (r *queryResolver) SiteMapChildren(ctx context.Context, host string, path *string) ([]SiteMapNode, error) {
+	var p string
+	if path != nil {
+		p = *path
+	}
+
+	nodes, err := r.RequestLogService.SiteMapChildren(ctx, host, p)
+	if errors.Is(err, reqlog.ErrProjectIDMustBeSet) {
+		return nil, noActiveProjectErr(ctx)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not get site map children: %w", err)
+	}
+
+	return parseSiteMapNodes(nodes), nil
+}
+
+func parseSiteMapNodes(nodes []reqlog.SiteMapNode) []SiteMapNode {
+	siteMapNodes := make([]SiteMapNode, len(nodes))
+
+	for i, node := range nodes {
+		siteMapNodes[i] = SiteMapNode{
+			Host:       node.Host,
+			Path:       node.Path,
+			Hits:       node.Hits,
+			Statuses:   parseStatusCounts(node.Statuses),
+			Methods:    make([]SiteMapMethod, len(node.Methods)),
+			ChildCount: node.ChildCount,
+		}
+
+		for j, method := range node.Methods {
+			siteMapNodes[i].Methods[j] = SiteMapMethod{
+				Method:   method.Method,
+				Hits:     method.Hits,
+				Statuses: parseStatusCounts(method.Statuses),
+			}
+		}
+	}
+
+	return siteMapNodes
+}
+
+// parseStatusCounts returns status counts, sorted by status code.
+func parseStatusCounts(statuses map[int]int) []StatusCount {
+	counts := make([]StatusCount, 0, len(statuses))
+	for statusCode, count := range statuses {
+		counts = append(counts, StatusCount{StatusCode: statusCode, Count: count})
+	}
+
+	sort.Slice(counts, func(i, j int) bool { return counts[i].StatusCode < counts[j].StatusCode })
+
+	return counts
+}
+
+func (r *mutationResolver) SetHTTPRequestLogFilter(
+	ctx context.Context,
+	input *HTTPRequestLogFilterInput,
+) (*HTTPRequestLogFilter, error) {
+	filter, err := findRequestsFilterFromInput(input)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse request log filter: %w", err)
+	}
+
+	err = r.ProjectService.SetRequestLogFindFilter(ctx, filter)
+	if errors.Is(err, proj.ErrNoProject) {
+		return nil, noActiveProjectErr(ctx)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not set request log filter: %w", err)
+	}
+
+	return findReqFilterToHTTPReqLogFilter(filter), nil
+}
+
+func (r *queryResolver) SearchPresets(ctx context.Context) ([]SearchPreset, error) {
+	presets, err := r.ProjectService.SearchPresets(ctx)
+	if errors.Is(err, proj.ErrNoProject) {
+		return nil, noActiveProjectErr(ctx)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not get search presets: %w", err)
+	}
+
+	searchPresets := make([]SearchPreset, len(presets))
+	for i, preset := range presets {
+		searchPresets[i] = parseSearchPreset(preset)
+	}
+
+	return searchPresets, nil
+}
+
+func (r *mutationResolver) SetSearchPreset(ctx context.Context, name string, expression string) (*SearchPreset, error) {
+	expr, err := search.ParseQuery(expression)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse search expression: %w", err)
+	}
+
+	preset := proj.SearchPreset{Name: name, Expr: expr}
+
+	err = r.ProjectService.SetSearchPreset(ctx, preset)
+	if errors.Is(err, proj.ErrNoProject) {
+		return nil, noActiveProjectErr(ctx)
+	} else if errors.Is(err, proj.ErrInvalidSearchPresetName) {
+		return nil, gqlerror.Errorf("Search preset name must not be empty.")
+	} else if err != nil {
+		return nil, fmt.Errorf("could not set search preset: %w", err)
+	}
+
+	searchPreset := parseSearchPreset(preset)
+
+	return &searchPreset, nil
+}
+
+func (r *mutationResolver) DeleteSearchPreset(ctx context.Context, name string) (*DeleteSearchPresetResult, error) {
+	err := r.ProjectService.DeleteSearchPreset(ctx, name)
+	if errors.Is(err, proj.ErrNoProject) {
+		return nil, noActiveProjectErr(ctx)
+	} else if errors.Is(err, proj.ErrSearchPresetNotFound) {
+		return nil, searchPresetNotFoundErr(ctx)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not delete search preset: %w", err)
+	}
+
+	return &DeleteSearchPresetResult{true}, nil
+}
+
+func (r *mutationResolver) ApplySearchPreset(ctx context.Context, name string) (*HTTPRequestLogFilter, error) {
+	_, err := r.ProjectService.ApplySearchPreset(ctx, name)
+	if errors.Is(err, proj.ErrNoProject) {
+		return nil, noActiveProjectErr(ctx)
+	} else if errors.Is(err, proj.ErrSearchPresetNotFound) {
+		return nil, searchPresetNotFoundErr(ctx)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not apply search preset: %w", err)
+	}
+
+	return findReqFilterToHTTPReqLogFilter(r.RequestLogService.FindReqsFilter), nil
+}
+
+func (r *queryResolver) UpstreamProxy(ctx context.Context) (*UpstreamProxySettings, error) {
+	return parseUpstreamConfig(r.Proxy.Upstream()), nil
+}
+
+func (r *mutationResolver) SetUpstreamProxy(
+	ctx context.Context,
+	input UpstreamProxySettingsInput,
+) (*UpstreamProxySettings, error) {
+	cfg := proxy.UpstreamConfig{
+		Rules: make([]proxy.UpstreamRule, len(input.Rules)),
+	}
+
+	var err error
+
+	if cfg.Default, err = stringPtrToURL(input.DefaultProxyURL); err != nil {
+		return nil, fmt.Errorf("could not parse default proxy URL: %w", err)
+	}
+
+	for i, rule := range input.Rules {
+		if cfg.Rules[i].Host, err = regexp.Compile(rule.Host); err != nil {
+			return nil, fmt.Errorf("could not compile host regexp: %w", err)
+		}
+
+		if cfg.Rules[i].Proxy, err = stringPtrToURL(rule.ProxyURL); err != nil {
+			return nil, fmt.Errorf("could not parse proxy URL: %w", err)
+		}
+	}
+
+	if err := r.Proxy.SetUpstream(cfg); err != nil {
+		return nil, fmt.Errorf("could not set upstream proxy: %w", err)
+	}
+
+	return parseUpstreamConfig(cfg), nil
+}
+
+func parseUpstreamConfig(cfg proxy.UpstreamConfig) *UpstreamProxySettings {
+	settings := &UpstreamProxySettings{
+		DefaultProxyURL: urlToStringPtr(cfg.Default),
+		Rules:           make([]UpstreamProxyRule, len(cfg.Rules)),
+	}
+
+	for i, rule := range cfg.Rules {
+		settings.Rules[i] = UpstreamProxyRule{
+			Host:     rule.Host.String(),
+			ProxyURL: urlToStringPtr(rule.Proxy),
+		}
+	}
+
+	return settings
+}
+
+func stringPtrToURL(s *string) (*url.URL, error) {
+	if s == nil || *s == "" {
+		return nil, nil
+	}
+
+	return url.Parse(*s)
+}
+
+func urlToStringPtr(u *url.URL) *string {
+	if u == nil {
+		return nil
+	}
+
+	s := u.String()
+
+	return &s
+}
+
+var tlsVersions = map[TLSVersion]uint16{
+	TLSVersionTLS10: tls.VersionTLS10,
+	TLSVersionTLS11: tls.VersionTLS11,
+	TLSVersionTLS12: tls.VersionTLS12,
+	TLSVersionTLS13: tls.VersionTLS13,
+}
+
+func (r *queryResolver) TLSPolicy(ctx context.Context) (*TLSPolicy, error) {
+	return parseTLSPolicy(r.Proxy.TLSPolicy()), nil
+}
+
+func (r *mutationResolver) SetTLSPolicy(ctx context.Context, input TLSPolicyInput) (*TLSPolicy, error) {
+	policy := proxy.TLSPolicy{
+		Passthrough: make([]*regexp.Regexp, len(input.PassthroughHosts)),
+		ClientCerts: make([]proxy.ClientCert, len(input.ClientCerts)),
+	}
+
+	var err error
+
+	for i, host := range input.PassthroughHosts {
+		if policy.Passthrough[i], err = regexp.Compile(host); err != nil {
+			return nil, fmt.Errorf("could not compile passthrough host regexp: %w", err)
+		}
+	}
+
+	for i, clientCert := range input.ClientCerts {
+		host, err := regexp.Compile(clientCert.Host)
+		if err != nil {
+			return nil, fmt.Errorf("could not compile client certificate host regexp: %w", err)
+		}
+
+		policy.ClientCerts[i] = proxy.ClientCert{
+			Host:    host,
+			CertPEM: []byte(clientCert.Certificate),
+			KeyPEM:  []byte(clientCert.PrivateKey),
+		}
+	}
+
+	if input.MinVersion != nil {
+		policy.MinVersion = tlsVersions[*input.MinVersion]
+	}
+
+	if input.MaxVersion != nil {
+		policy.MaxVersion = tlsVersions[*input.MaxVersion]
+	}
+
+	err = r.ProjectService.SetTLSPolicy(ctx, policy)
+	if errors.Is(err, proj.ErrNoProject) {
+		return nil, noActiveProjectErr(ctx)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not set TLS policy: %w", err)
+	}
+
+	return parseTLSPolicy(policy), nil
+}
+
+func (r *queryResolver) ProxyCa(ctx context.Context) (*ProxyCa, error) {
+	return parseProxyCA(r.Proxy.CA()), nil
+}
+
+func (r *mutationResolver) RotateProxyCa(ctx context.Context) (*ProxyCa, error) {
+	ca, err := r.Proxy.RotateCA()
+	if err != nil {
+		return nil, fmt.Errorf("could not rotate proxy CA: %w", err)
+	}
+
+	return parseProxyCA(ca), nil
+}
+
+func (r *mutationResolver) SetProxyCa(ctx context.Context, input ProxyCaInput) (*ProxyCa, error) {
+	ca, caKey, err := proxy.ParseCA([]byte(input.Certificate), []byte(input.PrivateKey))
+	if err != nil {
+		return nil, fmt.Errorf("could not parse proxy CA: %w", err)
+	}
+
+	if err := r.Proxy.SetCA(ca, caKey); err != nil {
+		return nil, fmt.Errorf("could not set proxy CA: %w", err)
+	}
+
+	return parseProxyCA(ca), nil
+}
+
+func parseProxyCA(ca *x509.Certificate) *ProxyCa {
+	sum := sha256.Sum256(ca.Raw)
+	fingerprint := make([]string, len(sum))
+
+	for i, b := range sum {
+		fingerprint[i] = fmt.Sprintf("%02X", b)
+	}
+
+	return &ProxyCa{
+		Subject:           ca.Subject.String(),
+		NotBefore:         ca.NotBefore,
+		NotAfter:          ca.NotAfter,
+		Sha256Fingerprint: strings.Join(fingerprint, ":"),
+		Certificate:       string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.Raw})),
+	}
+}
+
+func (r *queryResolver) RetentionSettings(ctx context.Context) (*RetentionSettings, error) {
+	p, err := r.ProjectService.ActiveProject(ctx)
+	if errors.Is(err, proj.ErrNoProject) {
+		return nil, noActiveProjectErr(ctx)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not get active project: %w", err)
+	}
+
+	return r.retentionSettings(p), nil
+}
+
+func (r *mutationResolver) SetRetentionSettings(
+	ctx context.Context,
+	input RetentionSettingsInput,
+) (*RetentionSettings, error) {
+	if input.MaxEntries < 0 || input.MaxAgeSeconds < 0 || input.MaxBodyBytes < 0 {
+		return nil, gqlerror.Errorf("Retention limits must not be negative.")
+	}
+
+	policy := reqlog.RetentionPolicy{
+		MaxEntries:   input.MaxEntries,
+		MaxAge:       time.Duration(input.MaxAgeSeconds) * time.Second,
+		MaxBodyBytes: int64(input.MaxBodyBytes),
+	}
+
+	err := r.ProjectService.SetRetention(ctx, policy, input.OnlyInScope)
+	if errors.Is(err, proj.ErrNoProject) {
+		return nil, noActiveProjectErr(ctx)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not set retention settings: %w", err)
+	}
+
+	// The new policy is applied right away, so the returned counters include
+	// request logs it pruned.
+	if _, err := r.RequestLogService.Prune(ctx); err != nil {
+		return nil, fmt.Errorf("could not prune request logs: %w", err)
+	}
+
+	p, err := r.ProjectService.ActiveProject(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get active project: %w", err)
+	}
+
+	return r.retentionSettings(p), nil
+}
+
+func (r *Resolver) retentionSettings(p proj.Project) *RetentionSettings {
+	policy := p.Settings.Retention
+	stats := r.RequestLogService.ProjectPruneStats(p.ID)
+
+	settings := &RetentionSettings{
+		MaxEntries:      policy.MaxEntries,
+		MaxAgeSeconds:   int(policy.MaxAge / time.Second),
+		MaxBodyBytes:    int(policy.MaxBodyBytes),
+		OnlyInScope:     p.Settings.ReqLogBypassOutOfScope,
+		PrunedEntries:   stats.Entries,
+		PrunedBodyBytes: int(stats.BodyBytes),
+	}
+
+	if !stats.LastPrunedAt.IsZero() {
+		settings.LastPrunedAt = &stats.LastPrunedAt
+	}
+
+	return settings
+}
+
+// parseTLSPolicy converts a TLS policy. Private keys of client certificates
+// aren't included.
+func parseTLSPolicy(policy proxy.TLSPolicy) *TLSPolicy {
+	tlsPolicy := &TLSPolicy{
+		PassthroughHosts: make([]string, len(policy.Passthrough)),
+		ClientCerts:      make([]TLSClientCert, len(policy.ClientCerts)),
+		MinVersion:       tlsVersionPtr(policy.MinVersion),
+		MaxVersion:       tlsVersionPtr(policy.MaxVersion),
+	}
+
+	for i, host := range policy.Passthrough {
+		tlsPolicy.PassthroughHosts[i] = host.String()
+	}
+
+	for i, clientCert := range policy.ClientCerts {
+		tlsPolicy.ClientCerts[i] = TLSClientCert{
+			Host:        clientCert.Host.String(),
+			Certificate: string(clientCert.CertPEM),
+		}
+	}
+
+	return tlsPolicy
+}
+
+func tlsVersionPtr(v uint16) *TLSVersion {
+	for version, tlsVersion := range tlsVersions {
+		if tlsVersion == v {
+			return &version
+		}
+	}
+
+	return nil
+}
+
+func parseSearchPreset(preset proj.SearchPreset) SearchPreset {
+	return SearchPreset{
+		Name:       preset.Name,
+		Expression: preset.Expr.String(),
+	}
+}
+
+func searchPresetNotFoundErr(ctx context.Context) error {
+	return &gqlerror.Error{
+		Path:    graphql.GetPath(ctx),
+		Message: "Search preset not found.",
+		Extensions: map[string]interface{}{
+			"code": "not_found",
+		},
+	}
+}
+
+func (r *mutationResolver) SetLoginHTTPRequestLog(ctx context.Context, id *ULID) (*SetLoginHTTPRequestLogResult, error) {
+	var reqLogID ulid.ULID
+	if id != nil {
+		reqLogID = ulid.ULID(*id)
+	}
+
+	err := r.ProjectService.SetLoginRequestLog(ctx, reqLogID)
+	if errors.Is(err, proj.ErrNoProject) {
+		return nil, noActiveProjectErr(ctx)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not set login request log: %w", err)
+	}
+
+	return &SetLoginHTTPRequestLogResult{true}, nil
+}
+
+var highlightColors = map[HighlightColor]reqlog.HighlightColor{
+	HighlightColorRed:    reqlog.HighlightColorRed,
+	HighlightColorOrange: reqlog.HighlightColorOrange,
+	HighlightColorYellow: reqlog.HighlightColorYellow,
+	HighlightColorGreen:  reqlog.HighlightColorGreen,
+	HighlightColorCyan:   reqlog.HighlightColorCyan,
+	HighlightColorBlue:   reqlog.HighlightColorBlue,
+	HighlightColorPurple: reqlog.HighlightColorPurple,
+	HighlightColorGray:   reqlog.HighlightColorGray,
+}
+
+// SetHTTPRequestLogNote sets the note of a request log. A null or empty note
+// removes it.
+func (r *mutationResolver) SetHTTPRequestLogNote(ctx context.Context, id ULID, note *string) (*HTTPRequestLog, error) {
+	var s string
+	if note != nil {
+		s = *note
+	}
+
+	reqLog, err := r.RequestLogService.SetNote(ctx, ulid.ULID(id), s)
+
+	return annotatedRequestLog(ctx, reqLog, err)
+}
+
+// SetHTTPRequestLogTags replaces the tags of a request log.
+func (r *mutationResolver) SetHTTPRequestLogTags(ctx context.Context, id ULID, tags []string) (*HTTPRequestLog, error) {
+	reqLog, err := r.RequestLogService.SetTags(ctx, ulid.ULID(id), tags)
+
+	return annotatedRequestLog(ctx, reqLog, err)
+}
+
+// SetHTTPRequestLogHighlightColor sets the highlight color of a request log. A
+// null color removes the highlight.
+func (r *mutationResolver) SetHTTPRequestLogHighlightColor(
+	ctx context.Context,
+	id ULID,
+	color *HighlightColor,
+) (*HTTPRequestLog, error) {
+	c := reqlog.HighlightColorNone
+	if color != nil {
+		c = highlightColors[*color]
+	}
+
+	reqLog, err := r.RequestLogService.SetHighlightColor(ctx, ulid.ULID(id), c)
+
+	return annotatedRequestLog(ctx, reqLog, err)
+}
+
+// annotatedRequestLog returns the result of a mutation of request log
+// annotations.
+func annotatedRequestLog(ctx context.Context, reqLog reqlog.RequestLog, err error) (*HTTPRequestLog, error) {
+	if errors.Is(err, reqlog.ErrRequestNotFound) {
+		return nil, reqLogNotFoundErr(ctx)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not annotate request log: %w", err)
+	}
+
+	// Like when getting a single request log, bodies stored on disk are
+	// loaded.
+	result, err := parseRequestLog(reqLog, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func stringPtrToRegexp(s *string) (*regexp.Regexp, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	return regexp.Compile(*s)
+}
+
+func scopeToScopeRules(rules []scope.Rule) []ScopeRule {
+	scopeRules := make([]ScopeRule, len(rules))
+	for i, rule := range rules {
+		scopeRules[i].URL = regexpToStringPtr(rule.URL)
+		if rule.Header.Key != nil || rule.Header.Value != nil {
+			scopeRules[i].Header = &ScopeHeader{
+				Key:   regexpToStringPtr(rule.Header.Key),
+				Value: regexpToStringPtr(rule.Header.Value),
+			}
+		}
+
+		scopeRules[i].Body = regexpToStringPtr(rule.Body)
+		scopeRules[i].Method = regexpToStringPtr(rule.Method)
+		scopeRules[i].Exclude = rule.Exclude
+
+		if rule.StatusCode.IsSet() {
+			scopeRules[i].StatusCode = &ScopeStatusCode{Min: rule.StatusCode.Min}
+			if rule.StatusCode.Max != 0 {
+				maxStatusCode := rule.StatusCode.Max
+				scopeRules[i].StatusCode.Max = &maxStatusCode
+			}
+		}
+
+		if rule.Port.IsSet() {
+			scopeRules[i].Port = &ScopePortRange{Min: rule.Port.Min}
+			if rule.Port.Max != 0 {
+				maxPort := rule.Port.Max
+				scopeRules[i].Port.Max = &maxPort
+			}
+		}
+	}
+
+	return scopeRules
+}
+
+func findRequestsFilterFromInput(input *HTTPRequestLogFilterInput) (filter reqlog.FindRequestsFilter, err error) {
+	if input == nil {
+		return
+	}
+
+	if input.OnlyInScope != nil {
+		filter.OnlyInScope = *input.OnlyInScope
+	}
+
+	if input.SearchExpression != nil && *input.SearchExpression != "" {
+		expr, err := search.ParseQuery(*input.SearchExpression)
+		if err != nil {
+			return reqlog.FindRequestsFilter{}, fmt.Errorf("could not parse search query: %w", err)
+		}
+
+		filter.SearchExpr = expr
+	}
+
+	return
+}
+
+func findReqFilterToHTTPReqLogFilter(findReqFilter reqlog.FindRequestsFilter) *HTTPRequestLogFilter {
+	empty := reqlog.FindRequestsFilter{}
+	if findReqFilter == empty {
+		return nil
+	}
+
+	httpReqLogFilter := &HTTPRequestLogFilter{
+		OnlyInScope: findReqFilter.OnlyInScope,
+	}
+
+	if findReqFilter.SearchExpr != nil {
+		searchExpr := findReqFilter.SearchExpr.String()
+		httpReqLogFilter.SearchExpression = &searchExpr
+	}
+
+	return httpReqLogFilter
+}
+
+func noActiveProjectErr(ctx context.Context) error {
+	return &gqlerror.Error{
+		Path:    graphql.GetPath(ctx),
+		Message: "No active project.",
+		Extensions: map[string]interface{}{
+			"code": "no_active_project",
+		},
+	}
+}
+
+func (r *queryResolver) InterceptSettings(ctx context.Context) (*InterceptSettings, error) {
+	return interceptSettingsFromService(r.InterceptService.Settings()), nil
+}
+
+func (r *mutationResolver) UpdateInterceptSettings(
+	ctx context.Context,
+	input UpdateInterceptSettingsInput,
+) (*InterceptSettings, error) {
+	settings := intercept.Settings{
+		RequestsEnabled:  input.RequestsEnabled,
+		ResponsesEnabled: input.ResponsesEnabled,
+	}
+
+	var err error
+
+	settings.RequestFilter, err = parseSearchExpression(input.RequestFilter)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse request filter: %w", err)
+	}
+
+	settings.ResponseFilter, err = parseSearchExpression(input.ResponseFilter)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse response filter: %w", err)
+	}
+
+	if err := r.InterceptService.UpdateSettings(settings); err != nil {
+		return nil, fmt.Errorf("could not update intercept settings: %w", err)
+	}
+
+	return interceptSettingsFromService(settings), nil
+}
+
+func (r *queryResolver) InterceptedItems(ctx context.Context) ([]InterceptedItem, error) {
+	items := r.InterceptService.Items()
+	interceptedItems := make([]InterceptedItem, len(items))
+
+	for i, item := range items {
+		interceptedItem, err := parseInterceptedItem(item)
+		if err != nil {
+			return nil, err
+		}
+
+		interceptedItems[i] = interceptedItem
+	}
+
+	return interceptedItems, nil
+}
+
+func (r *queryResolver) InterceptedItem(ctx context.Context, id ULID) (*InterceptedItem, error) {
+	item, err := r.InterceptService.ItemByID(ulid.ULID(id))
+	if errors.Is(err, intercept.ErrItemNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("could not get intercepted item by ID: %w", err)
+	}
+
+	interceptedItem, err := parseInterceptedItem(item)
+	if err != nil {
+		return nil, err
+	}
+
+	return &interceptedItem, nil
+}
+
+func (r *mutationResolver) ForwardInterceptedItem(ctx context.Context, id ULID) (*ForwardInterceptedItemResult, error) {
+	item, err := r.InterceptService.ItemByID(ulid.ULID(id))
+	if errors.Is(err, intercept.ErrItemNotFound) {
+		return nil, interceptedItemNotFoundErr(ctx)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not get intercepted item by ID: %w", err)
+	}
+
+	if item.IsResponse() {
+		err = r.InterceptService.ModifyResponse(item.ID, nil)
+	} else {
+		err = r.InterceptService.ModifyRequest(item.ID, nil)
+	}
+
+	if errors.Is(err, intercept.ErrItemNotFound) {
+		return nil, interceptedItemNotFoundErr(ctx)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not forward intercepted item: %w", err)
+	}
+
+	return &ForwardInterceptedItemResult{true}, nil
+}
+
+func (r *mutationResolver) ModifyInterceptedRequest(
+	ctx context.Context,
+	input ModifyInterceptedRequestInput,
+) (*ForwardInterceptedItemResult, error) {
+	u, err := url.Parse(input.URL)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse request URL: %w", err)
+	}
+
+	req := reqlog.RequestLog{
+		Method: input.Method.String(),
+		URL:    u,
+		Proto:  input.Proto,
+		Header: headerFromInput(input.Headers),
+	}
+
+	if input.Body != nil {
+		req.Body = []byte(*input.Body)
+	}
+
+	err = r.InterceptService.ModifyRequest(ulid.ULID(input.ID), &req)
+	if errors.Is(err, intercept.ErrItemNotFound) {
+		return nil, interceptedItemNotFoundErr(ctx)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not modify intercepted request: %w", err)
+	}
+
+	return &ForwardInterceptedItemResult{true}, nil
+}
+
+func (r *mutationResolver) ModifyInterceptedResponse(
+	ctx context.Context,
+	input ModifyInterceptedResponseInput,
+) (*ForwardInterceptedItemResult, error) {
+	res := reqlog.ResponseLog{
+		Proto:      input.Proto,
+		StatusCode: input.StatusCode,
+		Header:     headerFromInput(input.Headers),
+	}
+
+	if input.Body != nil {
+		res.Body = []byte(*input.Body)
+	}
+
+	err := r.InterceptService.ModifyResponse(ulid.ULID(input.ID), &res)
+	if errors.Is(err, intercept.ErrItemNotFound) {
+		return nil, interceptedItemNotFoundErr(ctx)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not modify intercepted response: %w", err)
+	}
+
+	return &ForwardInterceptedItemResult{true}, nil
+}
+
+func (r *mutationResolver) DropInterceptedItem(ctx context.Context, id ULID) (*DropInterceptedItemResult, error) {
+	err := r.InterceptService.DropItem(ulid.ULID(id))
+	if errors.Is(err, intercept.ErrItemNotFound) {
+		return nil, interceptedItemNotFoundErr(ctx)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not drop intercepted item: %w", err)
+	}
+
+	return &DropInterceptedItemResult{true}, nil
+}
+
+func parseSearchExpression(s *string) (search.Expression, error) {
+	if s == nil || *s == "" {
+		return nil, nil
+	}
+
+	return search.ParseQuery(*s)
+}
+
+func interceptSettingsFromService(settings intercept.Settings) *InterceptSettings {
+	interceptSettings := &InterceptSettings{
+		RequestsEnabled:  settings.RequestsEnabled,
+		ResponsesEnabled: settings.ResponsesEnabled,
+	}
+
+	if settings.RequestFilter != nil {
+		reqFilter := settings.RequestFilter.String()
+		interceptSettings.RequestFilter = &reqFilter
+	}
+
+	if settings.ResponseFilter != nil {
+		resFilter := settings.ResponseFilter.String()
+		interceptSettings.ResponseFilter = &resFilter
+	}
+
+	return interceptSettings
+}
+
+func parseInterceptedItem(item intercept.Item) (InterceptedItem, error) {
+	req, err := parseRequestLog(item.Request, false)
+	if err != nil {
+		return InterceptedItem{}, err
+	}
+
+	kind := InterceptedItemKindRequest
+	if item.IsResponse() {
+		kind = InterceptedItemKindResponse
+	}
+
+	return InterceptedItem{
+		ID:      ULID(item.ID),
+		Kind:    kind,
+		Request: &req,
+	}, nil
+}
+
+func headerFromInput(headers []HTTPHeaderInput) http.Header {
+	header := make(http.Header)
+	for _, h := range headers {
+		header.Add(h.Key, h.Value)
+	}
+
+	return header
+}
+
+func reqLogNotFoundErr(ctx context.Context) error {
+	return &gqlerror.Error{
+		Path:    graphql.GetPath(ctx),
+		Message: "Request log not found.",
+		Extensions: map[string]interface{}{
+			"code": "not_found",
+		},
+	}
+}
+
+func reqLogCursorNotFoundErr(ctx context.Context) error {
+	return &gqlerror.Error{
+		Path:    graphql.GetPath(ctx),
+		Message: "Request log cursor not found.",
+		Extensions: map[string]interface{}{
+			"code": "not_found",
+		},
+	}
+}
+
+func interceptedItemNotFoundErr(ctx context.Context) error {
+	return &gqlerror.Error{
+		Path:    graphql.GetPath(ctx),
+		Message: "Intercepted item not found.",
+		Extensions: map[string]interface{}{
+			"code": "not_found",
+		},
+	}
+}
+
+func (r *queryResolver) WebSocketMessages(
+	ctx context.Context,
+	connectionID *ULID,
+	search *string,
+) ([]WebSocketMessage, error) {
+	project, err := r.ProjectService.ActiveProject(ctx)
+	if errors.Is(err, proj.ErrNoProject) {
+		return nil, noActiveProjectErr(ctx)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not get active project: %w", err)
+	}
+
+	filter := wslog.FindMessagesFilter{ProjectID: project.ID}
+
+	if connectionID != nil {
+		filter.ConnectionID = ulid.ULID(*connectionID)
+	}
+
+	filter.SearchExpr, err = parseSearchExpression(search)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse search query: %w", err)
+	}
+
+	msgs, err := r.WSLogService.FindMessages(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("could not query repository for WebSocket messages: %w", err)
+	}
+
+	wsMsgs := make([]WebSocketMessage, len(msgs))
+	for i, msg := range msgs {
+		wsMsgs[i] = parseWebSocketMessage(msg)
+	}
+
+	return wsMsgs, nil
+}
+
+func (r *subscriptionResolver) HTTPRequestLogReceived(
+	ctx context.Context,
+	filter *string,
+) (<-chan *HTTPRequestLog, error) {
+	project, err := r.ProjectService.ActiveProject(ctx)
+	if errors.Is(err, proj.ErrNoProject) {
+		return nil, noActiveProjectErr(ctx)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not get active project: %w", err)
+	}
+
+	expr, err := parseSearchExpression(filter)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse filter: %w", err)
+	}
+
+	reqLogs, err := r.RequestLogService.Subscribe(ctx, expr)
+	if err != nil {
+		return nil, fmt.Errorf("could not subscribe to request logs: %w", err)
+	}
+
+	httpReqLogs := make(chan *HTTPRequestLog)
+
+	go func() {
+		defer close(httpReqLogs)
+
+		for reqLog := range reqLogs {
+			if reqLog.ProjectID != project.ID {
+				continue
+			}
+
+			// Like when listing request logs, bodies stored on disk aren't
+			// loaded.
+			httpReqLog, err := parseRequestLog(reqLog, false)
+			if err != nil {
+				continue
+			}
+
+			select {
+			case httpReqLogs <- &httpReqLog:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return httpReqLogs, nil
+}
+
+func (r *subscriptionResolver) WebSocketMessageLogged(
+	ctx context.Context,
+	connectionID *ULID,
+) (<-chan *WebSocketMessage, error) {
+	project, err := r.ProjectService.ActiveProject(ctx)
+	if errors.Is(err, proj.ErrNoProject) {
+		return nil, noActiveProjectErr(ctx)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not get active project: %w", err)
+	}
+
+	msgs := r.WSLogService.Subscribe(ctx)
+	wsMsgs := make(chan *WebSocketMessage)
+
+	go func() {
+		defer close(wsMsgs)
+
+		for msg := range msgs {
+			if msg.ProjectID != project.ID || connectionID != nil && msg.ConnectionID != ulid.ULID(*connectionID) {
+				continue
+			}
+
+			wsMsg := parseWebSocketMessage(msg)
+
+			select {
+			case wsMsgs <- &wsMsg:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return wsMsgs, nil
+}
+
+func parseWebSocketMessage(msg wslog.Message) WebSocketMessage {
+	direction := WebSocketDirectionClientToServer
+	if msg.Direction == wslog.DirectionServerToClient {
+		direction = WebSocketDirectionServerToClient
+	}
+
+	opcode := WebSocketOpcodeReserved
+
+	switch msg.Opcode {
+	case wslog.OpcodeContinuation:
+		opcode = WebSocketOpcodeContinuation
+	case wslog.OpcodeText:
+		opcode = WebSocketOpcodeText
+	case wslog.OpcodeBinary:
+		opcode = WebSocketOpcodeBinary
+	case wslog.OpcodeClose:
+		opcode = WebSocketOpcodeClose
+	case wslog.OpcodePing:
+		opcode = WebSocketOpcodePing
+	case wslog.OpcodePong:
+		opcode = WebSocketOpcodePong
+	}
+
+	return WebSocketMessage{
+		ID:           ULID(msg.ID),
+		ConnectionID: ULID(msg.ConnectionID),
+		Direction:    direction,
+		Opcode:       opcode,
+		Payload:      string(msg.Payload),
+		Compressed:   msg.Compressed,
+		Truncated:    msg.Truncated,
+		Timestamp:    ulid.Time(msg.ID.Time()),
+	}
+}
+
+var rewriteTargets = map[RewriteTarget]rewrite.Target{
+	RewriteTargetRequestURL:     rewrite.TargetRequestURL,
+	RewriteTargetRequestHeader:  rewrite.TargetRequestHeader,
+	RewriteTargetRequestBody:    rewrite.TargetRequestBody,
+	RewriteTargetResponseHeader: rewrite.TargetResponseHeader,
+	RewriteTargetResponseBody:   rewrite.TargetResponseBody,
+}
+
+func (r *queryResolver) RewriteRules(ctx context.Context) ([]RewriteRule, error) {
+	return rewriteToRewriteRules(r.ProjectService.Rewriter().Rules()), nil
+}
+
+func (r *mutationResolver) SetRewriteRules(ctx context.Context, input []RewriteRuleInput) ([]RewriteRule, error) {
+	rules := make([]rewrite.Rule, len(input))
+
+	for i, rule := range input {
+		match, err := regexp.Compile(rule.Match)
+		if err != nil {
+			return nil, fmt.Errorf("invalid match in rewrite rule: %w", err)
+		}
+
+		rules[i] = rewrite.Rule{
+			Target:  rewriteTargets[rule.Target],
+			Match:   match,
+			Replace: rule.Replace,
+		}
+	}
+
+	err := r.ProjectService.SetRewriteRules(ctx, rules)
+	if errors.Is(err, proj.ErrNoProject) {
+		return nil, noActiveProjectErr(ctx)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not set rewrite rules: %w", err)
+	}
+
+	return rewriteToRewriteRules(rules), nil
+}
+
+func rewriteToRewriteRules(rules []rewrite.Rule) []RewriteRule {
+	rewriteRules := make([]RewriteRule, len(rules))
+
+	for i, rule := range rules {
+		for target, t := range rewriteTargets {
+			if t == rule.Target {
+				rewriteRules[i].Target = target
+			}
+		}
+
+		if rule.Match != nil {
+			rewriteRules[i].Match = rule.Match.String()
+		}
+
+		rewriteRules[i].Replace = rule.Replace
+	}
+
+	return rewriteRules
+}
+
+func (r *queryResolver) SenderRequest(ctx context.Context, id ULID) (*SenderRequest, error) {
+	req, err := r.SenderService.FindRequestByID(ctx, ulid.ULID(id))
+	if errors.Is(err, sender.ErrRequestNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("could not get sender request by ID: %w", err)
+	}
+
+	senderReq, err := parseSenderRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &senderReq, nil
+}
+
+func (r *queryResolver) SenderRequests(ctx context.Context) ([]SenderRequest, error) {
+	reqs, err := r.SenderService.FindRequests(ctx)
+	if errors.Is(err, sender.ErrProjectIDMustBeSet) {
+		return nil, noActiveProjectErr(ctx)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not find sender requests: %w", err)
 	}
 
-	return scopeRules
+	senderReqs := make([]SenderRequest, len(reqs))
+
+	for i, req := range reqs {
+		senderReqs[i], err = parseSenderRequest(req)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return senderReqs, nil
 }
 
-func findRequestsFilterFromInput(input *HTTPRequestLogFilterInput) (filter reqlog.FindRequestsFilter, err error) {
-	if input == nil {
-		return
+func (r *mutationResolver) CreateOrUpdateSenderRequest(
+	ctx context.Context,
+	input SenderRequestInput,
+) (*SenderRequest, error) {
+	u, err := url.Parse(input.URL)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse request URL: %w", err)
 	}
 
-	if input.OnlyInScope != nil {
-		filter.OnlyInScope = *input.OnlyInScope
+	req := sender.Request{
+		URL:    u,
+		Method: input.Method.String(),
+		Proto:  "HTTP/1.1",
+		Header: headerFromInput(input.Headers),
 	}
 
-	if input.SearchExpression != nil && *input.SearchExpression != "" {
-		expr, err := search.ParseQuery(*input.SearchExpression)
+	if input.ID != nil {
+		req.ID = ulid.ULID(*input.ID)
+	}
+
+	if input.Proto != nil {
+		req.Proto = *input.Proto
+	}
+
+	if input.Body != nil {
+		req.Body = []byte(*input.Body)
+	}
+
+	req, err = r.SenderService.CreateOrUpdateRequest(ctx, req)
+	if errors.Is(err, sender.ErrProjectIDMustBeSet) {
+		return nil, noActiveProjectErr(ctx)
+	} else if errors.Is(err, sender.ErrRequestNotFound) {
+		return nil, senderRequestNotFoundErr(ctx)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not create or update sender request: %w", err)
+	}
+
+	return senderRequestResult(req)
+}
+
+func (r *mutationResolver) CreateSenderRequestFromHTTPRequestLog(ctx context.Context, id ULID) (*SenderRequest, error) {
+	req, err := r.SenderService.CloneFromRequestLog(ctx, ulid.ULID(id))
+	if errors.Is(err, sender.ErrProjectIDMustBeSet) {
+		return nil, noActiveProjectErr(ctx)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not create sender request from request log: %w", err)
+	}
+
+	return senderRequestResult(req)
+}
+
+func (r *mutationResolver) SendRequest(ctx context.Context, id ULID) (*SenderRequest, error) {
+	req, err := r.SenderService.SendRequest(ctx, ulid.ULID(id))
+	if errors.Is(err, sender.ErrRequestNotFound) {
+		return nil, senderRequestNotFoundErr(ctx)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not send request: %w", err)
+	}
+
+	return senderRequestResult(req)
+}
+
+func (r *mutationResolver) DeleteSenderRequests(ctx context.Context) (*DeleteSenderRequestsResult, error) {
+	project, err := r.ProjectService.ActiveProject(ctx)
+	if errors.Is(err, proj.ErrNoProject) {
+		return nil, noActiveProjectErr(ctx)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not get active project: %w", err)
+	}
+
+	if err := r.SenderService.DeleteRequests(ctx, project.ID); err != nil {
+		return nil, fmt.Errorf("could not delete sender requests: %w", err)
+	}
+
+	return &DeleteSenderRequestsResult{true}, nil
+}
+
+func senderRequestResult(req sender.Request) (*SenderRequest, error) {
+	senderReq, err := parseSenderRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &senderReq, nil
+}
+
+func parseSenderRequest(req sender.Request) (SenderRequest, error) {
+	method := HTTPMethod(req.Method)
+	if method != "" && !method.IsValid() {
+		return SenderRequest{}, fmt.Errorf("sender request has invalid method: %v", method)
+	}
+
+	senderReq := SenderRequest{
+		ID:        ULID(req.ID),
+		URL:       req.URL.String(),
+		Method:    method,
+		Proto:     req.Proto,
+		Headers:   parseHeader(req.Header),
+		Timestamp: ulid.Time(req.ID.Time()),
+		Attempts:  make([]SenderRequestAttempt, len(req.Attempts)),
+	}
+
+	if req.SourceRequestLogID.Compare(ulid.ULID{}) != 0 {
+		reqLogID := ULID(req.SourceRequestLogID)
+		senderReq.SourceRequestLogID = &reqLogID
+	}
+
+	if len(req.Body) > 0 {
+		bodyStr := string(req.Body)
+		senderReq.Body = &bodyStr
+	}
+
+	for i, attempt := range req.Attempts {
+		var err error
+
+		senderReq.Attempts[i], err = parseSenderRequestAttempt(attempt)
 		if err != nil {
-			return reqlog.FindRequestsFilter{}, fmt.Errorf("could not parse search query: %w", err)
+			return SenderRequest{}, err
 		}
+	}
 
-		filter.SearchExpr = expr
+	if n := len(senderReq.Attempts); n > 0 {
+		senderReq.Response = senderReq.Attempts[n-1].Response
 	}
 
-	return
+	return senderReq, nil
 }
 
-func findReqFilterToHTTPReqLogFilter(findReqFilter reqlog.FindRequestsFilter) *HTTPRequestLogFilter {
-	empty := reqlog.FindRequestsFilter{}
-	if findReqFilter == empty {
-		return nil
+func parseSenderRequestAttempt(attempt sender.Attempt) (SenderRequestAttempt, error) {
+	senderAttempt := SenderRequestAttempt{
+		ID:        ULID(attempt.ID),
+		URL:       attempt.URL.String(),
+		Method:    HTTPMethod(attempt.Method),
+		Proto:     attempt.Proto,
+		Headers:   parseHeader(attempt.Header),
+		Timestamp: ulid.Time(attempt.ID.Time()),
 	}
 
-	httpReqLogFilter := &HTTPRequestLogFilter{
-		OnlyInScope: findReqFilter.OnlyInScope,
+	if len(attempt.Body) > 0 {
+		bodyStr := string(attempt.Body)
+		senderAttempt.Body = &bodyStr
 	}
 
-	if findReqFilter.SearchExpr != nil {
-		searchExpr := findReqFilter.SearchExpr.String()
-		httpReqLogFilter.SearchExpression = &searchExpr
+	if attempt.Response != nil {
+		res, err := parseResponseLog(*attempt.Response, true)
+		if err != nil {
+			return SenderRequestAttempt{}, err
+		}
+
+		senderAttempt.Response = &res
 	}
 
-	return httpReqLogFilter
+	if attempt.Error != "" {
+		senderAttempt.Error = &attempt.Error
+	}
+
+	return senderAttempt, nil
 }
 
-func noActiveProjectErr(ctx context.Context) error {
+func senderRequestNotFoundErr(ctx context.Context) error {
 	return &gqlerror.Error{
 		Path:    graphql.GetPath(ctx),
-		Message: "No active project.",
+		Message: "Sender request not found.",
 		Extensions: map[string]interface{}{
-			"code": "no_active_project",
+			"code": "not_found",
+		},
+	}
+}
+
+var fuzzAttackModes = map[FuzzAttackMode]fuzz.Mode{
+	FuzzAttackModeSniper:       fuzz.ModeSniper,
+	FuzzAttackModeBatteringRAM: fuzz.ModeBatteringRam,
+	FuzzAttackModeClusterBomb:  fuzz.ModeClusterBomb,
+}
+
+func (r *queryResolver) FuzzAttacks(ctx context.Context) ([]FuzzAttack, error) {
+	attacks := r.FuzzService.Attacks()
+	fuzzAttacks := make([]FuzzAttack, len(attacks))
+
+	for i, attack := range attacks {
+		fuzzAttacks[i] = parseFuzzAttack(attack)
+	}
+
+	return fuzzAttacks, nil
+}
+
+func (r *queryResolver) FuzzAttack(ctx context.Context, id ULID) (*FuzzAttack, error) {
+	attack, err := r.FuzzService.Attack(ulid.ULID(id))
+	if errors.Is(err, fuzz.ErrAttackNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("could not get fuzz attack: %w", err)
+	}
+
+	fuzzAttack := parseFuzzAttack(attack)
+
+	return &fuzzAttack, nil
+}
+
+func (r *queryResolver) FuzzResults(ctx context.Context, attackID ULID, search *string) ([]FuzzResult, error) {
+	expr, err := parseSearchExpression(search)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse search query: %w", err)
+	}
+
+	results, err := r.FuzzService.Results(ulid.ULID(attackID), expr)
+	if errors.Is(err, fuzz.ErrAttackNotFound) {
+		return nil, fuzzAttackNotFoundErr(ctx)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not get fuzz results: %w", err)
+	}
+
+	fuzzResults := make([]FuzzResult, len(results))
+
+	for i, result := range results {
+		fuzzResults[i], err = parseFuzzResult(result)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return fuzzResults, nil
+}
+
+func (r *mutationResolver) StartFuzzAttack(ctx context.Context, input StartFuzzAttackInput) (*FuzzAttack, error) {
+	cfg := fuzz.AttackConfig{
+		Template: fuzz.Template{
+			Method: input.Method.String(),
+			URL:    input.URL,
+			Header: headerFromInput(input.Headers),
+		},
+		Mode:           fuzzAttackModes[input.Mode],
+		PayloadSources: make([]fuzz.PayloadSource, len(input.PayloadSources)),
+	}
+
+	if input.Body != nil {
+		cfg.Template.Body = *input.Body
+	}
+
+	if input.Concurrency != nil {
+		cfg.Concurrency = *input.Concurrency
+	}
+
+	for i, source := range input.PayloadSources {
+		payloadSource, err := payloadSourceFromInput(source)
+		if err != nil {
+			return nil, err
+		}
+
+		cfg.PayloadSources[i] = payloadSource
+	}
+
+	attack, err := r.FuzzService.StartAttack(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("could not start fuzz attack: %w", err)
+	}
+
+	fuzzAttack := parseFuzzAttack(attack)
+
+	return &fuzzAttack, nil
+}
+
+// payloadSourceFromInput returns the payload source that's set in the input,
+// which must set exactly one.
+func payloadSourceFromInput(input FuzzPayloadSourceInput) (fuzz.PayloadSource, error) {
+	var sources []fuzz.PayloadSource
+
+	if input.List != nil {
+		sources = append(sources, fuzz.List(input.List))
+	}
+
+	if input.WordlistPath != nil {
+		sources = append(sources, fuzz.Wordlist{Path: *input.WordlistPath})
+	}
+
+	if nr := input.NumberRange; nr != nil {
+		numberRange := fuzz.NumberRange{From: nr.From, To: nr.To}
+
+		if nr.Step != nil {
+			numberRange.Step = *nr.Step
+		}
+
+		if nr.Format != nil {
+			numberRange.Format = *nr.Format
+		}
+
+		sources = append(sources, numberRange)
+	}
+
+	if bf := input.BruteForce; bf != nil {
+		sources = append(sources, fuzz.BruteForce{Charset: bf.Charset, MinLength: bf.MinLength, MaxLength: bf.MaxLength})
+	}
+
+	if len(sources) != 1 {
+		return nil, errors.New("payload source must set exactly one of its fields")
+	}
+
+	return sources[0], nil
+}
+
+func (r *mutationResolver) StopFuzzAttack(ctx context.Context, id ULID) (*FuzzAttack, error) {
+	err := r.FuzzService.StopAttack(ulid.ULID(id))
+	if errors.Is(err, fuzz.ErrAttackNotFound) {
+		return nil, fuzzAttackNotFoundErr(ctx)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not stop fuzz attack: %w", err)
+	}
+
+	attack, err := r.FuzzService.Attack(ulid.ULID(id))
+	if err != nil {
+		return nil, fmt.Errorf("could not get fuzz attack: %w", err)
+	}
+
+	fuzzAttack := parseFuzzAttack(attack)
+
+	return &fuzzAttack, nil
+}
+
+func (r *mutationResolver) DeleteFuzzAttack(ctx context.Context, id ULID) (*DeleteFuzzAttackResult, error) {
+	err := r.FuzzService.DeleteAttack(ulid.ULID(id))
+	if errors.Is(err, fuzz.ErrAttackNotFound) {
+		return nil, fuzzAttackNotFoundErr(ctx)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not delete fuzz attack: %w", err)
+	}
+
+	return &DeleteFuzzAttackResult{true}, nil
+}
+
+func (r *subscriptionResolver) FuzzResultRecorded(ctx context.Context, attackID ULID) (<-chan *FuzzResult, error) {
+	if _, err := r.FuzzService.Attack(ulid.ULID(attackID)); errors.Is(err, fuzz.ErrAttackNotFound) {
+		return nil, fuzzAttackNotFoundErr(ctx)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not get fuzz attack: %w", err)
+	}
+
+	results := r.FuzzService.Subscribe(ctx)
+	fuzzResults := make(chan *FuzzResult)
+
+	go func() {
+		defer close(fuzzResults)
+
+		for result := range results {
+			if result.AttackID != ulid.ULID(attackID) {
+				continue
+			}
+
+			fuzzResult, err := parseFuzzResult(result)
+			if err != nil {
+				continue
+			}
+
+			select {
+			case fuzzResults <- &fuzzResult:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return fuzzResults, nil
+}
+
+func parseFuzzAttack(attack fuzz.Attack) FuzzAttack {
+	fuzzAttack := FuzzAttack{
+		ID:        ULID(attack.ID),
+		Total:     attack.Total,
+		Completed: attack.Completed,
+		Done:      attack.Done,
+		Timestamp: ulid.Time(attack.ID.Time()),
+	}
+
+	for mode, m := range fuzzAttackModes {
+		if m == attack.Mode {
+			fuzzAttack.Mode = mode
+		}
+	}
+
+	return fuzzAttack
+}
+
+func parseFuzzResult(result fuzz.Result) (FuzzResult, error) {
+	req, err := parseRequestLog(result.Request, true)
+	if err != nil {
+		return FuzzResult{}, err
+	}
+
+	fuzzResult := FuzzResult{
+		ID:         ULID(result.ID),
+		AttackID:   ULID(result.AttackID),
+		Index:      result.Index,
+		Payloads:   result.Payloads,
+		Request:    &req,
+		DurationMs: int(result.Duration.Milliseconds()),
+	}
+
+	if result.Error != "" {
+		fuzzResult.Error = &result.Error
+	}
+
+	return fuzzResult, nil
+}
+
+func fuzzAttackNotFoundErr(ctx context.Context) error {
+	return &gqlerror.Error{
+		Path:    graphql.GetPath(ctx),
+		Message: "Fuzz attack not found.",
+		Extensions: map[string]interface{}{
+			"code": "not_found",
+		},
+	}
+}
+
+var findingSeverities = map[FindingSeverity]scan.Severity{
+	FindingSeverityInfo:   scan.SeverityInfo,
+	FindingSeverityLow:    scan.SeverityLow,
+	FindingSeverityMedium: scan.SeverityMedium,
+	FindingSeverityHigh:   scan.SeverityHigh,
+}
+
+func (r *queryResolver) Findings(
+	ctx context.Context,
+	includeDismissed *bool,
+	minSeverity *FindingSeverity,
+) ([]Finding, error) {
+	filter := scan.FindingsFilter{}
+
+	if includeDismissed != nil {
+		filter.IncludeDismissed = *includeDismissed
+	}
+
+	if minSeverity != nil {
+		filter.MinSeverity = findingSeverities[*minSeverity]
+	}
+
+	findings, err := r.ScanService.FindFindings(ctx, filter)
+	if errors.Is(err, scan.ErrProjectIDMustBeSet) {
+		return nil, noActiveProjectErr(ctx)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not find findings: %w", err)
+	}
+
+	result := make([]Finding, len(findings))
+
+	for i, finding := range findings {
+		result[i] = parseFinding(finding)
+	}
+
+	return result, nil
+}
+
+func (r *mutationResolver) DismissFinding(ctx context.Context, id ULID, dismissed *bool) (*Finding, error) {
+	// Findings are dismissed unless explicitly undone.
+	dismiss := true
+	if dismissed != nil {
+		dismiss = *dismissed
+	}
+
+	finding, err := r.ScanService.DismissFinding(ctx, ulid.ULID(id), dismiss)
+	if errors.Is(err, scan.ErrFindingNotFound) {
+		return nil, findingNotFoundErr(ctx)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not dismiss finding: %w", err)
+	}
+
+	result := parseFinding(finding)
+
+	return &result, nil
+}
+
+func (r *mutationResolver) ClearFindings(ctx context.Context) (*ClearFindingsResult, error) {
+	err := r.ScanService.ClearFindings(ctx)
+	if errors.Is(err, scan.ErrProjectIDMustBeSet) {
+		return nil, noActiveProjectErr(ctx)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not clear findings: %w", err)
+	}
+
+	return &ClearFindingsResult{true}, nil
+}
+
+func parseFinding(finding scan.Finding) Finding {
+	result := Finding{
+		ID:           ULID(finding.ID),
+		RequestLogID: ULID(finding.ReqLogID),
+		Check:        finding.Check,
+		Title:        finding.Title,
+		Dismissed:    finding.Dismissed,
+		Timestamp:    ulid.Time(finding.ID.Time()),
+	}
+
+	for severity, s := range findingSeverities {
+		if s == finding.Severity {
+			result.Severity = severity
+		}
+	}
+
+	if finding.Evidence != "" {
+		result.Evidence = &finding.Evidence
+	}
+
+	return result
+}
+
+func findingNotFoundErr(ctx context.Context) error {
+	return &gqlerror.Error{
+		Path:    graphql.GetPath(ctx),
+		Message: "Finding not found.",
+		Extensions: map[string]interface{}{
+			"code": "not_found",
 		},
 	}
 }
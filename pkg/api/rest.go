@@ -0,0 +1,408 @@
+package api
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"regexp/syntax"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/oklog/ulid"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/dstotijn/hetty/pkg/proj"
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+// defaultRESTPageSize is the number of request logs returned by the REST API
+// if the page size isn't set.
+const defaultRESTPageSize = 100
+
+type restHandler struct {
+	query    *queryResolver
+	mutation *mutationResolver
+}
+
+type restError struct {
+	Message string `json:"message"`
+	Code    string `json:"code"`
+}
+
+// NewRESTHandler returns a handler that exposes the core operations of the
+// GraphQL API (request logs, scope and sender) as plain JSON over HTTP, for
+// scripts and CI pipelines. Routes are relative to the handler's mount point;
+// resources have the same JSON representation as in the GraphQL API.
+// Requests aren't authenticated; see `NewHandler`.
+func NewRESTHandler(resolver *Resolver) http.Handler {
+	h := &restHandler{
+		query:    &queryResolver{resolver},
+		mutation: &mutationResolver{resolver},
+	}
+
+	router := mux.NewRouter()
+	router.NotFoundHandler = http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		writeRESTError(w, http.StatusNotFound, "not_found", "Route not found.")
+	})
+	router.MethodNotAllowedHandler = http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		writeRESTError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Method not allowed.")
+	})
+
+	router.Path("/requests").Methods(http.MethodGet).HandlerFunc(h.requestLogs)
+	router.Path("/requests/{id}").Methods(http.MethodGet).HandlerFunc(h.requestLog)
+	router.Path("/scope").Methods(http.MethodGet).HandlerFunc(h.scope)
+	router.Path("/scope").Methods(http.MethodPut).HandlerFunc(h.setScope)
+	router.Path("/sender/requests").Methods(http.MethodGet).HandlerFunc(h.senderRequests)
+	router.Path("/sender/requests").Methods(http.MethodPost).HandlerFunc(h.createSenderRequest)
+	router.Path("/sender/requests/{id}").Methods(http.MethodGet).HandlerFunc(h.senderRequest)
+	router.Path("/sender/requests/{id}/send").Methods(http.MethodPost).HandlerFunc(h.sendRequest)
+
+	return router
+}
+
+// tokenAuth wraps next, responding with 401 Unauthorized to requests without
+// an `Authorization: Bearer <token>` header with a valid token.
+func tokenAuth(next http.Handler, token string) http.Handler {
+	const scheme = "Bearer "
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth := r.Header.Get("Authorization")
+
+		// The scheme is case-insensitive, per RFC 7235.
+		valid := len(auth) > len(scheme) && strings.EqualFold(auth[:len(scheme)], scheme) &&
+			subtle.ConstantTimeCompare([]byte(auth[len(scheme):]), []byte(token)) == 1
+
+		if !valid {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			writeRESTError(w, http.StatusUnauthorized, "unauthorized", "Missing or invalid API token.")
+
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// requestLogs responds with a page of the request logs of the active project.
+// Query parameters `search` (a search expression), `onlyInScope`, `first`,
+// `after`, `sortBy` and `sortDirection` work like their GraphQL counterparts.
+// If `first` isn't set, pages have `defaultRESTPageSize` request logs.
+func (h *restHandler) requestLogs(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	params := r.URL.Query()
+
+	opts := reqlog.PageOptions{First: defaultRESTPageSize}
+
+	if v := params.Get("first"); v != "" {
+		first, err := strconv.Atoi(v)
+		if err != nil || first < 0 {
+			writeRESTError(w, http.StatusBadRequest, "bad_request", "Parameter `first` must be a non-negative integer.")
+			return
+		}
+
+		opts.First = first
+	}
+
+	if v := params.Get("after"); v != "" {
+		after, err := ulid.Parse(v)
+		if err != nil {
+			writeRESTError(w, http.StatusBadRequest, "bad_request", "Parameter `after` must be a ULID.")
+			return
+		}
+
+		opts.After = after
+	}
+
+	if v := params.Get("sortBy"); v != "" {
+		sortBy := HTTPRequestLogSortField(strings.ToUpper(v))
+		if !sortBy.IsValid() {
+			writeRESTError(w, http.StatusBadRequest, "bad_request",
+				"Parameter `sortBy` must be one of TIMESTAMP, STATUS_CODE or DURATION.")
+			return
+		}
+
+		opts.SortBy = reqLogSortFields[sortBy]
+	}
+
+	if v := params.Get("sortDirection"); v != "" {
+		sortDirection := SortDirection(strings.ToUpper(v))
+		if !sortDirection.IsValid() {
+			writeRESTError(w, http.StatusBadRequest, "bad_request", "Parameter `sortDirection` must be ASC or DESC.")
+			return
+		}
+
+		opts.Descending = sortDirection == SortDirectionDesc
+	}
+
+	var onlyInScope bool
+
+	if v := params.Get("onlyInScope"); v != "" {
+		var err error
+
+		onlyInScope, err = strconv.ParseBool(v)
+		if err != nil {
+			writeRESTError(w, http.StatusBadRequest, "bad_request", "Parameter `onlyInScope` must be a boolean.")
+			return
+		}
+	}
+
+	searchQuery := params.Get("search")
+
+	expr, err := parseSearchExpression(&searchQuery)
+	if err != nil {
+		writeRESTError(w, http.StatusBadRequest, "bad_request", fmt.Sprintf("Invalid search expression: %v", err))
+		return
+	}
+
+	if _, err := h.query.ProjectService.ActiveProject(ctx); errors.Is(err, proj.ErrNoProject) {
+		writeResolverError(w, noActiveProjectErr(ctx))
+		return
+	} else if err != nil {
+		writeResolverError(w, fmt.Errorf("could not get active project: %w", err))
+		return
+	}
+
+	page, err := h.query.RequestLogService.SearchRequestsPage(ctx, expr, onlyInScope, opts)
+	if errors.Is(err, reqlog.ErrRequestNotFound) {
+		writeResolverError(w, reqLogCursorNotFoundErr(ctx))
+		return
+	} else if err != nil {
+		writeResolverError(w, fmt.Errorf("could not query repository for requests: %w", err))
+		return
+	}
+
+	reqLogPage := HTTPRequestLogPage{
+		RequestLogs: make([]HTTPRequestLog, len(page.RequestLogs)),
+		HasNextPage: page.HasNextPage,
+	}
+
+	for i, reqLog := range page.RequestLogs {
+		// Like in the GraphQL API, bodies stored on disk aren't loaded when
+		// listing request logs.
+		reqLogPage.RequestLogs[i], err = parseRequestLog(reqLog, false)
+		if err != nil {
+			writeResolverError(w, err)
+			return
+		}
+	}
+
+	if n := len(page.RequestLogs); n > 0 {
+		endCursor := ULID(page.RequestLogs[n-1].ID)
+		reqLogPage.EndCursor = &endCursor
+	}
+
+	writeJSON(w, http.StatusOK, reqLogPage)
+}
+
+func (h *restHandler) requestLog(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathULID(w, r)
+	if !ok {
+		return
+	}
+
+	reqLog, err := h.query.HTTPRequestLog(r.Context(), id)
+	if err != nil {
+		writeResolverError(w, err)
+		return
+	}
+
+	if reqLog == nil {
+		writeResolverError(w, reqLogNotFoundErr(r.Context()))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, reqLog)
+}
+
+func (h *restHandler) scope(w http.ResponseWriter, r *http.Request) {
+	rules, err := h.query.Scope(r.Context())
+	if err != nil {
+		writeResolverError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, rules)
+}
+
+// setScope replaces the scope rules of the active project with the rules in
+// the request body.
+func (h *restHandler) setScope(w http.ResponseWriter, r *http.Request) {
+	var input []ScopeRuleInput
+	if !decodeJSON(w, r, &input) {
+		return
+	}
+
+	rules, err := h.mutation.SetScope(r.Context(), input)
+	if err != nil {
+		writeResolverError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, rules)
+}
+
+func (h *restHandler) senderRequests(w http.ResponseWriter, r *http.Request) {
+	reqs, err := h.query.SenderRequests(r.Context())
+	if err != nil {
+		writeResolverError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, reqs)
+}
+
+func (h *restHandler) senderRequest(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathULID(w, r)
+	if !ok {
+		return
+	}
+
+	req, err := h.query.SenderRequest(r.Context(), id)
+	if err != nil {
+		writeResolverError(w, err)
+		return
+	}
+
+	if req == nil {
+		writeResolverError(w, senderRequestNotFoundErr(r.Context()))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, req)
+}
+
+// createSenderRequest creates a sender request, or updates it if the request
+// body has an ID. With query parameter `fromRequestLog` (a request log ID), the
+// sender request is instead cloned from that request log, and the request body
+// is ignored.
+func (h *restHandler) createSenderRequest(w http.ResponseWriter, r *http.Request) {
+	var (
+		req *SenderRequest
+		err error
+	)
+
+	if v := r.URL.Query().Get("fromRequestLog"); v != "" {
+		reqLogID, parseErr := ulid.Parse(v)
+		if parseErr != nil {
+			writeRESTError(w, http.StatusBadRequest, "bad_request", "Parameter `fromRequestLog` must be a ULID.")
+			return
+		}
+
+		req, err = h.mutation.CreateSenderRequestFromHTTPRequestLog(r.Context(), ULID(reqLogID))
+	} else {
+		var input SenderRequestInput
+		if !decodeJSON(w, r, &input) {
+			return
+		}
+
+		if !input.Method.IsValid() {
+			writeRESTError(w, http.StatusBadRequest, "bad_request", "Field `method` must be a valid HTTP method.")
+			return
+		}
+
+		req, err = h.mutation.CreateOrUpdateSenderRequest(r.Context(), input)
+	}
+
+	if err != nil {
+		writeResolverError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, req)
+}
+
+// sendRequest sends a sender request, and responds with the sender request
+// including its response.
+func (h *restHandler) sendRequest(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathULID(w, r)
+	if !ok {
+		return
+	}
+
+	req, err := h.mutation.SendRequest(r.Context(), id)
+	if err != nil {
+		writeResolverError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, req)
+}
+
+// writeResolverError writes an error returned by a resolver as JSON. Errors
+// with a GraphQL error code are mapped to a matching status code; other errors
+// are internal server errors, except for invalid regular expressions in input.
+// Like in the GraphQL API, the error message is included in the response.
+func writeResolverError(w http.ResponseWriter, err error) {
+	var (
+		gqlErr    *gqlerror.Error
+		syntaxErr *syntax.Error
+	)
+
+	switch {
+	case errors.As(err, &gqlErr):
+	case errors.Is(err, proj.ErrNoProject):
+		writeRESTError(w, http.StatusConflict, "no_active_project", "No active project.")
+		return
+	case errors.As(err, &syntaxErr):
+		writeRESTError(w, http.StatusBadRequest, "bad_request", err.Error())
+		return
+	default:
+		writeRESTError(w, http.StatusInternalServerError, "internal", err.Error())
+		return
+	}
+
+	code, _ := gqlErr.Extensions["code"].(string)
+
+	status := http.StatusBadRequest
+
+	switch code {
+	case "not_found":
+		status = http.StatusNotFound
+	case "no_active_project":
+		status = http.StatusConflict
+	}
+
+	writeRESTError(w, status, code, gqlErr.Message)
+}
+
+func writeRESTError(w http.ResponseWriter, status int, code, message string) {
+	writeJSON(w, status, struct {
+		Error restError `json:"error"`
+	}{restError{Message: message, Code: code}})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Printf("[ERROR] REST API: could not write response: %v", err)
+	}
+}
+
+// decodeJSON decodes the request body into v. If the body is invalid, it writes
+// a 400 Bad Request response and returns false.
+func decodeJSON(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeRESTError(w, http.StatusBadRequest, "bad_request", fmt.Sprintf("Invalid JSON request body: %v", err))
+		return false
+	}
+
+	return true
+}
+
+// pathULID returns the `id` path variable. If it isn't a valid ULID, it writes
+// a 400 Bad Request response and returns false.
+func pathULID(w http.ResponseWriter, r *http.Request) (ULID, bool) {
+	id, err := ulid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		writeRESTError(w, http.StatusBadRequest, "bad_request", "Path parameter `id` must be a ULID.")
+		return ULID{}, false
+	}
+
+	return ULID(id), true
+}
@@ -1,9 +1,12 @@
 package badger
 
 import (
+	"crypto/cipher"
 	"fmt"
+	"sync"
 
 	"github.com/dgraph-io/badger/v3"
+	"github.com/oklog/ulid"
 )
 
 const (
@@ -11,14 +14,32 @@ const (
 	projectPrefix = 0x00
 	reqLogPrefix  = 0x01
 	resLogPrefix  = 0x02
+	wsMsgPrefix   = 0x03
+	senderPrefix  = 0x04
+	scanPrefix    = 0x05
 
 	// Request log indices.
 	reqLogProjectIDIndex = 0x00
+
+	// WebSocket message indices. Index 0x00 is used by the messages
+	// themselves.
+	wsMsgProjectIDIndex = 0x01
+
+	// Sender request indices. Index 0x00 is used by the requests themselves.
+	senderProjectIDIndex = 0x01
+
+	// Finding indices. Index 0x00 is used by the findings themselves.
+	scanProjectIDIndex = 0x01
 )
 
 // Database is used to store and retrieve data from an underlying Badger database.
 type Database struct {
 	badger *badger.DB
+
+	// Keys of encrypted projects, and projects that are locked.
+	keysMu sync.RWMutex
+	keys   map[ulid.ULID]cipher.AEAD
+	locked map[ulid.ULID]bool
 }
 
 // OpenDatabase opens a new Badger database.
@@ -0,0 +1,337 @@
+package badger
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/gob"
+	"errors"
+	"fmt"
+
+	"github.com/dgraph-io/badger/v3"
+	"github.com/oklog/ulid"
+
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+// encryptedValueMarker is the first byte of encrypted values. Gob encoded
+// values never start with a zero byte, because it's the (non-zero) length of
+// their first message.
+const encryptedValueMarker = 0x00
+
+var ErrProjectLocked = errors.New("badger: project is encrypted, and its key isn't set")
+
+// SetProjectKey sets the AES-256 key that the request logs, WebSocket messages,
+// sender requests and findings of a project are encrypted with when they're
+// stored. A nil key stores them in plaintext. Stored values aren't re-encrypted;
+// use `RekeyProject` for that.
+func (db *Database) SetProjectKey(projectID ulid.ULID, key []byte) error {
+	var aead cipher.AEAD
+
+	if key != nil {
+		var err error
+
+		aead, err = newAEAD(key)
+		if err != nil {
+			return err
+		}
+	}
+
+	db.keysMu.Lock()
+	defer db.keysMu.Unlock()
+
+	db.setProjectAEADLocked(projectID, aead)
+
+	return nil
+}
+
+// LockProject discards the key of an encrypted project. Until its key is set
+// again, values of the project can't be read, and values that are stored for
+// it (e.g. responses that arrive after a project is closed) are rejected,
+// rather than stored in plaintext. It's a no-op for projects without key.
+func (db *Database) LockProject(projectID ulid.ULID) {
+	db.keysMu.Lock()
+	defer db.keysMu.Unlock()
+
+	if _, ok := db.keys[projectID]; !ok {
+		return
+	}
+
+	delete(db.keys, projectID)
+
+	if db.locked == nil {
+		db.locked = make(map[ulid.ULID]bool)
+	}
+
+	db.locked[projectID] = true
+}
+
+// RekeyProject re-encrypts the stored values of a project with key, and sets it
+// as the project's key. A nil key decrypts the values. Values are read with the
+// current key of the project; plaintext values are always readable, so an
+// interrupted rekey from plaintext can be retried. Values are encrypted and
+// committed while holding the read lock of the project keys (see
+// `updateEncrypted`), so writes are blocked while rekeying, and a value that's
+// encrypted with the previous key is always committed before it's rekeyed.
+// Reads that are in progress while rekeying can fail to decrypt values.
+func (db *Database) RekeyProject(ctx context.Context, projectID ulid.ULID, key []byte) error {
+	var aead cipher.AEAD
+
+	if key != nil {
+		var err error
+
+		aead, err = newAEAD(key)
+		if err != nil {
+			return err
+		}
+	}
+
+	db.keysMu.Lock()
+	defer db.keysMu.Unlock()
+
+	if db.locked[projectID] {
+		return ErrProjectLocked
+	}
+
+	txn := db.badger.NewTransaction(false)
+	defer txn.Discard()
+
+	keys, err := projectValueKeys(txn, projectID)
+	if err != nil {
+		return fmt.Errorf("badger: failed to find project values: %w", err)
+	}
+
+	writeBatch := db.badger.NewWriteBatch()
+	defer writeBatch.Cancel()
+
+	for _, k := range keys {
+		item, err := txn.Get(k)
+		if errors.Is(err, badger.ErrKeyNotFound) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("badger: failed to get value: %w", err)
+		}
+
+		value, err := item.ValueCopy(nil)
+		if err != nil {
+			return fmt.Errorf("badger: failed to copy value: %w", err)
+		}
+
+		plaintext, err := db.openValueLocked(value)
+		if err != nil {
+			return fmt.Errorf("badger: failed to decrypt value: %w", err)
+		}
+
+		value, err = sealValue(aead, projectID, plaintext)
+		if err != nil {
+			return fmt.Errorf("badger: failed to encrypt value: %w", err)
+		}
+
+		if err := writeBatch.Set(k, value); err != nil {
+			return fmt.Errorf("badger: failed to set value: %w", err)
+		}
+	}
+
+	if err := writeBatch.Flush(); err != nil {
+		return fmt.Errorf("badger: failed to commit batch write: %w", err)
+	}
+
+	db.setProjectAEADLocked(projectID, aead)
+
+	return nil
+}
+
+func (db *Database) setProjectAEADLocked(projectID ulid.ULID, aead cipher.AEAD) {
+	delete(db.locked, projectID)
+
+	if aead == nil {
+		delete(db.keys, projectID)
+		return
+	}
+
+	if db.keys == nil {
+		db.keys = make(map[ulid.ULID]cipher.AEAD)
+	}
+
+	db.keys[projectID] = aead
+}
+
+// updateEncrypted runs fn in a read-write transaction, while holding the read
+// lock of the project keys until the transaction is committed. Values must be
+// encrypted with `encryptValueLocked` in fn, so they can't be committed after
+// their project is rekeyed.
+func (db *Database) updateEncrypted(fn func(txn *badger.Txn) error) error {
+	db.keysMu.RLock()
+	defer db.keysMu.RUnlock()
+
+	return db.badger.Update(fn)
+}
+
+// encryptValueLocked encrypts a value of a project with the project's key, if
+// any. The caller must hold `keysMu`.
+func (db *Database) encryptValueLocked(projectID ulid.ULID, value []byte) ([]byte, error) {
+	if db.locked[projectID] {
+		return nil, ErrProjectLocked
+	}
+
+	return sealValue(db.keys[projectID], projectID, value)
+}
+
+// decryptValue decrypts a value with the key of its project. Plaintext values
+// are returned as-is.
+func (db *Database) decryptValue(value []byte) ([]byte, error) {
+	db.keysMu.RLock()
+	defer db.keysMu.RUnlock()
+
+	return db.openValueLocked(value)
+}
+
+func (db *Database) openValueLocked(value []byte) ([]byte, error) {
+	if len(value) == 0 || value[0] != encryptedValueMarker {
+		return value, nil
+	}
+
+	projectID, ok := encryptedValueProjectID(value)
+	if !ok {
+		return nil, errors.New("badger: encrypted value is too short")
+	}
+
+	aead, ok := db.keys[projectID]
+	if !ok {
+		return nil, ErrProjectLocked
+	}
+
+	// Encrypted values consist of: | marker | project ID | nonce | ciphertext
+	nonceStart := 1 + len(projectID)
+	if len(value) < nonceStart+aead.NonceSize() {
+		return nil, errors.New("badger: encrypted value is too short")
+	}
+
+	nonce := value[nonceStart : nonceStart+aead.NonceSize()]
+
+	plaintext, err := aead.Open(nil, nonce, value[nonceStart+aead.NonceSize():], projectID[:])
+	if err != nil {
+		return nil, fmt.Errorf("badger: failed to decrypt value: %w", err)
+	}
+
+	return plaintext, nil
+}
+
+// sealValue encrypts value with aead. The project ID is stored in plaintext,
+// so the key for decrypting can be looked up, and is authenticated. If aead is
+// nil, value is returned as-is.
+func sealValue(aead cipher.AEAD, projectID ulid.ULID, value []byte) ([]byte, error) {
+	if aead == nil {
+		return value, nil
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	sealed := make([]byte, 0, 1+len(projectID)+len(nonce)+len(value)+aead.Overhead())
+	sealed = append(sealed, encryptedValueMarker)
+	sealed = append(sealed, projectID[:]...)
+	sealed = append(sealed, nonce...)
+
+	return aead.Seal(sealed, nonce, value, projectID[:]), nil
+}
+
+func encryptedValueProjectID(value []byte) (ulid.ULID, bool) {
+	var projectID ulid.ULID
+
+	if len(value) < 1+len(projectID) {
+		return ulid.ULID{}, false
+	}
+
+	copy(projectID[:], value[1:])
+
+	return projectID, true
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	if len(key) != 32 {
+		return nil, fmt.Errorf("badger: invalid key size %v, must be 32 bytes", len(key))
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("badger: failed to create cipher: %w", err)
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// requestLogProjectID returns the project ID of a stored request log, without
+// decrypting it.
+func requestLogProjectID(txn *badger.Txn, reqLogID ulid.ULID) (ulid.ULID, error) {
+	item, err := txn.Get(entryKey(reqLogPrefix, 0, reqLogID[:]))
+	if err != nil {
+		return ulid.ULID{}, err
+	}
+
+	var projectID ulid.ULID
+
+	err = item.Value(func(value []byte) error {
+		if len(value) > 0 && value[0] == encryptedValueMarker {
+			var ok bool
+			if projectID, ok = encryptedValueProjectID(value); !ok {
+				return errors.New("encrypted value is too short")
+			}
+
+			return nil
+		}
+
+		var reqLog reqlog.RequestLog
+		if err := gob.NewDecoder(bytes.NewReader(value)).Decode(&reqLog); err != nil {
+			return fmt.Errorf("failed to decode request log: %w", err)
+		}
+
+		projectID = reqLog.ProjectID
+
+		return nil
+	})
+
+	return projectID, err
+}
+
+// projectValueKeys returns the keys of the values of a project that are
+// encrypted: its request logs, response logs, WebSocket messages, sender
+// requests and findings.
+func projectValueKeys(txn *badger.Txn, projectID ulid.ULID) ([][]byte, error) {
+	var keys [][]byte
+
+	reqLogIDs, err := findRequestLogIDsByProjectID(txn, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range reqLogIDs {
+		keys = append(keys, entryKey(reqLogPrefix, 0, id[:]), entryKey(resLogPrefix, 0, id[:]))
+	}
+
+	for _, find := range []struct {
+		prefix byte
+		fn     func(*badger.Txn, ulid.ULID) ([]ulid.ULID, error)
+	}{
+		{wsMsgPrefix, findWebSocketMessageIDsByProjectID},
+		{senderPrefix, findSenderRequestIDsByProjectID},
+		{scanPrefix, findFindingIDsByProjectID},
+	} {
+		ids, err := find.fn(txn, projectID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, id := range ids {
+			keys = append(keys, entryKey(find.prefix, 0, id[:]))
+		}
+	}
+
+	return keys, nil
+}
@@ -0,0 +1,255 @@
+package badger
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	badgerdb "github.com/dgraph-io/badger/v3"
+	"github.com/google/go-cmp/cmp"
+	"github.com/oklog/ulid"
+
+	"github.com/dstotijn/hetty/pkg/reqlog"
+	"github.com/dstotijn/hetty/pkg/sender"
+)
+
+func TestProjectEncryption(t *testing.T) {
+	t.Parallel()
+
+	database, err := OpenDatabase(badgerdb.DefaultOptions("").WithInMemory(true))
+	if err != nil {
+		t.Fatalf("failed to open badger database: %v", err)
+	}
+	defer database.Close()
+
+	ctx := context.Background()
+	key := bytes.Repeat([]byte{0x42}, 32)
+	secret := []byte("session=s3cr3t-t0k3n")
+	projectID := ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+
+	reqLog := reqlog.RequestLog{
+		ID:        ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy),
+		ProjectID: projectID,
+		URL:       mustParseURL(t, "https://example.com/"),
+		Method:    http.MethodGet,
+		Proto:     "HTTP/1.1",
+		Header:    http.Header{"Cookie": []string{string(secret)}},
+		Response: &reqlog.ResponseLog{
+			Proto:      "HTTP/1.1",
+			StatusCode: http.StatusOK,
+			Status:     "200 OK",
+			Header:     http.Header{},
+			Body:       secret,
+		},
+	}
+
+	// Stored before the project is encrypted.
+	if err := database.StoreRequestLog(ctx, reqLog); err != nil {
+		t.Fatalf("unexpected error storing request log: %v", err)
+	}
+
+	if err := database.StoreResponseLog(ctx, reqLog.ID, *reqLog.Response); err != nil {
+		t.Fatalf("unexpected error storing response log: %v", err)
+	}
+
+	assertRaw := func(t *testing.T, rawKey []byte, expPlaintext bool) {
+		t.Helper()
+
+		err := database.badger.View(func(txn *badgerdb.Txn) error {
+			item, err := txn.Get(rawKey)
+			if err != nil {
+				return err
+			}
+
+			value, err := item.ValueCopy(nil)
+			if err != nil {
+				return err
+			}
+
+			if got := bytes.Contains(value, secret); got != expPlaintext {
+				t.Fatalf("unexpected stored value (expected plaintext: %v, got: %v)", expPlaintext, got)
+			}
+
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("unexpected error reading raw value: %v", err)
+		}
+	}
+
+	assertRequestLog := func(t *testing.T) {
+		t.Helper()
+
+		got, err := database.FindRequestLogByID(ctx, reqLog.ID)
+		if err != nil {
+			t.Fatalf("unexpected error finding request log: %v", err)
+		}
+
+		if diff := cmp.Diff(reqLog, got); diff != "" {
+			t.Fatalf("request log not equal (-exp, +got):\n%v", diff)
+		}
+	}
+
+	t.Run("rekey encrypts stored values", func(t *testing.T) {
+		if err := database.RekeyProject(ctx, projectID, key); err != nil {
+			t.Fatalf("unexpected error rekeying project: %v", err)
+		}
+
+		assertRaw(t, entryKey(reqLogPrefix, 0, reqLog.ID[:]), false)
+		assertRaw(t, entryKey(resLogPrefix, 0, reqLog.ID[:]), false)
+		assertRequestLog(t)
+	})
+
+	senderReq := sender.Request{
+		ID:        ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy),
+		ProjectID: projectID,
+		URL:       mustParseURL(t, "https://example.com/"),
+		Method:    http.MethodPost,
+		Proto:     "HTTP/1.1",
+		Header:    http.Header{},
+		Body:      secret,
+	}
+
+	t.Run("stores new values encrypted", func(t *testing.T) {
+		if err := database.StoreSenderRequest(ctx, senderReq); err != nil {
+			t.Fatalf("unexpected error storing sender request: %v", err)
+		}
+
+		assertRaw(t, entryKey(senderPrefix, 0, senderReq.ID[:]), false)
+
+		got, err := database.FindSenderRequestByID(ctx, senderReq.ID)
+		if err != nil {
+			t.Fatalf("unexpected error finding sender request: %v", err)
+		}
+
+		if diff := cmp.Diff(senderReq, got); diff != "" {
+			t.Fatalf("sender request not equal (-exp, +got):\n%v", diff)
+		}
+	})
+
+	t.Run("locked project can't be read or written", func(t *testing.T) {
+		database.LockProject(projectID)
+
+		if _, err := database.FindRequestLogByID(ctx, reqLog.ID); !errors.Is(err, ErrProjectLocked) {
+			t.Fatalf("expected `ErrProjectLocked`, got: %v", err)
+		}
+
+		if err := database.StoreResponseLog(ctx, reqLog.ID, *reqLog.Response); !errors.Is(err, ErrProjectLocked) {
+			t.Fatalf("expected `ErrProjectLocked`, got: %v", err)
+		}
+
+		if err := database.RekeyProject(ctx, projectID, nil); !errors.Is(err, ErrProjectLocked) {
+			t.Fatalf("expected `ErrProjectLocked`, got: %v", err)
+		}
+	})
+
+	t.Run("rekey with nil key decrypts stored values", func(t *testing.T) {
+		if err := database.SetProjectKey(projectID, key); err != nil {
+			t.Fatalf("unexpected error setting project key: %v", err)
+		}
+
+		if err := database.RekeyProject(ctx, projectID, nil); err != nil {
+			t.Fatalf("unexpected error rekeying project: %v", err)
+		}
+
+		assertRaw(t, entryKey(reqLogPrefix, 0, reqLog.ID[:]), true)
+		assertRaw(t, entryKey(senderPrefix, 0, senderReq.ID[:]), true)
+		assertRequestLog(t)
+	})
+}
+
+func TestRekeyProjectConcurrentWrites(t *testing.T) {
+	t.Parallel()
+
+	database, err := OpenDatabase(badgerdb.DefaultOptions("").WithInMemory(true))
+	if err != nil {
+		t.Fatalf("failed to open badger database: %v", err)
+	}
+	defer database.Close()
+
+	ctx := context.Background()
+	keys := [][]byte{bytes.Repeat([]byte{0x42}, 32), bytes.Repeat([]byte{0x43}, 32)}
+	projectID := ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+
+	if err := database.SetProjectKey(projectID, keys[0]); err != nil {
+		t.Fatalf("unexpected error setting project key: %v", err)
+	}
+
+	const writers, writes = 8, 100
+
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs []error
+	)
+
+	for i := 0; i < writers; i++ {
+		reqs := make([]sender.Request, writes)
+		for j := range reqs {
+			reqs[j] = sender.Request{
+				ID:        ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy),
+				ProjectID: projectID,
+				URL:       mustParseURL(t, "https://example.com/"),
+				Method:    http.MethodGet,
+				Proto:     "HTTP/1.1",
+				Header:    http.Header{},
+			}
+		}
+
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for _, req := range reqs {
+				if err := database.StoreSenderRequest(ctx, req); err != nil {
+					mu.Lock()
+					errs = append(errs, err)
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+
+	// Rekey while values are written, alternating between keys.
+	for i := 1; i <= 100; i++ {
+		if err := database.RekeyProject(ctx, projectID, keys[i%2]); err != nil {
+			t.Fatalf("unexpected error rekeying project: %v", err)
+		}
+	}
+
+	wg.Wait()
+
+	if len(errs) > 0 {
+		t.Fatalf("unexpected error storing sender request: %v", errs[0])
+	}
+
+	// Every value must be readable with the final key; values that were
+	// encrypted with a previous key, but committed after rekeying, aren't.
+	got, err := database.FindSenderRequests(ctx, projectID)
+	if err != nil {
+		t.Fatalf("unexpected error finding sender requests: %v", err)
+	}
+
+	if exp := writers * writes; len(got) != exp {
+		t.Fatalf("incorrect number of sender requests (expected: %v, got: %v)", exp, len(got))
+	}
+}
+
+func TestSetProjectKeyInvalidSize(t *testing.T) {
+	t.Parallel()
+
+	database, err := OpenDatabase(badgerdb.DefaultOptions("").WithInMemory(true))
+	if err != nil {
+		t.Fatalf("failed to open badger database: %v", err)
+	}
+	defer database.Close()
+
+	if err := database.SetProjectKey(ulid.MustNew(1, ulidEntropy), []byte("too short")); err == nil {
+		t.Fatal("expected error for invalid key size")
+	}
+}
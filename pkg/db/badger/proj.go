@@ -65,6 +65,21 @@ func (db *Database) DeleteProject(ctx context.Context, projectID ulid.ULID) erro
 		return fmt.Errorf("badger: failed to delete project request logs: %w", err)
 	}
 
+	err = db.ClearWebSocketMessages(ctx, projectID)
+	if err != nil {
+		return fmt.Errorf("badger: failed to delete project WebSocket messages: %w", err)
+	}
+
+	err = db.DeleteSenderRequests(ctx, projectID)
+	if err != nil {
+		return fmt.Errorf("badger: failed to delete project sender requests: %w", err)
+	}
+
+	err = db.ClearFindings(ctx, projectID)
+	if err != nil {
+		return fmt.Errorf("badger: failed to delete project findings: %w", err)
+	}
+
 	err = db.badger.Update(func(txn *badger.Txn) error {
 		return txn.Delete(entryKey(projectPrefix, 0, projectID[:]))
 	})
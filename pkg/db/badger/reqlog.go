@@ -6,6 +6,7 @@ import (
 	"encoding/gob"
 	"errors"
 	"fmt"
+	"sort"
 
 	"github.com/dgraph-io/badger/v3"
 	"github.com/oklog/ulid"
@@ -27,44 +28,134 @@ func (db *Database) FindRequestLogs(ctx context.Context, filter reqlog.FindReque
 		return nil, fmt.Errorf("badger: failed to find request log IDs: %w", err)
 	}
 
+	match, err := reqlog.CompileFilter(filter, scope)
+	if err != nil {
+		return nil, err
+	}
+
 	reqLogs := make([]reqlog.RequestLog, 0, len(reqLogIDs))
 
 	for _, reqLogID := range reqLogIDs {
-		reqLog, err := getRequestLogWithResponse(txn, reqLogID)
+		// Request logs that can't match aren't retrieved.
+		if !filter.Candidates.Contains(reqLogID) {
+			continue
+		}
+
+		reqLog, err := db.getRequestLogWithResponse(txn, reqLogID)
 		if err != nil {
 			return nil, fmt.Errorf("badger: failed to get request log (id: %v): %w", reqLogID.String(), err)
 		}
 
-		if filter.OnlyInScope {
-			if !reqLog.MatchScope(scope) {
-				continue
-			}
+		// TODO: This filter logic should be done as items are retrieved (e.g.
+		// when using a `badger.Iterator`).
+		if !match(reqLog) {
+			continue
 		}
 
-		// Filter by search expression.
-		// TODO: Once pagination is introduced, this filter logic should be done
-		// as items are retrieved (e.g. when using a `badger.Iterator`).
-		if filter.SearchExpr != nil {
-			match, err := reqLog.Matches(filter.SearchExpr)
-			if err != nil {
-				return nil, fmt.Errorf(
-					"badger: failed to match search expression for request log (id: %v): %w",
-					reqLogID.String(), err,
-				)
+		reqLogs = append(reqLogs, reqLog)
+	}
+
+	return reqLogs, nil
+}
+
+// FindRequestLogsPage returns a page of the request logs that match filter.
+// When sorting by timestamp, request logs are only retrieved until the page is
+// full. Otherwise, all matching request logs are retrieved to be sorted.
+func (db *Database) FindRequestLogsPage(
+	ctx context.Context,
+	filter reqlog.FindRequestsFilter,
+	opts reqlog.PageOptions,
+	scope *scope.Scope,
+) (reqlog.RequestLogPage, error) {
+	if filter.ProjectID.Compare(ulid.ULID{}) == 0 {
+		return reqlog.RequestLogPage{}, reqlog.ErrProjectIDMustBeSet
+	}
+
+	txn := db.badger.NewTransaction(false)
+	defer txn.Discard()
+
+	reqLogIDs, err := findRequestLogIDsByProjectID(txn, filter.ProjectID)
+	if err != nil {
+		return reqlog.RequestLogPage{}, fmt.Errorf("badger: failed to find request log IDs: %w", err)
+	}
+
+	match, err := reqlog.CompileFilter(filter, scope)
+	if err != nil {
+		return reqlog.RequestLogPage{}, err
+	}
+
+	// The cursor is compared to request logs by sort key, so it needn't match
+	// the filter itself. Only its ID is needed when sorting by timestamp.
+	cursor := reqlog.RequestLog{ID: opts.After}
+	hasCursor := opts.After.Compare(ulid.ULID{}) != 0
+
+	if hasCursor && opts.SortBy != reqlog.SortByTimestamp {
+		cursor, err = db.getRequestLogWithResponse(txn, opts.After)
+		if errors.Is(err, badger.ErrKeyNotFound) {
+			return reqlog.RequestLogPage{}, reqlog.ErrRequestNotFound
+		}
+
+		if err != nil {
+			return reqlog.RequestLogPage{}, fmt.Errorf("badger: failed to get cursor request log: %w", err)
+		}
+	}
+
+	byTimestamp := opts.SortBy == reqlog.SortByTimestamp
+
+	if byTimestamp && opts.Descending {
+		for i, j := 0, len(reqLogIDs)-1; i < j; i, j = i+1, j-1 {
+			reqLogIDs[i], reqLogIDs[j] = reqLogIDs[j], reqLogIDs[i]
+		}
+	}
+
+	reqLogs := make([]reqlog.RequestLog, 0)
+
+	for _, reqLogID := range reqLogIDs {
+		// Request log IDs are in sort order when sorting by timestamp, so the
+		// page is full once it has one request log more than requested, which
+		// tells there's a next page.
+		if byTimestamp {
+			if opts.First > 0 && len(reqLogs) > opts.First {
+				break
 			}
 
-			if !match {
+			if hasCursor && !opts.Less(cursor, reqlog.RequestLog{ID: reqLogID}) {
 				continue
 			}
 		}
 
+		if !filter.Candidates.Contains(reqLogID) {
+			continue
+		}
+
+		reqLog, err := db.getRequestLogWithResponse(txn, reqLogID)
+		if err != nil {
+			return reqlog.RequestLogPage{}, fmt.Errorf("badger: failed to get request log (id: %v): %w",
+				reqLogID.String(), err)
+		}
+
+		if !match(reqLog) || (!byTimestamp && hasCursor && !opts.Less(cursor, reqLog)) {
+			continue
+		}
+
 		reqLogs = append(reqLogs, reqLog)
 	}
 
-	return reqLogs, nil
+	sort.Slice(reqLogs, func(i, j int) bool {
+		return opts.Less(reqLogs[i], reqLogs[j])
+	})
+
+	page := reqlog.RequestLogPage{RequestLogs: reqLogs}
+
+	if opts.First > 0 && len(reqLogs) > opts.First {
+		page.RequestLogs = reqLogs[:opts.First]
+		page.HasNextPage = true
+	}
+
+	return page, nil
 }
 
-func getRequestLogWithResponse(txn *badger.Txn, reqLogID ulid.ULID) (reqlog.RequestLog, error) {
+func (db *Database) getRequestLog(txn *badger.Txn, reqLogID ulid.ULID) (reqlog.RequestLog, error) {
 	item, err := txn.Get(entryKey(reqLogPrefix, 0, reqLogID[:]))
 	if err != nil {
 		return reqlog.RequestLog{}, fmt.Errorf("failed to lookup request log item: %w", err)
@@ -75,7 +166,12 @@ func getRequestLogWithResponse(txn *badger.Txn, reqLogID ulid.ULID) (reqlog.Requ
 	}
 
 	err = item.Value(func(rawReqLog []byte) error {
-		err = gob.NewDecoder(bytes.NewReader(rawReqLog)).Decode(&reqLog)
+		value, err := db.decryptValue(rawReqLog)
+		if err != nil {
+			return err
+		}
+
+		err = gob.NewDecoder(bytes.NewReader(value)).Decode(&reqLog)
 		if err != nil {
 			return fmt.Errorf("failed to decode request log: %w", err)
 		}
@@ -86,7 +182,16 @@ func getRequestLogWithResponse(txn *badger.Txn, reqLogID ulid.ULID) (reqlog.Requ
 		return reqlog.RequestLog{}, fmt.Errorf("failed to retrieve or parse request log value: %w", err)
 	}
 
-	item, err = txn.Get(entryKey(resLogPrefix, 0, reqLogID[:]))
+	return reqLog, nil
+}
+
+func (db *Database) getRequestLogWithResponse(txn *badger.Txn, reqLogID ulid.ULID) (reqlog.RequestLog, error) {
+	reqLog, err := db.getRequestLog(txn, reqLogID)
+	if err != nil {
+		return reqlog.RequestLog{}, err
+	}
+
+	item, err := txn.Get(entryKey(resLogPrefix, 0, reqLogID[:]))
 
 	if errors.Is(err, badger.ErrKeyNotFound) {
 		return reqLog, nil
@@ -98,7 +203,12 @@ func getRequestLogWithResponse(txn *badger.Txn, reqLogID ulid.ULID) (reqlog.Requ
 
 	err = item.Value(func(rawReslog []byte) error {
 		var resLog reqlog.ResponseLog
-		err = gob.NewDecoder(bytes.NewReader(rawReslog)).Decode(&resLog)
+		value, err := db.decryptValue(rawReslog)
+		if err != nil {
+			return err
+		}
+
+		err = gob.NewDecoder(bytes.NewReader(value)).Decode(&resLog)
 		if err != nil {
 			return fmt.Errorf("failed to decode response log: %w", err)
 		}
@@ -114,11 +224,56 @@ func getRequestLogWithResponse(txn *badger.Txn, reqLogID ulid.ULID) (reqlog.Requ
 	return reqLog, nil
 }
 
+// DistinctHosts returns the unique hosts of all request logs of a project,
+// sorted.
+func (db *Database) DistinctHosts(ctx context.Context, projectID ulid.ULID) ([]string, error) {
+	if projectID.Compare(ulid.ULID{}) == 0 {
+		return nil, reqlog.ErrProjectIDMustBeSet
+	}
+
+	txn := db.badger.NewTransaction(false)
+	defer txn.Discard()
+
+	reqLogIDs, err := findRequestLogIDsByProjectID(txn, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("badger: failed to find request log IDs: %w", err)
+	}
+
+	seen := make(map[string]struct{})
+	hosts := make([]string, 0)
+
+	for _, reqLogID := range reqLogIDs {
+		reqLog, err := db.getRequestLog(txn, reqLogID)
+		if err != nil {
+			return nil, fmt.Errorf("badger: failed to get request log (id: %v): %w", reqLogID.String(), err)
+		}
+
+		if reqLog.URL == nil || reqLog.URL.Host == "" {
+			continue
+		}
+
+		if _, ok := seen[reqLog.URL.Host]; ok {
+			continue
+		}
+
+		seen[reqLog.URL.Host] = struct{}{}
+		hosts = append(hosts, reqLog.URL.Host)
+	}
+
+	sort.Strings(hosts)
+
+	return hosts, nil
+}
+
 func (db *Database) FindRequestLogByID(ctx context.Context, reqLogID ulid.ULID) (reqLog reqlog.RequestLog, err error) {
 	txn := db.badger.NewTransaction(false)
 	defer txn.Discard()
 
-	reqLog, err = getRequestLogWithResponse(txn, reqLogID)
+	reqLog, err = db.getRequestLogWithResponse(txn, reqLogID)
+	if errors.Is(err, badger.ErrKeyNotFound) {
+		return reqlog.RequestLog{}, reqlog.ErrRequestNotFound
+	}
+
 	if err != nil {
 		return reqlog.RequestLog{}, fmt.Errorf("badger: failed to get request log: %w", err)
 	}
@@ -126,6 +281,88 @@ func (db *Database) FindRequestLogByID(ctx context.Context, reqLogID ulid.ULID)
 	return reqLog, nil
 }
 
+// Neighbors returns the request logs immediately preceding and following the
+// request log with the given ID, in order of ID (and thus time), within the
+// same project. For the first and last request logs of a project, prev and next
+// respectively are nil.
+func (db *Database) Neighbors(ctx context.Context, id ulid.ULID) (prev, next *reqlog.RequestLog, err error) {
+	txn := db.badger.NewTransaction(false)
+	defer txn.Discard()
+
+	reqLog, err := db.getRequestLog(txn, id)
+	if errors.Is(err, badger.ErrKeyNotFound) {
+		return nil, nil, reqlog.ErrRequestNotFound
+	}
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("badger: failed to get request log: %w", err)
+	}
+
+	prefix := entryKey(reqLogPrefix, reqLogProjectIDIndex, reqLog.ProjectID[:])
+	indexKey := entryKey(reqLogPrefix, reqLogProjectIDIndex, append(reqLog.ProjectID[:], id[:]...))
+
+	prevID, err := neighborRequestLogID(txn, prefix, indexKey, true)
+	if err != nil {
+		return nil, nil, fmt.Errorf("badger: failed to find previous request log ID: %w", err)
+	}
+
+	nextID, err := neighborRequestLogID(txn, prefix, indexKey, false)
+	if err != nil {
+		return nil, nil, fmt.Errorf("badger: failed to find next request log ID: %w", err)
+	}
+
+	if prevID != nil {
+		reqLog, err := db.getRequestLogWithResponse(txn, *prevID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("badger: failed to get previous request log: %w", err)
+		}
+
+		prev = &reqLog
+	}
+
+	if nextID != nil {
+		reqLog, err := db.getRequestLogWithResponse(txn, *nextID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("badger: failed to get next request log: %w", err)
+		}
+
+		next = &reqLog
+	}
+
+	return prev, next, nil
+}
+
+// neighborRequestLogID returns the ID of the request log that precedes (if
+// reverse is true) or follows the project ID index key. It returns nil if there
+// is none.
+func neighborRequestLogID(txn *badger.Txn, prefix, indexKey []byte, reverse bool) (*ulid.ULID, error) {
+	opts := badger.DefaultIteratorOptions
+	opts.PrefetchValues = false
+	opts.Reverse = reverse
+	iterator := txn.NewIterator(opts)
+	defer iterator.Close()
+
+	iterator.Seek(indexKey)
+
+	// Skip the index key of the request log itself.
+	if iterator.ValidForPrefix(prefix) && bytes.Equal(iterator.Item().Key(), indexKey) {
+		iterator.Next()
+	}
+
+	if !iterator.ValidForPrefix(prefix) {
+		return nil, nil
+	}
+
+	var id ulid.ULID
+	// The request log ID starts *after* the first 2 prefix and index bytes
+	// and the 16 byte project ID.
+	if err := id.UnmarshalBinary(iterator.Item().Key()[18:]); err != nil {
+		return nil, fmt.Errorf("failed to parse request log ID: %w", err)
+	}
+
+	return &id, nil
+}
+
 func (db *Database) StoreRequestLog(ctx context.Context, reqLog reqlog.RequestLog) error {
 	buf := bytes.Buffer{}
 
@@ -134,19 +371,24 @@ func (db *Database) StoreRequestLog(ctx context.Context, reqLog reqlog.RequestLo
 		return fmt.Errorf("badger: failed to encode request log: %w", err)
 	}
 
-	entries := []*badger.Entry{
-		// Request log itself.
-		{
-			Key:   entryKey(reqLogPrefix, 0, reqLog.ID[:]),
-			Value: buf.Bytes(),
-		},
-		// Index by project ID.
-		{
-			Key: entryKey(reqLogPrefix, reqLogProjectIDIndex, append(reqLog.ProjectID[:], reqLog.ID[:]...)),
-		},
-	}
+	err = db.updateEncrypted(func(txn *badger.Txn) error {
+		value, err := db.encryptValueLocked(reqLog.ProjectID, buf.Bytes())
+		if err != nil {
+			return fmt.Errorf("failed to encrypt request log: %w", err)
+		}
+
+		entries := []*badger.Entry{
+			// Request log itself.
+			{
+				Key:   entryKey(reqLogPrefix, 0, reqLog.ID[:]),
+				Value: value,
+			},
+			// Index by project ID.
+			{
+				Key: entryKey(reqLogPrefix, reqLogProjectIDIndex, append(reqLog.ProjectID[:], reqLog.ID[:]...)),
+			},
+		}
 
-	err = db.badger.Update(func(txn *badger.Txn) error {
 		for i := range entries {
 			err := txn.SetEntry(entries[i])
 			if err != nil {
@@ -170,10 +412,22 @@ func (db *Database) StoreResponseLog(ctx context.Context, reqLogID ulid.ULID, re
 		return fmt.Errorf("badger: failed to encode response log: %w", err)
 	}
 
-	err = db.badger.Update(func(txn *badger.Txn) error {
+	err = db.updateEncrypted(func(txn *badger.Txn) error {
+		// Response logs are encrypted with the key of the project of their
+		// request log.
+		projectID, err := requestLogProjectID(txn, reqLogID)
+		if err != nil && !errors.Is(err, badger.ErrKeyNotFound) {
+			return fmt.Errorf("failed to get project ID of request log: %w", err)
+		}
+
+		value, err := db.encryptValueLocked(projectID, buf.Bytes())
+		if err != nil {
+			return fmt.Errorf("failed to encrypt response log: %w", err)
+		}
+
 		return txn.SetEntry(&badger.Entry{
 			Key:   entryKey(resLogPrefix, 0, reqLogID[:]),
-			Value: buf.Bytes(),
+			Value: value,
 		})
 	})
 	if err != nil {
@@ -223,6 +477,32 @@ func (db *Database) ClearRequestLogs(ctx context.Context, projectID ulid.ULID) e
 	return nil
 }
 
+// DeleteRequestLogs removes request logs of a project, and their response logs.
+func (db *Database) DeleteRequestLogs(ctx context.Context, projectID ulid.ULID, reqLogIDs []ulid.ULID) error {
+	writeBatch := db.badger.NewWriteBatch()
+	defer writeBatch.Cancel()
+
+	for _, reqLogID := range reqLogIDs {
+		keys := [][]byte{
+			entryKey(reqLogPrefix, 0, reqLogID[:]),
+			entryKey(resLogPrefix, 0, reqLogID[:]),
+			entryKey(reqLogPrefix, reqLogProjectIDIndex, append(projectID[:], reqLogID[:]...)),
+		}
+
+		for _, key := range keys {
+			if err := writeBatch.Delete(key); err != nil {
+				return fmt.Errorf("badger: failed to delete request log: %w", err)
+			}
+		}
+	}
+
+	if err := writeBatch.Flush(); err != nil {
+		return fmt.Errorf("badger: failed to commit batch write: %w", err)
+	}
+
+	return nil
+}
+
 func findRequestLogIDsByProjectID(txn *badger.Txn, projectID ulid.ULID) ([]ulid.ULID, error) {
 	reqLogIDs := make([]ulid.ULID, 0)
 	opts := badger.DefaultIteratorOptions
@@ -106,9 +106,156 @@ func TestFindRequestLogs(t *testing.T) {
 		if diff := cmp.Diff(exp, got); diff != "" {
 			t.Fatalf("request logs not equal (-exp, +got):\n%v", diff)
 		}
+
+		// Only candidates are returned.
+		filter.Candidates = reqlog.NewCandidateSet([]ulid.ULID{exp[1].ID})
+
+		got, err = database.FindRequestLogs(context.Background(), filter, nil)
+		if err != nil {
+			t.Fatalf("unexpected error finding request logs: %v", err)
+		}
+
+		if diff := cmp.Diff(exp[1:], got); diff != "" {
+			t.Fatalf("candidate request logs not equal (-exp, +got):\n%v", diff)
+		}
 	})
 }
 
+func TestFindRequestLogsPage(t *testing.T) {
+	t.Parallel()
+
+	database, err := OpenDatabase(badgerdb.DefaultOptions("").WithInMemory(true))
+	if err != nil {
+		t.Fatalf("failed to open badger database: %v", err)
+	}
+	t.Cleanup(func() { database.Close() })
+
+	projectID := ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+	now := ulid.Timestamp(time.Now())
+
+	// Request logs in order of timestamp, with a response status code and
+	// duration, or without response.
+	fixtures := []struct {
+		statusCode int
+		duration   time.Duration
+	}{
+		{statusCode: 200, duration: 30 * time.Millisecond},
+		{statusCode: 404, duration: 10 * time.Millisecond},
+		{},
+		{statusCode: 200, duration: 20 * time.Millisecond},
+	}
+
+	ids := make([]ulid.ULID, len(fixtures))
+
+	for i, fixture := range fixtures {
+		ids[i] = ulid.MustNew(now+uint64(i), ulidEntropy)
+
+		reqLog := reqlog.RequestLog{
+			ID:        ids[i],
+			ProjectID: projectID,
+			URL:       mustParseURL(t, "https://example.com/"),
+			Method:    http.MethodGet,
+		}
+
+		if err := database.StoreRequestLog(context.Background(), reqLog); err != nil {
+			t.Fatalf("unexpected error creating request log fixture: %v", err)
+		}
+
+		if fixture.statusCode == 0 {
+			continue
+		}
+
+		resLog := reqlog.ResponseLog{StatusCode: fixture.statusCode, Duration: fixture.duration}
+		if err := database.StoreResponseLog(context.Background(), reqLog.ID, resLog); err != nil {
+			t.Fatalf("unexpected error creating response log fixture: %v", err)
+		}
+	}
+
+	tests := []struct {
+		name           string
+		opts           reqlog.PageOptions
+		expIDs         []ulid.ULID
+		expHasNextPage bool
+		expectedError  error
+	}{
+		{
+			name:           "first page, by timestamp",
+			opts:           reqlog.PageOptions{First: 2},
+			expIDs:         []ulid.ULID{ids[0], ids[1]},
+			expHasNextPage: true,
+		},
+		{
+			name:           "last page, by timestamp",
+			opts:           reqlog.PageOptions{First: 2, After: ids[1]},
+			expIDs:         []ulid.ULID{ids[2], ids[3]},
+			expHasNextPage: false,
+		},
+		{
+			name:           "by timestamp, descending",
+			opts:           reqlog.PageOptions{First: 3, Descending: true},
+			expIDs:         []ulid.ULID{ids[3], ids[2], ids[1]},
+			expHasNextPage: true,
+		},
+		{
+			name:   "all after cursor, by timestamp",
+			opts:   reqlog.PageOptions{After: ids[0]},
+			expIDs: []ulid.ULID{ids[1], ids[2], ids[3]},
+		},
+		{
+			// Request logs with the same status code are ordered by ID.
+			name:           "by status code",
+			opts:           reqlog.PageOptions{First: 3, SortBy: reqlog.SortByStatusCode},
+			expIDs:         []ulid.ULID{ids[2], ids[0], ids[3]},
+			expHasNextPage: true,
+		},
+		{
+			name:   "by status code, after cursor with equal status code",
+			opts:   reqlog.PageOptions{First: 3, After: ids[0], SortBy: reqlog.SortByStatusCode},
+			expIDs: []ulid.ULID{ids[3], ids[1]},
+		},
+		{
+			name:   "by duration, descending",
+			opts:   reqlog.PageOptions{SortBy: reqlog.SortByDuration, Descending: true},
+			expIDs: []ulid.ULID{ids[0], ids[3], ids[1], ids[2]},
+		},
+		{
+			name:          "unknown cursor",
+			opts:          reqlog.PageOptions{After: ulid.MustNew(now, ulidEntropy), SortBy: reqlog.SortByDuration},
+			expectedError: reqlog.ErrRequestNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			filter := reqlog.FindRequestsFilter{ProjectID: projectID}
+
+			page, err := database.FindRequestLogsPage(context.Background(), filter, tt.opts, nil)
+			if !errors.Is(err, tt.expectedError) {
+				t.Fatalf("unexpected error (expected: %v, got: %v)", tt.expectedError, err)
+			}
+
+			gotIDs := make([]ulid.ULID, len(page.RequestLogs))
+			for i, reqLog := range page.RequestLogs {
+				gotIDs[i] = reqLog.ID
+			}
+
+			if tt.expectedError == nil {
+				if diff := cmp.Diff(tt.expIDs, gotIDs); diff != "" {
+					t.Fatalf("request log IDs not equal (-exp, +got):\n%v", diff)
+				}
+			}
+
+			if page.HasNextPage != tt.expHasNextPage {
+				t.Fatalf("incorrect `HasNextPage` (expected: %v, got: %v)", tt.expHasNextPage, page.HasNextPage)
+			}
+		})
+	}
+}
+
 func mustParseURL(t *testing.T, s string) *url.URL {
 	t.Helper()
 
@@ -119,3 +266,127 @@ func mustParseURL(t *testing.T, s string) *url.URL {
 
 	return u
 }
+
+func TestDistinctHosts(t *testing.T) {
+	t.Parallel()
+
+	database, err := OpenDatabase(badgerdb.DefaultOptions("").WithInMemory(true))
+	if err != nil {
+		t.Fatalf("failed to open badger database: %v", err)
+	}
+	defer database.Close()
+
+	projectID := ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+	otherProjectID := ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+
+	fixtures := []reqlog.RequestLog{
+		{ProjectID: projectID, URL: mustParseURL(t, "https://example.com/foo")},
+		{ProjectID: projectID, URL: mustParseURL(t, "http://api.example.org:8080/")},
+		{ProjectID: projectID, URL: mustParseURL(t, "https://example.com/bar")},
+		{ProjectID: projectID, URL: mustParseURL(t, "https://beta.example.com/")},
+		{ProjectID: otherProjectID, URL: mustParseURL(t, "https://other.example.com/")},
+	}
+
+	for i, reqLog := range fixtures {
+		reqLog.ID = ulid.MustNew(ulid.Timestamp(time.Now())+uint64(i), ulidEntropy)
+
+		if err := database.StoreRequestLog(context.Background(), reqLog); err != nil {
+			t.Fatalf("unexpected error creating request log fixture: %v", err)
+		}
+	}
+
+	got, err := database.DistinctHosts(context.Background(), projectID)
+	if err != nil {
+		t.Fatalf("unexpected error finding distinct hosts: %v", err)
+	}
+
+	exp := []string{"api.example.org:8080", "beta.example.com", "example.com"}
+
+	if diff := cmp.Diff(exp, got); diff != "" {
+		t.Fatalf("hosts not equal (-exp, +got):\n%v", diff)
+	}
+}
+
+func TestNeighbors(t *testing.T) {
+	t.Parallel()
+
+	database, err := OpenDatabase(badgerdb.DefaultOptions("").WithInMemory(true))
+	if err != nil {
+		t.Fatalf("failed to open badger database: %v", err)
+	}
+	defer database.Close()
+
+	projectID := ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+	otherProjectID := ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+
+	fixtures := []reqlog.RequestLog{
+		{ProjectID: projectID, Method: "GET", URL: mustParseURL(t, "https://example.com/first")},
+		// Logged in between, but in another project.
+		{ProjectID: otherProjectID, Method: "GET", URL: mustParseURL(t, "https://example.com/other")},
+		{ProjectID: projectID, Method: "GET", URL: mustParseURL(t, "https://example.com/middle")},
+		{ProjectID: projectID, Method: "GET", URL: mustParseURL(t, "https://example.com/last")},
+	}
+
+	for i := range fixtures {
+		fixtures[i].ID = ulid.MustNew(ulid.Timestamp(time.Now())+uint64(i), ulidEntropy)
+
+		if err := database.StoreRequestLog(context.Background(), fixtures[i]); err != nil {
+			t.Fatalf("unexpected error creating request log fixture: %v", err)
+		}
+	}
+
+	first, middle, last := fixtures[0], fixtures[2], fixtures[3]
+
+	tests := []struct {
+		name    string
+		id      ulid.ULID
+		expPrev *reqlog.RequestLog
+		expNext *reqlog.RequestLog
+	}{
+		{
+			name:    "middle entry",
+			id:      middle.ID,
+			expPrev: &first,
+			expNext: &last,
+		},
+		{
+			name:    "first entry",
+			id:      first.ID,
+			expPrev: nil,
+			expNext: &middle,
+		},
+		{
+			name:    "last entry",
+			id:      last.ID,
+			expPrev: &middle,
+			expNext: nil,
+		},
+	}
+
+	// Subtests aren't run in parallel, because the database is closed once
+	// the test function returns.
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			prev, next, err := database.Neighbors(context.Background(), tt.id)
+			if err != nil {
+				t.Fatalf("unexpected error finding neighbors: %v", err)
+			}
+
+			if diff := cmp.Diff(tt.expPrev, prev); diff != "" {
+				t.Errorf("previous request log not equal (-exp, +got):\n%v", diff)
+			}
+
+			if diff := cmp.Diff(tt.expNext, next); diff != "" {
+				t.Errorf("next request log not equal (-exp, +got):\n%v", diff)
+			}
+		})
+	}
+
+	t.Run("unknown request log", func(t *testing.T) {
+		_, _, err := database.Neighbors(context.Background(), ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy))
+		if !errors.Is(err, reqlog.ErrRequestNotFound) {
+			t.Fatalf("expected error: %v, got: %v", reqlog.ErrRequestNotFound, err)
+		}
+	})
+}
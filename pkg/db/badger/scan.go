@@ -0,0 +1,189 @@
+package badger
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"errors"
+	"fmt"
+
+	"github.com/dgraph-io/badger/v3"
+	"github.com/oklog/ulid"
+
+	"github.com/dstotijn/hetty/pkg/scan"
+)
+
+func (db *Database) FindFindingByID(ctx context.Context, id ulid.ULID) (scan.Finding, error) {
+	txn := db.badger.NewTransaction(false)
+	defer txn.Discard()
+
+	finding, err := db.getFinding(txn, id)
+	if errors.Is(err, badger.ErrKeyNotFound) {
+		return scan.Finding{}, scan.ErrFindingNotFound
+	}
+
+	if err != nil {
+		return scan.Finding{}, fmt.Errorf("badger: failed to get finding: %w", err)
+	}
+
+	return finding, nil
+}
+
+// FindFindings returns the findings of a project, in the order they were
+// found.
+func (db *Database) FindFindings(ctx context.Context, projectID ulid.ULID) ([]scan.Finding, error) {
+	if projectID.Compare(ulid.ULID{}) == 0 {
+		return nil, scan.ErrProjectIDMustBeSet
+	}
+
+	txn := db.badger.NewTransaction(false)
+	defer txn.Discard()
+
+	findingIDs, err := findFindingIDsByProjectID(txn, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("badger: failed to find finding IDs: %w", err)
+	}
+
+	findings := make([]scan.Finding, 0, len(findingIDs))
+
+	for _, findingID := range findingIDs {
+		finding, err := db.getFinding(txn, findingID)
+		if err != nil {
+			return nil, fmt.Errorf("badger: failed to get finding (id: %v): %w", findingID.String(), err)
+		}
+
+		findings = append(findings, finding)
+	}
+
+	return findings, nil
+}
+
+func (db *Database) getFinding(txn *badger.Txn, findingID ulid.ULID) (scan.Finding, error) {
+	item, err := txn.Get(entryKey(scanPrefix, 0, findingID[:]))
+	if err != nil {
+		return scan.Finding{}, fmt.Errorf("failed to lookup finding item: %w", err)
+	}
+
+	finding := scan.Finding{
+		ID: findingID,
+	}
+
+	err = item.Value(func(rawFinding []byte) error {
+		value, err := db.decryptValue(rawFinding)
+		if err != nil {
+			return err
+		}
+
+		err = gob.NewDecoder(bytes.NewReader(value)).Decode(&finding)
+		if err != nil {
+			return fmt.Errorf("failed to decode finding: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return scan.Finding{}, fmt.Errorf("failed to retrieve or parse finding value: %w", err)
+	}
+
+	return finding, nil
+}
+
+// StoreFinding creates or overwrites a finding.
+func (db *Database) StoreFinding(ctx context.Context, finding scan.Finding) error {
+	buf := bytes.Buffer{}
+
+	err := gob.NewEncoder(&buf).Encode(finding)
+	if err != nil {
+		return fmt.Errorf("badger: failed to encode finding: %w", err)
+	}
+
+	err = db.updateEncrypted(func(txn *badger.Txn) error {
+		value, err := db.encryptValueLocked(finding.ProjectID, buf.Bytes())
+		if err != nil {
+			return fmt.Errorf("failed to encrypt finding: %w", err)
+		}
+
+		entries := []*badger.Entry{
+			// Finding itself.
+			{
+				Key:   entryKey(scanPrefix, 0, finding.ID[:]),
+				Value: value,
+			},
+			// Index by project ID.
+			{
+				Key: entryKey(scanPrefix, scanProjectIDIndex, append(finding.ProjectID[:], finding.ID[:]...)),
+			},
+		}
+
+		for i := range entries {
+			err := txn.SetEntry(entries[i])
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("badger: failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (db *Database) ClearFindings(ctx context.Context, projectID ulid.ULID) error {
+	txn := db.badger.NewTransaction(false)
+	defer txn.Discard()
+
+	findingIDs, err := findFindingIDsByProjectID(txn, projectID)
+	if err != nil {
+		return fmt.Errorf("badger: failed to find finding IDs: %w", err)
+	}
+
+	writeBatch := db.badger.NewWriteBatch()
+	defer writeBatch.Cancel()
+
+	for _, findingID := range findingIDs {
+		err := writeBatch.Delete(entryKey(scanPrefix, 0, findingID[:]))
+		if err != nil {
+			return fmt.Errorf("badger: failed to delete finding: %w", err)
+		}
+	}
+
+	if err := writeBatch.Flush(); err != nil {
+		return fmt.Errorf("badger: failed to commit batch write: %w", err)
+	}
+
+	err = db.badger.DropPrefix(entryKey(scanPrefix, scanProjectIDIndex, projectID[:]))
+	if err != nil {
+		return fmt.Errorf("badger: failed to drop finding project ID index items: %w", err)
+	}
+
+	return nil
+}
+
+func findFindingIDsByProjectID(txn *badger.Txn, projectID ulid.ULID) ([]ulid.ULID, error) {
+	findingIDs := make([]ulid.ULID, 0)
+	opts := badger.DefaultIteratorOptions
+	opts.PrefetchValues = false
+	iterator := txn.NewIterator(opts)
+	defer iterator.Close()
+
+	var projectIndexKey []byte
+
+	prefix := entryKey(scanPrefix, scanProjectIDIndex, projectID[:])
+
+	for iterator.Seek(prefix); iterator.ValidForPrefix(prefix); iterator.Next() {
+		projectIndexKey = iterator.Item().KeyCopy(projectIndexKey)
+
+		var id ulid.ULID
+		// The finding ID starts *after* the first 2 prefix and index bytes and
+		// the 16 byte project ID.
+		if err := id.UnmarshalBinary(projectIndexKey[18:]); err != nil {
+			return nil, fmt.Errorf("failed to parse finding ID: %w", err)
+		}
+
+		findingIDs = append(findingIDs, id)
+	}
+
+	return findingIDs, nil
+}
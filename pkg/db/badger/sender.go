@@ -0,0 +1,189 @@
+package badger
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"errors"
+	"fmt"
+
+	"github.com/dgraph-io/badger/v3"
+	"github.com/oklog/ulid"
+
+	"github.com/dstotijn/hetty/pkg/sender"
+)
+
+func (db *Database) FindSenderRequestByID(ctx context.Context, id ulid.ULID) (sender.Request, error) {
+	txn := db.badger.NewTransaction(false)
+	defer txn.Discard()
+
+	req, err := db.getSenderRequest(txn, id)
+	if errors.Is(err, badger.ErrKeyNotFound) {
+		return sender.Request{}, sender.ErrRequestNotFound
+	}
+
+	if err != nil {
+		return sender.Request{}, fmt.Errorf("badger: failed to get sender request: %w", err)
+	}
+
+	return req, nil
+}
+
+// FindSenderRequests returns the sender requests of a project, in the order
+// they were created.
+func (db *Database) FindSenderRequests(ctx context.Context, projectID ulid.ULID) ([]sender.Request, error) {
+	if projectID.Compare(ulid.ULID{}) == 0 {
+		return nil, sender.ErrProjectIDMustBeSet
+	}
+
+	txn := db.badger.NewTransaction(false)
+	defer txn.Discard()
+
+	reqIDs, err := findSenderRequestIDsByProjectID(txn, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("badger: failed to find sender request IDs: %w", err)
+	}
+
+	reqs := make([]sender.Request, 0, len(reqIDs))
+
+	for _, reqID := range reqIDs {
+		req, err := db.getSenderRequest(txn, reqID)
+		if err != nil {
+			return nil, fmt.Errorf("badger: failed to get sender request (id: %v): %w", reqID.String(), err)
+		}
+
+		reqs = append(reqs, req)
+	}
+
+	return reqs, nil
+}
+
+func (db *Database) getSenderRequest(txn *badger.Txn, reqID ulid.ULID) (sender.Request, error) {
+	item, err := txn.Get(entryKey(senderPrefix, 0, reqID[:]))
+	if err != nil {
+		return sender.Request{}, fmt.Errorf("failed to lookup sender request item: %w", err)
+	}
+
+	req := sender.Request{
+		ID: reqID,
+	}
+
+	err = item.Value(func(rawReq []byte) error {
+		value, err := db.decryptValue(rawReq)
+		if err != nil {
+			return err
+		}
+
+		err = gob.NewDecoder(bytes.NewReader(value)).Decode(&req)
+		if err != nil {
+			return fmt.Errorf("failed to decode sender request: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return sender.Request{}, fmt.Errorf("failed to retrieve or parse sender request value: %w", err)
+	}
+
+	return req, nil
+}
+
+// StoreSenderRequest creates or overwrites a sender request.
+func (db *Database) StoreSenderRequest(ctx context.Context, req sender.Request) error {
+	buf := bytes.Buffer{}
+
+	err := gob.NewEncoder(&buf).Encode(req)
+	if err != nil {
+		return fmt.Errorf("badger: failed to encode sender request: %w", err)
+	}
+
+	err = db.updateEncrypted(func(txn *badger.Txn) error {
+		value, err := db.encryptValueLocked(req.ProjectID, buf.Bytes())
+		if err != nil {
+			return fmt.Errorf("failed to encrypt sender request: %w", err)
+		}
+
+		entries := []*badger.Entry{
+			// Sender request itself.
+			{
+				Key:   entryKey(senderPrefix, 0, req.ID[:]),
+				Value: value,
+			},
+			// Index by project ID.
+			{
+				Key: entryKey(senderPrefix, senderProjectIDIndex, append(req.ProjectID[:], req.ID[:]...)),
+			},
+		}
+
+		for i := range entries {
+			err := txn.SetEntry(entries[i])
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("badger: failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (db *Database) DeleteSenderRequests(ctx context.Context, projectID ulid.ULID) error {
+	txn := db.badger.NewTransaction(false)
+	defer txn.Discard()
+
+	reqIDs, err := findSenderRequestIDsByProjectID(txn, projectID)
+	if err != nil {
+		return fmt.Errorf("badger: failed to find sender request IDs: %w", err)
+	}
+
+	writeBatch := db.badger.NewWriteBatch()
+	defer writeBatch.Cancel()
+
+	for _, reqID := range reqIDs {
+		err := writeBatch.Delete(entryKey(senderPrefix, 0, reqID[:]))
+		if err != nil {
+			return fmt.Errorf("badger: failed to delete sender request: %w", err)
+		}
+	}
+
+	if err := writeBatch.Flush(); err != nil {
+		return fmt.Errorf("badger: failed to commit batch write: %w", err)
+	}
+
+	err = db.badger.DropPrefix(entryKey(senderPrefix, senderProjectIDIndex, projectID[:]))
+	if err != nil {
+		return fmt.Errorf("badger: failed to drop sender request project ID index items: %w", err)
+	}
+
+	return nil
+}
+
+func findSenderRequestIDsByProjectID(txn *badger.Txn, projectID ulid.ULID) ([]ulid.ULID, error) {
+	reqIDs := make([]ulid.ULID, 0)
+	opts := badger.DefaultIteratorOptions
+	opts.PrefetchValues = false
+	iterator := txn.NewIterator(opts)
+	defer iterator.Close()
+
+	var projectIndexKey []byte
+
+	prefix := entryKey(senderPrefix, senderProjectIDIndex, projectID[:])
+
+	for iterator.Seek(prefix); iterator.ValidForPrefix(prefix); iterator.Next() {
+		projectIndexKey = iterator.Item().KeyCopy(projectIndexKey)
+
+		var id ulid.ULID
+		// The request ID starts *after* the first 2 prefix and index bytes and
+		// the 16 byte project ID.
+		if err := id.UnmarshalBinary(projectIndexKey[18:]); err != nil {
+			return nil, fmt.Errorf("failed to parse sender request ID: %w", err)
+		}
+
+		reqIDs = append(reqIDs, id)
+	}
+
+	return reqIDs, nil
+}
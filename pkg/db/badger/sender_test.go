@@ -0,0 +1,130 @@
+package badger
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	badgerdb "github.com/dgraph-io/badger/v3"
+	"github.com/google/go-cmp/cmp"
+	"github.com/oklog/ulid"
+
+	"github.com/dstotijn/hetty/pkg/reqlog"
+	"github.com/dstotijn/hetty/pkg/sender"
+)
+
+func TestFindSenderRequests(t *testing.T) {
+	t.Parallel()
+
+	t.Run("without project ID", func(t *testing.T) {
+		t.Parallel()
+
+		database, err := OpenDatabase(badgerdb.DefaultOptions("").WithInMemory(true))
+		if err != nil {
+			t.Fatalf("failed to open badger database: %v", err)
+		}
+		defer database.Close()
+
+		_, err = database.FindSenderRequests(context.Background(), ulid.ULID{})
+		if !errors.Is(err, sender.ErrProjectIDMustBeSet) {
+			t.Fatalf("expected `sender.ErrProjectIDMustBeSet`, got: %v", err)
+		}
+	})
+
+	t.Run("unknown ID", func(t *testing.T) {
+		t.Parallel()
+
+		database, err := OpenDatabase(badgerdb.DefaultOptions("").WithInMemory(true))
+		if err != nil {
+			t.Fatalf("failed to open badger database: %v", err)
+		}
+		defer database.Close()
+
+		_, err = database.FindSenderRequestByID(context.Background(), ulid.MustNew(1, ulidEntropy))
+		if !errors.Is(err, sender.ErrRequestNotFound) {
+			t.Fatalf("expected `sender.ErrRequestNotFound`, got: %v", err)
+		}
+	})
+
+	t.Run("returns requests of project and deletes them", func(t *testing.T) {
+		t.Parallel()
+
+		database, err := OpenDatabase(badgerdb.DefaultOptions("").WithInMemory(true))
+		if err != nil {
+			t.Fatalf("failed to open badger database: %v", err)
+		}
+		defer database.Close()
+
+		projectID := ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+		otherProjectID := ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+		u := &url.URL{Scheme: "https", Host: "example.com", Path: "/foo"}
+
+		reqs := []sender.Request{
+			{
+				ID:        ulid.MustNew(1, ulidEntropy),
+				ProjectID: projectID,
+				URL:       u,
+				Method:    http.MethodGet,
+				Header:    http.Header{"X-Foo": []string{"bar"}},
+				Attempts: []sender.Attempt{
+					{
+						ID:       ulid.MustNew(4, ulidEntropy),
+						URL:      u,
+						Method:   http.MethodGet,
+						Response: &reqlog.ResponseLog{StatusCode: 200, Status: "200 OK", Body: []byte("foo")},
+					},
+				},
+			},
+			{
+				ID:        ulid.MustNew(2, ulidEntropy),
+				ProjectID: otherProjectID,
+				URL:       u,
+				Method:    http.MethodPost,
+			},
+			{
+				ID:        ulid.MustNew(3, ulidEntropy),
+				ProjectID: projectID,
+				URL:       u,
+				Method:    http.MethodPut,
+				Body:      []byte("baz"),
+			},
+		}
+
+		for _, req := range reqs {
+			if err := database.StoreSenderRequest(context.Background(), req); err != nil {
+				t.Fatalf("unexpected error storing request: %v", err)
+			}
+		}
+
+		got, err := database.FindSenderRequests(context.Background(), projectID)
+		if err != nil {
+			t.Fatalf("unexpected error finding requests: %v", err)
+		}
+
+		exp := []sender.Request{reqs[0], reqs[2]}
+		if diff := cmp.Diff(exp, got); diff != "" {
+			t.Fatalf("requests not equal (-exp, +got):\n%v", diff)
+		}
+
+		if err := database.DeleteSenderRequests(context.Background(), projectID); err != nil {
+			t.Fatalf("unexpected error deleting requests: %v", err)
+		}
+
+		got, err = database.FindSenderRequests(context.Background(), projectID)
+		if err != nil {
+			t.Fatalf("unexpected error finding requests: %v", err)
+		}
+
+		if len(got) != 0 {
+			t.Fatalf("expected no requests after deleting, got: %v", len(got))
+		}
+
+		// Requests of other projects are kept.
+		if _, err := database.FindSenderRequestByID(context.Background(), reqs[1].ID); err != nil {
+			t.Fatalf("unexpected error finding request of other project: %v", err)
+		}
+	})
+}
@@ -0,0 +1,189 @@
+package badger
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"fmt"
+
+	"github.com/dgraph-io/badger/v3"
+	"github.com/oklog/ulid"
+
+	"github.com/dstotijn/hetty/pkg/wslog"
+)
+
+// FindWebSocketMessages returns the WebSocket messages of a project, in the
+// order they were logged.
+func (db *Database) FindWebSocketMessages(
+	ctx context.Context,
+	filter wslog.FindMessagesFilter,
+) ([]wslog.Message, error) {
+	if filter.ProjectID.Compare(ulid.ULID{}) == 0 {
+		return nil, wslog.ErrProjectIDMustBeSet
+	}
+
+	txn := db.badger.NewTransaction(false)
+	defer txn.Discard()
+
+	msgIDs, err := findWebSocketMessageIDsByProjectID(txn, filter.ProjectID)
+	if err != nil {
+		return nil, fmt.Errorf("badger: failed to find WebSocket message IDs: %w", err)
+	}
+
+	msgs := make([]wslog.Message, 0, len(msgIDs))
+
+	for _, msgID := range msgIDs {
+		msg, err := db.getWebSocketMessage(txn, msgID)
+		if err != nil {
+			return nil, fmt.Errorf("badger: failed to get WebSocket message (id: %v): %w", msgID.String(), err)
+		}
+
+		if filter.ConnectionID.Compare(ulid.ULID{}) != 0 && msg.ConnectionID.Compare(filter.ConnectionID) != 0 {
+			continue
+		}
+
+		if filter.SearchExpr != nil {
+			match, err := msg.Matches(filter.SearchExpr)
+			if err != nil {
+				return nil, fmt.Errorf("badger: failed to match WebSocket message (id: %v): %w", msgID.String(), err)
+			}
+
+			if !match {
+				continue
+			}
+		}
+
+		msgs = append(msgs, msg)
+	}
+
+	return msgs, nil
+}
+
+func (db *Database) getWebSocketMessage(txn *badger.Txn, msgID ulid.ULID) (wslog.Message, error) {
+	item, err := txn.Get(entryKey(wsMsgPrefix, 0, msgID[:]))
+	if err != nil {
+		return wslog.Message{}, fmt.Errorf("failed to lookup WebSocket message item: %w", err)
+	}
+
+	msg := wslog.Message{
+		ID: msgID,
+	}
+
+	err = item.Value(func(rawMsg []byte) error {
+		value, err := db.decryptValue(rawMsg)
+		if err != nil {
+			return err
+		}
+
+		err = gob.NewDecoder(bytes.NewReader(value)).Decode(&msg)
+		if err != nil {
+			return fmt.Errorf("failed to decode WebSocket message: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return wslog.Message{}, fmt.Errorf("failed to retrieve or parse WebSocket message value: %w", err)
+	}
+
+	return msg, nil
+}
+
+func (db *Database) StoreWebSocketMessage(ctx context.Context, msg wslog.Message) error {
+	buf := bytes.Buffer{}
+
+	err := gob.NewEncoder(&buf).Encode(msg)
+	if err != nil {
+		return fmt.Errorf("badger: failed to encode WebSocket message: %w", err)
+	}
+
+	err = db.updateEncrypted(func(txn *badger.Txn) error {
+		value, err := db.encryptValueLocked(msg.ProjectID, buf.Bytes())
+		if err != nil {
+			return fmt.Errorf("failed to encrypt WebSocket message: %w", err)
+		}
+
+		entries := []*badger.Entry{
+			// WebSocket message itself.
+			{
+				Key:   entryKey(wsMsgPrefix, 0, msg.ID[:]),
+				Value: value,
+			},
+			// Index by project ID.
+			{
+				Key: entryKey(wsMsgPrefix, wsMsgProjectIDIndex, append(msg.ProjectID[:], msg.ID[:]...)),
+			},
+		}
+
+		for i := range entries {
+			err := txn.SetEntry(entries[i])
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("badger: failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (db *Database) ClearWebSocketMessages(ctx context.Context, projectID ulid.ULID) error {
+	txn := db.badger.NewTransaction(false)
+	defer txn.Discard()
+
+	msgIDs, err := findWebSocketMessageIDsByProjectID(txn, projectID)
+	if err != nil {
+		return fmt.Errorf("badger: failed to find WebSocket message IDs: %w", err)
+	}
+
+	writeBatch := db.badger.NewWriteBatch()
+	defer writeBatch.Cancel()
+
+	for _, msgID := range msgIDs {
+		err := writeBatch.Delete(entryKey(wsMsgPrefix, 0, msgID[:]))
+		if err != nil {
+			return fmt.Errorf("badger: failed to delete WebSocket message: %w", err)
+		}
+	}
+
+	if err := writeBatch.Flush(); err != nil {
+		return fmt.Errorf("badger: failed to commit batch write: %w", err)
+	}
+
+	err = db.badger.DropPrefix(entryKey(wsMsgPrefix, wsMsgProjectIDIndex, projectID[:]))
+	if err != nil {
+		return fmt.Errorf("badger: failed to drop WebSocket message project ID index items: %w", err)
+	}
+
+	return nil
+}
+
+func findWebSocketMessageIDsByProjectID(txn *badger.Txn, projectID ulid.ULID) ([]ulid.ULID, error) {
+	msgIDs := make([]ulid.ULID, 0)
+	opts := badger.DefaultIteratorOptions
+	opts.PrefetchValues = false
+	iterator := txn.NewIterator(opts)
+	defer iterator.Close()
+
+	var projectIndexKey []byte
+
+	prefix := entryKey(wsMsgPrefix, wsMsgProjectIDIndex, projectID[:])
+
+	for iterator.Seek(prefix); iterator.ValidForPrefix(prefix); iterator.Next() {
+		projectIndexKey = iterator.Item().KeyCopy(projectIndexKey)
+
+		var id ulid.ULID
+		// The message ID starts *after* the first 2 prefix and index bytes and
+		// the 16 byte project ID.
+		if err := id.UnmarshalBinary(projectIndexKey[18:]); err != nil {
+			return nil, fmt.Errorf("failed to parse WebSocket message ID: %w", err)
+		}
+
+		msgIDs = append(msgIDs, id)
+	}
+
+	return msgIDs, nil
+}
@@ -0,0 +1,106 @@
+package badger
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	badgerdb "github.com/dgraph-io/badger/v3"
+	"github.com/google/go-cmp/cmp"
+	"github.com/oklog/ulid"
+
+	"github.com/dstotijn/hetty/pkg/wslog"
+)
+
+func TestFindWebSocketMessages(t *testing.T) {
+	t.Parallel()
+
+	t.Run("without project ID in filter", func(t *testing.T) {
+		t.Parallel()
+
+		database, err := OpenDatabase(badgerdb.DefaultOptions("").WithInMemory(true))
+		if err != nil {
+			t.Fatalf("failed to open badger database: %v", err)
+		}
+		defer database.Close()
+
+		_, err = database.FindWebSocketMessages(context.Background(), wslog.FindMessagesFilter{})
+		if !errors.Is(err, wslog.ErrProjectIDMustBeSet) {
+			t.Fatalf("expected `wslog.ErrProjectIDMustBeSet`, got: %v", err)
+		}
+	})
+
+	t.Run("returns messages of connection and clears them", func(t *testing.T) {
+		t.Parallel()
+
+		database, err := OpenDatabase(badgerdb.DefaultOptions("").WithInMemory(true))
+		if err != nil {
+			t.Fatalf("failed to open badger database: %v", err)
+		}
+		defer database.Close()
+
+		projectID := ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+		connID := ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+		otherConnID := ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+
+		msgs := []wslog.Message{
+			{
+				ID:           ulid.MustNew(1, ulidEntropy),
+				ProjectID:    projectID,
+				ConnectionID: connID,
+				Direction:    wslog.DirectionClientToServer,
+				Opcode:       wslog.OpcodeText,
+				Payload:      []byte("foo"),
+			},
+			{
+				ID:           ulid.MustNew(2, ulidEntropy),
+				ProjectID:    projectID,
+				ConnectionID: otherConnID,
+				Direction:    wslog.DirectionServerToClient,
+				Opcode:       wslog.OpcodeText,
+				Payload:      []byte("bar"),
+			},
+			{
+				ID:           ulid.MustNew(3, ulidEntropy),
+				ProjectID:    projectID,
+				ConnectionID: connID,
+				Direction:    wslog.DirectionServerToClient,
+				Opcode:       wslog.OpcodeBinary,
+				Payload:      []byte("baz"),
+			},
+		}
+
+		for _, msg := range msgs {
+			if err := database.StoreWebSocketMessage(context.Background(), msg); err != nil {
+				t.Fatalf("unexpected error storing message: %v", err)
+			}
+		}
+
+		got, err := database.FindWebSocketMessages(context.Background(), wslog.FindMessagesFilter{
+			ProjectID:    projectID,
+			ConnectionID: connID,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error finding messages: %v", err)
+		}
+
+		exp := []wslog.Message{msgs[0], msgs[2]}
+		if diff := cmp.Diff(exp, got); diff != "" {
+			t.Fatalf("messages not equal (-exp, +got):\n%v", diff)
+		}
+
+		if err := database.ClearWebSocketMessages(context.Background(), projectID); err != nil {
+			t.Fatalf("unexpected error clearing messages: %v", err)
+		}
+
+		got, err = database.FindWebSocketMessages(context.Background(), wslog.FindMessagesFilter{ProjectID: projectID})
+		if err != nil {
+			t.Fatalf("unexpected error finding messages: %v", err)
+		}
+
+		if len(got) != 0 {
+			t.Fatalf("expected no messages after clearing, got: %v", len(got))
+		}
+	})
+}
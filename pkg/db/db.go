@@ -0,0 +1,63 @@
+// Package db provides the storage backends of Hetty, behind a single
+// repository interface.
+package db
+
+import (
+	"fmt"
+
+	badgerdb "github.com/dgraph-io/badger/v3"
+
+	"github.com/dstotijn/hetty/pkg/db/badger"
+	"github.com/dstotijn/hetty/pkg/db/sqlite"
+	"github.com/dstotijn/hetty/pkg/proj"
+	"github.com/dstotijn/hetty/pkg/reqlog"
+	"github.com/dstotijn/hetty/pkg/scan"
+	"github.com/dstotijn/hetty/pkg/sender"
+	"github.com/dstotijn/hetty/pkg/wslog"
+)
+
+// Storage drivers, see `Open`.
+const (
+	DriverBadger = "badger"
+	DriverSQLite = "sqlite"
+)
+
+// Repository is implemented by storage backends, and holds the data of all
+// services.
+type Repository interface {
+	proj.Repository
+	reqlog.Repository
+	sender.Repository
+	wslog.Repository
+	scan.Repository
+}
+
+var (
+	_ Repository = (*badger.Database)(nil)
+	_ Repository = (*sqlite.Database)(nil)
+)
+
+// Open opens the database at path with a storage driver. For Badger, path is a
+// directory; for SQLite, it's a database file.
+func Open(driver, path string) (Repository, error) {
+	// Return nil explicitly on errors, rather than a `Repository` holding a
+	// typed nil pointer.
+	switch driver {
+	case DriverBadger:
+		database, err := badger.OpenDatabase(badgerdb.DefaultOptions(path))
+		if err != nil {
+			return nil, err
+		}
+
+		return database, nil
+	case DriverSQLite:
+		database, err := sqlite.OpenDatabase(path)
+		if err != nil {
+			return nil, err
+		}
+
+		return database, nil
+	default:
+		return nil, fmt.Errorf("db: unsupported driver %q", driver)
+	}
+}
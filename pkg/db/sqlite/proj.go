@@ -0,0 +1,101 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/oklog/ulid"
+
+	"github.com/dstotijn/hetty/pkg/proj"
+)
+
+func (db *Database) UpsertProject(ctx context.Context, project proj.Project) error {
+	data, err := encode(project)
+	if err != nil {
+		return fmt.Errorf("sqlite: failed to encode project: %w", err)
+	}
+
+	_, err = db.db.ExecContext(ctx, `INSERT INTO projects (id, name, data) VALUES (?, ?, ?)
+		ON CONFLICT (id) DO UPDATE SET name = excluded.name, data = excluded.data`,
+		project.ID.String(), project.Name, data)
+	if err != nil {
+		return fmt.Errorf("sqlite: failed to store project: %w", err)
+	}
+
+	return nil
+}
+
+func (db *Database) FindProjectByID(ctx context.Context, projectID ulid.ULID) (proj.Project, error) {
+	var data []byte
+
+	err := db.db.QueryRowContext(ctx, `SELECT data FROM projects WHERE id = ?`, projectID.String()).Scan(&data)
+	if errors.Is(err, sql.ErrNoRows) {
+		return proj.Project{}, proj.ErrProjectNotFound
+	}
+
+	if err != nil {
+		return proj.Project{}, fmt.Errorf("sqlite: failed to get project: %w", err)
+	}
+
+	var project proj.Project
+	if err := decode(data, &project); err != nil {
+		return proj.Project{}, fmt.Errorf("sqlite: failed to decode project: %w", err)
+	}
+
+	return project, nil
+}
+
+// DeleteProject deletes a project and all of its data, in a single transaction.
+func (db *Database) DeleteProject(ctx context.Context, projectID ulid.ULID) error {
+	err := db.withTx(ctx, func(tx *sql.Tx) error {
+		if err := clearRequestLogs(ctx, tx, projectID); err != nil {
+			return err
+		}
+
+		for _, table := range []string{"websocket_messages", "sender_requests", "findings"} {
+			_, err := tx.ExecContext(ctx, `DELETE FROM `+table+` WHERE project_id = ?`, projectID.String())
+			if err != nil {
+				return fmt.Errorf("failed to delete from %v: %w", table, err)
+			}
+		}
+
+		_, err := tx.ExecContext(ctx, `DELETE FROM projects WHERE id = ?`, projectID.String())
+		if err != nil {
+			return fmt.Errorf("failed to delete project: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("sqlite: failed to delete project: %w", err)
+	}
+
+	return nil
+}
+
+func (db *Database) Projects(ctx context.Context) ([]proj.Project, error) {
+	rows, err := db.db.QueryContext(ctx, `SELECT data FROM projects ORDER BY id`)
+	if err != nil {
+		return nil, fmt.Errorf("sqlite: failed to query projects: %w", err)
+	}
+	defer rows.Close()
+
+	projects := make([]proj.Project, 0)
+
+	for rows.Next() {
+		var project proj.Project
+		if err := scanRecord(rows, &project); err != nil {
+			return nil, fmt.Errorf("sqlite: failed to scan project: %w", err)
+		}
+
+		projects = append(projects, project)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("sqlite: failed to iterate projects: %w", err)
+	}
+
+	return projects, nil
+}
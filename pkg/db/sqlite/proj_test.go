@@ -0,0 +1,162 @@
+package sqlite
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/oklog/ulid"
+
+	"github.com/dstotijn/hetty/pkg/proj"
+	"github.com/dstotijn/hetty/pkg/reqlog"
+	"github.com/dstotijn/hetty/pkg/scan"
+	"github.com/dstotijn/hetty/pkg/sender"
+	"github.com/dstotijn/hetty/pkg/wslog"
+)
+
+//nolint:gosec
+var ulidEntropy = rand.New(rand.NewSource(time.Now().UnixNano()))
+
+func openTestDatabase(t *testing.T) *Database {
+	t.Helper()
+
+	database, err := OpenDatabase(filepath.Join(t.TempDir(), "hetty.db"))
+	if err != nil {
+		t.Fatalf("failed to open sqlite database: %v", err)
+	}
+
+	t.Cleanup(func() { database.Close() })
+
+	return database
+}
+
+func TestUpsertProject(t *testing.T) {
+	t.Parallel()
+
+	database := openTestDatabase(t)
+
+	project := proj.Project{
+		ID:   ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy),
+		Name: "foobar",
+		Settings: proj.Settings{
+			ReqLogBypassOutOfScope: true,
+		},
+	}
+
+	if err := database.UpsertProject(context.Background(), project); err != nil {
+		t.Fatalf("unexpected error storing project: %v", err)
+	}
+
+	project.Name = "bazqux"
+
+	if err := database.UpsertProject(context.Background(), project); err != nil {
+		t.Fatalf("unexpected error updating project: %v", err)
+	}
+
+	got, err := database.FindProjectByID(context.Background(), project.ID)
+	if err != nil {
+		t.Fatalf("unexpected error finding project: %v", err)
+	}
+
+	if diff := cmp.Diff(project, got, cmpopts.IgnoreUnexported(proj.Project{})); diff != "" {
+		t.Fatalf("project not equal (-exp, +got):\n%v", diff)
+	}
+
+	projects, err := database.Projects(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error finding projects: %v", err)
+	}
+
+	if diff := cmp.Diff([]proj.Project{project}, projects, cmpopts.IgnoreUnexported(proj.Project{})); diff != "" {
+		t.Fatalf("projects not equal (-exp, +got):\n%v", diff)
+	}
+
+	_, err = database.FindProjectByID(context.Background(), ulid.MustNew(0, ulidEntropy))
+	if !errors.Is(err, proj.ErrProjectNotFound) {
+		t.Fatalf("expected `proj.ErrProjectNotFound`, got: %v", err)
+	}
+}
+
+func TestDeleteProject(t *testing.T) {
+	t.Parallel()
+
+	database := openTestDatabase(t)
+	ctx := context.Background()
+
+	projectID := ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+	otherProjectID := ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+
+	for _, id := range []ulid.ULID{projectID, otherProjectID} {
+		if err := database.UpsertProject(ctx, proj.Project{ID: id}); err != nil {
+			t.Fatalf("unexpected error storing project fixture: %v", err)
+		}
+
+		reqLog := reqlog.RequestLog{
+			ID:        ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy),
+			ProjectID: id,
+			Response:  &reqlog.ResponseLog{StatusCode: 200},
+		}
+		storeRequestLogs(t, database, []reqlog.RequestLog{reqLog})
+
+		if err := database.StoreWebSocketMessage(ctx, wslog.Message{
+			ID:        ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy),
+			ProjectID: id,
+		}); err != nil {
+			t.Fatalf("unexpected error storing WebSocket message fixture: %v", err)
+		}
+
+		if err := database.StoreSenderRequest(ctx, sender.Request{
+			ID:        ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy),
+			ProjectID: id,
+		}); err != nil {
+			t.Fatalf("unexpected error storing sender request fixture: %v", err)
+		}
+
+		if err := database.StoreFinding(ctx, scan.Finding{
+			ID:        ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy),
+			ProjectID: id,
+			ReqLogID:  reqLog.ID,
+		}); err != nil {
+			t.Fatalf("unexpected error storing finding fixture: %v", err)
+		}
+	}
+
+	if err := database.DeleteProject(ctx, projectID); err != nil {
+		t.Fatalf("unexpected error deleting project: %v", err)
+	}
+
+	// Rows of the deleted project are removed, and rows of the other project
+	// are kept.
+	tables := []string{"projects", "request_logs", "response_logs", "websocket_messages", "sender_requests", "findings"}
+
+	for _, table := range tables {
+		var count int
+		if err := database.db.QueryRow(`SELECT COUNT(*) FROM ` + table).Scan(&count); err != nil {
+			t.Fatalf("unexpected error counting rows of %v: %v", table, err)
+		}
+
+		if count != 1 {
+			t.Errorf("incorrect row count of %v (expected: 1, got: %v)", table, count)
+		}
+	}
+}
+
+func TestSetProjectKey(t *testing.T) {
+	t.Parallel()
+
+	database := openTestDatabase(t)
+	projectID := ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+
+	if err := database.SetProjectKey(projectID, nil); err != nil {
+		t.Fatalf("unexpected error (expected: nil, got: %v)", err)
+	}
+
+	if err := database.SetProjectKey(projectID, make([]byte, 32)); !errors.Is(err, ErrEncryptionNotSupported) {
+		t.Fatalf("expected `ErrEncryptionNotSupported`, got: %v", err)
+	}
+}
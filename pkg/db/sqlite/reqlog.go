@@ -0,0 +1,447 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/oklog/ulid"
+
+	"github.com/dstotijn/hetty/pkg/reqlog"
+	"github.com/dstotijn/hetty/pkg/scope"
+)
+
+const selectRequestLogs = `SELECT r.data, s.data FROM request_logs r
+	LEFT JOIN response_logs s ON s.request_log_id = r.id`
+
+func (db *Database) FindRequestLogs(
+	ctx context.Context,
+	filter reqlog.FindRequestsFilter,
+	scope *scope.Scope,
+) ([]reqlog.RequestLog, error) {
+	if filter.ProjectID.Compare(ulid.ULID{}) == 0 {
+		return nil, reqlog.ErrProjectIDMustBeSet
+	}
+
+	match, err := reqlog.CompileFilter(filter, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	reqLogs, err := db.queryRequestLogs(ctx, selectRequestLogs+" WHERE r.project_id = ? ORDER BY r.id",
+		filter.ProjectID.String())
+	if err != nil {
+		return nil, fmt.Errorf("sqlite: failed to find request logs: %w", err)
+	}
+
+	matched := make([]reqlog.RequestLog, 0, len(reqLogs))
+
+	for _, reqLog := range reqLogs {
+		if match(reqLog) {
+			matched = append(matched, reqLog)
+		}
+	}
+
+	return matched, nil
+}
+
+// pageSortKeys are the SQL expressions of the sort keys of request logs, per
+// `reqlog.PageOptions.Less`.
+var pageSortKeys = map[reqlog.SortField]string{
+	reqlog.SortByTimestamp:  "0",
+	reqlog.SortByStatusCode: "COALESCE(s.status_code, -1)",
+	reqlog.SortByDuration:   "COALESCE(s.duration, -1)",
+}
+
+// FindRequestLogsPage returns a page of the request logs that match filter.
+// Because filters are matched in Go rather than in SQL, request logs are read
+// in sort order, in batches of the page size, until the page is full.
+func (db *Database) FindRequestLogsPage(
+	ctx context.Context,
+	filter reqlog.FindRequestsFilter,
+	opts reqlog.PageOptions,
+	scope *scope.Scope,
+) (reqlog.RequestLogPage, error) {
+	if filter.ProjectID.Compare(ulid.ULID{}) == 0 {
+		return reqlog.RequestLogPage{}, reqlog.ErrProjectIDMustBeSet
+	}
+
+	sortKey, ok := pageSortKeys[opts.SortBy]
+	if !ok {
+		return reqlog.RequestLogPage{}, fmt.Errorf("sqlite: unsupported sort field: %v", opts.SortBy)
+	}
+
+	match, err := reqlog.CompileFilter(filter, scope)
+	if err != nil {
+		return reqlog.RequestLogPage{}, err
+	}
+
+	// The cursor is compared to request logs by sort key, so it needn't match
+	// the filter itself. Only its ID is needed when sorting by timestamp.
+	var cursorKey int64
+
+	cursorID := opts.After.String()
+	hasCursor := opts.After.Compare(ulid.ULID{}) != 0
+
+	if hasCursor && opts.SortBy != reqlog.SortByTimestamp {
+		err := db.db.QueryRowContext(ctx, `SELECT `+sortKey+` FROM request_logs r
+			LEFT JOIN response_logs s ON s.request_log_id = r.id WHERE r.id = ?`, cursorID).Scan(&cursorKey)
+		if errors.Is(err, sql.ErrNoRows) {
+			return reqlog.RequestLogPage{}, reqlog.ErrRequestNotFound
+		} else if err != nil {
+			return reqlog.RequestLogPage{}, fmt.Errorf("sqlite: failed to get cursor: %w", err)
+		}
+	}
+
+	order, cmp := "ASC", ">"
+	if opts.Descending {
+		order, cmp = "DESC", "<"
+	}
+
+	// One request log more than the page size is read, to know if there's a
+	// next page. A limit of -1 means no limit.
+	limit := -1
+	if opts.First > 0 {
+		limit = opts.First + 1
+	}
+
+	page := reqlog.RequestLogPage{RequestLogs: make([]reqlog.RequestLog, 0)}
+
+	for {
+		query := `SELECT r.data, s.data, ` + sortKey + `, r.id FROM request_logs r
+			LEFT JOIN response_logs s ON s.request_log_id = r.id WHERE r.project_id = ?`
+		args := []interface{}{filter.ProjectID.String()}
+
+		if hasCursor {
+			query += ` AND (` + sortKey + `, r.id) ` + cmp + ` (?, ?)`
+			args = append(args, cursorKey, cursorID)
+		}
+
+		// A constant is an index into the result columns in `ORDER BY`, so the
+		// sort key is omitted when sorting by timestamp.
+		if opts.SortBy == reqlog.SortByTimestamp {
+			query += ` ORDER BY r.id ` + order + ` LIMIT ?`
+		} else {
+			query += ` ORDER BY ` + sortKey + ` ` + order + `, r.id ` + order + ` LIMIT ?`
+		}
+		args = append(args, limit)
+
+		n, err := db.scanRequestLogsPage(ctx, query, args, func(reqLog reqlog.RequestLog, key int64, id string) bool {
+			cursorKey, cursorID, hasCursor = key, id, true
+
+			if match(reqLog) {
+				page.RequestLogs = append(page.RequestLogs, reqLog)
+			}
+
+			return opts.First == 0 || len(page.RequestLogs) <= opts.First
+		})
+		if err != nil {
+			return reqlog.RequestLogPage{}, fmt.Errorf("sqlite: failed to find request logs: %w", err)
+		}
+
+		if opts.First > 0 && len(page.RequestLogs) > opts.First {
+			page.RequestLogs = page.RequestLogs[:opts.First]
+			page.HasNextPage = true
+
+			return page, nil
+		}
+
+		if limit == -1 || n < limit {
+			return page, nil
+		}
+	}
+}
+
+// scanRequestLogsPage runs a query for request logs with their sort key and
+// ID, and calls fn for each row until it returns false. It returns the number
+// of rows that were read.
+func (db *Database) scanRequestLogsPage(
+	ctx context.Context,
+	query string,
+	args []interface{},
+	fn func(reqLog reqlog.RequestLog, key int64, id string) bool,
+) (int, error) {
+	rows, err := db.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query request logs: %w", err)
+	}
+	defer rows.Close()
+
+	n := 0
+
+	for rows.Next() {
+		var (
+			rawReqLog, rawResLog []byte
+			key                  int64
+			id                   string
+		)
+
+		if err := rows.Scan(&rawReqLog, &rawResLog, &key, &id); err != nil {
+			return 0, fmt.Errorf("failed to scan request log: %w", err)
+		}
+
+		reqLog, err := decodeRequestLog(rawReqLog, rawResLog)
+		if err != nil {
+			return 0, err
+		}
+
+		n++
+
+		if !fn(reqLog, key, id) {
+			return n, nil
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return 0, fmt.Errorf("failed to iterate request logs: %w", err)
+	}
+
+	return n, nil
+}
+
+func (db *Database) FindRequestLogByID(ctx context.Context, reqLogID ulid.ULID) (reqlog.RequestLog, error) {
+	reqLogs, err := db.queryRequestLogs(ctx, selectRequestLogs+" WHERE r.id = ?", reqLogID.String())
+	if err != nil {
+		return reqlog.RequestLog{}, fmt.Errorf("sqlite: failed to get request log: %w", err)
+	}
+
+	if len(reqLogs) == 0 {
+		return reqlog.RequestLog{}, reqlog.ErrRequestNotFound
+	}
+
+	return reqLogs[0], nil
+}
+
+// DistinctHosts returns the unique hosts of all request logs of a project,
+// sorted.
+func (db *Database) DistinctHosts(ctx context.Context, projectID ulid.ULID) ([]string, error) {
+	if projectID.Compare(ulid.ULID{}) == 0 {
+		return nil, reqlog.ErrProjectIDMustBeSet
+	}
+
+	rows, err := db.db.QueryContext(ctx,
+		`SELECT DISTINCT host FROM request_logs WHERE project_id = ? AND host != '' ORDER BY host`,
+		projectID.String())
+	if err != nil {
+		return nil, fmt.Errorf("sqlite: failed to query hosts: %w", err)
+	}
+	defer rows.Close()
+
+	hosts := make([]string, 0)
+
+	for rows.Next() {
+		var host string
+		if err := rows.Scan(&host); err != nil {
+			return nil, fmt.Errorf("sqlite: failed to scan host: %w", err)
+		}
+
+		hosts = append(hosts, host)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("sqlite: failed to iterate hosts: %w", err)
+	}
+
+	return hosts, nil
+}
+
+// Neighbors returns the request logs immediately preceding and following the
+// request log with the given ID, in order of ID (and thus time), within the
+// same project. For the first and last request logs of a project, prev and next
+// respectively are nil.
+func (db *Database) Neighbors(ctx context.Context, id ulid.ULID) (prev, next *reqlog.RequestLog, err error) {
+	var projectID string
+
+	err = db.db.QueryRowContext(ctx, `SELECT project_id FROM request_logs WHERE id = ?`, id.String()).
+		Scan(&projectID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil, reqlog.ErrRequestNotFound
+	}
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("sqlite: failed to get request log: %w", err)
+	}
+
+	prevLogs, err := db.queryRequestLogs(ctx,
+		selectRequestLogs+" WHERE r.project_id = ? AND r.id < ? ORDER BY r.id DESC LIMIT 1", projectID, id.String())
+	if err != nil {
+		return nil, nil, fmt.Errorf("sqlite: failed to get previous request log: %w", err)
+	}
+
+	nextLogs, err := db.queryRequestLogs(ctx,
+		selectRequestLogs+" WHERE r.project_id = ? AND r.id > ? ORDER BY r.id LIMIT 1", projectID, id.String())
+	if err != nil {
+		return nil, nil, fmt.Errorf("sqlite: failed to get next request log: %w", err)
+	}
+
+	if len(prevLogs) > 0 {
+		prev = &prevLogs[0]
+	}
+
+	if len(nextLogs) > 0 {
+		next = &nextLogs[0]
+	}
+
+	return prev, next, nil
+}
+
+func (db *Database) StoreRequestLog(ctx context.Context, reqLog reqlog.RequestLog) error {
+	data, err := encode(reqLog)
+	if err != nil {
+		return fmt.Errorf("sqlite: failed to encode request log: %w", err)
+	}
+
+	var url, host string
+	if reqLog.URL != nil {
+		url, host = reqLog.URL.String(), reqLog.URL.Host
+	}
+
+	_, err = db.db.ExecContext(ctx, `INSERT INTO request_logs (id, project_id, method, url, host, proto, data)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT (id) DO UPDATE SET project_id = excluded.project_id, method = excluded.method,
+			url = excluded.url, host = excluded.host, proto = excluded.proto, data = excluded.data`,
+		reqLog.ID.String(), reqLog.ProjectID.String(), reqLog.Method, url, host, reqLog.Proto, data)
+	if err != nil {
+		return fmt.Errorf("sqlite: failed to store request log: %w", err)
+	}
+
+	return nil
+}
+
+func (db *Database) StoreResponseLog(ctx context.Context, reqLogID ulid.ULID, resLog reqlog.ResponseLog) error {
+	data, err := encode(resLog)
+	if err != nil {
+		return fmt.Errorf("sqlite: failed to encode response log: %w", err)
+	}
+
+	_, err = db.db.ExecContext(ctx, `INSERT INTO response_logs (request_log_id, status_code, duration, data)
+		VALUES (?, ?, ?, ?)
+		ON CONFLICT (request_log_id) DO UPDATE SET status_code = excluded.status_code,
+			duration = excluded.duration, data = excluded.data`,
+		reqLogID.String(), resLog.StatusCode, int64(resLog.Duration), data)
+	if err != nil {
+		return fmt.Errorf("sqlite: failed to store response log: %w", err)
+	}
+
+	return nil
+}
+
+func (db *Database) ClearRequestLogs(ctx context.Context, projectID ulid.ULID) error {
+	err := db.withTx(ctx, func(tx *sql.Tx) error {
+		return clearRequestLogs(ctx, tx, projectID)
+	})
+	if err != nil {
+		return fmt.Errorf("sqlite: failed to clear request logs: %w", err)
+	}
+
+	return nil
+}
+
+func clearRequestLogs(ctx context.Context, tx *sql.Tx, projectID ulid.ULID) error {
+	_, err := tx.ExecContext(ctx, `DELETE FROM response_logs WHERE request_log_id IN
+		(SELECT id FROM request_logs WHERE project_id = ?)`, projectID.String())
+	if err != nil {
+		return fmt.Errorf("failed to delete response logs: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `DELETE FROM request_logs WHERE project_id = ?`, projectID.String())
+	if err != nil {
+		return fmt.Errorf("failed to delete request logs: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteRequestLogs removes request logs of a project, and their response logs.
+func (db *Database) DeleteRequestLogs(ctx context.Context, projectID ulid.ULID, reqLogIDs []ulid.ULID) error {
+	if len(reqLogIDs) == 0 {
+		return nil
+	}
+
+	args := make([]interface{}, 0, len(reqLogIDs)+1)
+	args = append(args, projectID.String())
+
+	for _, reqLogID := range reqLogIDs {
+		args = append(args, reqLogID.String())
+	}
+
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(reqLogIDs)), ", ")
+
+	err := db.withTx(ctx, func(tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, `DELETE FROM response_logs WHERE request_log_id IN
+			(SELECT id FROM request_logs WHERE project_id = ? AND id IN (`+placeholders+`))`, args...)
+		if err != nil {
+			return fmt.Errorf("failed to delete response logs: %w", err)
+		}
+
+		_, err = tx.ExecContext(ctx,
+			`DELETE FROM request_logs WHERE project_id = ? AND id IN (`+placeholders+`)`, args...)
+		if err != nil {
+			return fmt.Errorf("failed to delete request logs: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("sqlite: failed to delete request logs: %w", err)
+	}
+
+	return nil
+}
+
+// queryRequestLogs returns the request logs, with their response logs if any,
+// for a query that selects encoded request and response logs respectively.
+func (db *Database) queryRequestLogs(
+	ctx context.Context,
+	query string,
+	args ...interface{},
+) ([]reqlog.RequestLog, error) {
+	rows, err := db.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query request logs: %w", err)
+	}
+	defer rows.Close()
+
+	reqLogs := make([]reqlog.RequestLog, 0)
+
+	for rows.Next() {
+		var rawReqLog, rawResLog []byte
+		if err := rows.Scan(&rawReqLog, &rawResLog); err != nil {
+			return nil, fmt.Errorf("failed to scan request log: %w", err)
+		}
+
+		reqLog, err := decodeRequestLog(rawReqLog, rawResLog)
+		if err != nil {
+			return nil, err
+		}
+
+		reqLogs = append(reqLogs, reqLog)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate request logs: %w", err)
+	}
+
+	return reqLogs, nil
+}
+
+// decodeRequestLog decodes a request log and its response log, if any.
+func decodeRequestLog(rawReqLog, rawResLog []byte) (reqlog.RequestLog, error) {
+	var reqLog reqlog.RequestLog
+	if err := decode(rawReqLog, &reqLog); err != nil {
+		return reqlog.RequestLog{}, fmt.Errorf("failed to decode request log: %w", err)
+	}
+
+	if rawResLog != nil {
+		var resLog reqlog.ResponseLog
+		if err := decode(rawResLog, &resLog); err != nil {
+			return reqlog.RequestLog{}, fmt.Errorf("failed to decode response log: %w", err)
+		}
+
+		reqLog.Response = &resLog
+	}
+
+	return reqLog, nil
+}
@@ -0,0 +1,347 @@
+package sqlite
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/oklog/ulid"
+
+	"github.com/dstotijn/hetty/pkg/reqlog"
+	"github.com/dstotijn/hetty/pkg/search"
+)
+
+func TestFindRequestLogs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("without project ID in filter", func(t *testing.T) {
+		t.Parallel()
+
+		database := openTestDatabase(t)
+
+		_, err := database.FindRequestLogs(context.Background(), reqlog.FindRequestsFilter{}, nil)
+		if !errors.Is(err, reqlog.ErrProjectIDMustBeSet) {
+			t.Fatalf("expected `reqlog.ErrProjectIDMustBeSet`, got: %v", err)
+		}
+	})
+
+	t.Run("returns request logs and related response logs", func(t *testing.T) {
+		t.Parallel()
+
+		database := openTestDatabase(t)
+		projectID := ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+
+		exp := []reqlog.RequestLog{
+			{
+				ID:        ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy),
+				ProjectID: projectID,
+				URL:       mustParseURL(t, "https://example.com/foobar"),
+				Method:    http.MethodPost,
+				Proto:     "HTTP/1.1",
+				Header: http.Header{
+					"X-Foo": []string{"baz"},
+				},
+				Body: []byte("foo"),
+				Response: &reqlog.ResponseLog{
+					Proto:      "HTTP/1.1",
+					Status:     "200 OK",
+					StatusCode: 200,
+					Header: http.Header{
+						"X-Yolo": []string{"swag"},
+					},
+					Body: []byte("bar"),
+				},
+			},
+			{
+				ID:        ulid.MustNew(ulid.Timestamp(time.Now())+100, ulidEntropy),
+				ProjectID: projectID,
+				URL:       mustParseURL(t, "https://example.com/foo?bar=baz"),
+				Method:    http.MethodGet,
+				Proto:     "HTTP/1.1",
+				Header: http.Header{
+					"X-Foo": []string{"baz"},
+				},
+			},
+		}
+
+		storeRequestLogs(t, database, exp)
+
+		got, err := database.FindRequestLogs(context.Background(), reqlog.FindRequestsFilter{ProjectID: projectID}, nil)
+		if err != nil {
+			t.Fatalf("unexpected error finding request logs: %v", err)
+		}
+
+		if diff := cmp.Diff(exp, got); diff != "" {
+			t.Fatalf("request logs not equal (-exp, +got):\n%v", diff)
+		}
+
+		searchExpr, err := search.ParseQuery(`req.method = "POST"`)
+		if err != nil {
+			t.Fatalf("unexpected error parsing search expression: %v", err)
+		}
+
+		filter := reqlog.FindRequestsFilter{ProjectID: projectID, SearchExpr: searchExpr}
+
+		got, err = database.FindRequestLogs(context.Background(), filter, nil)
+		if err != nil {
+			t.Fatalf("unexpected error finding request logs: %v", err)
+		}
+
+		if diff := cmp.Diff(exp[:1], got); diff != "" {
+			t.Fatalf("filtered request logs not equal (-exp, +got):\n%v", diff)
+		}
+	})
+}
+
+func TestFindRequestLogsPage(t *testing.T) {
+	t.Parallel()
+
+	database := openTestDatabase(t)
+	projectID := ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+	now := ulid.Timestamp(time.Now())
+
+	statusCodes := []int{200, 404, 0, 200}
+	durations := []time.Duration{3 * time.Second, time.Second, 0, 2 * time.Second}
+	methods := []string{http.MethodGet, http.MethodPost, http.MethodGet, http.MethodPost}
+	ids := make([]ulid.ULID, len(statusCodes))
+	fixtures := make([]reqlog.RequestLog, len(statusCodes))
+
+	for i, statusCode := range statusCodes {
+		ids[i] = ulid.MustNew(now+uint64(i), ulidEntropy)
+		fixtures[i] = reqlog.RequestLog{
+			ID:        ids[i],
+			ProjectID: projectID,
+			URL:       mustParseURL(t, "https://example.com/"),
+			Method:    methods[i],
+		}
+
+		if statusCode != 0 {
+			fixtures[i].Response = &reqlog.ResponseLog{StatusCode: statusCode, Duration: durations[i]}
+		}
+	}
+
+	storeRequestLogs(t, database, fixtures)
+
+	tests := []struct {
+		name           string
+		query          string
+		opts           reqlog.PageOptions
+		expIDs         []ulid.ULID
+		expHasNextPage bool
+		expectedError  error
+	}{
+		{
+			name:           "first page, by timestamp",
+			opts:           reqlog.PageOptions{First: 2},
+			expIDs:         []ulid.ULID{ids[0], ids[1]},
+			expHasNextPage: true,
+		},
+		{
+			name:   "last page, by timestamp",
+			opts:   reqlog.PageOptions{First: 2, After: ids[1]},
+			expIDs: []ulid.ULID{ids[2], ids[3]},
+		},
+		{
+			name:           "by timestamp, descending",
+			opts:           reqlog.PageOptions{First: 3, Descending: true},
+			expIDs:         []ulid.ULID{ids[3], ids[2], ids[1]},
+			expHasNextPage: true,
+		},
+		{
+			name:   "by status code, after cursor with equal status code",
+			opts:   reqlog.PageOptions{First: 3, After: ids[0], SortBy: reqlog.SortByStatusCode},
+			expIDs: []ulid.ULID{ids[3], ids[1]},
+		},
+		{
+			name:           "by duration, descending",
+			opts:           reqlog.PageOptions{First: 2, SortBy: reqlog.SortByDuration, Descending: true},
+			expIDs:         []ulid.ULID{ids[0], ids[3]},
+			expHasNextPage: true,
+		},
+		{
+			name:   "by duration, after cursor",
+			opts:   reqlog.PageOptions{First: 2, After: ids[1], SortBy: reqlog.SortByDuration},
+			expIDs: []ulid.ULID{ids[3], ids[0]},
+		},
+		{
+			// Request logs are read in batches of the page size, so the page is
+			// filled from more than one batch.
+			name:           "with search expression",
+			query:          "req.method = POST",
+			opts:           reqlog.PageOptions{First: 1, After: ids[1]},
+			expIDs:         []ulid.ULID{ids[3]},
+			expHasNextPage: false,
+		},
+		{
+			name:           "with search expression, first page",
+			query:          "req.method = GET",
+			opts:           reqlog.PageOptions{First: 1},
+			expIDs:         []ulid.ULID{ids[0]},
+			expHasNextPage: true,
+		},
+		{
+			name:          "unknown cursor",
+			opts:          reqlog.PageOptions{After: ulid.MustNew(now, ulidEntropy), SortBy: reqlog.SortByStatusCode},
+			expectedError: reqlog.ErrRequestNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			filter := reqlog.FindRequestsFilter{ProjectID: projectID}
+
+			if tt.query != "" {
+				expr, err := search.ParseQuery(tt.query)
+				if err != nil {
+					t.Fatalf("unexpected error parsing search expression: %v", err)
+				}
+
+				filter.SearchExpr = expr
+			}
+
+			page, err := database.FindRequestLogsPage(context.Background(), filter, tt.opts, nil)
+			if !errors.Is(err, tt.expectedError) {
+				t.Fatalf("unexpected error (expected: %v, got: %v)", tt.expectedError, err)
+			}
+
+			gotIDs := make([]ulid.ULID, len(page.RequestLogs))
+			for i, reqLog := range page.RequestLogs {
+				gotIDs[i] = reqLog.ID
+			}
+
+			if tt.expectedError == nil {
+				if diff := cmp.Diff(tt.expIDs, gotIDs); diff != "" {
+					t.Fatalf("request log IDs not equal (-exp, +got):\n%v", diff)
+				}
+			}
+
+			if page.HasNextPage != tt.expHasNextPage {
+				t.Fatalf("incorrect `HasNextPage` (expected: %v, got: %v)", tt.expHasNextPage, page.HasNextPage)
+			}
+		})
+	}
+}
+
+func TestNeighborsAndDistinctHosts(t *testing.T) {
+	t.Parallel()
+
+	database := openTestDatabase(t)
+	projectID := ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+	otherProjectID := ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+
+	fixtures := []reqlog.RequestLog{
+		{ProjectID: projectID, Method: "GET", URL: mustParseURL(t, "https://example.com/first")},
+		// Logged in between, but in another project.
+		{ProjectID: otherProjectID, Method: "GET", URL: mustParseURL(t, "https://other.example.com/")},
+		{ProjectID: projectID, Method: "GET", URL: mustParseURL(t, "http://api.example.org:8080/last")},
+	}
+
+	for i := range fixtures {
+		fixtures[i].ID = ulid.MustNew(ulid.Timestamp(time.Now())+uint64(i), ulidEntropy)
+	}
+
+	storeRequestLogs(t, database, fixtures)
+
+	prev, next, err := database.Neighbors(context.Background(), fixtures[0].ID)
+	if err != nil {
+		t.Fatalf("unexpected error finding neighbors: %v", err)
+	}
+
+	if diff := cmp.Diff((*reqlog.RequestLog)(nil), prev); diff != "" {
+		t.Fatalf("previous request log not equal (-exp, +got):\n%v", diff)
+	}
+
+	if diff := cmp.Diff(&fixtures[2], next); diff != "" {
+		t.Fatalf("next request log not equal (-exp, +got):\n%v", diff)
+	}
+
+	if _, _, err := database.Neighbors(context.Background(), ulid.MustNew(0, ulidEntropy)); !errors.Is(
+		err, reqlog.ErrRequestNotFound) {
+		t.Fatalf("expected `reqlog.ErrRequestNotFound`, got: %v", err)
+	}
+
+	hosts, err := database.DistinctHosts(context.Background(), projectID)
+	if err != nil {
+		t.Fatalf("unexpected error finding distinct hosts: %v", err)
+	}
+
+	if diff := cmp.Diff([]string{"api.example.org:8080", "example.com"}, hosts); diff != "" {
+		t.Fatalf("hosts not equal (-exp, +got):\n%v", diff)
+	}
+}
+
+func TestDeleteRequestLogs(t *testing.T) {
+	t.Parallel()
+
+	database := openTestDatabase(t)
+	projectID := ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+
+	fixtures := make([]reqlog.RequestLog, 3)
+	for i := range fixtures {
+		fixtures[i] = reqlog.RequestLog{
+			ID:        ulid.MustNew(ulid.Timestamp(time.Now())+uint64(i), ulidEntropy),
+			ProjectID: projectID,
+			Response:  &reqlog.ResponseLog{StatusCode: 200},
+		}
+	}
+
+	storeRequestLogs(t, database, fixtures)
+
+	err := database.DeleteRequestLogs(context.Background(), projectID, []ulid.ULID{fixtures[0].ID, fixtures[2].ID})
+	if err != nil {
+		t.Fatalf("unexpected error deleting request logs: %v", err)
+	}
+
+	got, err := database.FindRequestLogs(context.Background(), reqlog.FindRequestsFilter{ProjectID: projectID}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error finding request logs: %v", err)
+	}
+
+	if diff := cmp.Diff(fixtures[1:2], got); diff != "" {
+		t.Fatalf("request logs not equal (-exp, +got):\n%v", diff)
+	}
+
+	var resLogCount int
+	if err := database.db.QueryRow(`SELECT COUNT(*) FROM response_logs`).Scan(&resLogCount); err != nil {
+		t.Fatalf("unexpected error counting response logs: %v", err)
+	}
+
+	if resLogCount != 1 {
+		t.Fatalf("incorrect response log count (expected: 1, got: %v)", resLogCount)
+	}
+}
+
+func storeRequestLogs(t *testing.T, database *Database, reqLogs []reqlog.RequestLog) {
+	t.Helper()
+
+	for _, reqLog := range reqLogs {
+		if err := database.StoreRequestLog(context.Background(), reqLog); err != nil {
+			t.Fatalf("unexpected error creating request log fixture: %v", err)
+		}
+
+		if reqLog.Response != nil {
+			if err := database.StoreResponseLog(context.Background(), reqLog.ID, *reqLog.Response); err != nil {
+				t.Fatalf("unexpected error creating response log fixture: %v", err)
+			}
+		}
+	}
+}
+
+func mustParseURL(t *testing.T, s string) *url.URL {
+	t.Helper()
+
+	u, err := url.Parse(s)
+	if err != nil {
+		panic(err)
+	}
+
+	return u
+}
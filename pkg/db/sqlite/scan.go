@@ -0,0 +1,95 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/oklog/ulid"
+
+	"github.com/dstotijn/hetty/pkg/scan"
+)
+
+func (db *Database) FindFindingByID(ctx context.Context, id ulid.ULID) (scan.Finding, error) {
+	var data []byte
+
+	err := db.db.QueryRowContext(ctx, `SELECT data FROM findings WHERE id = ?`, id.String()).Scan(&data)
+	if errors.Is(err, sql.ErrNoRows) {
+		return scan.Finding{}, scan.ErrFindingNotFound
+	}
+
+	if err != nil {
+		return scan.Finding{}, fmt.Errorf("sqlite: failed to get finding: %w", err)
+	}
+
+	var finding scan.Finding
+	if err := decode(data, &finding); err != nil {
+		return scan.Finding{}, fmt.Errorf("sqlite: failed to decode finding: %w", err)
+	}
+
+	return finding, nil
+}
+
+// FindFindings returns the findings of a project, in the order they were
+// found.
+func (db *Database) FindFindings(ctx context.Context, projectID ulid.ULID) ([]scan.Finding, error) {
+	if projectID.Compare(ulid.ULID{}) == 0 {
+		return nil, scan.ErrProjectIDMustBeSet
+	}
+
+	rows, err := db.db.QueryContext(ctx, `SELECT data FROM findings WHERE project_id = ? ORDER BY id`,
+		projectID.String())
+	if err != nil {
+		return nil, fmt.Errorf("sqlite: failed to query findings: %w", err)
+	}
+	defer rows.Close()
+
+	findings := make([]scan.Finding, 0)
+
+	for rows.Next() {
+		var finding scan.Finding
+		if err := scanRecord(rows, &finding); err != nil {
+			return nil, fmt.Errorf("sqlite: failed to scan finding: %w", err)
+		}
+
+		findings = append(findings, finding)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("sqlite: failed to iterate findings: %w", err)
+	}
+
+	return findings, nil
+}
+
+// StoreFinding creates or overwrites a finding.
+func (db *Database) StoreFinding(ctx context.Context, finding scan.Finding) error {
+	data, err := encode(finding)
+	if err != nil {
+		return fmt.Errorf("sqlite: failed to encode finding: %w", err)
+	}
+
+	_, err = db.db.ExecContext(ctx, `INSERT INTO findings
+		(id, project_id, request_log_id, check_name, title, severity, data)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT (id) DO UPDATE SET project_id = excluded.project_id, request_log_id = excluded.request_log_id,
+			check_name = excluded.check_name, title = excluded.title, severity = excluded.severity,
+			data = excluded.data`,
+		finding.ID.String(), finding.ProjectID.String(), finding.ReqLogID.String(), finding.Check, finding.Title,
+		int(finding.Severity), data)
+	if err != nil {
+		return fmt.Errorf("sqlite: failed to store finding: %w", err)
+	}
+
+	return nil
+}
+
+func (db *Database) ClearFindings(ctx context.Context, projectID ulid.ULID) error {
+	_, err := db.db.ExecContext(ctx, `DELETE FROM findings WHERE project_id = ?`, projectID.String())
+	if err != nil {
+		return fmt.Errorf("sqlite: failed to delete findings: %w", err)
+	}
+
+	return nil
+}
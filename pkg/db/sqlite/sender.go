@@ -0,0 +1,97 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/oklog/ulid"
+
+	"github.com/dstotijn/hetty/pkg/sender"
+)
+
+func (db *Database) FindSenderRequestByID(ctx context.Context, id ulid.ULID) (sender.Request, error) {
+	var data []byte
+
+	err := db.db.QueryRowContext(ctx, `SELECT data FROM sender_requests WHERE id = ?`, id.String()).Scan(&data)
+	if errors.Is(err, sql.ErrNoRows) {
+		return sender.Request{}, sender.ErrRequestNotFound
+	}
+
+	if err != nil {
+		return sender.Request{}, fmt.Errorf("sqlite: failed to get sender request: %w", err)
+	}
+
+	var req sender.Request
+	if err := decode(data, &req); err != nil {
+		return sender.Request{}, fmt.Errorf("sqlite: failed to decode sender request: %w", err)
+	}
+
+	return req, nil
+}
+
+// FindSenderRequests returns the sender requests of a project, in the order
+// they were created.
+func (db *Database) FindSenderRequests(ctx context.Context, projectID ulid.ULID) ([]sender.Request, error) {
+	if projectID.Compare(ulid.ULID{}) == 0 {
+		return nil, sender.ErrProjectIDMustBeSet
+	}
+
+	rows, err := db.db.QueryContext(ctx, `SELECT data FROM sender_requests WHERE project_id = ? ORDER BY id`,
+		projectID.String())
+	if err != nil {
+		return nil, fmt.Errorf("sqlite: failed to query sender requests: %w", err)
+	}
+	defer rows.Close()
+
+	reqs := make([]sender.Request, 0)
+
+	for rows.Next() {
+		var req sender.Request
+		if err := scanRecord(rows, &req); err != nil {
+			return nil, fmt.Errorf("sqlite: failed to scan sender request: %w", err)
+		}
+
+		reqs = append(reqs, req)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("sqlite: failed to iterate sender requests: %w", err)
+	}
+
+	return reqs, nil
+}
+
+// StoreSenderRequest creates or overwrites a sender request.
+func (db *Database) StoreSenderRequest(ctx context.Context, req sender.Request) error {
+	data, err := encode(req)
+	if err != nil {
+		return fmt.Errorf("sqlite: failed to encode sender request: %w", err)
+	}
+
+	var url string
+	if req.URL != nil {
+		url = req.URL.String()
+	}
+
+	_, err = db.db.ExecContext(ctx, `INSERT INTO sender_requests (id, project_id, method, url, data)
+		VALUES (?, ?, ?, ?, ?)
+		ON CONFLICT (id) DO UPDATE SET project_id = excluded.project_id, method = excluded.method,
+			url = excluded.url, data = excluded.data`,
+		req.ID.String(), req.ProjectID.String(), req.Method, url, data)
+	if err != nil {
+		return fmt.Errorf("sqlite: failed to store sender request: %w", err)
+	}
+
+	return nil
+}
+
+func (db *Database) DeleteSenderRequests(ctx context.Context, projectID ulid.ULID) error {
+	_, err := db.db.ExecContext(ctx, `DELETE FROM sender_requests WHERE project_id = ?`, projectID.String())
+	if err != nil {
+		return fmt.Errorf("sqlite: failed to delete sender requests: %w", err)
+	}
+
+	return nil
+}
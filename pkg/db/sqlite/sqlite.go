@@ -0,0 +1,168 @@
+// Package sqlite implements the repositories of Hetty with a SQLite database.
+//
+// Besides the encoded records themselves, tables have columns for commonly
+// queried fields (e.g. the method, URL and host of a request log), so the
+// database can be queried outside of Hetty too.
+package sqlite
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/gob"
+	"errors"
+	"fmt"
+
+	"github.com/oklog/ulid"
+	// Registers the `sqlite` driver. Unlike cgo based drivers, it's pure Go, so
+	// Hetty can be built with `CGO_ENABLED=0`.
+	_ "modernc.org/sqlite"
+)
+
+var ErrEncryptionNotSupported = errors.New("sqlite: encryption at rest is not supported")
+
+var schema = []string{
+	`CREATE TABLE IF NOT EXISTS projects (
+		id TEXT PRIMARY KEY,
+		name TEXT NOT NULL,
+		data BLOB NOT NULL
+	)`,
+	`CREATE TABLE IF NOT EXISTS request_logs (
+		id TEXT PRIMARY KEY,
+		project_id TEXT NOT NULL,
+		method TEXT NOT NULL,
+		url TEXT NOT NULL,
+		host TEXT NOT NULL,
+		proto TEXT NOT NULL,
+		data BLOB NOT NULL
+	)`,
+	`CREATE INDEX IF NOT EXISTS request_logs_project_id ON request_logs (project_id, id)`,
+	`CREATE TABLE IF NOT EXISTS response_logs (
+		request_log_id TEXT PRIMARY KEY,
+		status_code INTEGER NOT NULL,
+		duration INTEGER NOT NULL,
+		data BLOB NOT NULL
+	)`,
+	`CREATE TABLE IF NOT EXISTS websocket_messages (
+		id TEXT PRIMARY KEY,
+		project_id TEXT NOT NULL,
+		connection_id TEXT NOT NULL,
+		data BLOB NOT NULL
+	)`,
+	`CREATE INDEX IF NOT EXISTS websocket_messages_project_id ON websocket_messages (project_id, id)`,
+	`CREATE TABLE IF NOT EXISTS sender_requests (
+		id TEXT PRIMARY KEY,
+		project_id TEXT NOT NULL,
+		method TEXT NOT NULL,
+		url TEXT NOT NULL,
+		data BLOB NOT NULL
+	)`,
+	`CREATE INDEX IF NOT EXISTS sender_requests_project_id ON sender_requests (project_id, id)`,
+	`CREATE TABLE IF NOT EXISTS findings (
+		id TEXT PRIMARY KEY,
+		project_id TEXT NOT NULL,
+		request_log_id TEXT NOT NULL,
+		check_name TEXT NOT NULL,
+		title TEXT NOT NULL,
+		severity INTEGER NOT NULL,
+		data BLOB NOT NULL
+	)`,
+	`CREATE INDEX IF NOT EXISTS findings_project_id ON findings (project_id, id)`,
+}
+
+// Database is used to store and retrieve data from an underlying SQLite
+// database.
+type Database struct {
+	db *sql.DB
+}
+
+// OpenDatabase opens a SQLite database file, and creates its tables if they
+// don't exist yet. Use `:memory:` as path for an in-memory database.
+func OpenDatabase(path string) (*Database, error) {
+	sqlDB, err := sql.Open("sqlite", "file:"+path+"?_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)")
+	if err != nil {
+		return nil, fmt.Errorf("sqlite: failed to open database: %w", err)
+	}
+
+	// SQLite only supports a single writer; serializing all access to a single
+	// connection prevents "database is locked" errors, and keeps in-memory
+	// databases from being created per connection.
+	sqlDB.SetMaxOpenConns(1)
+
+	for _, stmt := range schema {
+		if _, err := sqlDB.Exec(stmt); err != nil {
+			sqlDB.Close()
+			return nil, fmt.Errorf("sqlite: failed to create schema: %w", err)
+		}
+	}
+
+	return &Database{db: sqlDB}, nil
+}
+
+// Close closes the underlying SQLite database.
+func (db *Database) Close() error {
+	return db.db.Close()
+}
+
+// SetProjectKey returns an error for keys other than nil, because the SQLite
+// backend doesn't support encryption at rest.
+func (db *Database) SetProjectKey(projectID ulid.ULID, key []byte) error {
+	if key != nil {
+		return ErrEncryptionNotSupported
+	}
+
+	return nil
+}
+
+// LockProject is a no-op, as projects are never encrypted.
+func (db *Database) LockProject(projectID ulid.ULID) {}
+
+// RekeyProject returns an error for keys other than nil, because the SQLite
+// backend doesn't support encryption at rest.
+func (db *Database) RekeyProject(ctx context.Context, projectID ulid.ULID, key []byte) error {
+	return db.SetProjectKey(projectID, key)
+}
+
+func encode(v interface{}) ([]byte, error) {
+	buf := bytes.Buffer{}
+
+	if err := gob.NewEncoder(&buf).Encode(v); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func decode(data []byte, v interface{}) error {
+	return gob.NewDecoder(bytes.NewReader(data)).Decode(v)
+}
+
+// withTx runs fn in a transaction, which is committed if fn returns nil, and
+// rolled back otherwise.
+func (db *Database) withTx(ctx context.Context, fn func(tx *sql.Tx) error) error {
+	tx, err := db.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	if err := fn(tx); err != nil {
+		tx.Rollback() //nolint:errcheck
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// scanRecord decodes the encoded record of the current row into v.
+func scanRecord(rows *sql.Rows, v interface{}) error {
+	var data []byte
+	if err := rows.Scan(&data); err != nil {
+		return err
+	}
+
+	return decode(data, v)
+}
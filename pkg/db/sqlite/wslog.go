@@ -0,0 +1,90 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/oklog/ulid"
+
+	"github.com/dstotijn/hetty/pkg/wslog"
+)
+
+// FindWebSocketMessages returns the WebSocket messages of a project, in the
+// order they were logged.
+func (db *Database) FindWebSocketMessages(
+	ctx context.Context,
+	filter wslog.FindMessagesFilter,
+) ([]wslog.Message, error) {
+	if filter.ProjectID.Compare(ulid.ULID{}) == 0 {
+		return nil, wslog.ErrProjectIDMustBeSet
+	}
+
+	query := `SELECT data FROM websocket_messages WHERE project_id = ?`
+	args := []interface{}{filter.ProjectID.String()}
+
+	if filter.ConnectionID.Compare(ulid.ULID{}) != 0 {
+		query += ` AND connection_id = ?`
+		args = append(args, filter.ConnectionID.String())
+	}
+
+	rows, err := db.db.QueryContext(ctx, query+` ORDER BY id`, args...)
+	if err != nil {
+		return nil, fmt.Errorf("sqlite: failed to query WebSocket messages: %w", err)
+	}
+	defer rows.Close()
+
+	msgs := make([]wslog.Message, 0)
+
+	for rows.Next() {
+		var msg wslog.Message
+		if err := scanRecord(rows, &msg); err != nil {
+			return nil, fmt.Errorf("sqlite: failed to scan WebSocket message: %w", err)
+		}
+
+		if filter.SearchExpr != nil {
+			match, err := msg.Matches(filter.SearchExpr)
+			if err != nil {
+				return nil, fmt.Errorf("sqlite: failed to match WebSocket message (id: %v): %w", msg.ID.String(), err)
+			}
+
+			if !match {
+				continue
+			}
+		}
+
+		msgs = append(msgs, msg)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("sqlite: failed to iterate WebSocket messages: %w", err)
+	}
+
+	return msgs, nil
+}
+
+func (db *Database) StoreWebSocketMessage(ctx context.Context, msg wslog.Message) error {
+	data, err := encode(msg)
+	if err != nil {
+		return fmt.Errorf("sqlite: failed to encode WebSocket message: %w", err)
+	}
+
+	_, err = db.db.ExecContext(ctx, `INSERT INTO websocket_messages (id, project_id, connection_id, data)
+		VALUES (?, ?, ?, ?)
+		ON CONFLICT (id) DO UPDATE SET project_id = excluded.project_id, connection_id = excluded.connection_id,
+			data = excluded.data`,
+		msg.ID.String(), msg.ProjectID.String(), msg.ConnectionID.String(), data)
+	if err != nil {
+		return fmt.Errorf("sqlite: failed to store WebSocket message: %w", err)
+	}
+
+	return nil
+}
+
+func (db *Database) ClearWebSocketMessages(ctx context.Context, projectID ulid.ULID) error {
+	_, err := db.db.ExecContext(ctx, `DELETE FROM websocket_messages WHERE project_id = ?`, projectID.String())
+	if err != nil {
+		return fmt.Errorf("sqlite: failed to delete WebSocket messages: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,476 @@
+package fuzz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/oklog/ulid"
+
+	"github.com/dstotijn/hetty/pkg/reqlog"
+	"github.com/dstotijn/hetty/pkg/search"
+	"github.com/dstotijn/hetty/pkg/sender"
+)
+
+// subscriberBufferSize is the number of results that are buffered for each
+// subscriber. Results are dropped for subscribers that fall behind.
+const subscriberBufferSize = 64
+
+var ErrAttackNotFound = errors.New("fuzz: attack not found")
+
+// Mode defines how payloads are inserted in the insertion points of a template.
+type Mode int
+
+const (
+	// ModeSniper inserts each payload of a single payload set in each
+	// insertion point in turn. Other insertion points keep their default value.
+	ModeSniper Mode = iota
+	// ModeBatteringRam inserts each payload of a single payload set in all
+	// insertion points at once.
+	ModeBatteringRam
+	// ModeClusterBomb uses a payload set per insertion point, and sends each
+	// combination of payloads.
+	ModeClusterBomb
+)
+
+type AttackConfig struct {
+	Template Template
+	Mode     Mode
+	// PayloadSources holds a payload source per insertion point for
+	// `ModeClusterBomb`, and a single payload source for other modes.
+	PayloadSources []PayloadSource
+	// Concurrency is the number of requests that are sent at once. Defaults
+	// to 1.
+	Concurrency int
+}
+
+// Attack is the progress of an attack.
+type Attack struct {
+	ID   ulid.ULID
+	Mode Mode
+	// Total is the number of requests of the attack.
+	Total int
+	// Completed is the number of requests that were sent so far.
+	Completed int
+	// Done is true once all requests were sent, or the attack was stopped.
+	Done bool
+}
+
+// Result is a request sent by an attack, with its response.
+type Result struct {
+	ID       ulid.ULID
+	AttackID ulid.ULID
+	// Index is the position of the request in the order of the attack, which
+	// can differ from the order in which results are recorded.
+	Index int
+	// Payloads are the values of the insertion points, in order.
+	Payloads []string
+	// Request is the sent request, with its response. It's a request log, so
+	// results can be filtered with the search language of `reqlog`.
+	Request reqlog.RequestLog
+	// Error is the reason the request failed, in which case there's no
+	// response.
+	Error    string
+	Duration time.Duration
+}
+
+type attack struct {
+	mu      sync.Mutex
+	state   Attack
+	results []Result
+	cancel  context.CancelFunc
+}
+
+// Service runs attacks, which send requests with payloads inserted in a
+// template. Attacks and their results are kept in memory.
+type Service struct {
+	sender *sender.Service
+
+	mu sync.Mutex
+	// entropy is monotonic, so results are ordered by ID.
+	entropy     io.Reader
+	attacks     map[ulid.ULID]*attack
+	subscribers map[chan Result]struct{}
+}
+
+type Config struct {
+	// SenderService is used for sending requests.
+	SenderService *sender.Service
+}
+
+func NewService(cfg Config) *Service {
+	return &Service{
+		sender: cfg.SenderService,
+		//nolint:gosec
+		entropy:     ulid.Monotonic(rand.New(rand.NewSource(time.Now().UnixNano())), 0),
+		attacks:     make(map[ulid.ULID]*attack),
+		subscribers: make(map[chan Result]struct{}),
+	}
+}
+
+// StartAttack validates the attack config, and starts sending its requests in
+// the background. Use `Results` or `Subscribe` to get the results.
+func (svc *Service) StartAttack(cfg AttackConfig) (Attack, error) {
+	tmpl, err := parseTemplate(cfg.Template)
+	if err != nil {
+		return Attack{}, err
+	}
+
+	gen, err := newGenerator(cfg.Mode, tmpl.defaults(), cfg.PayloadSources)
+	if err != nil {
+		return Attack{}, err
+	}
+
+	concurrency := cfg.Concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	svc.mu.Lock()
+	a := &attack{
+		state: Attack{
+			ID:    ulid.MustNew(ulid.Timestamp(time.Now()), svc.entropy),
+			Mode:  cfg.Mode,
+			Total: gen.total(),
+		},
+		cancel: cancel,
+	}
+	svc.attacks[a.state.ID] = a
+	svc.mu.Unlock()
+
+	go svc.run(ctx, a, tmpl, gen, concurrency)
+
+	return a.snapshot(), nil
+}
+
+func (svc *Service) run(ctx context.Context, a *attack, tmpl parsedTemplate, gen generator, concurrency int) {
+	indices := make(chan int)
+	wg := sync.WaitGroup{}
+
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for index := range indices {
+				svc.send(ctx, a, tmpl, index, gen.values(index))
+			}
+		}()
+	}
+
+loop:
+	for i := 0; i < gen.total(); i++ {
+		select {
+		case indices <- i:
+		case <-ctx.Done():
+			break loop
+		}
+	}
+
+	close(indices)
+	wg.Wait()
+
+	a.mu.Lock()
+	a.state.Done = true
+	a.mu.Unlock()
+}
+
+func (svc *Service) send(ctx context.Context, a *attack, tmpl parsedTemplate, index int, values []string) {
+	svc.mu.Lock()
+	result := Result{
+		ID:       ulid.MustNew(ulid.Timestamp(time.Now()), svc.entropy),
+		AttackID: a.state.ID,
+		Index:    index,
+		Payloads: values,
+	}
+	svc.mu.Unlock()
+
+	req, err := tmpl.render(values)
+	if err != nil {
+		result.Error = err.Error()
+	} else {
+		result.Request = reqlog.RequestLog{
+			ID:     result.ID,
+			URL:    req.URL,
+			Method: req.Method,
+			Proto:  req.Proto,
+			Header: req.Header,
+			Body:   req.Body,
+		}
+
+		start := time.Now()
+
+		resLog, err := svc.sender.Do(ctx, req)
+
+		result.Duration = time.Since(start)
+
+		switch {
+		case ctx.Err() != nil:
+			// The attack was stopped while the request was in flight.
+			return
+		case err != nil:
+			result.Error = err.Error()
+		default:
+			result.Request.Response = &resLog
+		}
+	}
+
+	a.mu.Lock()
+	a.results = append(a.results, result)
+	a.state.Completed++
+	a.mu.Unlock()
+
+	svc.broadcast(result)
+}
+
+func (svc *Service) broadcast(result Result) {
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+
+	for ch := range svc.subscribers {
+		select {
+		case ch <- result:
+		default:
+		}
+	}
+}
+
+func (a *attack) snapshot() Attack {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.state
+}
+
+func (svc *Service) findAttack(id ulid.ULID) (*attack, error) {
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+
+	a, ok := svc.attacks[id]
+	if !ok {
+		return nil, ErrAttackNotFound
+	}
+
+	return a, nil
+}
+
+func (svc *Service) Attack(id ulid.ULID) (Attack, error) {
+	a, err := svc.findAttack(id)
+	if err != nil {
+		return Attack{}, err
+	}
+
+	return a.snapshot(), nil
+}
+
+// Attacks returns all attacks, oldest first.
+func (svc *Service) Attacks() []Attack {
+	svc.mu.Lock()
+	attacks := make([]*attack, 0, len(svc.attacks))
+
+	for _, a := range svc.attacks {
+		attacks = append(attacks, a)
+	}
+	svc.mu.Unlock()
+
+	states := make([]Attack, len(attacks))
+	for i, a := range attacks {
+		states[i] = a.snapshot()
+	}
+
+	sort.Slice(states, func(i, j int) bool {
+		return states[i].ID.Compare(states[j].ID) < 0
+	})
+
+	return states
+}
+
+// Results returns the results of an attack that match expr, in order of index.
+// If expr is nil, all results are returned.
+func (svc *Service) Results(id ulid.ULID, expr search.Expression) ([]Result, error) {
+	a, err := svc.findAttack(id)
+	if err != nil {
+		return nil, err
+	}
+
+	a.mu.Lock()
+	results := make([]Result, len(a.results))
+	copy(results, a.results)
+	a.mu.Unlock()
+
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].Index < results[j].Index
+	})
+
+	if expr == nil {
+		return results, nil
+	}
+
+	match, err := reqlog.CompileMatcher(expr, reqlog.DefaultMatchConfig())
+	if err != nil {
+		return nil, fmt.Errorf("fuzz: could not compile search expression: %w", err)
+	}
+
+	matched := make([]Result, 0, len(results))
+
+	for _, result := range results {
+		if match(result.Request) {
+			matched = append(matched, result)
+		}
+	}
+
+	return matched, nil
+}
+
+// StopAttack stops sending the requests of an attack. Its results are kept.
+func (svc *Service) StopAttack(id ulid.ULID) error {
+	a, err := svc.findAttack(id)
+	if err != nil {
+		return err
+	}
+
+	a.cancel()
+
+	return nil
+}
+
+// DeleteAttack stops an attack, and removes it and its results.
+func (svc *Service) DeleteAttack(id ulid.ULID) error {
+	if err := svc.StopAttack(id); err != nil {
+		return err
+	}
+
+	svc.mu.Lock()
+	delete(svc.attacks, id)
+	svc.mu.Unlock()
+
+	return nil
+}
+
+// Subscribe returns a channel that receives results as they're recorded, until
+// ctx is done.
+func (svc *Service) Subscribe(ctx context.Context) <-chan Result {
+	ch := make(chan Result, subscriberBufferSize)
+
+	svc.mu.Lock()
+	svc.subscribers[ch] = struct{}{}
+	svc.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		svc.mu.Lock()
+		delete(svc.subscribers, ch)
+		svc.mu.Unlock()
+
+		close(ch)
+	}()
+
+	return ch
+}
+
+// generator returns the values of the insertion points for each request of an
+// attack, by index, so permutations needn't be kept in memory.
+type generator struct {
+	mode     Mode
+	defaults []string
+	sets     [][]string
+}
+
+func newGenerator(mode Mode, defaults []string, sources []PayloadSource) (generator, error) {
+	if len(defaults) == 0 {
+		return generator{}, errors.New("fuzz: template must have an insertion point")
+	}
+
+	switch mode {
+	case ModeSniper, ModeBatteringRam:
+		if len(sources) != 1 {
+			return generator{}, errors.New("fuzz: attack mode requires a single payload source")
+		}
+	case ModeClusterBomb:
+		if len(sources) != len(defaults) {
+			return generator{}, fmt.Errorf("fuzz: cluster bomb attack requires a payload source per insertion point (%v)",
+				len(defaults))
+		}
+	default:
+		return generator{}, fmt.Errorf("fuzz: invalid attack mode: %v", mode)
+	}
+
+	gen := generator{
+		mode:     mode,
+		defaults: defaults,
+		sets:     make([][]string, len(sources)),
+	}
+
+	for i, source := range sources {
+		payloads, err := source.Payloads()
+		if err != nil {
+			return generator{}, err
+		}
+
+		if len(payloads) == 0 {
+			return generator{}, errors.New("fuzz: payload set is empty")
+		}
+
+		gen.sets[i] = payloads
+	}
+
+	if gen.total() > maxPayloads {
+		return generator{}, fmt.Errorf("fuzz: attack has more than %v requests", maxPayloads)
+	}
+
+	return gen, nil
+}
+
+func (gen generator) total() int {
+	switch gen.mode {
+	case ModeSniper:
+		return len(gen.defaults) * len(gen.sets[0])
+	case ModeBatteringRam:
+		return len(gen.sets[0])
+	default:
+		total := 1
+
+		for _, set := range gen.sets {
+			total *= len(set)
+			// Stop early, so the product can't overflow.
+			if total > maxPayloads {
+				return total
+			}
+		}
+
+		return total
+	}
+}
+
+func (gen generator) values(index int) []string {
+	values := make([]string, len(gen.defaults))
+
+	switch gen.mode {
+	case ModeSniper:
+		copy(values, gen.defaults)
+		set := gen.sets[0]
+		values[index/len(set)] = set[index%len(set)]
+	case ModeBatteringRam:
+		for i := range values {
+			values[i] = gen.sets[0][index]
+		}
+	default:
+		// The last insertion point's payload changes fastest.
+		for i := len(values) - 1; i >= 0; i-- {
+			set := gen.sets[i]
+			values[i] = set[index%len(set)]
+			index /= len(set)
+		}
+	}
+
+	return values
+}
@@ -0,0 +1,325 @@
+package fuzz_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/oklog/ulid"
+
+	"github.com/dstotijn/hetty/pkg/fuzz"
+	"github.com/dstotijn/hetty/pkg/search"
+	"github.com/dstotijn/hetty/pkg/sender"
+)
+
+// loginHandler responds with status 200 if the password is "secret", and 401
+// otherwise. The response body echoes the user and the request body.
+func loginHandler(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+
+	if r.URL.Query().Get("pass") != "secret" {
+		w.WriteHeader(http.StatusUnauthorized)
+	}
+
+	w.Write([]byte(r.URL.Query().Get("user") + " " + r.Header.Get("X-Token") + " " + string(body))) //nolint:errcheck
+}
+
+func waitForAttack(t *testing.T, svc *fuzz.Service, id ulid.ULID) fuzz.Attack {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+
+	for time.Now().Before(deadline) {
+		attack, err := svc.Attack(id)
+		if err != nil {
+			t.Fatalf("unexpected error getting attack: %v", err)
+		}
+
+		if attack.Done {
+			return attack
+		}
+
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	t.Fatal("timed out waiting for attack to finish")
+
+	return fuzz.Attack{}
+}
+
+func TestStartAttack(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(loginHandler))
+	// Parallel subtests run after the test function returns.
+	t.Cleanup(ts.Close)
+
+	tests := []struct {
+		name             string
+		cfg              fuzz.AttackConfig
+		expectedPayloads [][]string
+		expectedBodies   []string
+	}{
+		{
+			name: "sniper",
+			cfg: fuzz.AttackConfig{
+				Template: fuzz.Template{
+					Method: http.MethodGet,
+					URL:    ts.URL + "/login?user=§admin§&pass=§x§",
+				},
+				Mode:           fuzz.ModeSniper,
+				PayloadSources: []fuzz.PayloadSource{fuzz.List{"root", "secret"}},
+				Concurrency:    2,
+			},
+			expectedPayloads: [][]string{{"root", "x"}, {"secret", "x"}, {"admin", "root"}, {"admin", "secret"}},
+			expectedBodies:   []string{"root  ", "secret  ", "admin  ", "admin  "},
+		},
+		{
+			name: "battering ram",
+			cfg: fuzz.AttackConfig{
+				Template: fuzz.Template{
+					Method: http.MethodGet,
+					URL:    ts.URL + "/login?user=§§&pass=§§",
+				},
+				Mode:           fuzz.ModeBatteringRam,
+				PayloadSources: []fuzz.PayloadSource{fuzz.List{"root", "secret"}},
+			},
+			expectedPayloads: [][]string{{"root", "root"}, {"secret", "secret"}},
+			expectedBodies:   []string{"root  ", "secret  "},
+		},
+		{
+			name: "cluster bomb, insertion points in URL, header and body",
+			cfg: fuzz.AttackConfig{
+				Template: fuzz.Template{
+					Method: http.MethodPost,
+					URL:    ts.URL + "/login?user=§§&pass=secret",
+					Header: http.Header{"X-Token": []string{"t§§"}},
+					Body:   "id=§0§",
+				},
+				Mode: fuzz.ModeClusterBomb,
+				PayloadSources: []fuzz.PayloadSource{
+					fuzz.List{"root", "admin"},
+					fuzz.NumberRange{From: 1, To: 2},
+					fuzz.List{"9"},
+				},
+				Concurrency: 4,
+			},
+			expectedPayloads: [][]string{{"root", "1", "9"}, {"root", "2", "9"}, {"admin", "1", "9"}, {"admin", "2", "9"}},
+			expectedBodies:   []string{"root t1 id=9", "root t2 id=9", "admin t1 id=9", "admin t2 id=9"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := fuzz.NewService(fuzz.Config{SenderService: sender.NewService(sender.Config{})})
+
+			attack, err := svc.StartAttack(tt.cfg)
+			assertError(t, nil, err)
+
+			if attack.Total != len(tt.expectedPayloads) {
+				t.Errorf("incorrect total (expected: %v, got: %v)", len(tt.expectedPayloads), attack.Total)
+			}
+
+			attack = waitForAttack(t, svc, attack.ID)
+
+			if attack.Completed != attack.Total {
+				t.Errorf("incorrect completed count (expected: %v, got: %v)", attack.Total, attack.Completed)
+			}
+
+			results, err := svc.Results(attack.ID, nil)
+			assertError(t, nil, err)
+
+			var gotPayloads [][]string
+
+			var gotBodies []string
+
+			for _, result := range results {
+				if result.Error != "" {
+					t.Fatalf("unexpected result error: %v", result.Error)
+				}
+
+				gotPayloads = append(gotPayloads, result.Payloads)
+				gotBodies = append(gotBodies, string(result.Request.Response.Body))
+			}
+
+			if diff := cmp.Diff(tt.expectedPayloads, gotPayloads); diff != "" {
+				t.Errorf("payloads not equal (-exp, +got):\n%v", diff)
+			}
+
+			if diff := cmp.Diff(tt.expectedBodies, gotBodies); diff != "" {
+				t.Errorf("response bodies not equal (-exp, +got):\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestStartAttackInvalidConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		cfg           fuzz.AttackConfig
+		expectedError error
+	}{
+		{
+			name:          "without method",
+			cfg:           fuzz.AttackConfig{Template: fuzz.Template{URL: "http://example.com/§§"}},
+			expectedError: errors.New("fuzz: method must be set"),
+		},
+		{
+			name: "unbalanced marker",
+			cfg: fuzz.AttackConfig{
+				Template: fuzz.Template{Method: http.MethodGet, URL: "http://example.com/§foo"},
+			},
+			expectedError: errors.New(`fuzz: invalid URL: unbalanced insertion point marker "§"`),
+		},
+		{
+			name: "without insertion point",
+			cfg: fuzz.AttackConfig{
+				Template:       fuzz.Template{Method: http.MethodGet, URL: "http://example.com/"},
+				PayloadSources: []fuzz.PayloadSource{fuzz.List{"foo"}},
+			},
+			expectedError: errors.New("fuzz: template must have an insertion point"),
+		},
+		{
+			name: "cluster bomb with too few payload sources",
+			cfg: fuzz.AttackConfig{
+				Template:       fuzz.Template{Method: http.MethodGet, URL: "http://example.com/§a§/§b§"},
+				Mode:           fuzz.ModeClusterBomb,
+				PayloadSources: []fuzz.PayloadSource{fuzz.List{"foo"}},
+			},
+			expectedError: errors.New("fuzz: cluster bomb attack requires a payload source per insertion point (2)"),
+		},
+		{
+			name: "empty payload set",
+			cfg: fuzz.AttackConfig{
+				Template:       fuzz.Template{Method: http.MethodGet, URL: "http://example.com/§a§"},
+				PayloadSources: []fuzz.PayloadSource{fuzz.List{}},
+			},
+			expectedError: errors.New("fuzz: payload set is empty"),
+		},
+		{
+			name: "too many requests",
+			cfg: fuzz.AttackConfig{
+				Template: fuzz.Template{Method: http.MethodGet, URL: "http://example.com/§a§/§b§"},
+				Mode:     fuzz.ModeClusterBomb,
+				PayloadSources: []fuzz.PayloadSource{
+					fuzz.NumberRange{From: 1, To: 1000},
+					fuzz.NumberRange{From: 1, To: 1000},
+				},
+			},
+			expectedError: errors.New("fuzz: attack has more than 100000 requests"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := fuzz.NewService(fuzz.Config{SenderService: sender.NewService(sender.Config{})})
+
+			_, err := svc.StartAttack(tt.cfg)
+			assertError(t, tt.expectedError, err)
+		})
+	}
+}
+
+func TestResultsFilterAndSubscribe(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(loginHandler))
+	defer ts.Close()
+
+	svc := fuzz.NewService(fuzz.Config{SenderService: sender.NewService(sender.Config{})})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	recorded := svc.Subscribe(ctx)
+
+	attack, err := svc.StartAttack(fuzz.AttackConfig{
+		Template: fuzz.Template{
+			Method: http.MethodGet,
+			URL:    ts.URL + "/login?user=admin&pass=§§",
+		},
+		PayloadSources: []fuzz.PayloadSource{fuzz.List{"foo", "secret", "bar"}},
+	})
+	assertError(t, nil, err)
+
+	for i := 0; i < attack.Total; i++ {
+		select {
+		case result := <-recorded:
+			if result.AttackID != attack.ID {
+				t.Errorf("incorrect attack ID (expected: %v, got: %v)", attack.ID, result.AttackID)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for recorded result")
+		}
+	}
+
+	waitForAttack(t, svc, attack.ID)
+
+	expr, err := search.ParseQuery("res.statusCode = 200")
+	assertError(t, nil, err)
+
+	results, err := svc.Results(attack.ID, expr)
+	assertError(t, nil, err)
+
+	if len(results) != 1 || results[0].Payloads[0] != "secret" {
+		t.Fatalf("incorrect filtered results: %+v", results)
+	}
+
+	if err := svc.DeleteAttack(attack.ID); err != nil {
+		t.Fatalf("unexpected error deleting attack: %v", err)
+	}
+
+	_, err = svc.Results(attack.ID, nil)
+	assertError(t, fuzz.ErrAttackNotFound, err)
+}
+
+func TestStopAttack(t *testing.T) {
+	t.Parallel()
+
+	unblock := make(chan struct{})
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-unblock:
+		case <-r.Context().Done():
+		}
+	}))
+	defer ts.Close()
+	defer close(unblock)
+
+	svc := fuzz.NewService(fuzz.Config{SenderService: sender.NewService(sender.Config{})})
+
+	attack, err := svc.StartAttack(fuzz.AttackConfig{
+		Template:       fuzz.Template{Method: http.MethodGet, URL: ts.URL + "/§§"},
+		PayloadSources: []fuzz.PayloadSource{fuzz.NumberRange{From: 1, To: 100}},
+	})
+	assertError(t, nil, err)
+
+	if err := svc.StopAttack(attack.ID); err != nil {
+		t.Fatalf("unexpected error stopping attack: %v", err)
+	}
+
+	// The request in flight is canceled, and isn't recorded.
+	attack = waitForAttack(t, svc, attack.ID)
+
+	if attack.Completed != 0 {
+		t.Errorf("expected no completed requests, got: %v", attack.Completed)
+	}
+
+	if err := svc.StopAttack(ulid.MustNew(1, nil)); !errors.Is(err, fuzz.ErrAttackNotFound) {
+		t.Errorf("expected `fuzz.ErrAttackNotFound`, got: %v", err)
+	}
+}
@@ -0,0 +1,166 @@
+package fuzz
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// maxPayloads is the maximum number of payloads of a payload source, and of
+// requests of an attack.
+const maxPayloads = 100000
+
+// PayloadSource provides a payload set.
+type PayloadSource interface {
+	Payloads() ([]string, error)
+}
+
+// List is a payload source of fixed values.
+type List []string
+
+func (l List) Payloads() ([]string, error) {
+	return l, nil
+}
+
+// Wordlist is a payload source that reads payloads from a file, one per line.
+// Empty lines are skipped.
+type Wordlist struct {
+	Path string
+}
+
+func (wl Wordlist) Payloads() ([]string, error) {
+	f, err := os.Open(wl.Path)
+	if err != nil {
+		return nil, fmt.Errorf("fuzz: could not open wordlist: %w", err)
+	}
+	defer f.Close()
+
+	var payloads []string
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSuffix(scanner.Text(), "\r")
+		if line == "" {
+			continue
+		}
+
+		if len(payloads) == maxPayloads {
+			return nil, fmt.Errorf("fuzz: wordlist has more than %v payloads", maxPayloads)
+		}
+
+		payloads = append(payloads, line)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("fuzz: could not read wordlist: %w", err)
+	}
+
+	return payloads, nil
+}
+
+// NumberRange is a payload source of the numbers from From up to and including
+// To, incremented by Step. Step defaults to 1, or -1 if To is less than From.
+// Numbers are formatted with Format (see `fmt`), e.g. `%04d`, which defaults
+// to `%d`.
+type NumberRange struct {
+	From, To, Step int
+	Format         string
+}
+
+func (nr NumberRange) Payloads() ([]string, error) {
+	step := nr.Step
+	if step == 0 {
+		step = 1
+		if nr.To < nr.From {
+			step = -1
+		}
+	}
+
+	if (step > 0 && nr.To < nr.From) || (step < 0 && nr.To > nr.From) {
+		return nil, errors.New("fuzz: number range step doesn't lead from start to end")
+	}
+
+	if n := (nr.To-nr.From)/step + 1; n > maxPayloads {
+		return nil, fmt.Errorf("fuzz: number range has more than %v payloads", maxPayloads)
+	}
+
+	format := nr.Format
+	if format == "" {
+		format = "%d"
+	}
+
+	var payloads []string
+
+	for n := nr.From; (step > 0 && n <= nr.To) || (step < 0 && n >= nr.To); n += step {
+		payloads = append(payloads, fmt.Sprintf(format, n))
+	}
+
+	return payloads, nil
+}
+
+// BruteForce is a payload source of all strings of Charset characters, with a
+// length from MinLength up to and including MaxLength.
+type BruteForce struct {
+	Charset              string
+	MinLength, MaxLength int
+}
+
+func (bf BruteForce) Payloads() ([]string, error) {
+	charset := []rune(bf.Charset)
+
+	if len(charset) == 0 {
+		return nil, errors.New("fuzz: brute force charset must be set")
+	}
+
+	if bf.MinLength < 1 || bf.MaxLength < bf.MinLength {
+		return nil, errors.New("fuzz: brute force lengths must be positive, with minimum not exceeding maximum")
+	}
+
+	total := 0
+
+	for length, n := 1, 1; length <= bf.MaxLength; length++ {
+		n *= len(charset)
+		if length >= bf.MinLength {
+			total += n
+		}
+
+		if total > maxPayloads || n > maxPayloads {
+			return nil, fmt.Errorf("fuzz: brute force has more than %v payloads", maxPayloads)
+		}
+	}
+
+	payloads := make([]string, 0, total)
+
+	for length := bf.MinLength; length <= bf.MaxLength; length++ {
+		// Each payload is a number in base len(charset), with a digit per
+		// character.
+		digits := make([]int, length)
+		payload := make([]rune, length)
+
+		for {
+			for i, d := range digits {
+				payload[i] = charset[d]
+			}
+
+			payloads = append(payloads, string(payload))
+
+			i := length - 1
+			for ; i >= 0; i-- {
+				digits[i]++
+				if digits[i] < len(charset) {
+					break
+				}
+
+				digits[i] = 0
+			}
+
+			if i < 0 {
+				break
+			}
+		}
+	}
+
+	return payloads, nil
+}
@@ -0,0 +1,101 @@
+package fuzz_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/dstotijn/hetty/pkg/fuzz"
+)
+
+func TestPayloads(t *testing.T) {
+	t.Parallel()
+
+	wordlist := filepath.Join(t.TempDir(), "words.txt")
+	if err := os.WriteFile(wordlist, []byte("admin\r\n\nroot\nguest\n"), 0o600); err != nil {
+		t.Fatalf("failed to write wordlist: %v", err)
+	}
+
+	tests := []struct {
+		name             string
+		source           fuzz.PayloadSource
+		expectedPayloads []string
+		expectedError    error
+	}{
+		{
+			name:             "list",
+			source:           fuzz.List{"foo", "bar"},
+			expectedPayloads: []string{"foo", "bar"},
+		},
+		{
+			name:             "wordlist, empty lines skipped",
+			source:           fuzz.Wordlist{Path: wordlist},
+			expectedPayloads: []string{"admin", "root", "guest"},
+		},
+		{
+			name:             "number range",
+			source:           fuzz.NumberRange{From: 1, To: 10, Step: 4},
+			expectedPayloads: []string{"1", "5", "9"},
+		},
+		{
+			name:             "descending number range, with format",
+			source:           fuzz.NumberRange{From: 3, To: 1, Format: "%03d"},
+			expectedPayloads: []string{"003", "002", "001"},
+		},
+		{
+			name:          "number range with step in wrong direction",
+			source:        fuzz.NumberRange{From: 1, To: 3, Step: -1},
+			expectedError: errors.New("fuzz: number range step doesn't lead from start to end"),
+		},
+		{
+			name:          "number range too large",
+			source:        fuzz.NumberRange{From: 0, To: 1000000},
+			expectedError: errors.New("fuzz: number range has more than 100000 payloads"),
+		},
+		{
+			name:             "brute force",
+			source:           fuzz.BruteForce{Charset: "ab", MinLength: 1, MaxLength: 2},
+			expectedPayloads: []string{"a", "b", "aa", "ab", "ba", "bb"},
+		},
+		{
+			name:          "brute force too large",
+			source:        fuzz.BruteForce{Charset: "abcdefghijklmnopqrstuvwxyz", MinLength: 1, MaxLength: 4},
+			expectedError: errors.New("fuzz: brute force has more than 100000 payloads"),
+		},
+		{
+			name:          "brute force without charset",
+			source:        fuzz.BruteForce{MinLength: 1, MaxLength: 1},
+			expectedError: errors.New("fuzz: brute force charset must be set"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tt.source.Payloads()
+			assertError(t, tt.expectedError, err)
+
+			if diff := cmp.Diff(tt.expectedPayloads, got); diff != "" {
+				t.Fatalf("payloads not equal (-exp, +got):\n%v", diff)
+			}
+		})
+	}
+}
+
+func assertError(t *testing.T, exp, got error) {
+	t.Helper()
+
+	switch {
+	case exp == nil && got != nil:
+		t.Fatalf("expected: nil, got: %v", got)
+	case exp != nil && got == nil:
+		t.Fatalf("expected: %v, got: nil", exp.Error())
+	case exp != nil && got != nil && exp.Error() != got.Error():
+		t.Fatalf("expected: %v, got: %v", exp.Error(), got.Error())
+	}
+}
@@ -0,0 +1,153 @@
+package fuzz
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/dstotijn/hetty/pkg/sender"
+)
+
+// Marker delimits insertion points in a template, e.g. `id=§1§`. The text
+// between a pair of markers is the default value of the insertion point.
+const Marker = "§"
+
+// Template is a request with insertion points in its URL, header values and
+// body. Insertion points are numbered in that order, with header values in
+// order of key.
+type Template struct {
+	Method string
+	URL    string
+	Header http.Header
+	Body   string
+}
+
+// parsedTemplate holds the fields of a template split by marker. Parts at odd
+// indices are the default values of insertion points.
+type parsedTemplate struct {
+	method string
+	url    []string
+	header []parsedHeaderField
+	body   []string
+}
+
+type parsedHeaderField struct {
+	key   string
+	value []string
+}
+
+func parseTemplate(tmpl Template) (parsedTemplate, error) {
+	if tmpl.Method == "" {
+		return parsedTemplate{}, errors.New("fuzz: method must be set")
+	}
+
+	parsed := parsedTemplate{method: tmpl.Method}
+
+	var err error
+
+	if parsed.url, err = splitMarkers(tmpl.URL); err != nil {
+		return parsedTemplate{}, fmt.Errorf("fuzz: invalid URL: %w", err)
+	}
+
+	keys := make([]string, 0, len(tmpl.Header))
+	for key := range tmpl.Header {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		for _, value := range tmpl.Header[key] {
+			parts, err := splitMarkers(value)
+			if err != nil {
+				return parsedTemplate{}, fmt.Errorf("fuzz: invalid value of header %q: %w", key, err)
+			}
+
+			parsed.header = append(parsed.header, parsedHeaderField{key: key, value: parts})
+		}
+	}
+
+	if parsed.body, err = splitMarkers(tmpl.Body); err != nil {
+		return parsedTemplate{}, fmt.Errorf("fuzz: invalid body: %w", err)
+	}
+
+	return parsed, nil
+}
+
+func splitMarkers(s string) ([]string, error) {
+	parts := strings.Split(s, Marker)
+	if len(parts)%2 == 0 {
+		return nil, fmt.Errorf("unbalanced insertion point marker %q", Marker)
+	}
+
+	return parts, nil
+}
+
+// defaults returns the default values of the insertion points.
+func (t parsedTemplate) defaults() []string {
+	var values []string
+
+	t.eachField(func(parts []string) {
+		for i := 1; i < len(parts); i += 2 {
+			values = append(values, parts[i])
+		}
+	})
+
+	return values
+}
+
+func (t parsedTemplate) eachField(fn func(parts []string)) {
+	fn(t.url)
+
+	for _, field := range t.header {
+		fn(field.value)
+	}
+
+	fn(t.body)
+}
+
+// render returns the request with a value inserted in each insertion point.
+func (t parsedTemplate) render(values []string) (sender.Request, error) {
+	i := 0
+
+	join := func(parts []string) string {
+		b := strings.Builder{}
+
+		for j, part := range parts {
+			if j%2 == 0 {
+				b.WriteString(part)
+				continue
+			}
+
+			b.WriteString(values[i])
+			i++
+		}
+
+		return b.String()
+	}
+
+	u, err := url.Parse(join(t.url))
+	if err != nil {
+		return sender.Request{}, fmt.Errorf("fuzz: invalid URL after inserting payloads: %w", err)
+	}
+
+	req := sender.Request{
+		Method: t.method,
+		URL:    u,
+		Proto:  "HTTP/1.1",
+		Header: make(http.Header, len(t.header)),
+	}
+
+	for _, field := range t.header {
+		req.Header.Add(field.key, join(field.value))
+	}
+
+	if body := join(t.body); body != "" {
+		req.Body = []byte(body)
+	}
+
+	return req, nil
+}
@@ -0,0 +1,390 @@
+package intercept
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"math/rand"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/oklog/ulid"
+
+	"github.com/dstotijn/hetty/pkg/proxy"
+	"github.com/dstotijn/hetty/pkg/reqlog"
+	"github.com/dstotijn/hetty/pkg/search"
+)
+
+var (
+	ErrItemNotFound = errors.New("intercept: item not found")
+	ErrInvalidItem  = errors.New("intercept: invalid item")
+)
+
+var ulidEntropy = rand.New(rand.NewSource(time.Now().UnixNano()))
+
+// Settings define which requests and responses are intercepted.
+type Settings struct {
+	RequestsEnabled  bool
+	ResponsesEnabled bool
+	// RequestFilter, if set, limits intercepted requests to the ones that match
+	// it, e.g. `req.method = POST AND req.url =~ "/api/"`.
+	RequestFilter search.Expression
+	// ResponseFilter, if set, limits intercepted responses to the ones that
+	// match it. The request body isn't available to it.
+	ResponseFilter search.Expression
+}
+
+// Item is an intercepted request or response, that is held until it's
+// forwarded (optionally modified) or dropped. For a response, `Request.Response`
+// is set.
+type Item struct {
+	ID      ulid.ULID
+	Request reqlog.RequestLog
+}
+
+// IsResponse returns true if the item is an intercepted response.
+func (item Item) IsResponse() bool {
+	return item.Request.Response != nil
+}
+
+// decision is what to do with a held item. If drop is false, the item is
+// forwarded, with the request or response replaced by the modified one if
+// set.
+type decision struct {
+	req  *reqlog.RequestLog
+	res  *reqlog.ResponseLog
+	drop bool
+}
+
+type pendingItem struct {
+	item Item
+	done chan decision
+}
+
+// Service holds intercepted requests and responses, until they are forwarded
+// or dropped using its methods, e.g. via the admin API.
+type Service struct {
+	mu       sync.RWMutex
+	settings Settings
+	reqMatch reqlog.Matcher
+	resMatch reqlog.Matcher
+	items    map[ulid.ULID]pendingItem
+}
+
+func NewService() *Service {
+	return &Service{
+		items: make(map[ulid.ULID]pendingItem),
+	}
+}
+
+func (svc *Service) Settings() Settings {
+	svc.mu.RLock()
+	defer svc.mu.RUnlock()
+
+	return svc.settings
+}
+
+// UpdateSettings replaces the intercept settings. It returns an error if a
+// filter is invalid. When interception of requests or responses is disabled,
+// their pending items are forwarded unmodified.
+func (svc *Service) UpdateSettings(settings Settings) error {
+	reqMatch, err := compileFilter(settings.RequestFilter)
+	if err != nil {
+		return fmt.Errorf("intercept: invalid request filter: %w", err)
+	}
+
+	resMatch, err := compileFilter(settings.ResponseFilter)
+	if err != nil {
+		return fmt.Errorf("intercept: invalid response filter: %w", err)
+	}
+
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+
+	svc.settings = settings
+	svc.reqMatch = reqMatch
+	svc.resMatch = resMatch
+
+	for id, pending := range svc.items {
+		if pending.item.IsResponse() && settings.ResponsesEnabled ||
+			!pending.item.IsResponse() && settings.RequestsEnabled {
+			continue
+		}
+
+		delete(svc.items, id)
+		pending.done <- decision{}
+	}
+
+	return nil
+}
+
+func compileFilter(expr search.Expression) (reqlog.Matcher, error) {
+	if expr == nil {
+		return nil, nil
+	}
+
+	return reqlog.CompileMatcher(expr, reqlog.DefaultMatchConfig())
+}
+
+// Items returns the pending items, in the order they were intercepted.
+func (svc *Service) Items() []Item {
+	svc.mu.RLock()
+	defer svc.mu.RUnlock()
+
+	items := make([]Item, 0, len(svc.items))
+	for _, pending := range svc.items {
+		items = append(items, pending.item)
+	}
+
+	sort.Slice(items, func(i, j int) bool { return items[i].ID.Compare(items[j].ID) < 0 })
+
+	return items
+}
+
+func (svc *Service) ItemByID(id ulid.ULID) (Item, error) {
+	svc.mu.RLock()
+	defer svc.mu.RUnlock()
+
+	pending, ok := svc.items[id]
+	if !ok {
+		return Item{}, ErrItemNotFound
+	}
+
+	return pending.item, nil
+}
+
+// ModifyRequest forwards an intercepted request, replaced by req if it's not
+// nil. Its method, URL, proto, headers and body are used.
+func (svc *Service) ModifyRequest(id ulid.ULID, req *reqlog.RequestLog) error {
+	if req != nil && req.URL == nil {
+		return fmt.Errorf("%w: request URL cannot be empty", ErrInvalidItem)
+	}
+
+	return svc.decide(id, false, decision{req: req})
+}
+
+// ModifyResponse forwards an intercepted response, replaced by res if it's not
+// nil. Its proto, status code, headers and body are used.
+func (svc *Service) ModifyResponse(id ulid.ULID, res *reqlog.ResponseLog) error {
+	return svc.decide(id, true, decision{res: res})
+}
+
+// DropItem drops an intercepted request or response. The client connection is
+// closed.
+func (svc *Service) DropItem(id ulid.ULID) error {
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+
+	pending, ok := svc.items[id]
+	if !ok {
+		return ErrItemNotFound
+	}
+
+	delete(svc.items, id)
+	pending.done <- decision{drop: true}
+
+	return nil
+}
+
+func (svc *Service) decide(id ulid.ULID, isResponse bool, d decision) error {
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+
+	pending, ok := svc.items[id]
+	if !ok || pending.item.IsResponse() != isResponse {
+		return ErrItemNotFound
+	}
+
+	delete(svc.items, id)
+	pending.done <- d
+
+	return nil
+}
+
+// hold adds an item to the pending items, and waits until a decision is made
+// about it. It returns false for ok if the client went away first.
+func (svc *Service) hold(item Item, clientGone <-chan struct{}) (d decision, ok bool) {
+	// The channel is buffered, so decisions can be sent while holding the
+	// lock, even if the client went away in the meantime.
+	done := make(chan decision, 1)
+
+	svc.mu.Lock()
+	svc.items[item.ID] = pendingItem{item: item, done: done}
+	svc.mu.Unlock()
+
+	select {
+	case d := <-done:
+		return d, true
+	case <-clientGone:
+		svc.mu.Lock()
+		delete(svc.items, item.ID)
+		svc.mu.Unlock()
+
+		return decision{}, false
+	}
+}
+
+// RequestModifier holds requests that should be intercepted, until they're
+// forwarded or dropped. Other request modifiers run first.
+func (svc *Service) RequestModifier(next proxy.RequestModifyFunc) proxy.RequestModifyFunc {
+	return func(req *http.Request) {
+		next(req)
+
+		svc.mu.RLock()
+		enabled, match := svc.settings.RequestsEnabled, svc.reqMatch
+		svc.mu.RUnlock()
+
+		if !enabled {
+			return
+		}
+
+		var body []byte
+
+		if req.Body != nil {
+			var err error
+
+			body, err = ioutil.ReadAll(req.Body)
+			if err != nil {
+				log.Printf("[ERROR] Could not read request body for intercept: %v", err)
+				return
+			}
+
+			req.Body = ioutil.NopCloser(bytes.NewReader(body))
+		}
+
+		reqLog := reqlog.RequestLog{
+			ID:     ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy),
+			Method: req.Method,
+			URL:    req.URL,
+			Proto:  req.Proto,
+			Header: req.Header.Clone(),
+			Body:   body,
+		}
+
+		if match != nil && !match(reqLog) {
+			return
+		}
+
+		d, ok := svc.hold(Item{ID: reqLog.ID, Request: reqLog}, req.Context().Done())
+
+		switch {
+		case !ok:
+			return
+		case d.drop:
+			proxy.DropRequest(req)
+		case d.req != nil:
+			applyRequest(req, *d.req)
+		}
+	}
+}
+
+// ResponseModifier holds responses that should be intercepted, until they're
+// forwarded or dropped. Other response modifiers run first.
+func (svc *Service) ResponseModifier(next proxy.ResponseModifyFunc) proxy.ResponseModifyFunc {
+	return func(res *http.Response) error {
+		if err := next(res); err != nil {
+			return err
+		}
+
+		svc.mu.RLock()
+		enabled, match := svc.settings.ResponsesEnabled, svc.resMatch
+		svc.mu.RUnlock()
+
+		// The body of an upgraded response is the connection to the server,
+		// so it can't be held.
+		if !enabled || res.StatusCode == http.StatusSwitchingProtocols {
+			return nil
+		}
+
+		body, err := ioutil.ReadAll(res.Body)
+		if err != nil {
+			return fmt.Errorf("intercept: could not read response body: %w", err)
+		}
+
+		res.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+		reqLog := reqlog.RequestLog{
+			ID:     ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy),
+			Method: res.Request.Method,
+			URL:    res.Request.URL,
+			Proto:  res.Request.Proto,
+			Header: res.Request.Header.Clone(),
+			Response: &reqlog.ResponseLog{
+				Proto:      res.Proto,
+				StatusCode: res.StatusCode,
+				Status:     res.Status,
+				Header:     res.Header.Clone(),
+				Body:       body,
+			},
+		}
+
+		if match != nil && !match(reqLog) {
+			return nil
+		}
+
+		d, ok := svc.hold(Item{ID: reqLog.ID, Request: reqLog}, res.Request.Context().Done())
+
+		switch {
+		case !ok:
+			return nil
+		case d.drop:
+			return fmt.Errorf("intercept: response dropped: %w", proxy.ErrDropped)
+		case d.res != nil:
+			applyResponse(res, *d.res)
+		}
+
+		return nil
+	}
+}
+
+// applyRequest replaces the method, URL, proto, headers and body of req.
+func applyRequest(req *http.Request, mod reqlog.RequestLog) {
+	req.Method = mod.Method
+	req.URL = mod.URL
+	req.Host = mod.URL.Host
+
+	if major, minor, ok := http.ParseHTTPVersion(mod.Proto); ok {
+		req.Proto, req.ProtoMajor, req.ProtoMinor = mod.Proto, major, minor
+	}
+
+	if mod.Header == nil {
+		mod.Header = make(http.Header)
+	}
+
+	// The length of the body is known, so `Content-Length` and
+	// `Transfer-Encoding` are derived from it.
+	req.Header = mod.Header
+	req.Body = ioutil.NopCloser(bytes.NewReader(mod.Body))
+	req.ContentLength = int64(len(mod.Body))
+	req.TransferEncoding = nil
+}
+
+// applyResponse replaces the proto, status code, headers and body of res.
+func applyResponse(res *http.Response, mod reqlog.ResponseLog) {
+	if major, minor, ok := http.ParseHTTPVersion(mod.Proto); ok {
+		res.Proto, res.ProtoMajor, res.ProtoMinor = mod.Proto, major, minor
+	}
+
+	res.StatusCode = mod.StatusCode
+	res.Status = strconv.Itoa(mod.StatusCode) + " " + http.StatusText(mod.StatusCode)
+
+	if mod.Header == nil {
+		mod.Header = make(http.Header)
+	}
+
+	// Headers are written to the client as-is, so a `Content-Length` header
+	// must match the modified body.
+	if mod.Header.Get("Content-Length") != "" {
+		mod.Header.Set("Content-Length", strconv.Itoa(len(mod.Body)))
+	}
+
+	res.Header = mod.Header
+	res.Body = ioutil.NopCloser(bytes.NewReader(mod.Body))
+	res.ContentLength = int64(len(mod.Body))
+	res.TransferEncoding = nil
+}
@@ -0,0 +1,324 @@
+package intercept_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dstotijn/hetty/pkg/intercept"
+	"github.com/dstotijn/hetty/pkg/proxy"
+	"github.com/dstotijn/hetty/pkg/reqlog"
+	"github.com/dstotijn/hetty/pkg/search"
+)
+
+// waitForItem waits until the service holds an item, and returns it.
+func waitForItem(t *testing.T, svc *intercept.Service) intercept.Item {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+
+	for time.Now().Before(deadline) {
+		if items := svc.Items(); len(items) > 0 {
+			return items[0]
+		}
+
+		time.Sleep(time.Millisecond)
+	}
+
+	t.Fatal("timed out waiting for intercepted item")
+
+	return intercept.Item{}
+}
+
+func mustParseQuery(t *testing.T, s string) search.Expression {
+	t.Helper()
+
+	expr, err := search.ParseQuery(s)
+	if err != nil {
+		t.Fatalf("unexpected error parsing query: %v", err)
+	}
+
+	return expr
+}
+
+func newService(t *testing.T, settings intercept.Settings) *intercept.Service {
+	t.Helper()
+
+	svc := intercept.NewService()
+	if err := svc.UpdateSettings(settings); err != nil {
+		t.Fatalf("unexpected error updating settings: %v", err)
+	}
+
+	return svc
+}
+
+func TestRequestModifier(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		decide         func(svc *intercept.Service, item intercept.Item) error
+		expectedURL    string
+		expectedBody   string
+		expectedHeader string
+		expectedDrop   bool
+	}{
+		{
+			name: "forward unmodified",
+			decide: func(svc *intercept.Service, item intercept.Item) error {
+				return svc.ModifyRequest(item.ID, nil)
+			},
+			expectedURL:    "https://example.com/foo",
+			expectedBody:   "foo",
+			expectedHeader: "bar",
+		},
+		{
+			name: "forward modified",
+			decide: func(svc *intercept.Service, item intercept.Item) error {
+				return svc.ModifyRequest(item.ID, &reqlog.RequestLog{
+					Method: http.MethodPut,
+					URL:    &url.URL{Scheme: "https", Host: "example.com", Path: "/bar"},
+					Proto:  "HTTP/1.1",
+					Header: http.Header{"X-Foo": []string{"baz"}},
+					Body:   []byte("modified"),
+				})
+			},
+			expectedURL:    "https://example.com/bar",
+			expectedBody:   "modified",
+			expectedHeader: "baz",
+		},
+		{
+			name: "drop",
+			decide: func(svc *intercept.Service, item intercept.Item) error {
+				return svc.DropItem(item.ID)
+			},
+			expectedURL:    "https://example.com/foo",
+			expectedBody:   "foo",
+			expectedHeader: "bar",
+			expectedDrop:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := newService(t, intercept.Settings{RequestsEnabled: true})
+			reqModFn := svc.RequestModifier(func(*http.Request) {})
+
+			req := httptest.NewRequest(http.MethodPost, "https://example.com/foo", strings.NewReader("foo"))
+			req.Header.Set("X-Foo", "bar")
+
+			done := make(chan struct{})
+			go func() {
+				reqModFn(req)
+				close(done)
+			}()
+
+			item := waitForItem(t, svc)
+			if item.IsResponse() {
+				t.Fatal("expected intercepted request, got response")
+			}
+
+			if got := string(item.Request.Body); got != "foo" {
+				t.Errorf("incorrect intercepted body (expected: %q, got: %q)", "foo", got)
+			}
+
+			if err := tt.decide(svc, item); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			<-done
+
+			if got := req.URL.String(); got != tt.expectedURL {
+				t.Errorf("incorrect URL (expected: %q, got: %q)", tt.expectedURL, got)
+			}
+
+			body, err := io.ReadAll(req.Body)
+			if err != nil {
+				t.Fatalf("unexpected error reading body: %v", err)
+			}
+
+			if got := string(body); got != tt.expectedBody {
+				t.Errorf("incorrect body (expected: %q, got: %q)", tt.expectedBody, got)
+			}
+
+			if got := req.Header.Get("X-Foo"); got != tt.expectedHeader {
+				t.Errorf("incorrect header (expected: %q, got: %q)", tt.expectedHeader, got)
+			}
+
+			if got := errors.Is(req.Context().Err(), context.Canceled); got != tt.expectedDrop {
+				t.Errorf("incorrect dropped state (expected: %v, got: %v)", tt.expectedDrop, got)
+			}
+
+			if items := svc.Items(); len(items) != 0 {
+				t.Errorf("expected no pending items, got: %v", len(items))
+			}
+		})
+	}
+}
+
+func TestRequestModifierFilter(t *testing.T) {
+	t.Parallel()
+
+	svc := newService(t, intercept.Settings{
+		RequestsEnabled: true,
+		RequestFilter:   mustParseQuery(t, `req.method = POST`),
+	})
+	reqModFn := svc.RequestModifier(func(*http.Request) {})
+
+	// A request that doesn't match the filter is forwarded without being held.
+	reqModFn(httptest.NewRequest(http.MethodGet, "https://example.com/", nil))
+
+	if items := svc.Items(); len(items) != 0 {
+		t.Fatalf("expected no pending items, got: %v", len(items))
+	}
+}
+
+func TestUpdateSettings(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid filter", func(t *testing.T) {
+		t.Parallel()
+
+		svc := intercept.NewService()
+		err := svc.UpdateSettings(intercept.Settings{
+			RequestFilter: search.InfixExpression{
+				Operator: search.TokOpRe,
+				Left:     search.StringLiteral{Value: "req.url"},
+				Right:    search.StringLiteral{Value: "foo"},
+			},
+		})
+
+		if err == nil || !strings.Contains(err.Error(), "invalid request filter") {
+			t.Fatalf("expected invalid request filter error, got: %v", err)
+		}
+	})
+
+	t.Run("disabling forwards pending items", func(t *testing.T) {
+		t.Parallel()
+
+		svc := newService(t, intercept.Settings{RequestsEnabled: true})
+		reqModFn := svc.RequestModifier(func(*http.Request) {})
+		req := httptest.NewRequest(http.MethodGet, "https://example.com/", nil)
+
+		done := make(chan struct{})
+		go func() {
+			reqModFn(req)
+			close(done)
+		}()
+
+		waitForItem(t, svc)
+
+		if err := svc.UpdateSettings(intercept.Settings{}); err != nil {
+			t.Fatalf("unexpected error updating settings: %v", err)
+		}
+
+		<-done
+
+		if items := svc.Items(); len(items) != 0 {
+			t.Fatalf("expected no pending items, got: %v", len(items))
+		}
+	})
+}
+
+func TestResponseModifier(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name               string
+		decide             func(svc *intercept.Service, item intercept.Item) error
+		expectedStatusCode int
+		expectedBody       string
+		expectedError      error
+	}{
+		{
+			name: "forward modified",
+			decide: func(svc *intercept.Service, item intercept.Item) error {
+				return svc.ModifyResponse(item.ID, &reqlog.ResponseLog{
+					Proto:      "HTTP/1.1",
+					StatusCode: http.StatusNotFound,
+					Header:     http.Header{"Content-Length": []string{"3"}},
+					Body:       []byte("modified"),
+				})
+			},
+			expectedStatusCode: http.StatusNotFound,
+			expectedBody:       "modified",
+		},
+		{
+			name: "drop",
+			decide: func(svc *intercept.Service, item intercept.Item) error {
+				return svc.DropItem(item.ID)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       "foo",
+			expectedError:      proxy.ErrDropped,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := newService(t, intercept.Settings{ResponsesEnabled: true})
+			resModFn := svc.ResponseModifier(func(*http.Response) error { return nil })
+
+			res := &http.Response{
+				Proto:      "HTTP/1.1",
+				StatusCode: http.StatusOK,
+				Status:     "200 OK",
+				Header:     http.Header{},
+				Body:       io.NopCloser(strings.NewReader("foo")),
+				Request:    httptest.NewRequest(http.MethodGet, "https://example.com/", nil),
+			}
+
+			errc := make(chan error, 1)
+			go func() {
+				errc <- resModFn(res)
+			}()
+
+			item := waitForItem(t, svc)
+			if !item.IsResponse() {
+				t.Fatal("expected intercepted response, got request")
+			}
+
+			// A request can't be forwarded using the ID of a response.
+			if err := svc.ModifyRequest(item.ID, nil); !errors.Is(err, intercept.ErrItemNotFound) {
+				t.Fatalf("expected error: %v, got: %v", intercept.ErrItemNotFound, err)
+			}
+
+			if err := tt.decide(svc, item); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if err := <-errc; !errors.Is(err, tt.expectedError) {
+				t.Fatalf("expected error: %v, got: %v", tt.expectedError, err)
+			}
+
+			if res.StatusCode != tt.expectedStatusCode {
+				t.Errorf("incorrect status code (expected: %v, got: %v)", tt.expectedStatusCode, res.StatusCode)
+			}
+
+			body, err := io.ReadAll(res.Body)
+			if err != nil {
+				t.Fatalf("unexpected error reading body: %v", err)
+			}
+
+			if got := string(body); got != tt.expectedBody {
+				t.Errorf("incorrect body (expected: %q, got: %q)", tt.expectedBody, got)
+			}
+
+			if got := res.Header.Get("Content-Length"); tt.expectedError == nil && got != "8" {
+				t.Errorf("incorrect `Content-Length` header (expected: %q, got: %q)", "8", got)
+			}
+		})
+	}
+}
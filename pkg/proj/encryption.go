@@ -0,0 +1,201 @@
+package proj
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+// Encryption holds the parameters of an encrypted project. The key itself is
+// never stored; it's derived from the passphrase that's supplied when the
+// project is opened.
+type Encryption struct {
+	Salt []byte
+	// KeyCheck is used to verify a derived key, before it's used for
+	// decrypting.
+	KeyCheck []byte
+}
+
+var (
+	ErrPassphraseRequired = errors.New("proj: passphrase is required")
+	ErrInvalidPassphrase  = errors.New("proj: invalid passphrase")
+	ErrNotEncrypted       = errors.New("proj: project is not encrypted")
+	ErrAlreadyEncrypted   = errors.New("proj: project is already encrypted")
+)
+
+const (
+	saltSize = 16
+	keySize  = 32
+
+	// Cost parameters for scrypt, as recommended for interactive logins.
+	scryptN = 1 << 15
+	scryptR = 8
+	scryptP = 1
+)
+
+var keyCheckMessage = []byte("hetty project key check")
+
+// newEncryption returns encryption parameters with a random salt, and the key
+// derived from passphrase.
+func newEncryption(passphrase string) (*Encryption, []byte, error) {
+	if passphrase == "" {
+		return nil, nil, ErrPassphraseRequired
+	}
+
+	salt := make([]byte, saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, nil, fmt.Errorf("proj: failed to generate salt: %w", err)
+	}
+
+	key, err := deriveKey(passphrase, salt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &Encryption{Salt: salt, KeyCheck: keyCheck(key)}, key, nil
+}
+
+// Key derives the key of a project from passphrase.
+func (enc *Encryption) Key(passphrase string) ([]byte, error) {
+	if passphrase == "" {
+		return nil, ErrPassphraseRequired
+	}
+
+	key, err := deriveKey(passphrase, enc.Salt)
+	if err != nil {
+		return nil, err
+	}
+
+	if !hmac.Equal(keyCheck(key), enc.KeyCheck) {
+		return nil, ErrInvalidPassphrase
+	}
+
+	return key, nil
+}
+
+func deriveKey(passphrase string, salt []byte) ([]byte, error) {
+	key, err := scrypt.Key([]byte(passphrase), salt, scryptN, scryptR, scryptP, keySize)
+	if err != nil {
+		return nil, fmt.Errorf("proj: failed to derive key: %w", err)
+	}
+
+	return key, nil
+}
+
+func keyCheck(key []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(keyCheckMessage)
+
+	return mac.Sum(nil)
+}
+
+// EnableEncryption encrypts the stored data of the active project with a key
+// derived from passphrase. From then on, the passphrase must be supplied when
+// opening the project, and response bodies are kept in the database instead of
+// in body files. Body files that were stored before remain unencrypted.
+func (svc *service) EnableEncryption(ctx context.Context, passphrase string) error {
+	project, err := svc.ActiveProject(ctx)
+	if err != nil {
+		return err
+	}
+
+	if project.Settings.Encryption != nil {
+		return ErrAlreadyEncrypted
+	}
+
+	enc, key, err := newEncryption(passphrase)
+	if err != nil {
+		return err
+	}
+
+	if err := svc.repo.RekeyProject(ctx, project.ID, key); err != nil {
+		return fmt.Errorf("proj: failed to encrypt project: %w", err)
+	}
+
+	project.Settings.Encryption = enc
+
+	err = svc.repo.UpsertProject(ctx, project)
+	if err != nil {
+		svc.repo.RekeyProject(ctx, project.ID, nil) //nolint:errcheck
+		return fmt.Errorf("proj: failed to update project: %w", err)
+	}
+
+	svc.reqLogSvc.DisableBodyFiles = true
+
+	return nil
+}
+
+// DisableEncryption decrypts the stored data of the active project.
+func (svc *service) DisableEncryption(ctx context.Context, passphrase string) error {
+	project, err := svc.ActiveProject(ctx)
+	if err != nil {
+		return err
+	}
+
+	if project.Settings.Encryption == nil {
+		return ErrNotEncrypted
+	}
+
+	key, err := project.Settings.Encryption.Key(passphrase)
+	if err != nil {
+		return err
+	}
+
+	if err := svc.repo.RekeyProject(ctx, project.ID, nil); err != nil {
+		return fmt.Errorf("proj: failed to decrypt project: %w", err)
+	}
+
+	project.Settings.Encryption = nil
+
+	err = svc.repo.UpsertProject(ctx, project)
+	if err != nil {
+		svc.repo.RekeyProject(ctx, project.ID, key) //nolint:errcheck
+		return fmt.Errorf("proj: failed to update project: %w", err)
+	}
+
+	svc.reqLogSvc.DisableBodyFiles = false
+
+	return nil
+}
+
+// ChangePassphrase re-encrypts the stored data of the active project with a key
+// derived from newPassphrase.
+func (svc *service) ChangePassphrase(ctx context.Context, passphrase, newPassphrase string) error {
+	project, err := svc.ActiveProject(ctx)
+	if err != nil {
+		return err
+	}
+
+	if project.Settings.Encryption == nil {
+		return ErrNotEncrypted
+	}
+
+	key, err := project.Settings.Encryption.Key(passphrase)
+	if err != nil {
+		return err
+	}
+
+	enc, newKey, err := newEncryption(newPassphrase)
+	if err != nil {
+		return err
+	}
+
+	if err := svc.repo.RekeyProject(ctx, project.ID, newKey); err != nil {
+		return fmt.Errorf("proj: failed to rekey project: %w", err)
+	}
+
+	project.Settings.Encryption = enc
+
+	err = svc.repo.UpsertProject(ctx, project)
+	if err != nil {
+		svc.repo.RekeyProject(ctx, project.ID, key) //nolint:errcheck
+		return fmt.Errorf("proj: failed to update project: %w", err)
+	}
+
+	return nil
+}
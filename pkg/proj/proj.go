@@ -12,7 +12,9 @@ import (
 
 	"github.com/oklog/ulid"
 
+	"github.com/dstotijn/hetty/pkg/proxy"
 	"github.com/dstotijn/hetty/pkg/reqlog"
+	"github.com/dstotijn/hetty/pkg/rewrite"
 	"github.com/dstotijn/hetty/pkg/scope"
 	"github.com/dstotijn/hetty/pkg/search"
 )
@@ -28,7 +30,7 @@ type (
 // Service is used for managing projects.
 type Service interface {
 	CreateProject(ctx context.Context, name string) (Project, error)
-	OpenProject(ctx context.Context, projectID ulid.ULID) (Project, error)
+	OpenProject(ctx context.Context, projectID ulid.ULID, passphrase string) (Project, error)
 	CloseProject() error
 	DeleteProject(ctx context.Context, projectID ulid.ULID) error
 	ActiveProject(ctx context.Context) (Project, error)
@@ -36,7 +38,20 @@ type Service interface {
 	Projects(ctx context.Context) ([]Project, error)
 	Scope() *scope.Scope
 	SetScopeRules(ctx context.Context, rules []scope.Rule) error
+	SetScopeMatchMode(ctx context.Context, mode scope.MatchMode) error
+	Rewriter() *rewrite.Rewriter
+	SetRewriteRules(ctx context.Context, rules []rewrite.Rule) error
 	SetRequestLogFindFilter(ctx context.Context, filter reqlog.FindRequestsFilter) error
+	SetLoginRequestLog(ctx context.Context, reqLogID ulid.ULID) error
+	SearchPresets(ctx context.Context) ([]SearchPreset, error)
+	SetSearchPreset(ctx context.Context, preset SearchPreset) error
+	DeleteSearchPreset(ctx context.Context, name string) error
+	ApplySearchPreset(ctx context.Context, name string) (SearchPreset, error)
+	SetTLSPolicy(ctx context.Context, policy proxy.TLSPolicy) error
+	EnableEncryption(ctx context.Context, passphrase string) error
+	DisableEncryption(ctx context.Context, passphrase string) error
+	ChangePassphrase(ctx context.Context, passphrase, newPassphrase string) error
+	SetRetention(ctx context.Context, policy reqlog.RetentionPolicy, onlyInScope bool) error
 	OnProjectOpen(fn OnProjectOpenFn)
 	OnProjectClose(fn OnProjectCloseFn)
 }
@@ -45,6 +60,8 @@ type service struct {
 	repo              Repository
 	reqLogSvc         *reqlog.Service
 	scope             *scope.Scope
+	rewriter          *rewrite.Rewriter
+	proxy             *proxy.Proxy
 	activeProjectID   ulid.ULID
 	onProjectOpenFns  []OnProjectOpenFn
 	onProjectCloseFns []OnProjectCloseFn
@@ -63,7 +80,22 @@ type Settings struct {
 	ReqLogBypassOutOfScope bool
 	ReqLogOnlyFindInScope  bool
 	ScopeRules             []scope.Rule
+	ScopeMatchMode         scope.MatchMode
 	SearchExpr             search.Expression
+	// LoginReqLogID is the ID of the request log marked as the login request,
+	// or a zero value if none is marked.
+	LoginReqLogID ulid.ULID
+	// RewriteRules are applied by the proxy to requests and responses while
+	// the project is open.
+	RewriteRules []rewrite.Rule
+	// SearchPresets are named search expressions, in order of creation.
+	SearchPresets []SearchPreset
+	// TLSPolicy is applied by the proxy while the project is open.
+	TLSPolicy proxy.TLSPolicy
+	// Encryption is set if the data of the project is encrypted at rest.
+	Encryption *Encryption
+	// Retention limits the request logs that are kept for the project.
+	Retention reqlog.RetentionPolicy
 }
 
 var (
@@ -79,6 +111,8 @@ type Config struct {
 	Repository    Repository
 	ReqLogService *reqlog.Service
 	Scope         *scope.Scope
+	Rewriter      *rewrite.Rewriter
+	Proxy         *proxy.Proxy
 }
 
 // NewService returns a new Service.
@@ -87,6 +121,8 @@ func NewService(cfg Config) (Service, error) {
 		repo:      cfg.Repository,
 		reqLogSvc: cfg.ReqLogService,
 		scope:     cfg.Scope,
+		rewriter:  cfg.Rewriter,
+		proxy:     cfg.Proxy,
 	}, nil
 }
 
@@ -123,8 +159,18 @@ func (svc *service) CloseProject() error {
 	svc.reqLogSvc.ActiveProjectID = ulid.ULID{}
 	svc.reqLogSvc.BypassOutOfScopeRequests = false
 	svc.reqLogSvc.FindReqsFilter = reqlog.FindRequestsFilter{}
+	svc.reqLogSvc.LoginBoundary = ulid.ULID{}
+	svc.reqLogSvc.DisableBodyFiles = false
+	svc.reqLogSvc.Retention = reqlog.RetentionPolicy{}
 	svc.scope.SetRules(nil)
+	svc.scope.SetMatchMode(scope.MatchAny)
+	svc.rewriter.SetRules(nil)
 
+	if err := svc.proxy.SetTLSPolicy(proxy.TLSPolicy{}); err != nil {
+		log.Printf("[ERROR] Could not reset TLS policy: %v", err)
+	}
+
+	svc.repo.LockProject(closedProjectID)
 	svc.emitProjectClosed(closedProjectID)
 
 	return nil
@@ -140,11 +186,16 @@ func (svc *service) DeleteProject(ctx context.Context, projectID ulid.ULID) erro
 		return fmt.Errorf("proj: could not delete project: %w", err)
 	}
 
+	if err := svc.reqLogSvc.DeleteBodyFiles(projectID); err != nil {
+		return fmt.Errorf("proj: could not delete project body files: %w", err)
+	}
+
 	return nil
 }
 
-// OpenProject sets a project as the currently active project.
-func (svc *service) OpenProject(ctx context.Context, projectID ulid.ULID) (Project, error) {
+// OpenProject sets a project as the currently active project. The passphrase
+// is required for encrypted projects, and ignored otherwise.
+func (svc *service) OpenProject(ctx context.Context, projectID ulid.ULID, passphrase string) (Project, error) {
 	svc.mu.Lock()
 	defer svc.mu.Unlock()
 
@@ -153,6 +204,21 @@ func (svc *service) OpenProject(ctx context.Context, projectID ulid.ULID) (Proje
 		return Project{}, fmt.Errorf("proj: failed to get project: %w", err)
 	}
 
+	if enc := project.Settings.Encryption; enc != nil {
+		key, err := enc.Key(passphrase)
+		if err != nil {
+			return Project{}, err
+		}
+
+		if err := svc.repo.SetProjectKey(project.ID, key); err != nil {
+			return Project{}, fmt.Errorf("proj: failed to set project key: %w", err)
+		}
+	}
+
+	if prevProjectID := svc.activeProjectID; prevProjectID.Compare(project.ID) != 0 {
+		svc.repo.LockProject(prevProjectID)
+	}
+
 	svc.activeProjectID = project.ID
 	svc.reqLogSvc.FindReqsFilter = reqlog.FindRequestsFilter{
 		ProjectID:   project.ID,
@@ -161,8 +227,17 @@ func (svc *service) OpenProject(ctx context.Context, projectID ulid.ULID) (Proje
 	}
 	svc.reqLogSvc.BypassOutOfScopeRequests = project.Settings.ReqLogBypassOutOfScope
 	svc.reqLogSvc.ActiveProjectID = project.ID
+	svc.reqLogSvc.LoginBoundary = project.Settings.LoginReqLogID
+	svc.reqLogSvc.DisableBodyFiles = project.Settings.Encryption != nil
+	svc.reqLogSvc.Retention = project.Settings.Retention
 
 	svc.scope.SetRules(project.Settings.ScopeRules)
+	svc.scope.SetMatchMode(project.Settings.ScopeMatchMode)
+	svc.rewriter.SetRules(project.Settings.RewriteRules)
+
+	if err := svc.proxy.SetTLSPolicy(project.Settings.TLSPolicy); err != nil {
+		log.Printf("[ERROR] Could not apply TLS policy of project: %v", err)
+	}
 
 	svc.emitProjectOpened()
 
@@ -198,6 +273,10 @@ func (svc *service) Scope() *scope.Scope {
 	return svc.scope
 }
 
+func (svc *service) Rewriter() *rewrite.Rewriter {
+	return svc.rewriter
+}
+
 func (svc *service) OnProjectOpen(fn OnProjectOpenFn) {
 	svc.mu.Lock()
 	defer svc.mu.Unlock()
@@ -246,6 +325,42 @@ func (svc *service) SetScopeRules(ctx context.Context, rules []scope.Rule) error
 	return nil
 }
 
+func (svc *service) SetScopeMatchMode(ctx context.Context, mode scope.MatchMode) error {
+	project, err := svc.ActiveProject(ctx)
+	if err != nil {
+		return err
+	}
+
+	project.Settings.ScopeMatchMode = mode
+
+	err = svc.repo.UpsertProject(ctx, project)
+	if err != nil {
+		return fmt.Errorf("proj: failed to update project: %w", err)
+	}
+
+	svc.scope.SetMatchMode(mode)
+
+	return nil
+}
+
+func (svc *service) SetRewriteRules(ctx context.Context, rules []rewrite.Rule) error {
+	project, err := svc.ActiveProject(ctx)
+	if err != nil {
+		return err
+	}
+
+	project.Settings.RewriteRules = rules
+
+	err = svc.repo.UpsertProject(ctx, project)
+	if err != nil {
+		return fmt.Errorf("proj: failed to update project: %w", err)
+	}
+
+	svc.rewriter.SetRules(rules)
+
+	return nil
+}
+
 func (svc *service) SetRequestLogFindFilter(ctx context.Context, filter reqlog.FindRequestsFilter) error {
 	project, err := svc.ActiveProject(ctx)
 	if err != nil {
@@ -264,9 +379,86 @@ func (svc *service) SetRequestLogFindFilter(ctx context.Context, filter reqlog.F
 
 	svc.reqLogSvc.FindReqsFilter = filter
 
+	if filter.SearchExpr != nil {
+		svc.reqLogSvc.RecordSearch(filter.SearchExpr.String())
+	}
+
+	return nil
+}
+
+// SetLoginRequestLog marks a request log as the login request, for the
+// `req.afterLogin` search key. A zero value ID unmarks it.
+func (svc *service) SetLoginRequestLog(ctx context.Context, reqLogID ulid.ULID) error {
+	project, err := svc.ActiveProject(ctx)
+	if err != nil {
+		return err
+	}
+
+	if reqLogID.Compare(ulid.ULID{}) != 0 {
+		if _, err := svc.reqLogSvc.FindRequestLogByID(ctx, reqLogID); err != nil {
+			return fmt.Errorf("proj: failed to get request log: %w", err)
+		}
+	}
+
+	project.Settings.LoginReqLogID = reqLogID
+
+	err = svc.repo.UpsertProject(ctx, project)
+	if err != nil {
+		return fmt.Errorf("proj: failed to update project: %w", err)
+	}
+
+	svc.reqLogSvc.LoginBoundary = reqLogID
+
 	return nil
 }
 
 func (svc *service) IsProjectActive(projectID ulid.ULID) bool {
 	return projectID.Compare(svc.activeProjectID) == 0
 }
+
+// SetTLSPolicy sets the TLS policy of the active project, and applies it to
+// the proxy.
+func (svc *service) SetTLSPolicy(ctx context.Context, policy proxy.TLSPolicy) error {
+	project, err := svc.ActiveProject(ctx)
+	if err != nil {
+		return err
+	}
+
+	// The proxy validates the policy, so it's applied before it's stored.
+	if err := svc.proxy.SetTLSPolicy(policy); err != nil {
+		return fmt.Errorf("proj: failed to apply TLS policy: %w", err)
+	}
+
+	prevPolicy := project.Settings.TLSPolicy
+	project.Settings.TLSPolicy = policy
+
+	err = svc.repo.UpsertProject(ctx, project)
+	if err != nil {
+		svc.proxy.SetTLSPolicy(prevPolicy) //nolint:errcheck
+		return fmt.Errorf("proj: failed to update project: %w", err)
+	}
+
+	return nil
+}
+
+// SetRetention sets the retention policy of the active project, and whether
+// only in scope requests are logged.
+func (svc *service) SetRetention(ctx context.Context, policy reqlog.RetentionPolicy, onlyInScope bool) error {
+	project, err := svc.ActiveProject(ctx)
+	if err != nil {
+		return err
+	}
+
+	project.Settings.Retention = policy
+	project.Settings.ReqLogBypassOutOfScope = onlyInScope
+
+	err = svc.repo.UpsertProject(ctx, project)
+	if err != nil {
+		return fmt.Errorf("proj: failed to update project: %w", err)
+	}
+
+	svc.reqLogSvc.Retention = policy
+	svc.reqLogSvc.BypassOutOfScopeRequests = onlyInScope
+
+	return nil
+}
@@ -11,5 +11,13 @@ type Repository interface {
 	UpsertProject(ctx context.Context, project Project) error
 	DeleteProject(ctx context.Context, id ulid.ULID) error
 	Projects(ctx context.Context) ([]Project, error)
+	// SetProjectKey sets the key that data of a project is encrypted with. A
+	// nil key stores data in plaintext.
+	SetProjectKey(projectID ulid.ULID, key []byte) error
+	// LockProject discards the key of a project, if any.
+	LockProject(projectID ulid.ULID)
+	// RekeyProject re-encrypts the stored data of a project with key, and sets
+	// it as the project's key.
+	RekeyProject(ctx context.Context, projectID ulid.ULID, key []byte) error
 	Close() error
 }
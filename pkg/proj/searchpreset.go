@@ -0,0 +1,123 @@
+package proj
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/dstotijn/hetty/pkg/search"
+)
+
+var (
+	ErrSearchPresetNotFound    = errors.New("proj: search preset not found")
+	ErrInvalidSearchPresetName = errors.New("proj: invalid search preset name, must not be empty")
+)
+
+// SearchPreset is a named search expression, saved with a project so it can be
+// applied as request log filter by name.
+type SearchPreset struct {
+	Name string
+	Expr search.Expression
+}
+
+// SearchPresets returns the search presets of the active project, in order of
+// creation.
+func (svc *service) SearchPresets(ctx context.Context) ([]SearchPreset, error) {
+	project, err := svc.ActiveProject(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return project.Settings.SearchPresets, nil
+}
+
+// SetSearchPreset saves a search preset in the active project. An existing
+// preset with the same name is replaced.
+func (svc *service) SetSearchPreset(ctx context.Context, preset SearchPreset) error {
+	preset.Name = strings.TrimSpace(preset.Name)
+	if preset.Name == "" {
+		return ErrInvalidSearchPresetName
+	}
+
+	if preset.Expr == nil {
+		return errors.New("proj: search preset expression must be set")
+	}
+
+	project, err := svc.ActiveProject(ctx)
+	if err != nil {
+		return err
+	}
+
+	if i := searchPresetIndex(project.Settings.SearchPresets, preset.Name); i >= 0 {
+		project.Settings.SearchPresets[i] = preset
+	} else {
+		project.Settings.SearchPresets = append(project.Settings.SearchPresets, preset)
+	}
+
+	if err := svc.repo.UpsertProject(ctx, project); err != nil {
+		return fmt.Errorf("proj: failed to update project: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteSearchPreset removes a search preset from the active project.
+func (svc *service) DeleteSearchPreset(ctx context.Context, name string) error {
+	project, err := svc.ActiveProject(ctx)
+	if err != nil {
+		return err
+	}
+
+	i := searchPresetIndex(project.Settings.SearchPresets, name)
+	if i < 0 {
+		return ErrSearchPresetNotFound
+	}
+
+	presets := project.Settings.SearchPresets
+	project.Settings.SearchPresets = append(presets[:i:i], presets[i+1:]...)
+
+	if err := svc.repo.UpsertProject(ctx, project); err != nil {
+		return fmt.Errorf("proj: failed to update project: %w", err)
+	}
+
+	return nil
+}
+
+// ApplySearchPreset sets the search expression of the request log filter to
+// that of a search preset of the active project. Other filter settings are
+// kept.
+func (svc *service) ApplySearchPreset(ctx context.Context, name string) (SearchPreset, error) {
+	project, err := svc.ActiveProject(ctx)
+	if err != nil {
+		return SearchPreset{}, err
+	}
+
+	i := searchPresetIndex(project.Settings.SearchPresets, name)
+	if i < 0 {
+		return SearchPreset{}, ErrSearchPresetNotFound
+	}
+
+	preset := project.Settings.SearchPresets[i]
+
+	filter := svc.reqLogSvc.FindReqsFilter
+	filter.SearchExpr = preset.Expr
+
+	if err := svc.SetRequestLogFindFilter(ctx, filter); err != nil {
+		return SearchPreset{}, err
+	}
+
+	return preset, nil
+}
+
+func searchPresetIndex(presets []SearchPreset, name string) int {
+	name = strings.TrimSpace(name)
+
+	for i, preset := range presets {
+		if preset.Name == name {
+			return i
+		}
+	}
+
+	return -1
+}
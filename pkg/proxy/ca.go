@@ -0,0 +1,110 @@
+package proxy
+
+import (
+	"crypto"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"log"
+	"net/http"
+	"path"
+	"time"
+)
+
+// caValidity is the validity of CA certificates generated at runtime.
+const caValidity = 365 * 24 * time.Hour
+
+// CA returns the CA certificate that the proxy signs certificates for
+// intercepted TLS connections with.
+func (p *Proxy) CA() *x509.Certificate {
+	return p.certConfig.CA()
+}
+
+// SetCAFiles sets the file paths that the CA is written to when it's changed
+// at runtime, so it's kept across restarts. If unset, changes aren't written to
+// disk.
+func (p *Proxy) SetCAFiles(caKeyFile, caCertFile string) {
+	p.caMu.Lock()
+	defer p.caMu.Unlock()
+
+	p.caKeyFile = caKeyFile
+	p.caCertFile = caCertFile
+}
+
+// SetCA replaces the CA of the proxy. Certificates for new TLS connections
+// are signed by it; certificates signed by the previous CA are discarded.
+func (p *Proxy) SetCA(ca *x509.Certificate, caPrivKey crypto.PrivateKey) error {
+	if !ca.IsCA {
+		return ErrNotCA
+	}
+
+	p.caMu.Lock()
+	defer p.caMu.Unlock()
+
+	if p.caKeyFile != "" && p.caCertFile != "" {
+		if err := SaveCA(p.caKeyFile, p.caCertFile, ca, caPrivKey); err != nil {
+			return err
+		}
+	}
+
+	return p.certConfig.SetCA(ca, caPrivKey)
+}
+
+// RotateCA generates a new CA, and replaces the CA of the proxy with it (see
+// `SetCA`). Clients must trust the new CA certificate.
+func (p *Proxy) RotateCA() (*x509.Certificate, error) {
+	ca, caPrivKey, err := NewCA("Hetty", "Hetty CA", caValidity)
+	if err != nil {
+		return nil, fmt.Errorf("proxy: could not generate new CA keypair: %w", err)
+	}
+
+	if err := p.SetCA(ca, caPrivKey); err != nil {
+		return nil, err
+	}
+
+	return ca, nil
+}
+
+// CAHandler returns a handler that serves the proxy's CA certificate, for
+// installing it on clients. The format depends on the extension of the request
+// path: `.crt` (DER), `.pem` or `.p12` (a PKCS#12 trust store, protected with
+// the `password` query parameter, if set).
+func (p *Proxy) CAHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var (
+			ca          = p.CA()
+			ext         = path.Ext(r.URL.Path)
+			contentType string
+			body        []byte
+		)
+
+		switch ext {
+		case ".crt":
+			contentType = "application/x-x509-ca-cert"
+			body = ca.Raw
+		case ".pem":
+			contentType = "application/x-pem-file"
+			body = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.Raw})
+		case ".p12":
+			var err error
+
+			body, err = EncodePKCS12TrustStore([]*x509.Certificate{ca}, r.URL.Query().Get("password"))
+			if err != nil {
+				log.Printf("[ERROR] Could not encode CA certificate as PKCS#12: %v", err)
+				http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+
+				return
+			}
+
+			contentType = "application/x-pkcs12"
+		default:
+			http.NotFound(w, r)
+			return
+		}
+
+		w.Header().Set("Content-Type", contentType)
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"hetty_ca%v\"", ext))
+		w.Header().Set("Cache-Control", "no-store")
+		w.Write(body) //nolint:errcheck
+	})
+}
@@ -0,0 +1,294 @@
+package proxy
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestCertConfigCache(t *testing.T) {
+	t.Parallel()
+
+	ca, caKey, err := NewCA("Hetty", "Hetty CA", time.Hour)
+	if err != nil {
+		t.Fatalf("unexpected error creating CA: %v", err)
+	}
+
+	certConfig, err := NewCertConfig(ca, caKey)
+	if err != nil {
+		t.Fatalf("unexpected error creating cert config: %v", err)
+	}
+
+	first, err := certConfig.cert("example.com:443")
+	if err != nil {
+		t.Fatalf("unexpected error creating certificate: %v", err)
+	}
+
+	second, err := certConfig.cert("example.com")
+	if err != nil {
+		t.Fatalf("unexpected error creating certificate: %v", err)
+	}
+
+	if first != second {
+		t.Fatal("expected cached certificate to be returned")
+	}
+
+	newCA, newCAKey, err := NewCA("Hetty", "Hetty CA", time.Hour)
+	if err != nil {
+		t.Fatalf("unexpected error creating CA: %v", err)
+	}
+
+	if err := certConfig.SetCA(newCA, newCAKey); err != nil {
+		t.Fatalf("unexpected error setting CA: %v", err)
+	}
+
+	third, err := certConfig.cert("example.com")
+	if err != nil {
+		t.Fatalf("unexpected error creating certificate: %v", err)
+	}
+
+	if third == first {
+		t.Fatal("expected certificate to be regenerated after CA change")
+	}
+
+	if err := third.Leaf.CheckSignatureFrom(newCA); err != nil {
+		t.Fatalf("expected certificate to be signed by new CA: %v", err)
+	}
+
+	if err := certConfig.SetCA(third.Leaf, newCAKey); !errors.Is(err, ErrNotCA) {
+		t.Fatalf("expected `ErrNotCA`, got: %v", err)
+	}
+}
+
+func TestRotateCA(t *testing.T) {
+	t.Parallel()
+
+	ca, caKey, err := NewCA("Hetty", "Hetty CA", time.Hour)
+	if err != nil {
+		t.Fatalf("unexpected error creating CA: %v", err)
+	}
+
+	p, err := NewProxy(ca, caKey)
+	if err != nil {
+		t.Fatalf("unexpected error creating proxy: %v", err)
+	}
+
+	dir := t.TempDir()
+	caKeyFile, caCertFile := filepath.Join(dir, "key.pem"), filepath.Join(dir, "cert.pem")
+	p.SetCAFiles(caKeyFile, caCertFile)
+
+	newCA, err := p.RotateCA()
+	if err != nil {
+		t.Fatalf("unexpected error rotating CA: %v", err)
+	}
+
+	if newCA.Equal(ca) || !p.CA().Equal(newCA) {
+		t.Fatal("expected CA to be replaced")
+	}
+
+	// The new CA is written to disk.
+	loaded, _, err := LoadOrCreateCA(caKeyFile, caCertFile)
+	if err != nil {
+		t.Fatalf("unexpected error loading CA: %v", err)
+	}
+
+	if !loaded.Equal(newCA) {
+		t.Fatal("expected new CA to be written to disk")
+	}
+}
+
+func TestPKCS12KDF(t *testing.T) {
+	t.Parallel()
+
+	// Test vectors from `golang.org/x/crypto/pkcs12`.
+	tests := []struct {
+		name     string
+		password []byte
+		salt     []byte
+		expected []byte
+	}{
+		{
+			name:     "long key",
+			password: append(bmpString("sesame"), 0, 0),
+			salt:     []byte("\xff\xff\xff\xff\xff\xff\xff\xff"),
+			expected: []byte("\x7c\xd9\xfd\x3e\x2b\x3b\xe7\x69\x1a\x44\xe3\xbe\xf0\xf9\xea\x0f\xb9\xb8\x97\xd4\xe3\x25\xd9\xd1"),
+		},
+		{
+			name:     "leading zeros in input block",
+			password: []byte("\x00\x00"),
+			salt:     []byte("\xf3\x7e\x05\xb5\x18\x32\x4b\x4b"),
+			expected: []byte("\x00\xf7\x59\xff\x47\xd1\x4d\xd0\x36\x65\xd5\x94\x3c\xb3\xc4\xa3\x9a\x25\x55\xc0\x2a\xed\x66\xe1"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := pkcs12KDF(tt.password, tt.salt, 2048, 1, 24); !bytes.Equal(got, tt.expected) {
+				t.Fatalf("incorrect key (expected: %x, got: %x)", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestEncodePKCS12TrustStore(t *testing.T) {
+	t.Parallel()
+
+	ca, _, err := NewCA("Hetty", "Hetty CA", time.Hour)
+	if err != nil {
+		t.Fatalf("unexpected error creating CA: %v", err)
+	}
+
+	for _, password := range []string{"", "foobar"} {
+		data, err := EncodePKCS12TrustStore([]*x509.Certificate{ca}, password)
+		if err != nil {
+			t.Fatalf("unexpected error encoding PKCS#12: %v", err)
+		}
+
+		if got := decodeTestPKCS12(t, data, password); !bytes.Equal(got, ca.Raw) {
+			t.Fatalf("expected PKCS#12 (password: %q) to contain CA certificate", password)
+		}
+
+		if got := decodeTestPKCS12(t, data, "wrong"); got != nil {
+			t.Fatal("expected MAC not to match for wrong password")
+		}
+	}
+}
+
+// decodeTestPKCS12 returns the certificate of a PKCS#12 trust store with a
+// single certificate, or nil if the MAC doesn't match.
+func decodeTestPKCS12(t *testing.T, data []byte, password string) []byte {
+	t.Helper()
+
+	var pfx pfxPdu
+	if _, err := asn1.Unmarshal(data, &pfx); err != nil {
+		t.Fatalf("unexpected error decoding PKCS#12: %v", err)
+	}
+
+	var authSafe []byte
+	if _, err := asn1.Unmarshal(pfx.AuthSafe.Content.Bytes, &authSafe); err != nil {
+		t.Fatalf("unexpected error decoding authenticated safe: %v", err)
+	}
+
+	key := pkcs12KDF(append(bmpString(password), 0, 0), pfx.MacData.MacSalt, pfx.MacData.Iterations, 3, sha1.Size)
+	mac := hmac.New(sha1.New, key)
+	mac.Write(authSafe)
+
+	if !hmac.Equal(mac.Sum(nil), pfx.MacData.Mac.Digest) {
+		return nil
+	}
+
+	var contentInfos []contentInfo
+	if _, err := asn1.Unmarshal(authSafe, &contentInfos); err != nil || len(contentInfos) != 1 {
+		t.Fatalf("unexpected authenticated safe (error: %v)", err)
+	}
+
+	var safeContents []byte
+	if _, err := asn1.Unmarshal(contentInfos[0].Content.Bytes, &safeContents); err != nil {
+		t.Fatalf("unexpected error decoding safe contents: %v", err)
+	}
+
+	var bags []safeBag
+	if _, err := asn1.Unmarshal(safeContents, &bags); err != nil || len(bags) != 1 {
+		t.Fatalf("unexpected safe bags (error: %v)", err)
+	}
+
+	var bag certBag
+	if _, err := asn1.Unmarshal(bags[0].Value.Bytes, &bag); err != nil {
+		t.Fatalf("unexpected error decoding cert bag: %v", err)
+	}
+
+	return bag.Data
+}
+
+func TestCAHandler(t *testing.T) {
+	t.Parallel()
+
+	ca, caKey, err := NewCA("Hetty", "Hetty CA", time.Hour)
+	if err != nil {
+		t.Fatalf("unexpected error creating CA: %v", err)
+	}
+
+	p, err := NewProxy(ca, caKey)
+	if err != nil {
+		t.Fatalf("unexpected error creating proxy: %v", err)
+	}
+
+	tests := []struct {
+		path           string
+		expStatusCode  int
+		expContentType string
+		expCert        func(t *testing.T, body []byte) []byte
+	}{
+		{
+			path:           "/ca.crt",
+			expStatusCode:  http.StatusOK,
+			expContentType: "application/x-x509-ca-cert",
+			expCert:        func(_ *testing.T, body []byte) []byte { return body },
+		},
+		{
+			path:           "/ca.pem",
+			expStatusCode:  http.StatusOK,
+			expContentType: "application/x-pem-file",
+			expCert: func(_ *testing.T, body []byte) []byte {
+				block, _ := pem.Decode(body)
+				if block == nil {
+					return nil
+				}
+
+				return block.Bytes
+			},
+		},
+		{
+			path:           "/ca.p12?password=foobar",
+			expStatusCode:  http.StatusOK,
+			expContentType: "application/x-pkcs12",
+			expCert: func(t *testing.T, body []byte) []byte {
+				return decodeTestPKCS12(t, body, "foobar")
+			},
+		},
+		{
+			path:          "/ca.der",
+			expStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.path, func(t *testing.T) {
+			t.Parallel()
+
+			rec := httptest.NewRecorder()
+			p.CAHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "http://hetty.proxy"+tt.path, nil))
+
+			if rec.Code != tt.expStatusCode {
+				t.Fatalf("incorrect status code (expected: %v, got: %v)", tt.expStatusCode, rec.Code)
+			}
+
+			if tt.expCert == nil {
+				return
+			}
+
+			if got := rec.Header().Get("Content-Type"); got != tt.expContentType {
+				t.Fatalf("incorrect content type (expected: %v, got: %v)", tt.expContentType, got)
+			}
+
+			if !bytes.Equal(tt.expCert(t, rec.Body.Bytes()), ca.Raw) {
+				t.Fatal("expected response body to contain CA certificate")
+			}
+		})
+	}
+}
@@ -16,6 +16,7 @@ import (
 	"net"
 	"os"
 	"path/filepath"
+	"sync"
 	"time"
 )
 
@@ -24,13 +25,25 @@ import (
 // bytes (2^(8*20)-1).
 var MaxSerialNumber = big.NewInt(0).SetBytes(bytes.Repeat([]byte{255}, 20))
 
+// ErrNotCA is returned when a certificate that isn't a CA is used as CA.
+var ErrNotCA = errors.New("proxy: certificate is not a CA")
+
+// leafCertRenewal is how long before expiry cached leaf certificates are
+// regenerated.
+const leafCertRenewal = time.Hour
+
 // CertConfig is a set of configuration values that are used to build TLS configs
 // capable of MITM.
 type CertConfig struct {
+	mu     sync.RWMutex
 	ca     *x509.Certificate
 	caPriv crypto.PrivateKey
-	priv   *rsa.PrivateKey
-	keyID  []byte
+	// cache holds generated leaf certificates by hostname. It's reset when the
+	// CA changes.
+	cache map[string]*tls.Certificate
+
+	priv  *rsa.PrivateKey
+	keyID []byte
 }
 
 // NewCertConfig creates a MITM config using the CA certificate and
@@ -57,14 +70,40 @@ func NewCertConfig(ca *x509.Certificate, caPrivKey crypto.PrivateKey) (*CertConf
 	return &CertConfig{
 		ca:     ca,
 		caPriv: caPrivKey,
+		cache:  make(map[string]*tls.Certificate),
 		priv:   priv,
 		keyID:  keyID,
 	}, nil
 }
 
+// CA returns the CA certificate that leaf certificates are signed with.
+func (c *CertConfig) CA() *x509.Certificate {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.ca
+}
+
+// SetCA replaces the CA that leaf certificates are signed with. Cached leaf
+// certificates, signed by the previous CA, are discarded.
+func (c *CertConfig) SetCA(ca *x509.Certificate, caPrivKey crypto.PrivateKey) error {
+	if !ca.IsCA {
+		return ErrNotCA
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.ca = ca
+	c.caPriv = caPrivKey
+	c.cache = make(map[string]*tls.Certificate)
+
+	return nil
+}
+
 // LoadOrCreateCA loads an existing CA key pair from disk, or creates
 // a new keypair and saves to disk if certificate or key files don't exist.
-func LoadOrCreateCA(caKeyFile, caCertFile string) (*x509.Certificate, *rsa.PrivateKey, error) {
+func LoadOrCreateCA(caKeyFile, caCertFile string) (*x509.Certificate, crypto.PrivateKey, error) {
 	tlsCA, err := tls.LoadX509KeyPair(caCertFile, caKeyFile)
 	if err == nil {
 		caCert, err := x509.ParseCertificate(tlsCA.Certificate[0])
@@ -72,12 +111,7 @@ func LoadOrCreateCA(caKeyFile, caCertFile string) (*x509.Certificate, *rsa.Priva
 			return nil, nil, fmt.Errorf("proxy: could not parse CA: %w", err)
 		}
 
-		caKey, ok := tlsCA.PrivateKey.(*rsa.PrivateKey)
-		if !ok {
-			return nil, nil, errors.New("proxy: private key is not RSA")
-		}
-
-		return caCert, caKey, nil
+		return caCert, tlsCA.PrivateKey, nil
 	}
 
 	if !os.IsNotExist(err) {
@@ -109,32 +143,62 @@ func LoadOrCreateCA(caKeyFile, caCertFile string) (*x509.Certificate, *rsa.Priva
 		return nil, nil, fmt.Errorf("proxy: could not generate new CA keypair: %w", err)
 	}
 
+	if err := SaveCA(caKeyFile, caCertFile, caCert, caKey); err != nil {
+		return nil, nil, err
+	}
+
+	return caCert, caKey, nil
+}
+
+// SaveCA writes a CA certificate and private key to disk, PEM encoded.
+func SaveCA(caKeyFile, caCertFile string, caCert *x509.Certificate, caKey crypto.PrivateKey) error {
 	// Open CA certificate and key files for writing.
 	certOut, err := os.Create(caCertFile)
 	if err != nil {
-		return nil, nil, fmt.Errorf("proxy: could not open cert file for writing: %w", err)
+		return fmt.Errorf("proxy: could not open cert file for writing: %w", err)
 	}
+	defer certOut.Close()
 
 	keyOut, err := os.OpenFile(caKeyFile, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
 	if err != nil {
-		return nil, nil, fmt.Errorf("proxy: could not open key file for writing: %w", err)
+		return fmt.Errorf("proxy: could not open key file for writing: %w", err)
 	}
+	defer keyOut.Close()
 
 	// Write PEM blocks to CA certificate and key files.
 	if err := pem.Encode(certOut, &pem.Block{Type: "CERTIFICATE", Bytes: caCert.Raw}); err != nil {
-		return nil, nil, fmt.Errorf("proxy: could not write CA certificate to disk: %w", err)
+		return fmt.Errorf("proxy: could not write CA certificate to disk: %w", err)
 	}
 
 	privBytes, err := x509.MarshalPKCS8PrivateKey(caKey)
 	if err != nil {
-		return nil, nil, fmt.Errorf("proxy: could not convert private key to DER format: %w", err)
+		return fmt.Errorf("proxy: could not convert private key to DER format: %w", err)
 	}
 
 	if err := pem.Encode(keyOut, &pem.Block{Type: "PRIVATE KEY", Bytes: privBytes}); err != nil {
-		return nil, nil, fmt.Errorf("proxy: could not write CA key to disk: %w", err)
+		return fmt.Errorf("proxy: could not write CA key to disk: %w", err)
 	}
 
-	return caCert, caKey, nil
+	return nil
+}
+
+// ParseCA parses a PEM encoded CA certificate and private key.
+func ParseCA(certPEM, keyPEM []byte) (*x509.Certificate, crypto.PrivateKey, error) {
+	keyPair, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return nil, nil, fmt.Errorf("proxy: could not parse CA key pair: %w", err)
+	}
+
+	caCert, err := x509.ParseCertificate(keyPair.Certificate[0])
+	if err != nil {
+		return nil, nil, fmt.Errorf("proxy: could not parse CA: %w", err)
+	}
+
+	if !caCert.IsCA {
+		return nil, nil, ErrNotCA
+	}
+
+	return caCert, keyPair.PrivateKey, nil
 }
 
 // NewCA creates a new CA certificate and associated private key.
@@ -217,6 +281,36 @@ func (c *CertConfig) cert(hostname string) (*tls.Certificate, error) {
 		hostname = host
 	}
 
+	c.mu.RLock()
+	cert, ok := c.cache[hostname]
+	ca, caPriv := c.ca, c.caPriv
+	c.mu.RUnlock()
+
+	if ok && time.Now().Add(leafCertRenewal).Before(cert.Leaf.NotAfter) {
+		return cert, nil
+	}
+
+	cert, err = c.newCert(hostname, ca, caPriv)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	// Don't cache a certificate signed by a CA that was replaced meanwhile.
+	if c.ca == ca {
+		c.cache[hostname] = cert
+	}
+	c.mu.Unlock()
+
+	return cert, nil
+}
+
+// newCert generates a leaf certificate for hostname, signed by ca.
+func (c *CertConfig) newCert(
+	hostname string,
+	ca *x509.Certificate,
+	caPriv crypto.PrivateKey,
+) (*tls.Certificate, error) {
 	serial, err := rand.Int(rand.Reader, MaxSerialNumber)
 	if err != nil {
 		return nil, err
@@ -242,7 +336,7 @@ func (c *CertConfig) cert(hostname string) (*tls.Certificate, error) {
 		tmpl.DNSNames = []string{hostname}
 	}
 
-	raw, err := x509.CreateCertificate(rand.Reader, tmpl, c.ca, c.priv.Public(), c.caPriv)
+	raw, err := x509.CreateCertificate(rand.Reader, tmpl, ca, c.priv.Public(), caPriv)
 	if err != nil {
 		return nil, err
 	}
@@ -254,7 +348,7 @@ func (c *CertConfig) cert(hostname string) (*tls.Certificate, error) {
 	}
 
 	return &tls.Certificate{
-		Certificate: [][]byte{raw, c.ca.Raw},
+		Certificate: [][]byte{raw, ca.Raw},
 		PrivateKey:  c.priv,
 		Leaf:        x509c,
 	}, nil
@@ -0,0 +1,141 @@
+package proxy
+
+import (
+	"crypto/tls"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"sync"
+	"testing"
+)
+
+func TestHTTP2(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, r.Proto+" "+r.URL.Path) //nolint:errcheck
+	}))
+	srv.EnableHTTP2 = true
+	srv.StartTLS()
+	t.Cleanup(srv.Close)
+
+	p := newTestProxy(t)
+	p.transport.base.TLSClientConfig = srv.Client().Transport.(*http.Transport).TLSClientConfig
+
+	if err := p.SetTLSPolicy(TLSPolicy{}); err != nil {
+		t.Fatalf("unexpected error (expected: nil, got: %v)", err)
+	}
+
+	var (
+		mu     sync.Mutex
+		logged []string
+	)
+
+	p.UseRequestModifier(func(next RequestModifyFunc) RequestModifyFunc {
+		return func(req *http.Request) {
+			mu.Lock()
+			logged = append(logged, req.Proto+" "+req.Host+" "+req.URL.Path)
+			mu.Unlock()
+
+			next(req)
+		}
+	})
+
+	proxySrv := httptest.NewServer(p)
+	t.Cleanup(proxySrv.Close)
+
+	proxyURL, err := url.Parse(proxySrv.URL)
+	if err != nil {
+		t.Fatalf("unexpected error parsing URL: %v", err)
+	}
+
+	client := &http.Client{
+		Transport: &http.Transport{
+			Proxy:             http.ProxyURL(proxyURL),
+			TLSClientConfig:   &tls.Config{ServerName: "example.com", InsecureSkipVerify: true}, //nolint:gosec
+			ForceAttemptHTTP2: true,
+		},
+	}
+	t.Cleanup(client.CloseIdleConnections)
+
+	// Concurrent requests are multiplexed on a single client connection.
+	paths := []string{"/foo", "/bar"}
+
+	var wg sync.WaitGroup
+
+	for _, path := range paths {
+		path := path
+
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			res, err := client.Get(srv.URL + path)
+			if err != nil {
+				t.Errorf("unexpected error sending request: %v", err)
+				return
+			}
+			defer res.Body.Close()
+
+			body, err := io.ReadAll(res.Body)
+			if err != nil {
+				t.Errorf("unexpected error reading body: %v", err)
+				return
+			}
+
+			if res.Proto != "HTTP/2.0" {
+				t.Errorf("incorrect client protocol (expected: HTTP/2.0, got: %v)", res.Proto)
+			}
+
+			if exp := "HTTP/2.0 " + path; string(body) != exp {
+				t.Errorf("incorrect upstream protocol or path (expected: %q, got: %q)", exp, string(body))
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	host := srv.Listener.Addr().String()
+	exp := []string{"HTTP/2.0 " + host + " /bar", "HTTP/2.0 " + host + " /foo"}
+
+	sort.Strings(logged)
+
+	if len(logged) != len(exp) || logged[0] != exp[0] || logged[1] != exp[1] {
+		t.Fatalf("incorrect requests (expected: %v, got: %v)", exp, logged)
+	}
+}
+
+func TestHTTP2Disabled(t *testing.T) {
+	t.Parallel()
+
+	p := newTestProxy(t)
+	p.SetHTTP2(false)
+
+	clientConn, proxyConn := net.Pipe()
+	defer clientConn.Close()
+
+	go func() {
+		tlsConn, _, err := p.clientTLSConn(proxyConn)
+		if err == nil {
+			tlsConn.Close()
+		}
+	}()
+
+	client := tls.Client(clientConn, &tls.Config{ //nolint:gosec
+		ServerName:         "example.com",
+		InsecureSkipVerify: true,
+		NextProtos:         []string{"h2", "http/1.1"},
+	})
+
+	if err := client.Handshake(); err != nil {
+		t.Fatalf("unexpected handshake error: %v", err)
+	}
+
+	if got := client.ConnectionState().NegotiatedProtocol; got != "http/1.1" {
+		t.Fatalf("incorrect negotiated protocol (expected: http/1.1, got: %v)", got)
+	}
+}
@@ -0,0 +1,261 @@
+package proxy
+
+import (
+	"bytes"
+	"crypto/md5" //nolint:gosec // JA3 is defined as an MD5 hash.
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	recordTypeHandshake      = 0x16
+	handshakeTypeClientHello = 0x01
+
+	extSupportedGroups = 10
+	extECPointFormats  = 11
+)
+
+var errInvalidClientHello = errors.New("invalid ClientHello")
+
+// JA3 returns the JA3 fingerprint of a TLS ClientHello, in raw form (e.g.
+// `771,4865-4866,0-10-11,29-23,0`) and as MD5 hash. The input is read starting
+// at the first TLS record of a connection; a ClientHello can span multiple
+// records. GREASE values (RFC 8701) are ignored.
+func JA3(records []byte) (raw, hash string, err error) {
+	msg, err := clientHelloMessage(records)
+	if err != nil {
+		return "", "", err
+	}
+
+	s := cryptobyte(msg)
+
+	var (
+		version                  uint16
+		ciphers, exts, curves    []string
+		pointFormats             []string
+		sessionID, compression   cryptobyte
+		cipherSuites, extensions cryptobyte
+	)
+
+	if !s.readUint16(&version) ||
+		!s.skip(32) || // Random.
+		!s.readUint8LengthPrefixed(&sessionID) ||
+		!s.readUint16LengthPrefixed(&cipherSuites) ||
+		!s.readUint8LengthPrefixed(&compression) {
+		return "", "", errInvalidClientHello
+	}
+
+	for !cipherSuites.empty() {
+		var suite uint16
+		if !cipherSuites.readUint16(&suite) {
+			return "", "", errInvalidClientHello
+		}
+
+		if !isGREASE(suite) {
+			ciphers = append(ciphers, strconv.Itoa(int(suite)))
+		}
+	}
+
+	// Extensions are optional.
+	if !s.empty() && !s.readUint16LengthPrefixed(&extensions) {
+		return "", "", errInvalidClientHello
+	}
+
+	for !extensions.empty() {
+		var (
+			extType uint16
+			extData cryptobyte
+		)
+
+		if !extensions.readUint16(&extType) || !extensions.readUint16LengthPrefixed(&extData) {
+			return "", "", errInvalidClientHello
+		}
+
+		if isGREASE(extType) {
+			continue
+		}
+
+		exts = append(exts, strconv.Itoa(int(extType)))
+
+		switch extType {
+		case extSupportedGroups:
+			var groups cryptobyte
+			if !extData.readUint16LengthPrefixed(&groups) {
+				return "", "", errInvalidClientHello
+			}
+
+			for !groups.empty() {
+				var group uint16
+				if !groups.readUint16(&group) {
+					return "", "", errInvalidClientHello
+				}
+
+				if !isGREASE(group) {
+					curves = append(curves, strconv.Itoa(int(group)))
+				}
+			}
+		case extECPointFormats:
+			var formats cryptobyte
+			if !extData.readUint8LengthPrefixed(&formats) {
+				return "", "", errInvalidClientHello
+			}
+
+			for _, format := range formats {
+				pointFormats = append(pointFormats, strconv.Itoa(int(format)))
+			}
+		}
+	}
+
+	raw = strings.Join([]string{
+		strconv.Itoa(int(version)),
+		strings.Join(ciphers, "-"),
+		strings.Join(exts, "-"),
+		strings.Join(curves, "-"),
+		strings.Join(pointFormats, "-"),
+	}, ",")
+
+	sum := md5.Sum([]byte(raw)) //nolint:gosec
+
+	return raw, hex.EncodeToString(sum[:]), nil
+}
+
+// clientHelloMessage returns the body of the ClientHello handshake message,
+// reassembled from the handshake records it's fragmented over.
+func clientHelloMessage(records []byte) ([]byte, error) {
+	var handshake []byte
+
+	for len(records) >= 5 {
+		if records[0] != recordTypeHandshake {
+			break
+		}
+
+		n := int(binary.BigEndian.Uint16(records[3:5]))
+		if len(records) < 5+n {
+			return nil, errInvalidClientHello
+		}
+
+		handshake = append(handshake, records[5:5+n]...)
+		records = records[5+n:]
+
+		if len(handshake) >= 4 && len(handshake) >= 4+handshakeLength(handshake) {
+			break
+		}
+	}
+
+	if len(handshake) < 4 || handshake[0] != handshakeTypeClientHello {
+		return nil, errInvalidClientHello
+	}
+
+	n := handshakeLength(handshake)
+	if len(handshake) < 4+n {
+		return nil, errInvalidClientHello
+	}
+
+	return handshake[4 : 4+n], nil
+}
+
+func handshakeLength(handshake []byte) int {
+	return int(handshake[1])<<16 | int(handshake[2])<<8 | int(handshake[3])
+}
+
+// isGREASE returns true for the reserved GREASE values of RFC 8701, e.g.
+// 0x0a0a and 0x1a1a.
+func isGREASE(v uint16) bool {
+	return v&0x0f0f == 0x0a0a && v>>8 == v&0xff
+}
+
+// cryptobyte is a minimal reader for length-prefixed TLS structures.
+type cryptobyte []byte
+
+func (s *cryptobyte) empty() bool {
+	return len(*s) == 0
+}
+
+func (s *cryptobyte) skip(n int) bool {
+	if len(*s) < n {
+		return false
+	}
+
+	*s = (*s)[n:]
+
+	return true
+}
+
+func (s *cryptobyte) readUint16(v *uint16) bool {
+	if len(*s) < 2 {
+		return false
+	}
+
+	*v = binary.BigEndian.Uint16(*s)
+	*s = (*s)[2:]
+
+	return true
+}
+
+func (s *cryptobyte) readLengthPrefixed(lenSize int, out *cryptobyte) bool {
+	if len(*s) < lenSize {
+		return false
+	}
+
+	n := 0
+	for _, b := range (*s)[:lenSize] {
+		n = n<<8 | int(b)
+	}
+
+	if len(*s) < lenSize+n {
+		return false
+	}
+
+	*out = (*s)[lenSize : lenSize+n]
+	*s = (*s)[lenSize+n:]
+
+	return true
+}
+
+func (s *cryptobyte) readUint8LengthPrefixed(out *cryptobyte) bool {
+	return s.readLengthPrefixed(1, out)
+}
+
+func (s *cryptobyte) readUint16LengthPrefixed(out *cryptobyte) bool {
+	return s.readLengthPrefixed(2, out)
+}
+
+// helloRecorder wraps a connection and records the bytes read from it, until
+// stop is called. It's used to capture the raw ClientHello during a TLS
+// handshake.
+type helloRecorder struct {
+	net.Conn
+
+	mu      sync.Mutex
+	buf     bytes.Buffer
+	stopped bool
+}
+
+func (hr *helloRecorder) Read(p []byte) (int, error) {
+	n, err := hr.Conn.Read(p)
+
+	hr.mu.Lock()
+	if !hr.stopped {
+		hr.buf.Write(p[:n])
+	}
+	hr.mu.Unlock()
+
+	return n, err
+}
+
+// stop stops recording and returns the recorded bytes.
+func (hr *helloRecorder) stop() []byte {
+	hr.mu.Lock()
+	defer hr.mu.Unlock()
+
+	hr.stopped = true
+	b := hr.buf.Bytes()
+	hr.buf = bytes.Buffer{}
+
+	return b
+}
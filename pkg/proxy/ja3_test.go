@@ -0,0 +1,199 @@
+package proxy
+
+import (
+	"crypto/tls"
+	"encoding/binary"
+	"net"
+	"testing"
+	"time"
+)
+
+// clientHelloRecords builds a ClientHello handshake message, fragmented over
+// TLS records of at most fragmentSize bytes.
+func clientHelloRecords(t *testing.T, fragmentSize int) []byte {
+	t.Helper()
+
+	u16 := func(v uint16) []byte {
+		b := make([]byte, 2)
+		binary.BigEndian.PutUint16(b, v)
+		return b
+	}
+	prefixed16 := func(b []byte) []byte { return append(u16(uint16(len(b))), b...) }
+
+	var body []byte
+	body = append(body, u16(0x0303)...)      // Version: TLS 1.2.
+	body = append(body, make([]byte, 32)...) // Random.
+	body = append(body, 0)                   // Session ID.
+	body = append(body, prefixed16([]byte{
+		0x0a, 0x0a, // GREASE.
+		0x13, 0x01, // TLS_AES_128_GCM_SHA256.
+		0x13, 0x02, // TLS_AES_256_GCM_SHA384.
+		0xc0, 0x2b, // TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256.
+	})...)
+	body = append(body, 1, 0) // Compression methods: null.
+
+	var exts []byte
+	exts = append(exts, u16(0x1a1a)...) // GREASE.
+	exts = append(exts, prefixed16(nil)...)
+	exts = append(exts, u16(0)...) // Server name.
+	exts = append(exts, prefixed16([]byte{0, 0})...)
+	exts = append(exts, u16(extSupportedGroups)...)
+	exts = append(exts, prefixed16(prefixed16([]byte{
+		0x2a, 0x2a, // GREASE.
+		0x00, 0x1d, // X25519.
+		0x00, 0x17, // P-256.
+	}))...)
+	exts = append(exts, u16(extECPointFormats)...)
+	exts = append(exts, prefixed16([]byte{1, 0})...)
+	exts = append(exts, u16(43)...) // Supported versions.
+	exts = append(exts, prefixed16([]byte{2, 0x03, 0x04})...)
+
+	body = append(body, prefixed16(exts)...)
+
+	handshake := []byte{handshakeTypeClientHello, byte(len(body) >> 16), byte(len(body) >> 8), byte(len(body))}
+	handshake = append(handshake, body...)
+
+	var records []byte
+
+	for len(handshake) > 0 {
+		n := fragmentSize
+		if n > len(handshake) {
+			n = len(handshake)
+		}
+
+		records = append(records, recordTypeHandshake, 0x03, 0x01)
+		records = append(records, prefixed16(handshake[:n])...)
+		handshake = handshake[n:]
+	}
+
+	return records
+}
+
+func TestJA3(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		records []byte
+		expRaw  string
+		expHash string
+		expErr  bool
+	}{
+		{
+			name:    "single record",
+			records: clientHelloRecords(t, 16384),
+			expRaw:  "771,4865-4866-49195,0-10-11-43,29-23,0",
+			expHash: "fd17f1f9b9c56d4bfda8900c8900ec06",
+		},
+		{
+			name:    "fragmented over multiple records",
+			records: clientHelloRecords(t, 20),
+			expRaw:  "771,4865-4866-49195,0-10-11-43,29-23,0",
+			expHash: "fd17f1f9b9c56d4bfda8900c8900ec06",
+		},
+		{
+			name:    "truncated",
+			records: clientHelloRecords(t, 16384)[:50],
+			expErr:  true,
+		},
+		{
+			name:    "not a handshake record",
+			records: []byte("GET / HTTP/1.1\r\n\r\n"),
+			expErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			raw, hash, err := JA3(tt.records)
+			if tt.expErr {
+				if err == nil {
+					t.Fatal("expected error, got: nil")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if raw != tt.expRaw {
+				t.Errorf("expected raw JA3: %v, got: %v", tt.expRaw, raw)
+			}
+
+			if hash != tt.expHash {
+				t.Errorf("expected JA3 hash: %v, got: %v", tt.expHash, hash)
+			}
+		})
+	}
+}
+
+func TestClientTLSConnRecordsClientHello(t *testing.T) {
+	t.Parallel()
+
+	p := newTestProxy(t)
+	p.SetJA3Capture(true)
+
+	serverConn, clientConn := net.Pipe()
+	defer serverConn.Close()
+	defer clientConn.Close()
+
+	go func() {
+		//nolint:gosec
+		client := tls.Client(clientConn, &tls.Config{ServerName: "example.com", InsecureSkipVerify: true})
+		_ = client.Handshake()
+	}()
+
+	_, clientHello, err := p.clientTLSConn(serverConn)
+	if err != nil {
+		t.Fatalf("unexpected error during handshake: %v", err)
+	}
+
+	if _, hash, err := JA3(clientHello); err != nil || len(hash) != 32 {
+		t.Fatalf("expected JA3 hash of recorded ClientHello, got: %q (error: %v)", hash, err)
+	}
+}
+
+func TestClientTLSConnJA3CaptureDisabled(t *testing.T) {
+	t.Parallel()
+
+	p := newTestProxy(t)
+
+	serverConn, clientConn := net.Pipe()
+	defer serverConn.Close()
+	defer clientConn.Close()
+
+	go func() {
+		//nolint:gosec
+		client := tls.Client(clientConn, &tls.Config{ServerName: "example.com", InsecureSkipVerify: true})
+		_ = client.Handshake()
+	}()
+
+	_, clientHello, err := p.clientTLSConn(serverConn)
+	if err != nil {
+		t.Fatalf("unexpected error during handshake: %v", err)
+	}
+
+	if clientHello != nil {
+		t.Errorf("expected no recorded ClientHello, got: %d bytes", len(clientHello))
+	}
+}
+
+func newTestProxy(t *testing.T) *Proxy {
+	t.Helper()
+
+	ca, key, err := NewCA("Hetty", "Hetty CA", time.Hour)
+	if err != nil {
+		t.Fatalf("unexpected error creating CA: %v", err)
+	}
+
+	p, err := NewProxy(ca, key)
+	if err != nil {
+		t.Fatalf("unexpected error creating proxy: %v", err)
+	}
+
+	return p
+}
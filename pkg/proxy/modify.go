@@ -22,3 +22,16 @@ type ResponseModifyFunc func(res *http.Response) error
 // ResponseModifyMiddleware defines a type for chaining response modifier
 // middleware.
 type ResponseModifyMiddleware func(ResponseModifyFunc) ResponseModifyFunc
+
+// TLSErrorFunc defines a type for a function that's called when the TLS
+// handshake with a client fails. The request is the CONNECT request that
+// preceded the handshake.
+type TLSErrorFunc func(connectReq *http.Request, err error)
+
+// MalformedRequestFunc defines a type for a function that's called when a
+// request from a client is rejected because it couldn't be parsed, e.g. because
+// of a malformed request line or an unsupported protocol version, such as
+// HTTP/0.9. The request line is as received, and status is the status of the
+// response the client got, e.g. `400 Bad Request`. For requests tunneled using
+// CONNECT, connectReq is the CONNECT request; it's nil otherwise.
+type MalformedRequestFunc func(connectReq *http.Request, requestLine, status string)
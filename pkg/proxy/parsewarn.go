@@ -0,0 +1,306 @@
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptrace"
+	"sync"
+)
+
+// maxRecordedHeaderBytes is the maximum number of bytes read from a connection
+// that are kept for finding the header of the next message in. Headers that
+// don't fit aren't checked for parse warnings.
+const maxRecordedHeaderBytes = 64 << 10
+
+// recordBytes appends b to buf, keeping at most the last
+// `maxRecordedHeaderBytes` bytes.
+func recordBytes(buf, b []byte) []byte {
+	buf = append(buf, b...)
+
+	if over := len(buf) - maxRecordedHeaderBytes; over > 0 {
+		buf = buf[:copy(buf, buf[over:])]
+	}
+
+	return buf
+}
+
+// headerBlock returns the message header at the start of raw: the start line
+// and header fields, including the empty line that ends the header. It returns
+// nil if raw doesn't hold a complete header.
+func headerBlock(raw []byte) []byte {
+	for i := 0; i < len(raw); {
+		j := bytes.IndexByte(raw[i:], '\n')
+		if j < 0 {
+			return nil
+		}
+
+		// The start line is never empty, so an empty line after it ends the
+		// header.
+		empty := len(bytes.TrimSuffix(raw[i:i+j], []byte("\r"))) == 0
+		if empty && i > 0 {
+			return raw[:i+j+1]
+		}
+
+		i += j + 1
+	}
+
+	return nil
+}
+
+// headerWarnings returns warnings about a raw message header that is tolerated
+// by the parser, but malformed or ambiguous per RFC 9112, such as obsolete line
+// folding. Intermediaries that handle these differently can be prone to
+// request smuggling or response desync.
+func headerWarnings(header []byte) []string {
+	var warnings []string
+
+	add := func(format string, args ...interface{}) {
+		w := fmt.Sprintf(format, args...)
+		for _, v := range warnings {
+			if v == w {
+				return
+			}
+		}
+
+		warnings = append(warnings, w)
+	}
+
+	counts := make(map[string]int)
+	prevName := ""
+
+	for i, line := range bytes.Split(header, []byte("\n")) {
+		if len(line) == 0 {
+			// End of header, or the empty remainder after its final line feed.
+			break
+		}
+
+		if line[len(line)-1] != '\r' {
+			add("bare LF line ending")
+		}
+
+		line = bytes.TrimSuffix(line, []byte("\r"))
+
+		if i == 0 {
+			if bytes.Contains(line, []byte("  ")) || bytes.ContainsAny(line, "\t") ||
+				bytes.HasSuffix(line, []byte(" ")) {
+				add("extra whitespace in start line")
+			}
+
+			continue
+		}
+
+		if len(line) == 0 {
+			break
+		}
+
+		if line[0] == ' ' || line[0] == '\t' {
+			add("obsolete line folding in header %q", prevName)
+			continue
+		}
+
+		colon := bytes.IndexByte(line, ':')
+		if colon < 0 {
+			add("header line without colon")
+			continue
+		}
+
+		name := bytes.TrimRight(line[:colon], " \t")
+		if len(name) != colon {
+			add("whitespace before colon in header %q", name)
+		}
+
+		prevName = http.CanonicalHeaderKey(string(name))
+		counts[prevName]++
+	}
+
+	for _, name := range []string{"Content-Length", "Host", "Transfer-Encoding"} {
+		if counts[name] > 1 {
+			add("multiple %v headers", name)
+		}
+	}
+
+	if counts["Transfer-Encoding"] > 0 && counts["Content-Length"] > 0 {
+		add("both Transfer-Encoding and Content-Length headers")
+	}
+
+	return warnings
+}
+
+// requestHeaderIndex returns the offset in raw of the header of r: the first
+// line that starts with the request method and holds the request target. It
+// returns -1 if it's not found.
+func requestHeaderIndex(raw []byte, r *http.Request) int {
+	method, target := []byte(r.Method), []byte(r.RequestURI)
+
+	for i := 0; i < len(raw); {
+		line := raw[i:]
+
+		j := bytes.IndexByte(line, '\n')
+		if j >= 0 {
+			line = line[:j]
+		}
+
+		if len(line) > len(method) && bytes.HasPrefix(line, method) &&
+			(line[len(method)] == ' ' || line[len(method)] == '\t') && bytes.Contains(line, target) {
+			return i
+		}
+
+		if j < 0 {
+			break
+		}
+
+		i += j + 1
+	}
+
+	return -1
+}
+
+// upstreamConn is a net.Conn to an upstream server, that records the bytes
+// read since the last write, so the header of the response to the last
+// written request can be checked for parse warnings. It only records once
+// recording is enabled, so connections that TLS is layered upon (whose bytes
+// are encrypted) don't record needlessly.
+type upstreamConn struct {
+	net.Conn
+
+	mu     sync.Mutex
+	record bool
+	raw    []byte
+}
+
+func (c *upstreamConn) Read(b []byte) (int, error) {
+	n, err := c.Conn.Read(b)
+
+	if n > 0 {
+		c.mu.Lock()
+		if c.record {
+			c.raw = recordBytes(c.raw, b[:n])
+		}
+		c.mu.Unlock()
+	}
+
+	return n, err
+}
+
+func (c *upstreamConn) Write(b []byte) (int, error) {
+	c.mu.Lock()
+	c.raw = c.raw[:0]
+	c.mu.Unlock()
+
+	return c.Conn.Write(b)
+}
+
+func (c *upstreamConn) enableRecording() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.record = true
+}
+
+// responseWarnings returns the parse warnings of the header of the response
+// to the last written request. Interim (1xx) responses are skipped.
+func (c *upstreamConn) responseWarnings() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for raw := c.raw; ; {
+		header := headerBlock(raw)
+		if header == nil {
+			return nil
+		}
+
+		if !isInformationalResponse(header) {
+			return headerWarnings(header)
+		}
+
+		raw = raw[len(header):]
+	}
+}
+
+// isInformationalResponse returns true if a raw response header has a 1xx
+// status code.
+func isInformationalResponse(header []byte) bool {
+	fields := bytes.Fields(header)
+
+	return len(fields) > 1 && len(fields[1]) == 3 && fields[1][0] == '1'
+}
+
+// dialUpstream wraps a dial func, so that the connections it returns can record
+// response headers for parse warnings.
+func dialUpstream(
+	dial func(ctx context.Context, network, addr string) (net.Conn, error),
+) func(ctx context.Context, network, addr string) (net.Conn, error) {
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		conn, err := dial(ctx, network, addr)
+		if err != nil {
+			return nil, err
+		}
+
+		return &upstreamConn{Conn: conn}, nil
+	}
+}
+
+// parseWarningTracer tracks the upstream connection an HTTP request is sent on,
+// to get the parse warnings of its response.
+type parseWarningTracer struct {
+	mu       sync.Mutex
+	conn     *upstreamConn
+	warnings []string
+}
+
+func (pt *parseWarningTracer) clientTrace() *httptrace.ClientTrace {
+	return &httptrace.ClientTrace{
+		GotConn: func(info httptrace.GotConnInfo) {
+			// Connections that TLS is layered upon are hidden by the
+			// *tls.Conn, so only plaintext HTTP/1.x responses are checked.
+			conn, ok := info.Conn.(*upstreamConn)
+			if !ok {
+				return
+			}
+
+			conn.enableRecording()
+
+			pt.mu.Lock()
+			defer pt.mu.Unlock()
+			pt.conn = conn
+		},
+	}
+}
+
+// snapshot stores the parse warnings of the response header that was read
+// last. It must be called once the response header was read, and before the
+// connection is reused for another request.
+func (pt *parseWarningTracer) snapshot() {
+	pt.mu.Lock()
+	defer pt.mu.Unlock()
+
+	if pt.conn != nil {
+		pt.warnings = pt.conn.responseWarnings()
+	}
+}
+
+func withParseWarningTracer(ctx context.Context) context.Context {
+	pt := &parseWarningTracer{}
+	ctx = httptrace.WithClientTrace(ctx, pt.clientTrace())
+
+	return context.WithValue(ctx, parseWarningTracerKey, pt)
+}
+
+// ResponseParseWarningsFromContext returns the parse warnings of the upstream
+// response to a request made with ctx (see `ParseWarningsKey`). Use it with the
+// request context of a response, e.g. in a ResponseModifyFunc. Warnings are
+// only recorded for plaintext HTTP/1.x upstream connections.
+func ResponseParseWarningsFromContext(ctx context.Context) []string {
+	pt, ok := ctx.Value(parseWarningTracerKey).(*parseWarningTracer)
+	if !ok {
+		return nil
+	}
+
+	pt.mu.Lock()
+	defer pt.mu.Unlock()
+
+	return pt.warnings
+}
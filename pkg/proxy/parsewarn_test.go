@@ -0,0 +1,194 @@
+package proxy
+
+import (
+	"bufio"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestHeaderWarnings(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		header   string
+		expected []string
+	}{
+		{
+			name:     "well-formed header",
+			header:   "GET / HTTP/1.1\r\nHost: example.com\r\nAccept: */*\r\n\r\n",
+			expected: nil,
+		},
+		{
+			name:     "folded header",
+			header:   "GET / HTTP/1.1\r\nHost: example.com\r\nX-Foo: bar\r\n baz\r\n\r\n",
+			expected: []string{`obsolete line folding in header "X-Foo"`},
+		},
+		{
+			name:     "bare LF line endings",
+			header:   "HTTP/1.1 200 OK\nContent-Length: 0\n\n",
+			expected: []string{"bare LF line ending"},
+		},
+		{
+			name:     "whitespace",
+			header:   "GET /  HTTP/1.1\r\nHost : example.com\r\n\r\n",
+			expected: []string{"extra whitespace in start line", `whitespace before colon in header "Host"`},
+		},
+		{
+			name: "conflicting framing headers",
+			header: "POST / HTTP/1.1\r\nHost: example.com\r\nContent-Length: 5\r\ncontent-length: 5\r\n" +
+				"Transfer-Encoding: chunked\r\n\r\n",
+			expected: []string{"multiple Content-Length headers", "both Transfer-Encoding and Content-Length headers"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := headerWarnings(headerBlock([]byte(tt.header + "body")))
+
+			if diff := cmp.Diff(tt.expected, got); diff != "" {
+				t.Errorf("warnings not equal (-exp, +got):\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestRequestParseWarnings(t *testing.T) {
+	t.Parallel()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("unexpected error listening: %v", err)
+	}
+
+	srv := &http.Server{
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = io.Copy(io.Discard, r.Body)
+			warnings, _ := withConnInfo(r).Context().Value(ParseWarningsKey).([]string)
+			io.WriteString(w, strings.Join(warnings, "; "))
+		}),
+		ConnContext: ConnContext,
+	}
+
+	go srv.Serve(PipelineListener{Listener: l})
+	defer srv.Close()
+
+	conn, err := net.Dial("tcp", l.Addr().String())
+	if err != nil {
+		t.Fatalf("unexpected error dialing: %v", err)
+	}
+	defer conn.Close()
+
+	br := bufio.NewReader(conn)
+
+	for i, tt := range []struct {
+		rawReq   string
+		expected string
+	}{
+		{
+			// The body holds a request line, which must not be mistaken for
+			// the header of the next request.
+			rawReq:   "POST /foo HTTP/1.1\r\nHost: example.com\r\nContent-Length: 19\r\n\r\nGET /bar HTTP/1.1\r\n",
+			expected: "",
+		},
+		{
+			rawReq:   "GET /bar HTTP/1.1\r\nHost: example.com\r\nX-Foo: foo\r\n\tbar\r\n\r\n",
+			expected: `obsolete line folding in header "X-Foo"`,
+		},
+		{
+			rawReq:   "GET /bar HTTP/1.1\r\nHost: example.com\r\n\r\n",
+			expected: "",
+		},
+	} {
+		if _, err := io.WriteString(conn, tt.rawReq); err != nil {
+			t.Fatalf("unexpected error writing request: %v", err)
+		}
+
+		res, err := http.ReadResponse(br, nil)
+		if err != nil {
+			t.Fatalf("unexpected error reading response: %v", err)
+		}
+
+		body, err := io.ReadAll(res.Body)
+		res.Body.Close()
+
+		if err != nil {
+			t.Fatalf("unexpected error reading response body: %v", err)
+		}
+
+		if got := string(body); got != tt.expected {
+			t.Errorf("request %v: expected warnings: %q, got: %q", i, tt.expected, got)
+		}
+	}
+}
+
+func TestResponseParseWarnings(t *testing.T) {
+	t.Parallel()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("unexpected error listening: %v", err)
+	}
+	defer l.Close()
+
+	rawResponses := []string{
+		"HTTP/1.1 200 OK\r\nContent-Length: 2\r\nX-Foo: foo\r\n bar\r\n\r\nok",
+		"HTTP/1.1 200 OK\r\nContent-Length: 2\r\n\r\nok",
+	}
+
+	// Serve raw responses on a single connection, so it's reused.
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		br := bufio.NewReader(conn)
+
+		for _, rawRes := range rawResponses {
+			if _, err := http.ReadRequest(br); err != nil {
+				return
+			}
+
+			if _, err := io.WriteString(conn, rawRes); err != nil {
+				return
+			}
+		}
+	}()
+
+	transport := &http.Transport{DialContext: dialUpstream((&net.Dialer{}).DialContext)}
+	defer transport.CloseIdleConnections()
+
+	for i, expected := range [][]string{{`obsolete line folding in header "X-Foo"`}, nil} {
+		req, err := http.NewRequest(http.MethodGet, "http://"+l.Addr().String(), nil)
+		if err != nil {
+			t.Fatalf("unexpected error creating request: %v", err)
+		}
+
+		req = req.WithContext(withParseWarningTracer(req.Context()))
+
+		res, err := transport.RoundTrip(req)
+		if err != nil {
+			t.Fatalf("unexpected error sending request: %v", err)
+		}
+
+		res.Request.Context().Value(parseWarningTracerKey).(*parseWarningTracer).snapshot()
+
+		_, _ = io.Copy(io.Discard, res.Body)
+		res.Body.Close()
+
+		got := ResponseParseWarningsFromContext(req.Context())
+		if diff := cmp.Diff(expected, got); diff != "" {
+			t.Errorf("response %v: warnings not equal (-exp, +got):\n%v", i, diff)
+		}
+	}
+}
@@ -0,0 +1,231 @@
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// pipelineConn is a net.Conn that tracks whether HTTP/1.1 requests were
+// pipelined, i.e. sent by the client before the response to the previous
+// request on the connection was written.
+//
+// The server only reads the next request from the connection once it has
+// written the previous response. If no read was needed after the last write of
+// the previous response, the request was already received (and buffered) when
+// that response was written, so it was pipelined.
+//
+// It also records the bytes read since the header of the previous request, so
+// the raw header of each request can be checked for parse warnings, and the
+// request line of requests that the server rejects can be reported.
+type pipelineConn struct {
+	net.Conn
+
+	// onRejected, if set, is called with the raw request line of a request that
+	// the server rejected because it couldn't be parsed, and the status of the
+	// response.
+	onRejected func(requestLine, status string)
+
+	mu               sync.Mutex
+	reads            int
+	readsAtLastWrite int
+	requests         int
+	raw              []byte
+	// skip is the number of bytes of the current request body that are yet
+	// to be read, and aren't recorded.
+	skip int64
+}
+
+func newPipelineConn(conn net.Conn) *pipelineConn {
+	return &pipelineConn{Conn: conn}
+}
+
+func (c *pipelineConn) Read(b []byte) (int, error) {
+	n, err := c.Conn.Read(b)
+
+	if n > 0 {
+		c.mu.Lock()
+		c.reads++
+		c.raw = recordBytes(c.raw, c.skipBody(b[:n]))
+		c.mu.Unlock()
+	}
+
+	return n, err
+}
+
+func (c *pipelineConn) Write(b []byte) (int, error) {
+	n, err := c.Conn.Write(b)
+
+	c.mu.Lock()
+	c.readsAtLastWrite = c.reads
+	requestLine, status, rejected := c.rejectedRequest(b)
+	c.mu.Unlock()
+
+	if rejected && c.onRejected != nil {
+		c.onRejected(requestLine, status)
+	}
+
+	return n, err
+}
+
+// rejectedRequest returns the raw request line of the request that the server
+// rejected with response b, and the status of the response, if b is such a
+// response. Otherwise, it returns false.
+func (c *pipelineConn) rejectedRequest(b []byte) (requestLine, status string, ok bool) {
+	status, ok = rejectionStatus(b)
+	if !ok || len(c.raw) == 0 {
+		return "", "", false
+	}
+
+	// The server never handled the request, so the recorded bytes start with
+	// its request line.
+	line := c.raw
+	if i := bytes.IndexByte(line, '\n'); i >= 0 {
+		line = line[:i]
+	}
+
+	c.raw = c.raw[:0]
+
+	return string(bytes.TrimSuffix(line, []byte("\r"))), status, true
+}
+
+// rejectionStatus returns the status of b, if it's a response that net/http's
+// server writes to a connection itself, when it can't parse a request because
+// of a malformed request line or header (400), or an unsupported protocol
+// version (505). These responses have a fixed set of headers, in an order
+// that responses written by handlers don't have.
+func rejectionStatus(b []byte) (string, bool) {
+	const (
+		proto   = "HTTP/1.1 "
+		headers = "\r\nContent-Type: text/plain; charset=utf-8\r\nConnection: close\r\n\r\n"
+	)
+
+	if !bytes.HasPrefix(b, []byte(proto)) {
+		return "", false
+	}
+
+	i := bytes.Index(b, []byte(headers))
+	if i < 0 {
+		return "", false
+	}
+
+	status := string(b[len(proto):i])
+	if !strings.HasPrefix(status, "400 ") && !strings.HasPrefix(status, "505 ") {
+		return "", false
+	}
+
+	return status, true
+}
+
+// CloseWrite shuts down the writing side of the underlying connection, if it
+// supports it, so wrapping a *net.TCPConn doesn't change how the server closes
+// connections.
+func (c *pipelineConn) CloseWrite() error {
+	if cw, ok := c.Conn.(interface{ CloseWrite() error }); ok {
+		return cw.CloseWrite()
+	}
+
+	return nil
+}
+
+// startRequest registers the start of handling a request read from the
+// connection. It returns whether the request was pipelined, and the parse
+// warnings of its raw header.
+func (c *pipelineConn) startRequest(r *http.Request) (pipelined bool, warnings []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.requests++
+	pipelined = c.requests > 1 && c.reads == c.readsAtLastWrite
+
+	if i := requestHeaderIndex(c.raw, r); i >= 0 {
+		if header := headerBlock(c.raw[i:]); header != nil {
+			warnings = headerWarnings(header)
+			// Skip the body, so that a request line in it isn't mistaken for
+			// the header of the next request. The length of chunked bodies
+			// isn't known upfront, so these are searched as well.
+			c.skip = 0
+			if r.ContentLength > 0 {
+				c.skip = r.ContentLength
+			}
+
+			c.raw = c.skipBody(c.raw[i+len(header):])
+		}
+	}
+
+	return pipelined, warnings
+}
+
+// skipBody returns b without the bytes that are part of the current request
+// body.
+func (c *pipelineConn) skipBody(b []byte) []byte {
+	n := int64(len(b))
+	if n > c.skip {
+		n = c.skip
+	}
+
+	c.skip -= n
+
+	return b[n:]
+}
+
+// PipelineListener wraps a net.Listener, so that the connections it accepts
+// track whether requests were pipelined, and their parse warnings. Use it with
+// `ConnContext` as the connection context func of the http.Server that serves
+// the proxy. Requests tunneled using CONNECT are tracked by the proxy itself.
+type PipelineListener struct {
+	net.Listener
+
+	// Proxy, if set, is notified of requests that are rejected because they
+	// couldn't be parsed (see `Proxy.OnMalformedRequest`).
+	Proxy *Proxy
+}
+
+func (l PipelineListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+
+	pc := newPipelineConn(conn)
+
+	if l.Proxy != nil {
+		pc.onRejected = func(requestLine, status string) {
+			l.Proxy.handleMalformedRequest(nil, requestLine, status)
+		}
+	}
+
+	return pc, nil
+}
+
+// ConnContext stores the connection in the context if it was accepted by a
+// `PipelineListener`. It's meant to be used as `http.Server.ConnContext`.
+func ConnContext(ctx context.Context, conn net.Conn) context.Context {
+	if pc, ok := conn.(*pipelineConn); ok {
+		return context.WithValue(ctx, pipelineConnKey, pc)
+	}
+
+	return ctx
+}
+
+// withConnInfo stores whether the request was pipelined and its parse warnings
+// in the request context, with keys `PipelinedKey` and `ParseWarningsKey`. It's
+// a no-op for requests that weren't received on a connection that tracks them.
+func withConnInfo(r *http.Request) *http.Request {
+	pc, ok := r.Context().Value(pipelineConnKey).(*pipelineConn)
+	if !ok {
+		return r
+	}
+
+	pipelined, warnings := pc.startRequest(r)
+
+	ctx := context.WithValue(r.Context(), PipelinedKey, pipelined)
+	if len(warnings) > 0 {
+		ctx = context.WithValue(ctx, ParseWarningsKey, warnings)
+	}
+
+	return r.WithContext(ctx)
+}
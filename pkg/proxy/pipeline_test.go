@@ -0,0 +1,186 @@
+package proxy
+
+import (
+	"bufio"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPipelineConn(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		writeAtOnce bool
+		expected    []bool
+	}{
+		{
+			name:        "pipelined requests",
+			writeAtOnce: true,
+			expected:    []bool{false, true, true},
+		},
+		{
+			name:        "sequential requests",
+			writeAtOnce: false,
+			expected:    []bool{false, false, false},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			l, err := net.Listen("tcp", "127.0.0.1:0")
+			if err != nil {
+				t.Fatalf("unexpected error listening: %v", err)
+			}
+
+			srv := &http.Server{
+				Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					pipelined, _ := withConnInfo(r).Context().Value(PipelinedKey).(bool)
+					io.WriteString(w, strconv.FormatBool(pipelined))
+				}),
+				ConnContext: ConnContext,
+			}
+
+			go srv.Serve(PipelineListener{Listener: l})
+			defer srv.Close()
+
+			conn, err := net.Dial("tcp", l.Addr().String())
+			if err != nil {
+				t.Fatalf("unexpected error dialing: %v", err)
+			}
+			defer conn.Close()
+
+			rawReq := "GET / HTTP/1.1\r\nHost: example.com\r\n\r\n"
+			br := bufio.NewReader(conn)
+
+			if tt.writeAtOnce {
+				if _, err := io.WriteString(conn, strings.Repeat(rawReq, len(tt.expected))); err != nil {
+					t.Fatalf("unexpected error writing requests: %v", err)
+				}
+			}
+
+			for i, exp := range tt.expected {
+				if !tt.writeAtOnce {
+					if _, err := io.WriteString(conn, rawReq); err != nil {
+						t.Fatalf("unexpected error writing request: %v", err)
+					}
+				}
+
+				res, err := http.ReadResponse(br, nil)
+				if err != nil {
+					t.Fatalf("unexpected error reading response: %v", err)
+				}
+
+				body, err := io.ReadAll(res.Body)
+				res.Body.Close()
+
+				if err != nil {
+					t.Fatalf("unexpected error reading response body: %v", err)
+				}
+
+				if got := string(body); got != strconv.FormatBool(exp) {
+					t.Errorf("request %v: expected pipelined: %v, got: %v", i, exp, got)
+				}
+			}
+		})
+	}
+}
+
+func TestPipelineConnRejectedRequest(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		rawReq      string
+		expRejected []string
+	}{
+		{
+			name:        "HTTP/0.9 request",
+			rawReq:      "GET /\r\n",
+			expRejected: []string{"GET / | 400 Bad Request"},
+		},
+		{
+			name:        "unsupported protocol version",
+			rawReq:      "GET / HTTP/2.0\r\nHost: example.com\r\n\r\n",
+			expRejected: []string{"GET / HTTP/2.0 | 505 HTTP Version Not Supported: unsupported protocol version"},
+		},
+		{
+			name:        "malformed request after valid request",
+			rawReq:      "GET / HTTP/1.1\r\nHost: example.com\r\n\r\nGET / FOO/1.1\r\nHost: example.com\r\n\r\n",
+			expRejected: []string{"GET / FOO/1.1 | 400 Bad Request"},
+		},
+		{
+			name:   "bad request response written by handler",
+			rawReq: "GET /bad HTTP/1.1\r\nHost: example.com\r\nConnection: close\r\n\r\n",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				mu       sync.Mutex
+				rejected []string
+			)
+
+			p := &Proxy{}
+			p.OnMalformedRequest(func(connectReq *http.Request, requestLine, status string) {
+				mu.Lock()
+				defer mu.Unlock()
+				rejected = append(rejected, requestLine+" | "+status)
+			})
+
+			l, err := net.Listen("tcp", "127.0.0.1:0")
+			if err != nil {
+				t.Fatalf("unexpected error listening: %v", err)
+			}
+
+			srv := &http.Server{
+				Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					withConnInfo(r)
+					if r.URL.Path == "/bad" {
+						http.Error(w, "400 Bad Request", http.StatusBadRequest)
+					}
+				}),
+				ConnContext: ConnContext,
+			}
+
+			go srv.Serve(PipelineListener{Listener: l, Proxy: p})
+			defer srv.Close()
+
+			conn, err := net.Dial("tcp", l.Addr().String())
+			if err != nil {
+				t.Fatalf("unexpected error dialing: %v", err)
+			}
+			defer conn.Close()
+
+			if _, err := io.WriteString(conn, tt.rawReq); err != nil {
+				t.Fatalf("unexpected error writing request: %v", err)
+			}
+
+			// The server closes the connection after rejecting a request.
+			if _, err := io.Copy(io.Discard, conn); err != nil {
+				t.Fatalf("unexpected error reading responses: %v", err)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if diff := cmp.Diff(tt.expRejected, rejected); diff != "" {
+				t.Fatalf("rejected requests not equal (-exp, +got):\n%v", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,263 @@
+package proxy
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"fmt"
+	"math/big"
+	"unicode/utf16"
+)
+
+// PKCS#12 (RFC 7292) is only encoded as a trust store, i.e. with certificates
+// and without private keys, so devices can install the CA certificate from a
+// single file. Hence, contents aren't encrypted; integrity is protected with a
+// password based MAC.
+
+const (
+	pkcs12MacIterations = 2048
+	// pkcs12MacKeyID is the ID that diversifies keys derived for MACs.
+	pkcs12MacKeyID = 3
+)
+
+var (
+	oidDataContentType = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 1}
+	oidCertBag         = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 12, 10, 1, 3}
+	oidCertTypeX509    = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 22, 1}
+	oidFriendlyName    = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 20}
+	oidSHA1            = asn1.ObjectIdentifier{1, 3, 14, 3, 2, 26}
+	// oidJavaTrustStore marks a certificate as trusted for Java key stores,
+	// for the extended key usage in its value.
+	oidJavaTrustStore      = asn1.ObjectIdentifier{2, 16, 840, 1, 113894, 746875, 1, 1}
+	oidAnyExtendedKeyUsage = asn1.ObjectIdentifier{2, 5, 29, 37, 0}
+)
+
+type pfxPdu struct {
+	Version  int
+	AuthSafe contentInfo
+	MacData  macData
+}
+
+type contentInfo struct {
+	ContentType asn1.ObjectIdentifier
+	Content     asn1.RawValue
+}
+
+type macData struct {
+	Mac        digestInfo
+	MacSalt    []byte
+	Iterations int `asn1:"optional,default:1"`
+}
+
+type digestInfo struct {
+	Algorithm pkix.AlgorithmIdentifier
+	Digest    []byte
+}
+
+type safeBag struct {
+	ID         asn1.ObjectIdentifier
+	Value      asn1.RawValue
+	Attributes []pkcs12Attribute `asn1:"set,optional"`
+}
+
+type pkcs12Attribute struct {
+	ID    asn1.ObjectIdentifier
+	Value asn1.RawValue
+}
+
+type certBag struct {
+	ID   asn1.ObjectIdentifier
+	Data []byte `asn1:"tag:0,explicit"`
+}
+
+// EncodePKCS12TrustStore encodes certificates as a PKCS#12 trust store, with
+// integrity protected by password, which may be empty. Certificates are
+// labeled with their subject's common name.
+func EncodePKCS12TrustStore(certs []*x509.Certificate, password string) ([]byte, error) {
+	bags := make([]safeBag, len(certs))
+
+	for i, cert := range certs {
+		bag, err := newCertSafeBag(cert)
+		if err != nil {
+			return nil, err
+		}
+
+		bags[i] = bag
+	}
+
+	safeContents, err := asn1.Marshal(bags)
+	if err != nil {
+		return nil, fmt.Errorf("proxy: could not encode PKCS#12 safe contents: %w", err)
+	}
+
+	safeContentsInfo, err := dataContentInfo(safeContents)
+	if err != nil {
+		return nil, err
+	}
+
+	authSafe, err := asn1.Marshal([]contentInfo{safeContentsInfo})
+	if err != nil {
+		return nil, fmt.Errorf("proxy: could not encode PKCS#12 authenticated safe: %w", err)
+	}
+
+	salt := make([]byte, 8)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, fmt.Errorf("proxy: could not generate PKCS#12 MAC salt: %w", err)
+	}
+
+	// Passwords are zero terminated.
+	key := pkcs12KDF(append(bmpString(password), 0, 0), salt, pkcs12MacIterations, pkcs12MacKeyID, sha1.Size)
+	mac := hmac.New(sha1.New, key)
+	mac.Write(authSafe)
+
+	authSafeInfo, err := dataContentInfo(authSafe)
+	if err != nil {
+		return nil, err
+	}
+
+	pfx := pfxPdu{
+		Version:  3,
+		AuthSafe: authSafeInfo,
+		MacData: macData{
+			Mac: digestInfo{
+				Algorithm: pkix.AlgorithmIdentifier{Algorithm: oidSHA1, Parameters: asn1.NullRawValue},
+				Digest:    mac.Sum(nil),
+			},
+			MacSalt:    salt,
+			Iterations: pkcs12MacIterations,
+		},
+	}
+
+	data, err := asn1.Marshal(pfx)
+	if err != nil {
+		return nil, fmt.Errorf("proxy: could not encode PKCS#12: %w", err)
+	}
+
+	return data, nil
+}
+
+func newCertSafeBag(cert *x509.Certificate) (safeBag, error) {
+	bagValue, err := asn1.Marshal(certBag{ID: oidCertTypeX509, Data: cert.Raw})
+	if err != nil {
+		return safeBag{}, fmt.Errorf("proxy: could not encode PKCS#12 cert bag: %w", err)
+	}
+
+	friendlyName, err := asn1.Marshal(asn1.RawValue{Tag: asn1.TagBMPString, Bytes: bmpString(cert.Subject.CommonName)})
+	if err != nil {
+		return safeBag{}, fmt.Errorf("proxy: could not encode PKCS#12 friendly name: %w", err)
+	}
+
+	trustedUsage, err := asn1.Marshal(oidAnyExtendedKeyUsage)
+	if err != nil {
+		return safeBag{}, fmt.Errorf("proxy: could not encode PKCS#12 trusted key usage: %w", err)
+	}
+
+	return safeBag{
+		ID:    oidCertBag,
+		Value: explicitTag(bagValue),
+		Attributes: []pkcs12Attribute{
+			{ID: oidFriendlyName, Value: attributeSet(friendlyName)},
+			{ID: oidJavaTrustStore, Value: attributeSet(trustedUsage)},
+		},
+	}, nil
+}
+
+// dataContentInfo returns content info of type data, for DER encoded content.
+func dataContentInfo(content []byte) (contentInfo, error) {
+	octets, err := asn1.Marshal(content)
+	if err != nil {
+		return contentInfo{}, fmt.Errorf("proxy: could not encode PKCS#12 content: %w", err)
+	}
+
+	return contentInfo{ContentType: oidDataContentType, Content: explicitTag(octets)}, nil
+}
+
+// explicitTag wraps a DER encoded value in an explicit, context-specific tag
+// 0. Struct tags don't apply to `asn1.RawValue` fields, so it's done manually.
+func explicitTag(value []byte) asn1.RawValue {
+	return asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: value}
+}
+
+// attributeSet returns a set with a single DER encoded value.
+func attributeSet(value []byte) asn1.RawValue {
+	return asn1.RawValue{Tag: asn1.TagSet, IsCompound: true, Bytes: value}
+}
+
+// bmpString encodes s as the content of a BMPString: UTF-16 big-endian code
+// units.
+func bmpString(s string) []byte {
+	units := utf16.Encode([]rune(s))
+	b := make([]byte, 0, 2*len(units)+2)
+
+	for _, u := range units {
+		b = append(b, byte(u>>8), byte(u))
+	}
+
+	return b
+}
+
+// pkcs12KDF derives a key of size bytes from a password, using SHA-1 (see RFC
+// 7292, appendix B.2). The ID byte diversifies keys for different purposes.
+func pkcs12KDF(password, salt []byte, iterations int, id byte, size int) []byte {
+	const v = 64 // Block size of SHA-1, in bytes.
+
+	d := make([]byte, v)
+	for i := range d {
+		d[i] = id
+	}
+
+	input := append(fillBlocks(salt, v), fillBlocks(password, v)...)
+	modulus := new(big.Int).Lsh(big.NewInt(1), 8*v)
+	one := big.NewInt(1)
+
+	var key []byte
+
+	for {
+		h := sha1.New()
+		h.Write(d)
+		h.Write(input)
+		a := h.Sum(nil)
+
+		for i := 1; i < iterations; i++ {
+			sum := sha1.Sum(a)
+			a = sum[:]
+		}
+
+		key = append(key, a...)
+		if len(key) >= size {
+			return key[:size]
+		}
+
+		// Each block of the input is incremented by the hash (repeated to the
+		// block size) plus one, modulo 2^(8*v).
+		b := new(big.Int).SetBytes(fillBlocks(a, v))
+
+		for j := 0; j < len(input); j += v {
+			block := new(big.Int).SetBytes(input[j : j+v])
+			block.Add(block, b)
+			block.Add(block, one)
+			block.Mod(block, modulus)
+			block.FillBytes(input[j : j+v])
+		}
+	}
+}
+
+// fillBlocks repeats b to fill a whole number of blocks of size v. It returns
+// nil for empty input.
+func fillBlocks(b []byte, v int) []byte {
+	if len(b) == 0 {
+		return nil
+	}
+
+	n := v * ((len(b) + v - 1) / v)
+	out := make([]byte, n)
+
+	for i := range out {
+		out[i] = b[i%len(b)]
+	}
+
+	return out
+}
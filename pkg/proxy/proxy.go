@@ -11,11 +11,40 @@ import (
 	"net"
 	"net/http"
 	"net/http/httputil"
+	"sync"
+
+	"golang.org/x/net/http2"
 )
 
 type contextKey int
 
-const ReqLogIDKey contextKey = 0
+const (
+	ReqLogIDKey contextKey = iota
+	timingKey
+	// OriginalURLKey holds the URL of a proxied request as it was requested by
+	// the client, before any request modifiers ran.
+	OriginalURLKey
+	// JA3Key holds the JA3 fingerprint (MD5 hash) of the TLS ClientHello of
+	// the client connection a request was received on.
+	JA3Key
+	// PipelinedKey holds whether a request was pipelined: sent by the client
+	// before the response to the previous request on the same connection was
+	// written.
+	PipelinedKey
+	// ParseWarningsKey holds the parse warnings ([]string) of a request: ways
+	// in which its raw header is malformed or ambiguous, though tolerated by
+	// the proxy, e.g. obsolete line folding. These can point to request
+	// smuggling or desync issues.
+	ParseWarningsKey
+	pipelineConnKey
+	tlsStateKey
+	parseWarningTracerKey
+	droppedKey
+)
+
+// ErrDropped can be returned by a ResponseModifyFunc to drop the response: it
+// isn't written, and the client connection is closed.
+var ErrDropped = errors.New("proxy: dropped")
 
 // Proxy implements http.Handler and offers MITM behaviour for modifying
 // HTTP requests and responses.
@@ -23,9 +52,24 @@ type Proxy struct {
 	certConfig *CertConfig
 	handler    http.Handler
 
+	// caMu serializes CA changes, including writing the CA to disk.
+	caMu       sync.Mutex
+	caKeyFile  string
+	caCertFile string
+	transport  *policyTransport
+
+	upstreamMu sync.RWMutex
+	upstream   UpstreamConfig
+
 	// TODO: Add mutex for modifier funcs.
 	reqModifiers []RequestModifyMiddleware
 	resModifiers []ResponseModifyMiddleware
+	tlsErrorFns  []TLSErrorFunc
+
+	malformedRequestFns []MalformedRequestFunc
+
+	captureJA3   bool
+	disableHTTP2 bool
 }
 
 // NewProxy returns a new Proxy.
@@ -41,8 +85,16 @@ func NewProxy(ca *x509.Certificate, key crypto.PrivateKey) (*Proxy, error) {
 		resModifiers: make([]ResponseModifyMiddleware, 0),
 	}
 
+	// Upstream connections record response headers, for their parse
+	// warnings.
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DialContext = dialUpstream(transport.DialContext)
+	transport.Proxy = p.upstreamProxyURL
+
+	p.transport = newPolicyTransport(transport)
 	p.handler = &httputil.ReverseProxy{
 		Director:       p.modifyRequest,
+		Transport:      p.transport,
 		ModifyResponse: p.modifyResponse,
 		ErrorHandler:   errorHandler,
 	}
@@ -52,11 +104,23 @@ func NewProxy(ca *x509.Certificate, key crypto.PrivateKey) (*Proxy, error) {
 
 func (p *Proxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	if r.Method == http.MethodConnect {
-		p.handleConnect(w)
+		p.handleConnect(w, r)
 		return
 	}
 
-	p.handler.ServeHTTP(w, r)
+	p.handler.ServeHTTP(w, withTLSState(withConnInfo(r)))
+}
+
+// HTTPClient returns a client that sends requests upstream with the proxy's
+// transport, bypassing request and response modifiers. Like the proxy, it
+// doesn't follow redirects.
+func (p *Proxy) HTTPClient() *http.Client {
+	return &http.Client{
+		Transport: p.transport,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
 }
 
 func (p *Proxy) UseRequestModifier(fn ...RequestModifyMiddleware) {
@@ -67,6 +131,41 @@ func (p *Proxy) UseResponseModifier(fn ...ResponseModifyMiddleware) {
 	p.resModifiers = append(p.resModifiers, fn...)
 }
 
+// OnTLSError registers functions that are called when the TLS handshake with a
+// client fails.
+func (p *Proxy) OnTLSError(fn ...TLSErrorFunc) {
+	p.tlsErrorFns = append(p.tlsErrorFns, fn...)
+}
+
+// OnMalformedRequest registers functions that are called when a request from a
+// client is rejected because it couldn't be parsed. Only requests received on
+// connections that are tracked by the proxy are reported: tunneled connections,
+// and connections accepted by a `PipelineListener` with the proxy set.
+func (p *Proxy) OnMalformedRequest(fn ...MalformedRequestFunc) {
+	p.malformedRequestFns = append(p.malformedRequestFns, fn...)
+}
+
+func (p *Proxy) handleMalformedRequest(connectReq *http.Request, requestLine, status string) {
+	for _, fn := range p.malformedRequestFns {
+		fn(connectReq, requestLine, status)
+	}
+}
+
+// SetJA3Capture sets whether the JA3 fingerprint of TLS client connections is
+// computed and stored in the request context, with key `JA3Key`. It's disabled
+// by default, as it requires recording the raw TLS handshake of each
+// connection.
+func (p *Proxy) SetJA3Capture(enabled bool) {
+	p.captureJA3 = enabled
+}
+
+// SetHTTP2 sets whether HTTP/2 is offered to clients, using ALPN, on
+// intercepted TLS connections. It's enabled by default. Upstream connections use
+// HTTP/2 if the server supports it, regardless.
+func (p *Proxy) SetHTTP2(enabled bool) {
+	p.disableHTTP2 = !enabled
+}
+
 func (p *Proxy) modifyRequest(r *http.Request) {
 	// Fix r.URL for HTTPS requests after CONNECT.
 	if r.URL.Scheme == "" {
@@ -74,6 +173,11 @@ func (p *Proxy) modifyRequest(r *http.Request) {
 		r.URL.Scheme = "https"
 	}
 
+	// Keep the URL as requested by the client, so it can be told apart from
+	// the URL after request modifiers (possibly) rewrote it.
+	origURL := *r.URL
+	*r = *r.WithContext(context.WithValue(r.Context(), OriginalURLKey, &origURL))
+
 	// Setting `X-Forwarded-For` to `nil` ensures that http.ReverseProxy doesn't
 	// set this header.
 	r.Header["X-Forwarded-For"] = nil
@@ -85,9 +189,18 @@ func (p *Proxy) modifyRequest(r *http.Request) {
 	}
 
 	fn(r)
+
+	// Trace the upstream request, so response modifiers can read its timing
+	// using `TimingFromContext`, and its response parse warnings using
+	// `ResponseParseWarningsFromContext`.
+	*r = *r.WithContext(withParseWarningTracer(WithTimingTrace(r.Context())))
 }
 
 func (p *Proxy) modifyResponse(res *http.Response) error {
+	if pt, ok := res.Request.Context().Value(parseWarningTracerKey).(*parseWarningTracer); ok {
+		pt.snapshot()
+	}
+
 	fn := nopResModifier
 
 	for i := len(p.resModifiers) - 1; i >= 0; i-- {
@@ -100,7 +213,12 @@ func (p *Proxy) modifyResponse(res *http.Response) error {
 // handleConnect hijacks the incoming HTTP request and sets up an HTTP tunnel.
 // During the TLS handshake with the client, we use the proxy's CA config to
 // create a certificate on-the-fly.
-func (p *Proxy) handleConnect(w http.ResponseWriter) {
+func (p *Proxy) handleConnect(w http.ResponseWriter, r *http.Request) {
+	if p.isPassthrough(r.Host) {
+		p.tunnel(w, r)
+		return
+	}
+
 	hj, ok := w.(http.Hijacker)
 	if !ok {
 		log.Printf("[ERROR] handleConnect: ResponseWriter is not a http.Hijacker (type: %T)", w)
@@ -121,16 +239,56 @@ func (p *Proxy) handleConnect(w http.ResponseWriter) {
 	defer clientConn.Close()
 
 	// Secure connection to client.
-	clientConn, err = p.clientTLSConn(clientConn)
+	tlsConn, clientHello, err := p.clientTLSConn(clientConn)
 	if err != nil {
 		log.Printf("[ERROR] Securing client connection failed: %v", err)
+
+		for _, fn := range p.tlsErrorFns {
+			fn(r, err)
+		}
+
 		return
 	}
 
-	clientConnNotify := ConnNotify{clientConn, make(chan struct{})}
+	var ja3 string
+
+	if p.captureJA3 {
+		if _, ja3, err = JA3(clientHello); err != nil {
+			log.Printf("[ERROR] Computing JA3 fingerprint failed: %v", err)
+		}
+	}
+
+	tlsState := tlsConn.ConnectionState()
+
+	if tlsState.NegotiatedProtocol == http2.NextProtoTLS {
+		p.serveHTTP2(tlsConn, ja3)
+		return
+	}
+
+	// Track pipelining and parse warnings above TLS, so requests are read in
+	// plaintext. As the http.Server below then doesn't get a *tls.Conn, the
+	// TLS connection state is set on requests by the proxy.
+	pipelineConn := newPipelineConn(tlsConn)
+	pipelineConn.onRejected = func(requestLine, status string) {
+		p.handleMalformedRequest(r, requestLine, status)
+	}
+
+	clientConnNotify := ConnNotify{pipelineConn, make(chan struct{})}
 	l := &OnceAcceptListener{clientConnNotify.Conn}
 
-	err = http.Serve(l, p)
+	srv := &http.Server{
+		Handler: p,
+		ConnContext: func(ctx context.Context, _ net.Conn) context.Context {
+			ctx = context.WithValue(ctx, pipelineConnKey, pipelineConn)
+			ctx = context.WithValue(ctx, tlsStateKey, &tlsState)
+			if ja3 == "" {
+				return ctx
+			}
+			return context.WithValue(ctx, JA3Key, ja3)
+		},
+	}
+
+	err = srv.Serve(l)
 	if err != nil && !errors.Is(err, ErrAlreadyAccepted) {
 		log.Printf("[ERROR] Serving HTTP request failed: %v", err)
 	}
@@ -138,19 +296,95 @@ func (p *Proxy) handleConnect(w http.ResponseWriter) {
 	<-clientConnNotify.closed
 }
 
-func (p *Proxy) clientTLSConn(conn net.Conn) (*tls.Conn, error) {
+// serveHTTP2 serves an intercepted client connection that negotiated HTTP/2.
+// Streams are multiplexed on the connection, and each one is handled (and
+// logged) as a separate request, with pseudo-header fields (e.g. `:authority`)
+// mapped to the request's URL and host. Pipelining and parse warnings don't
+// apply to HTTP/2, so the connection isn't tracked for those.
+func (p *Proxy) serveHTTP2(tlsConn *tls.Conn, ja3 string) {
+	ctx := context.Background()
+	if ja3 != "" {
+		ctx = context.WithValue(ctx, JA3Key, ja3)
+	}
+
+	srv := &http2.Server{}
+	srv.ServeConn(tlsConn, &http2.ServeConnOpts{
+		Context: ctx,
+		Handler: p,
+	})
+}
+
+// clientTLSConn performs a TLS handshake with the client. Besides the secured
+// connection, it returns the raw TLS records that were read during the
+// handshake, which start with the ClientHello. These are only recorded if JA3
+// capture is enabled; otherwise the returned records are nil.
+func (p *Proxy) clientTLSConn(conn net.Conn) (*tls.Conn, []byte, error) {
 	tlsConfig := p.certConfig.TLSConfig()
 
+	policy := p.TLSPolicy()
+	if policy.MinVersion != 0 {
+		tlsConfig.MinVersion = policy.MinVersion
+	}
+
+	tlsConfig.MaxVersion = policy.MaxVersion
+
+	if !p.disableHTTP2 {
+		tlsConfig.NextProtos = append([]string{http2.NextProtoTLS}, tlsConfig.NextProtos...)
+	}
+
+	var recorder *helloRecorder
+
+	if p.captureJA3 {
+		recorder = &helloRecorder{Conn: conn}
+		conn = recorder
+	}
+
 	tlsConn := tls.Server(conn, tlsConfig)
 	if err := tlsConn.Handshake(); err != nil {
 		tlsConn.Close()
-		return nil, fmt.Errorf("handshake error: %w", err)
+		return nil, nil, fmt.Errorf("handshake error: %w", err)
 	}
 
-	return tlsConn, nil
+	if recorder == nil {
+		return tlsConn, nil, nil
+	}
+
+	return tlsConn, recorder.stop(), nil
+}
+
+// withTLSState sets the TLS connection state of a request received on a TLS
+// connection that was wrapped, and thus isn't recognized by http.Server.
+func withTLSState(r *http.Request) *http.Request {
+	if r.TLS != nil {
+		return r
+	}
+
+	state, ok := r.Context().Value(tlsStateKey).(*tls.ConnectionState)
+	if !ok {
+		return r
+	}
+
+	r = r.WithContext(r.Context())
+	r.TLS = state
+
+	return r
+}
+
+// DropRequest drops a request from a RequestModifyFunc: it isn't sent upstream,
+// and the client connection is closed.
+func DropRequest(req *http.Request) {
+	ctx, cancel := context.WithCancel(context.WithValue(req.Context(), droppedKey, true))
+	cancel()
+
+	*req = *req.WithContext(ctx)
 }
 
 func errorHandler(w http.ResponseWriter, r *http.Request, err error) {
+	if dropped, _ := r.Context().Value(droppedKey).(bool); dropped || errors.Is(err, ErrDropped) {
+		// Closes the client connection, without logging.
+		panic(http.ErrAbortHandler)
+	}
+
 	if errors.Is(err, context.Canceled) {
 		return
 	}
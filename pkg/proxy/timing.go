@@ -0,0 +1,135 @@
+package proxy
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http/httptrace"
+	"sync"
+	"time"
+)
+
+// Timing holds the durations of the phases of a proxied upstream request.
+// Phases that didn't occur (e.g. DNS lookup and connect when an idle
+// connection was reused) have a zero duration.
+type Timing struct {
+	DNS          time.Duration
+	Connect      time.Duration
+	TLSHandshake time.Duration
+	// Response is the duration between the request being fully written and
+	// the first response byte being read.
+	Response time.Duration
+	// Total is the duration between getting a connection for the request and
+	// the first response byte being read, so it includes the other phases.
+	Total time.Duration
+}
+
+// timingTracer records the durations of upstream request phases using
+// `httptrace` hooks. Hooks can be called from different goroutines.
+type timingTracer struct {
+	now func() time.Time
+
+	mu                               sync.Mutex
+	start                            time.Time
+	dnsStart, connectStart, tlsStart time.Time
+	wroteRequest                     time.Time
+	timing                           Timing
+}
+
+func newTimingTracer() *timingTracer {
+	return &timingTracer{now: time.Now}
+}
+
+func (tt *timingTracer) clientTrace() *httptrace.ClientTrace {
+	return &httptrace.ClientTrace{
+		GetConn: func(_ string) {
+			tt.mu.Lock()
+			defer tt.mu.Unlock()
+			// When a request is retried on a new connection, measure from
+			// the first attempt.
+			if tt.start.IsZero() {
+				tt.start = tt.now()
+			}
+		},
+		DNSStart: func(_ httptrace.DNSStartInfo) {
+			tt.mu.Lock()
+			defer tt.mu.Unlock()
+			tt.dnsStart = tt.now()
+		},
+		DNSDone: func(_ httptrace.DNSDoneInfo) {
+			tt.mu.Lock()
+			defer tt.mu.Unlock()
+			tt.timing.DNS = tt.now().Sub(tt.dnsStart)
+		},
+		ConnectStart: func(_, _ string) {
+			tt.mu.Lock()
+			defer tt.mu.Unlock()
+			// With multiple dial attempts ("Happy Eyeballs"), measure from
+			// the first attempt.
+			if tt.connectStart.IsZero() {
+				tt.connectStart = tt.now()
+			}
+		},
+		ConnectDone: func(_, _ string, err error) {
+			if err != nil {
+				return
+			}
+			tt.mu.Lock()
+			defer tt.mu.Unlock()
+			tt.timing.Connect = tt.now().Sub(tt.connectStart)
+		},
+		TLSHandshakeStart: func() {
+			tt.mu.Lock()
+			defer tt.mu.Unlock()
+			tt.tlsStart = tt.now()
+		},
+		TLSHandshakeDone: func(_ tls.ConnectionState, _ error) {
+			tt.mu.Lock()
+			defer tt.mu.Unlock()
+			tt.timing.TLSHandshake = tt.now().Sub(tt.tlsStart)
+		},
+		WroteRequest: func(_ httptrace.WroteRequestInfo) {
+			tt.mu.Lock()
+			defer tt.mu.Unlock()
+			tt.wroteRequest = tt.now()
+		},
+		GotFirstResponseByte: func() {
+			tt.mu.Lock()
+			defer tt.mu.Unlock()
+			now := tt.now()
+			tt.timing.Response = now.Sub(tt.wroteRequest)
+			tt.timing.Total = now.Sub(tt.start)
+		},
+	}
+}
+
+// Timing returns the durations recorded so far.
+func (tt *timingTracer) Timing() Timing {
+	tt.mu.Lock()
+	defer tt.mu.Unlock()
+
+	return tt.timing
+}
+
+// WithTimingTrace returns a copy of ctx that traces the timing of an HTTP
+// request made with it. The timing can then be retrieved with
+// TimingFromContext.
+func WithTimingTrace(ctx context.Context) context.Context {
+	return withTimingTracer(ctx, newTimingTracer())
+}
+
+func withTimingTracer(ctx context.Context, tt *timingTracer) context.Context {
+	ctx = httptrace.WithClientTrace(ctx, tt.clientTrace())
+	return context.WithValue(ctx, timingKey, tt)
+}
+
+// TimingFromContext returns the timing of the upstream request that was made
+// with ctx. Use it with the request context of a response, e.g. in a
+// ResponseModifyFunc.
+func TimingFromContext(ctx context.Context) (Timing, bool) {
+	tt, ok := ctx.Value(timingKey).(*timingTracer)
+	if !ok {
+		return Timing{}, false
+	}
+
+	return tt.Timing(), true
+}
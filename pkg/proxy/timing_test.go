@@ -0,0 +1,61 @@
+package proxy
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http/httptrace"
+	"testing"
+	"time"
+)
+
+func TestTimingTracer(t *testing.T) {
+	t.Parallel()
+
+	// Fake clock that advances 10ms on every call.
+	clock := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	tt := &timingTracer{
+		now: func() time.Time {
+			clock = clock.Add(10 * time.Millisecond)
+			return clock
+		},
+	}
+
+	ctx := withTimingTracer(context.Background(), tt)
+	trace := httptrace.ContextClientTrace(ctx)
+
+	trace.GetConn("example.com:443")
+	trace.DNSStart(httptrace.DNSStartInfo{Host: "example.com"})
+	trace.DNSDone(httptrace.DNSDoneInfo{})
+	trace.ConnectStart("tcp", "192.0.2.1:443")
+	trace.ConnectStart("tcp", "[2001:db8::1]:443")
+	trace.ConnectDone("tcp", "[2001:db8::1]:443", nil)
+	trace.TLSHandshakeStart()
+	trace.TLSHandshakeDone(tls.ConnectionState{}, nil)
+	trace.WroteRequest(httptrace.WroteRequestInfo{})
+	trace.GotFirstResponseByte()
+
+	got, ok := TimingFromContext(ctx)
+	if !ok {
+		t.Fatal("expected timing in context")
+	}
+
+	exp := Timing{
+		DNS:          10 * time.Millisecond,
+		Connect:      10 * time.Millisecond,
+		TLSHandshake: 10 * time.Millisecond,
+		Response:     10 * time.Millisecond,
+		Total:        80 * time.Millisecond,
+	}
+
+	if exp != got {
+		t.Fatalf("incorrect timing (expected: %+v, got: %+v)", exp, got)
+	}
+}
+
+func TestTimingFromContextWithoutTrace(t *testing.T) {
+	t.Parallel()
+
+	if _, ok := TimingFromContext(context.Background()); ok {
+		t.Fatal("expected no timing in context")
+	}
+}
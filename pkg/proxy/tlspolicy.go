@@ -0,0 +1,264 @@
+package proxy
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"regexp"
+	"sync"
+)
+
+// TLSPolicy configures how the proxy handles TLS connections.
+type TLSPolicy struct {
+	// Passthrough are patterns of hosts (without port) whose connections are
+	// tunneled without interception, e.g. for apps that pin certificates.
+	// Their requests aren't seen by the proxy, so they aren't logged.
+	Passthrough []*regexp.Regexp
+	// ClientCerts are presented to upstream servers that request a client
+	// certificate (mTLS). The first one with a matching host is used.
+	ClientCerts []ClientCert
+	// MinVersion and MaxVersion are the TLS versions (e.g. `tls.VersionTLS12`)
+	// allowed for connections with clients and upstream servers. Zero values
+	// use the defaults of `crypto/tls`, except that the minimum version for
+	// clients defaults to TLS 1.2.
+	MinVersion, MaxVersion uint16
+}
+
+// ClientCert is a client certificate for upstream servers whose host (without
+// port) matches Host.
+type ClientCert struct {
+	Host *regexp.Regexp
+	// CertPEM is the PEM encoded certificate (chain), and KeyPEM the PEM
+	// encoded private key.
+	CertPEM, KeyPEM []byte
+}
+
+var tlsVersions = map[uint16]bool{
+	tls.VersionTLS10: true,
+	tls.VersionTLS11: true,
+	tls.VersionTLS12: true,
+	tls.VersionTLS13: true,
+}
+
+// policyTransport sends requests with a transport that has the TLS settings
+// of a policy. Requests to hosts with a client certificate get a transport of
+// their own, as connections are pooled per transport.
+type policyTransport struct {
+	// base is cloned for each transport, so they share dialing and routing via
+	// upstream proxies.
+	base *http.Transport
+
+	mu          sync.RWMutex
+	policy      TLSPolicy
+	def         *http.Transport
+	clientCerts []*http.Transport
+}
+
+func newPolicyTransport(base *http.Transport) *policyTransport {
+	return &policyTransport{
+		base: base,
+		def:  base.Clone(),
+	}
+}
+
+func (pt *policyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return pt.transport(req.URL.Hostname()).RoundTrip(req)
+}
+
+func (pt *policyTransport) transport(host string) *http.Transport {
+	pt.mu.RLock()
+	defer pt.mu.RUnlock()
+
+	for i, clientCert := range pt.policy.ClientCerts {
+		if clientCert.Host.MatchString(host) {
+			return pt.clientCerts[i]
+		}
+	}
+
+	return pt.def
+}
+
+func (pt *policyTransport) CloseIdleConnections() {
+	pt.mu.RLock()
+	defer pt.mu.RUnlock()
+
+	pt.def.CloseIdleConnections()
+
+	for _, t := range pt.clientCerts {
+		t.CloseIdleConnections()
+	}
+}
+
+func (pt *policyTransport) setPolicy(policy TLSPolicy) error {
+	newTransport := func(certs []tls.Certificate) *http.Transport {
+		t := pt.base.Clone()
+		if t.TLSClientConfig == nil {
+			t.TLSClientConfig = &tls.Config{} //nolint:gosec
+		}
+
+		t.TLSClientConfig.MinVersion = policy.MinVersion
+		t.TLSClientConfig.MaxVersion = policy.MaxVersion
+		t.TLSClientConfig.Certificates = certs
+
+		return t
+	}
+
+	def := newTransport(nil)
+	clientCerts := make([]*http.Transport, len(policy.ClientCerts))
+
+	for i, clientCert := range policy.ClientCerts {
+		if clientCert.Host == nil {
+			return errors.New("proxy: client certificate must have a host")
+		}
+
+		cert, err := tls.X509KeyPair(clientCert.CertPEM, clientCert.KeyPEM)
+		if err != nil {
+			return fmt.Errorf("proxy: invalid client certificate for host %q: %w", clientCert.Host, err)
+		}
+
+		clientCerts[i] = newTransport([]tls.Certificate{cert})
+	}
+
+	pt.mu.Lock()
+	oldDef, oldClientCerts := pt.def, pt.clientCerts
+	pt.policy, pt.def, pt.clientCerts = policy, def, clientCerts
+	pt.mu.Unlock()
+
+	oldDef.CloseIdleConnections()
+
+	for _, t := range oldClientCerts {
+		t.CloseIdleConnections()
+	}
+
+	return nil
+}
+
+// SetTLSPolicy sets the TLS policy of the proxy. It applies to connections
+// that are established from then on.
+func (p *Proxy) SetTLSPolicy(policy TLSPolicy) error {
+	for _, v := range []uint16{policy.MinVersion, policy.MaxVersion} {
+		if v != 0 && !tlsVersions[v] {
+			return fmt.Errorf("proxy: unsupported TLS version: %#x", v)
+		}
+	}
+
+	if policy.MinVersion != 0 && policy.MaxVersion != 0 && policy.MinVersion > policy.MaxVersion {
+		return errors.New("proxy: minimum TLS version must not exceed maximum")
+	}
+
+	for _, host := range policy.Passthrough {
+		if host == nil {
+			return errors.New("proxy: passthrough host must be set")
+		}
+	}
+
+	return p.transport.setPolicy(policy)
+}
+
+// TLSPolicy returns the TLS policy of the proxy.
+func (p *Proxy) TLSPolicy() TLSPolicy {
+	p.transport.mu.RLock()
+	defer p.transport.mu.RUnlock()
+
+	return p.transport.policy
+}
+
+// isPassthrough returns true if connections to hostport are tunneled without
+// interception.
+func (p *Proxy) isPassthrough(hostport string) bool {
+	host, _, err := net.SplitHostPort(hostport)
+	if err != nil {
+		host = hostport
+	}
+
+	for _, pattern := range p.TLSPolicy().Passthrough {
+		if pattern.MatchString(host) {
+			return true
+		}
+	}
+
+	return false
+}
+
+type clientCertDTO struct {
+	Host            string
+	CertPEM, KeyPEM []byte
+}
+
+type tlsPolicyDTO struct {
+	Passthrough            []string
+	ClientCerts            []clientCertDTO
+	MinVersion, MaxVersion uint16
+}
+
+func (policy TLSPolicy) MarshalBinary() ([]byte, error) {
+	dto := tlsPolicyDTO{
+		Passthrough: make([]string, len(policy.Passthrough)),
+		ClientCerts: make([]clientCertDTO, len(policy.ClientCerts)),
+		MinVersion:  policy.MinVersion,
+		MaxVersion:  policy.MaxVersion,
+	}
+
+	for i, host := range policy.Passthrough {
+		dto.Passthrough[i] = host.String()
+	}
+
+	for i, clientCert := range policy.ClientCerts {
+		dto.ClientCerts[i] = clientCertDTO{
+			Host:    clientCert.Host.String(),
+			CertPEM: clientCert.CertPEM,
+			KeyPEM:  clientCert.KeyPEM,
+		}
+	}
+
+	buf := bytes.Buffer{}
+
+	err := gob.NewEncoder(&buf).Encode(dto)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (policy *TLSPolicy) UnmarshalBinary(data []byte) error {
+	dto := tlsPolicyDTO{}
+
+	err := gob.NewDecoder(bytes.NewReader(data)).Decode(&dto)
+	if err != nil {
+		return err
+	}
+
+	*policy = TLSPolicy{
+		MinVersion: dto.MinVersion,
+		MaxVersion: dto.MaxVersion,
+	}
+
+	for _, host := range dto.Passthrough {
+		re, err := regexp.Compile(host)
+		if err != nil {
+			return err
+		}
+
+		policy.Passthrough = append(policy.Passthrough, re)
+	}
+
+	for _, clientCert := range dto.ClientCerts {
+		re, err := regexp.Compile(clientCert.Host)
+		if err != nil {
+			return err
+		}
+
+		policy.ClientCerts = append(policy.ClientCerts, ClientCert{
+			Host:    re,
+			CertPEM: clientCert.CertPEM,
+			KeyPEM:  clientCert.KeyPEM,
+		})
+	}
+
+	return nil
+}
@@ -0,0 +1,196 @@
+package proxy
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestSetTLSPolicyInvalid(t *testing.T) {
+	t.Parallel()
+
+	certPEM, _ := newTestCertPEM(t)
+
+	tests := []struct {
+		name   string
+		policy TLSPolicy
+	}{
+		{
+			name:   "unsupported version",
+			policy: TLSPolicy{MinVersion: 0x0200},
+		},
+		{
+			name:   "minimum version exceeds maximum",
+			policy: TLSPolicy{MinVersion: tls.VersionTLS13, MaxVersion: tls.VersionTLS12},
+		},
+		{
+			name:   "passthrough without host",
+			policy: TLSPolicy{Passthrough: []*regexp.Regexp{nil}},
+		},
+		{
+			name: "client certificate without private key",
+			policy: TLSPolicy{ClientCerts: []ClientCert{
+				{Host: regexp.MustCompile(`^example\.com$`), CertPEM: certPEM},
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if err := newTestProxy(t).SetTLSPolicy(tt.policy); err == nil {
+				t.Fatal("expected error, got: nil")
+			}
+		})
+	}
+}
+
+func TestTLSPolicyClientCert(t *testing.T) {
+	t.Parallel()
+
+	certPEM, keyPEM := newTestCertPEM(t)
+
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, r.TLS.PeerCertificates[0].Subject.CommonName) //nolint:errcheck
+	}))
+	srv.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert} //nolint:gosec
+	srv.StartTLS()
+	t.Cleanup(srv.Close)
+
+	p := newTestProxy(t)
+	p.transport.base.TLSClientConfig = srv.Client().Transport.(*http.Transport).TLSClientConfig
+
+	err := p.SetTLSPolicy(TLSPolicy{
+		ClientCerts: []ClientCert{
+			{Host: regexp.MustCompile(`^127\.0\.0\.1$`), CertPEM: certPEM, KeyPEM: keyPEM},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error (expected: nil, got: %v)", err)
+	}
+
+	res, err := p.HTTPClient().Get(srv.URL)
+	if err != nil {
+		t.Fatalf("unexpected error sending request: %v", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		t.Fatalf("unexpected error reading body: %v", err)
+	}
+
+	if exp := "Hetty"; string(body) != exp {
+		t.Fatalf("incorrect client certificate received (expected: %v, got: %v)", exp, string(body))
+	}
+}
+
+func TestTLSPolicyPassthrough(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, "upstream") //nolint:errcheck
+	}))
+	t.Cleanup(srv.Close)
+
+	p := newTestProxy(t)
+
+	if err := p.SetTLSPolicy(TLSPolicy{Passthrough: []*regexp.Regexp{regexp.MustCompile(`^127\.0\.0\.1$`)}}); err != nil {
+		t.Fatalf("unexpected error (expected: nil, got: %v)", err)
+	}
+
+	proxySrv := httptest.NewServer(p)
+	t.Cleanup(proxySrv.Close)
+
+	proxyURL, err := url.Parse(proxySrv.URL)
+	if err != nil {
+		t.Fatalf("unexpected error parsing URL: %v", err)
+	}
+
+	// The client only trusts the certificate of the upstream server, so the
+	// request fails if the proxy intercepts the connection.
+	transport := srv.Client().Transport.(*http.Transport).Clone()
+	transport.Proxy = http.ProxyURL(proxyURL)
+
+	client := &http.Client{Transport: transport}
+	t.Cleanup(client.CloseIdleConnections)
+
+	res, err := client.Get(srv.URL)
+	if err != nil {
+		t.Fatalf("unexpected error sending request: %v", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		t.Fatalf("unexpected error reading body: %v", err)
+	}
+
+	if exp := "upstream"; string(body) != exp {
+		t.Fatalf("incorrect body (expected: %v, got: %v)", exp, string(body))
+	}
+}
+
+func TestTLSPolicyMarshalBinary(t *testing.T) {
+	t.Parallel()
+
+	certPEM, keyPEM := newTestCertPEM(t)
+
+	policy := TLSPolicy{
+		Passthrough: []*regexp.Regexp{regexp.MustCompile(`\.apple\.com$`)},
+		ClientCerts: []ClientCert{
+			{Host: regexp.MustCompile(`^mtls\.example\.com$`), CertPEM: certPEM, KeyPEM: keyPEM},
+		},
+		MinVersion: tls.VersionTLS12,
+		MaxVersion: tls.VersionTLS13,
+	}
+
+	data, err := policy.MarshalBinary()
+	if err != nil {
+		t.Fatalf("unexpected error marshaling policy: %v", err)
+	}
+
+	var got TLSPolicy
+
+	if err := got.UnmarshalBinary(data); err != nil {
+		t.Fatalf("unexpected error unmarshaling policy: %v", err)
+	}
+
+	if got.Passthrough[0].String() != policy.Passthrough[0].String() ||
+		got.ClientCerts[0].Host.String() != policy.ClientCerts[0].Host.String() ||
+		string(got.ClientCerts[0].KeyPEM) != string(keyPEM) ||
+		got.MinVersion != policy.MinVersion || got.MaxVersion != policy.MaxVersion {
+		t.Fatalf("incorrect policy (expected: %+v, got: %+v)", policy, got)
+	}
+}
+
+// newTestCertPEM returns a PEM encoded certificate and private key, with common
+// name "Hetty".
+func newTestCertPEM(t *testing.T) (certPEM, keyPEM []byte) {
+	t.Helper()
+
+	cert, key, err := NewCA("Hetty", "Hetty CA", time.Hour)
+	if err != nil {
+		t.Fatalf("unexpected error creating CA: %v", err)
+	}
+
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatalf("unexpected error marshaling private key: %v", err)
+	}
+
+	certPEM = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})
+	keyPEM = pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER})
+
+	return certPEM, keyPEM
+}
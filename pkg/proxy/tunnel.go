@@ -0,0 +1,185 @@
+package proxy
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+
+	xproxy "golang.org/x/net/proxy"
+)
+
+// tunnel handles a CONNECT request for a passthrough host: bytes are copied
+// between the client and the upstream server, without TLS interception.
+func (p *Proxy) tunnel(w http.ResponseWriter, r *http.Request) {
+	hj, ok := w.(http.Hijacker)
+	if !ok {
+		log.Printf("[ERROR] tunnel: ResponseWriter is not a http.Hijacker (type: %T)", w)
+		writeError(w, http.StatusServiceUnavailable)
+
+		return
+	}
+
+	// Dial before responding, so a client gets an error status if the
+	// upstream server can't be reached.
+	upstreamConn, err := p.dialTunnel(r.Context(), r.Host)
+	if err != nil {
+		log.Printf("[ERROR] Dialing passthrough host failed: %v", err)
+		writeError(w, http.StatusBadGateway)
+
+		return
+	}
+	defer upstreamConn.Close()
+
+	w.WriteHeader(http.StatusOK)
+
+	clientConn, clientRW, err := hj.Hijack()
+	if err != nil {
+		log.Printf("[ERROR] Hijacking client connection failed: %v", err)
+		writeError(w, http.StatusServiceUnavailable)
+
+		return
+	}
+	defer clientConn.Close()
+
+	var wg sync.WaitGroup
+
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+		// Bytes sent by the client after the CONNECT request may already be
+		// buffered.
+		io.Copy(upstreamConn, clientRW.Reader) //nolint:errcheck
+		closeWrite(upstreamConn)
+	}()
+
+	io.Copy(clientConn, upstreamConn) //nolint:errcheck
+	closeWrite(clientConn)
+
+	wg.Wait()
+}
+
+// dialTunnel dials addr (host with port) for a tunnel, via the upstream proxy
+// for its host, if any.
+func (p *Proxy) dialTunnel(ctx context.Context, addr string) (net.Conn, error) {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, fmt.Errorf("proxy: invalid tunnel address: %w", err)
+	}
+
+	dialer := &net.Dialer{}
+
+	upstream := p.upstreamProxyForHost(host)
+	if upstream == nil {
+		return dialer.DialContext(ctx, "tcp", addr)
+	}
+
+	if upstream.Scheme == "socks5" {
+		d, err := xproxy.FromURL(upstream, dialer)
+		if err != nil {
+			return nil, fmt.Errorf("proxy: invalid upstream proxy: %w", err)
+		}
+
+		if cd, ok := d.(xproxy.ContextDialer); ok {
+			return cd.DialContext(ctx, "tcp", addr)
+		}
+
+		return d.Dial("tcp", addr)
+	}
+
+	return dialHTTPConnect(ctx, dialer, upstream, addr)
+}
+
+// dialHTTPConnect establishes a tunnel to addr via an HTTP upstream proxy,
+// using the CONNECT method.
+func dialHTTPConnect(ctx context.Context, dialer *net.Dialer, upstream *url.URL, addr string) (net.Conn, error) {
+	proxyAddr := upstream.Host
+	if upstream.Port() == "" {
+		port := "80"
+		if upstream.Scheme == "https" {
+			port = "443"
+		}
+
+		proxyAddr = net.JoinHostPort(upstream.Hostname(), port)
+	}
+
+	conn, err := dialer.DialContext(ctx, "tcp", proxyAddr)
+	if err != nil {
+		return nil, fmt.Errorf("proxy: failed to dial upstream proxy: %w", err)
+	}
+
+	if upstream.Scheme == "https" {
+		tlsConn := tls.Client(conn, &tls.Config{ServerName: upstream.Hostname(), MinVersion: tls.VersionTLS12})
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("proxy: TLS handshake with upstream proxy failed: %w", err)
+		}
+
+		conn = tlsConn
+	}
+
+	req := &http.Request{
+		Method: http.MethodConnect,
+		URL:    &url.URL{Opaque: addr},
+		Host:   addr,
+		Header: make(http.Header),
+	}
+
+	if upstream.User != nil {
+		password, _ := upstream.User.Password()
+		credentials := base64.StdEncoding.EncodeToString([]byte(upstream.User.Username() + ":" + password))
+		req.Header.Set("Proxy-Authorization", "Basic "+credentials)
+	}
+
+	if err := req.Write(conn); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("proxy: failed to write CONNECT request to upstream proxy: %w", err)
+	}
+
+	br := bufio.NewReader(conn)
+
+	res, err := http.ReadResponse(br, req)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("proxy: failed to read CONNECT response from upstream proxy: %w", err)
+	}
+	res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		conn.Close()
+		return nil, fmt.Errorf("proxy: upstream proxy refused CONNECT: %v", res.Status)
+	}
+
+	return &bufferedConn{Conn: conn, r: br}, nil
+}
+
+// bufferedConn is a net.Conn that reads from a buffered reader of the
+// connection, so that bytes buffered while reading a response aren't lost.
+type bufferedConn struct {
+	net.Conn
+	r *bufio.Reader
+}
+
+func (c *bufferedConn) Read(b []byte) (int, error) {
+	return c.r.Read(b)
+}
+
+// closeWrite shuts down the writing side of a connection, if supported, so the
+// peer reads EOF while the other direction of a tunnel can still be copied.
+func closeWrite(conn net.Conn) {
+	if bc, ok := conn.(*bufferedConn); ok {
+		conn = bc.Conn
+	}
+
+	if cw, ok := conn.(interface{ CloseWrite() error }); ok {
+		cw.CloseWrite() //nolint:errcheck
+	}
+}
@@ -0,0 +1,145 @@
+package proxy
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"testing"
+)
+
+func TestUpstreamProxyURL(t *testing.T) {
+	t.Parallel()
+
+	corp := mustParseUpstreamURL(t, "http://proxy.corp:3128")
+	tor := mustParseUpstreamURL(t, "socks5://localhost:9050")
+
+	p := newTestProxy(t)
+
+	err := p.SetUpstream(UpstreamConfig{
+		Default: corp,
+		Rules: []UpstreamRule{
+			{Host: regexp.MustCompile(`\.onion$`), Proxy: tor},
+			{Host: regexp.MustCompile(`^localhost$`)},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error (expected: nil, got: %v)", err)
+	}
+
+	tests := []struct {
+		name     string
+		url      string
+		expected *url.URL
+	}{
+		{
+			name:     "first matching rule",
+			url:      "http://example.onion/",
+			expected: tor,
+		},
+		{
+			name:     "rule without proxy, port is ignored",
+			url:      "http://localhost:8080/",
+			expected: nil,
+		},
+		{
+			name:     "default",
+			url:      "https://example.com/",
+			expected: corp,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := p.upstreamProxyURL(httptest.NewRequest(http.MethodGet, tt.url, nil))
+			if err != nil {
+				t.Fatalf("unexpected error (expected: nil, got: %v)", err)
+			}
+
+			if got != tt.expected {
+				t.Fatalf("incorrect upstream proxy (expected: %v, got: %v)", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestSetUpstreamInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		cfg  UpstreamConfig
+	}{
+		{
+			name: "unsupported scheme",
+			cfg:  UpstreamConfig{Default: mustParseUpstreamURL(t, "ftp://proxy.corp")},
+		},
+		{
+			name: "without host",
+			cfg:  UpstreamConfig{Default: mustParseUpstreamURL(t, "socks5:")},
+		},
+		{
+			name: "rule without host pattern",
+			cfg:  UpstreamConfig{Rules: []UpstreamRule{{Proxy: mustParseUpstreamURL(t, "http://proxy.corp")}}},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if err := newTestProxy(t).SetUpstream(tt.cfg); err == nil {
+				t.Fatal("expected error, got: nil")
+			}
+		})
+	}
+}
+
+func TestHTTPClientUpstream(t *testing.T) {
+	t.Parallel()
+
+	// A plaintext request is sent to an HTTP upstream proxy in absolute form.
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, r.URL.String()) //nolint:errcheck
+	}))
+	defer upstream.Close()
+
+	p := newTestProxy(t)
+
+	if err := p.SetUpstream(UpstreamConfig{Default: mustParseUpstreamURL(t, upstream.URL)}); err != nil {
+		t.Fatalf("unexpected error (expected: nil, got: %v)", err)
+	}
+
+	res, err := p.HTTPClient().Get("http://example.test/foo")
+	if err != nil {
+		t.Fatalf("unexpected error sending request: %v", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		t.Fatalf("unexpected error reading body: %v", err)
+	}
+
+	if exp := "http://example.test/foo"; string(body) != exp {
+		t.Fatalf("incorrect URL received by upstream proxy (expected: %v, got: %v)", exp, string(body))
+	}
+}
+
+func mustParseUpstreamURL(t *testing.T, s string) *url.URL {
+	t.Helper()
+
+	u, err := url.Parse(s)
+	if err != nil {
+		t.Fatalf("unexpected error parsing URL: %v", err)
+	}
+
+	return u
+}
@@ -0,0 +1,191 @@
+package reqlog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/oklog/ulid"
+
+	"github.com/dstotijn/hetty/pkg/search"
+)
+
+// reqTagKey is the search key that resolves to the tags of a request log.
+const reqTagKey = "req.tag"
+
+var ErrInvalidHighlightColor = errors.New("reqlog: invalid highlight color")
+
+// HighlightColor is a color that a request log is highlighted with, for
+// triage. The zero value is no highlight.
+type HighlightColor string
+
+const (
+	HighlightColorNone   HighlightColor = ""
+	HighlightColorRed    HighlightColor = "red"
+	HighlightColorOrange HighlightColor = "orange"
+	HighlightColorYellow HighlightColor = "yellow"
+	HighlightColorGreen  HighlightColor = "green"
+	HighlightColorCyan   HighlightColor = "cyan"
+	HighlightColorBlue   HighlightColor = "blue"
+	HighlightColorPurple HighlightColor = "purple"
+	HighlightColorGray   HighlightColor = "gray"
+)
+
+// Valid returns true for the defined highlight colors, including none.
+func (c HighlightColor) Valid() bool {
+	switch c {
+	case HighlightColorNone, HighlightColorRed, HighlightColorOrange, HighlightColorYellow, HighlightColorGreen,
+		HighlightColorCyan, HighlightColorBlue, HighlightColorPurple, HighlightColorGray:
+		return true
+	default:
+		return false
+	}
+}
+
+// Annotations are set by the user on a request log, e.g. to mark requests as
+// interesting during a test. They're stored with the request log.
+type Annotations struct {
+	Note string
+	// Tags are unique, in the order they were added.
+	Tags           []string
+	HighlightColor HighlightColor
+}
+
+// SetNote sets the note of a request log. An empty note removes it.
+func (svc *Service) SetNote(ctx context.Context, id ulid.ULID, note string) (RequestLog, error) {
+	return svc.annotate(ctx, id, func(a *Annotations) { a.Note = note })
+}
+
+// SetTags replaces the tags of a request log. Tags are trimmed of surrounding
+// whitespace; empty and duplicate tags are dropped.
+func (svc *Service) SetTags(ctx context.Context, id ulid.ULID, tags []string) (RequestLog, error) {
+	return svc.annotate(ctx, id, func(a *Annotations) { a.Tags = normalizeTags(tags) })
+}
+
+// SetHighlightColor sets the highlight color of a request log.
+// `HighlightColorNone` removes the highlight.
+func (svc *Service) SetHighlightColor(ctx context.Context, id ulid.ULID, color HighlightColor) (RequestLog, error) {
+	if !color.Valid() {
+		return RequestLog{}, ErrInvalidHighlightColor
+	}
+
+	return svc.annotate(ctx, id, func(a *Annotations) { a.HighlightColor = color })
+}
+
+// annotate updates the annotations of a request log with fn, and stores it.
+// Updates are serialized, so concurrent edits of different annotations of the
+// same request log don't overwrite each other.
+func (svc *Service) annotate(ctx context.Context, id ulid.ULID, fn func(a *Annotations)) (RequestLog, error) {
+	svc.annotateMu.Lock()
+	defer svc.annotateMu.Unlock()
+
+	reqLog, err := svc.repo.FindRequestLogByID(ctx, id)
+	if err != nil {
+		return RequestLog{}, err
+	}
+
+	fn(&reqLog.Annotations)
+
+	// Response logs are stored separately, so they're left out of the stored
+	// request log.
+	resLog := reqLog.Response
+	reqLog.Response = nil
+
+	if err := svc.repo.StoreRequestLog(ctx, reqLog); err != nil {
+		return RequestLog{}, fmt.Errorf("reqlog: could not store request log: %w", err)
+	}
+
+	reqLog.Response = resLog
+
+	return reqLog, nil
+}
+
+func normalizeTags(tags []string) []string {
+	var result []string
+
+	seen := make(map[string]bool, len(tags))
+
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if tag == "" || seen[tag] {
+			continue
+		}
+
+		seen[tag] = true
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// hasTag returns true if match returns true for any of the request log's tags.
+func (reqLog RequestLog) hasTag(match func(tag string) bool) bool {
+	for _, tag := range reqLog.Annotations.Tags {
+		if match(tag) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// compileTagMatch compiles a comparison of `req.tag`, which matches if any tag
+// of a request log matches, e.g. `req.tag = "idor"`. Negated comparisons match
+// if no tag matches. It returns nil for other operators.
+func (c compiler) compileTagMatch(expr search.InfixExpression) func(RequestLog) bool {
+	var (
+		matchTag func(reqLog RequestLog, tag string) bool
+		negate   bool
+	)
+
+	switch expr.Operator {
+	case search.TokOpEq, search.TokOpNotEq:
+		right, ok := expr.Right.(search.StringLiteral)
+		if !ok {
+			return nil
+		}
+
+		rightVal := c.compileKey(right.Value)
+		caseSensitive := c.cfg.CaseSensitive
+		matchTag = func(reqLog RequestLog, tag string) bool {
+			return equalString(tag, rightVal(reqLog), caseSensitive)
+		}
+		negate = expr.Operator == search.TokOpNotEq
+	case search.TokOpIn:
+		right, ok := expr.Right.(search.ListLiteral)
+		if !ok {
+			return nil
+		}
+
+		values := make([]compiledValue, len(right.Values))
+		for i, v := range right.Values {
+			values[i] = c.compileKey(v.Value)
+		}
+
+		matchTag = func(reqLog RequestLog, tag string) bool {
+			for _, v := range values {
+				if compareValues(tag, v(reqLog)) == 0 {
+					return true
+				}
+			}
+
+			return false
+		}
+	case search.TokOpRe, search.TokOpNotRe:
+		re, ok := expr.Right.(*regexp.Regexp)
+		if !ok {
+			return nil
+		}
+
+		matchTag = func(_ RequestLog, tag string) bool { return re.MatchString(tag) }
+		negate = expr.Operator == search.TokOpNotRe
+	default:
+		return nil
+	}
+
+	return func(reqLog RequestLog) bool {
+		return reqLog.hasTag(func(tag string) bool { return matchTag(reqLog, tag) }) != negate
+	}
+}
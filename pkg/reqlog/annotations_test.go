@@ -0,0 +1,121 @@
+package reqlog_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/oklog/ulid"
+
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+func TestAnnotate(t *testing.T) {
+	t.Parallel()
+
+	id := ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+
+	tests := []struct {
+		name           string
+		annotate       func(svc *reqlog.Service) (reqlog.RequestLog, error)
+		expAnnotations reqlog.Annotations
+		expectedError  error
+	}{
+		{
+			name: "set note",
+			annotate: func(svc *reqlog.Service) (reqlog.RequestLog, error) {
+				return svc.SetNote(context.Background(), id, "leaks user IDs")
+			},
+			expAnnotations: reqlog.Annotations{Note: "leaks user IDs", Tags: []string{"existing"}},
+		},
+		{
+			name: "set tags, normalized",
+			annotate: func(svc *reqlog.Service) (reqlog.RequestLog, error) {
+				return svc.SetTags(context.Background(), id, []string{" idor", "", "sqli", "idor "})
+			},
+			expAnnotations: reqlog.Annotations{Tags: []string{"idor", "sqli"}},
+		},
+		{
+			name: "set highlight color",
+			annotate: func(svc *reqlog.Service) (reqlog.RequestLog, error) {
+				return svc.SetHighlightColor(context.Background(), id, reqlog.HighlightColorRed)
+			},
+			expAnnotations: reqlog.Annotations{Tags: []string{"existing"}, HighlightColor: reqlog.HighlightColorRed},
+		},
+		{
+			name: "invalid highlight color",
+			annotate: func(svc *reqlog.Service) (reqlog.RequestLog, error) {
+				return svc.SetHighlightColor(context.Background(), id, "magenta")
+			},
+			expectedError: reqlog.ErrInvalidHighlightColor,
+		},
+		{
+			name: "unknown request log",
+			annotate: func(svc *reqlog.Service) (reqlog.RequestLog, error) {
+				return svc.SetNote(context.Background(), ulid.MustNew(0, ulidEntropy), "foo")
+			},
+			expectedError: reqlog.ErrRequestNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var stored *reqlog.RequestLog
+
+			repoMock := &RepoMock{
+				FindRequestLogByIDFunc: func(_ context.Context, reqLogID ulid.ULID) (reqlog.RequestLog, error) {
+					if reqLogID != id {
+						return reqlog.RequestLog{}, reqlog.ErrRequestNotFound
+					}
+
+					return reqlog.RequestLog{
+						ID:          id,
+						Annotations: reqlog.Annotations{Tags: []string{"existing"}},
+						Response:    &reqlog.ResponseLog{StatusCode: 200},
+					}, nil
+				},
+				StoreRequestLogFunc: func(_ context.Context, reqLog reqlog.RequestLog) error {
+					stored = &reqLog
+					return nil
+				},
+			}
+			svc := reqlog.NewService(reqlog.Config{Repository: repoMock})
+
+			got, err := tt.annotate(svc)
+			if !errors.Is(err, tt.expectedError) {
+				t.Fatalf("unexpected error (expected: %v, got: %v)", tt.expectedError, err)
+			}
+
+			if tt.expectedError != nil {
+				if stored != nil {
+					t.Fatal("expected request log not to be stored")
+				}
+
+				return
+			}
+
+			if diff := cmp.Diff(tt.expAnnotations, got.Annotations); diff != "" {
+				t.Fatalf("annotations not equal (-exp, +got):\n%v", diff)
+			}
+
+			if got.Response == nil {
+				t.Fatal("expected returned request log to have a response")
+			}
+
+			// The response log is stored separately.
+			if stored == nil || stored.Response != nil {
+				t.Fatalf("expected request log to be stored without response, got: %+v", stored)
+			}
+
+			if diff := cmp.Diff(tt.expAnnotations, stored.Annotations); diff != "" {
+				t.Fatalf("stored annotations not equal (-exp, +got):\n%v", diff)
+			}
+		})
+	}
+}
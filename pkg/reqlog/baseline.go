@@ -0,0 +1,44 @@
+package reqlog
+
+import (
+	"context"
+
+	"github.com/oklog/ulid"
+)
+
+// Baseline references the request log that a request was derived from (e.g.
+// when fuzzing), for comparison against it.
+type Baseline struct {
+	ReqLogID ulid.ULID
+	BodySize int
+}
+
+// WithBaseline returns a copy of ctx that marks a request made with it as
+// derived from baseline. The reference is stored with the request's log.
+// Nothing in hetty sends derived requests yet; this is the hook for a future
+// request sender or fuzzer to set a baseline.
+func WithBaseline(ctx context.Context, baseline RequestLog) context.Context {
+	return context.WithValue(ctx, baselineKey, Baseline{
+		ReqLogID: baseline.ID,
+		BodySize: len(baseline.Body),
+	})
+}
+
+func baselineFromContext(ctx context.Context) *Baseline {
+	baseline, ok := ctx.Value(baselineKey).(Baseline)
+	if !ok {
+		return nil
+	}
+
+	return &baseline
+}
+
+// bodySizeDelta returns the difference in bytes between the request body size
+// and the baseline body size. It returns false if the request has no baseline.
+func (reqLog RequestLog) bodySizeDelta() (int, bool) {
+	if reqLog.Baseline == nil {
+		return 0, false
+	}
+
+	return len(reqLog.Body) - reqLog.Baseline.BodySize, true
+}
@@ -0,0 +1,215 @@
+package reqlog
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/oklog/ulid"
+)
+
+// bodyRecorder is an io.Writer that buffers a body in memory, and spills over
+// to a file once the written data exceeds a threshold.
+type bodyRecorder struct {
+	threshold int64
+	newFile   func() (*os.File, error)
+
+	buf  bytes.Buffer
+	file *os.File
+}
+
+func (br *bodyRecorder) Write(p []byte) (int, error) {
+	if br.file == nil && int64(br.buf.Len()+len(p)) > br.threshold {
+		f, err := br.newFile()
+		if err != nil {
+			return 0, fmt.Errorf("could not create body file: %w", err)
+		}
+
+		if _, err := f.Write(br.buf.Bytes()); err != nil {
+			f.Close()
+			return 0, fmt.Errorf("could not write to body file: %w", err)
+		}
+
+		br.buf.Reset()
+		br.file = f
+	}
+
+	if br.file != nil {
+		return br.file.Write(p)
+	}
+
+	return br.buf.Write(p)
+}
+
+// reader returns a reader for the recorded body, from the start.
+func (br *bodyRecorder) reader() (io.Reader, error) {
+	if br.file == nil {
+		return bytes.NewReader(br.buf.Bytes()), nil
+	}
+
+	if _, err := br.file.Seek(0, io.SeekStart); err != nil {
+		return nil, fmt.Errorf("could not seek body file: %w", err)
+	}
+
+	return br.file, nil
+}
+
+// close closes the body file, if the recorder spilled over to one.
+func (br *bodyRecorder) close() error {
+	if br.file == nil {
+		return nil
+	}
+
+	return br.file.Close()
+}
+
+// discard closes and removes the body file, if the recorder spilled over to one.
+func (br *bodyRecorder) discard() {
+	if br.file == nil {
+		return
+	}
+
+	br.file.Close()
+	os.Remove(br.file.Name())
+}
+
+var errIncompleteBody = errors.New("body was closed before it was read completely")
+
+// recordingBody wraps a response body and copies everything that is read from
+// it to a bodyRecorder. When the body is closed, `onClose` is called, with an
+// error if the body couldn't be recorded completely (e.g. because the client
+// aborted the request).
+type recordingBody struct {
+	io.ReadCloser
+	rec     *bodyRecorder
+	onClose func(rec *bodyRecorder, rcv received, err error)
+	err     error
+	eof     bool
+	once    sync.Once
+
+	// start is the time the body was wrapped, and end the time it was read
+	// completely, for measuring how long it took to receive.
+	start, end time.Time
+	size       int64
+}
+
+func (rb *recordingBody) Read(p []byte) (int, error) {
+	n, err := rb.ReadCloser.Read(p)
+	rb.size += int64(n)
+
+	if n > 0 && rb.err == nil {
+		if _, werr := rb.rec.Write(p[:n]); werr != nil {
+			rb.err = werr
+		}
+	}
+
+	switch {
+	case errors.Is(err, io.EOF):
+		if !rb.eof {
+			rb.end = time.Now()
+		}
+		rb.eof = true
+	case err != nil && rb.err == nil:
+		rb.err = fmt.Errorf("could not read body: %w", err)
+	}
+
+	return n, err
+}
+
+func (rb *recordingBody) Close() error {
+	err := rb.ReadCloser.Close()
+
+	rb.once.Do(func() {
+		recErr := rb.err
+		if recErr == nil && !rb.eof {
+			recErr = errIncompleteBody
+		}
+
+		rb.onClose(rb.rec, received{size: rb.size, duration: rb.end.Sub(rb.start)}, recErr)
+	})
+
+	return err
+}
+
+// ReadBody returns the response body. If the body was stored in a file (see
+// `Config.BodyFileThreshold`), it's read from disk.
+func (resLog ResponseLog) ReadBody() ([]byte, error) {
+	if resLog.BodyFile == "" {
+		return resLog.Body, nil
+	}
+
+	body, err := os.ReadFile(resLog.BodyFile)
+	if err != nil {
+		return nil, fmt.Errorf("reqlog: could not read body file: %w", err)
+	}
+
+	return body, nil
+}
+
+// ReadRawBody returns the response body as it was received. It's the same as
+// the body returned by `ReadBody`, unless the body was decoded before it was
+// stored (see `ResponseLog.ContentEncoding`).
+func (resLog ResponseLog) ReadRawBody() ([]byte, error) {
+	if resLog.ContentEncoding == "" {
+		return resLog.ReadBody()
+	}
+
+	if resLog.RawBodyFile == "" {
+		return resLog.RawBody, nil
+	}
+
+	body, err := os.ReadFile(resLog.RawBodyFile)
+	if err != nil {
+		return nil, fmt.Errorf("reqlog: could not read raw body file: %w", err)
+	}
+
+	return body, nil
+}
+
+// bodySize returns the size of the body in bytes, without reading it from disk
+// if it was stored in a file.
+func (resLog ResponseLog) bodySize() int64 {
+	if resLog.BodyFile == "" {
+		return int64(len(resLog.Body))
+	}
+
+	fi, err := os.Stat(resLog.BodyFile)
+	if err != nil {
+		return 0
+	}
+
+	return fi.Size()
+}
+
+// size returns the number of body bytes as received. For response logs stored
+// before it was recorded, it falls back to the size of the (decoded) body.
+func (resLog ResponseLog) size() int64 {
+	if resLog.Size > 0 {
+		return resLog.Size
+	}
+
+	return resLog.bodySize()
+}
+
+func (svc *Service) projectBodyDir(projectID ulid.ULID) string {
+	return filepath.Join(svc.bodyFileDir, projectID.String())
+}
+
+// DeleteBodyFiles removes all response body files that were stored on disk for
+// a project.
+func (svc *Service) DeleteBodyFiles(projectID ulid.ULID) error {
+	if svc.bodyFileDir == "" {
+		return nil
+	}
+
+	if err := os.RemoveAll(svc.projectBodyDir(projectID)); err != nil {
+		return fmt.Errorf("reqlog: could not remove body files: %w", err)
+	}
+
+	return nil
+}
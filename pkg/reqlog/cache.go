@@ -0,0 +1,93 @@
+package reqlog
+
+import (
+	"net/http"
+	"strings"
+)
+
+// heuristicallyCacheableStatusCodes are the status codes of responses that a
+// cache can store without explicit freshness information (RFC 9111, section
+// 4.2.2).
+var heuristicallyCacheableStatusCodes = map[int]bool{
+	http.StatusOK:                   true,
+	http.StatusNonAuthoritativeInfo: true,
+	http.StatusNoContent:            true,
+	http.StatusPartialContent:       true,
+	http.StatusMultipleChoices:      true,
+	http.StatusMovedPermanently:     true,
+	http.StatusPermanentRedirect:    true,
+	http.StatusNotFound:             true,
+	http.StatusMethodNotAllowed:     true,
+	http.StatusGone:                 true,
+	http.StatusRequestURITooLong:    true,
+	http.StatusNotImplemented:       true,
+}
+
+// parseCacheControl returns the directives of the `Cache-Control` header(s),
+// keyed by lowercase name. Directives without an argument have an empty value.
+func parseCacheControl(header http.Header) map[string]string {
+	directives := make(map[string]string)
+
+	for _, value := range header.Values("Cache-Control") {
+		for _, directive := range strings.Split(value, ",") {
+			directive = strings.TrimSpace(directive)
+			if directive == "" {
+				continue
+			}
+
+			name, arg := directive, ""
+			if i := strings.Index(directive, "="); i >= 0 {
+				name, arg = directive[:i], strings.Trim(directive[i+1:], `"`)
+			}
+
+			directives[strings.ToLower(strings.TrimSpace(name))] = arg
+		}
+	}
+
+	return directives
+}
+
+// isCacheableByProxy returns true if a shared cache (e.g. a CDN or caching
+// proxy) could store the response to the request, based on the request method,
+// the `Authorization` header and the `Cache-Control` directives of both the
+// request and the response (RFC 9111, section 3).
+func isCacheableByProxy(rl RequestLog) bool {
+	if rl.Method != http.MethodGet && rl.Method != http.MethodHead {
+		return false
+	}
+
+	if _, ok := parseCacheControl(rl.Header)["no-store"]; ok {
+		return false
+	}
+
+	if rl.Response == nil {
+		return false
+	}
+
+	resDirectives := parseCacheControl(rl.Response.Header)
+
+	for _, directive := range []string{"no-store", "private"} {
+		if _, ok := resDirectives[directive]; ok {
+			return false
+		}
+	}
+
+	if rl.Response.Header.Get("Vary") == "*" {
+		return false
+	}
+
+	_, public := resDirectives["public"]
+	_, sMaxAge := resDirectives["s-maxage"]
+	_, maxAge := resDirectives["max-age"]
+	_, mustRevalidate := resDirectives["must-revalidate"]
+
+	// Responses to authorized requests can only be stored by a shared cache
+	// if explicitly allowed (RFC 9111, section 3.5).
+	if rl.Header.Get("Authorization") != "" && !public && !sMaxAge && !mustRevalidate {
+		return false
+	}
+
+	return public || sMaxAge || maxAge ||
+		rl.Response.Header.Get("Expires") != "" ||
+		heuristicallyCacheableStatusCodes[rl.Response.StatusCode]
+}
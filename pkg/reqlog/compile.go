@@ -0,0 +1,465 @@
+package reqlog
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dstotijn/hetty/pkg/scope"
+	"github.com/dstotijn/hetty/pkg/search"
+)
+
+// Matcher reports whether a request log matches a compiled search expression.
+type Matcher func(reqLog RequestLog) bool
+
+// compiledExpr evaluates a compiled expression against a request log with
+// decoded bodies.
+type compiledExpr func(reqLog RequestLog) bool
+
+// compiledValue resolves a compiled operand against a request log with decoded
+// bodies.
+type compiledValue func(reqLog RequestLog) string
+
+// compiler compiles search expressions. Expressions are compiled for request
+// logs, or, if responseOnly is true, for response logs without their request
+// (see `ResponseLog.MatchesWithConfig`), which are matched as request logs
+// with only a response.
+type compiler struct {
+	cfg MatchConfig
+	// responseOnly makes request search keys, and response keys that depend
+	// on the request, resolve to an empty string, and bare string literals
+	// only match response keys.
+	responseOnly bool
+}
+
+// CompileMatcher validates a search expression and compiles it into a Matcher,
+// using cfg for the computed search keys that depend on it. An invalid
+// expression, e.g. with a right operand of `=~` that isn't a regular
+// expression, returns an error once, instead of for every request log.
+//
+// Operators are checked and built-in search keys are looked up at compile time,
+// so use it instead of `MatchesWithConfig` when matching many request logs
+// against the same expression. Custom search keys are resolved when matching.
+func CompileMatcher(expr search.Expression, cfg MatchConfig) (Matcher, error) {
+	return compiler{cfg: cfg}.compile(expr)
+}
+
+// compile compiles expr into a Matcher that decodes bodies before they're
+// matched.
+func (c compiler) compile(expr search.Expression) (Matcher, error) {
+	match, err := c.compileExpr(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(reqLog RequestLog) bool {
+		return match(reqLog.withDecodedBodies())
+	}, nil
+}
+
+// CompileFilter returns a Matcher that reports whether a request log matches
+// filter, for repositories that find request logs. The search expression is
+// compiled once, so it's validated before any request log is retrieved. Request
+// logs that aren't candidates of the filter don't match.
+func CompileFilter(filter FindRequestsFilter, scope *scope.Scope) (Matcher, error) {
+	matchCfg := DefaultMatchConfig()
+	if filter.MatchConfig != nil {
+		matchCfg = *filter.MatchConfig
+	}
+
+	var matchExpr Matcher
+
+	if filter.SearchExpr != nil {
+		var err error
+
+		matchExpr, err = CompileMatcher(filter.SearchExpr, matchCfg)
+		if err != nil {
+			return nil, fmt.Errorf("reqlog: failed to compile search expression: %w", err)
+		}
+	}
+
+	return func(reqLog RequestLog) bool {
+		if !filter.Candidates.Contains(reqLog.ID) {
+			return false
+		}
+
+		if filter.OnlyInScope && !reqLog.MatchScope(scope) {
+			return false
+		}
+
+		return matchExpr == nil || matchExpr(reqLog)
+	}, nil
+}
+
+func (c compiler) compileExpr(expr search.Expression) (compiledExpr, error) {
+	switch e := expr.(type) {
+	case search.PrefixExpression:
+		if e.Operator != search.TokOpNot {
+			return nil, errors.New("operator is not supported")
+		}
+
+		right, err := c.compileExpr(e.Right)
+		if err != nil {
+			return nil, err
+		}
+
+		return func(reqLog RequestLog) bool { return !right(reqLog) }, nil
+	case search.InfixExpression:
+		return c.compileInfixExpr(e)
+	case search.StringLiteral:
+		s, cfg := e.Value, c.cfg
+
+		if c.responseOnly {
+			return func(reqLog RequestLog) bool { return reqLog.Response.freeTextMatch(s, cfg) }, nil
+		}
+
+		return func(reqLog RequestLog) bool { return reqLog.FreeTextMatchKey(s, cfg) != "" }, nil
+	case search.CallExpression:
+		call, err := c.compileCall(e)
+		if err != nil {
+			return nil, err
+		}
+
+		return func(reqLog RequestLog) bool { return call(reqLog) != "" }, nil
+	default:
+		return nil, fmt.Errorf("expression type (%T) not supported", expr)
+	}
+}
+
+func (c compiler) compileInfixExpr(expr search.InfixExpression) (compiledExpr, error) {
+	if expr.Operator == search.TokOpAnd || expr.Operator == search.TokOpOr {
+		left, err := c.compileExpr(expr.Left)
+		if err != nil {
+			return nil, err
+		}
+
+		right, err := c.compileExpr(expr.Right)
+		if err != nil {
+			return nil, err
+		}
+
+		if expr.Operator == search.TokOpAnd {
+			return func(reqLog RequestLog) bool { return left(reqLog) && right(reqLog) }, nil
+		}
+
+		return func(reqLog RequestLog) bool { return left(reqLog) || right(reqLog) }, nil
+	}
+
+	var (
+		leftVal  compiledValue
+		matchKey func(reqLog RequestLog) (match, ok bool)
+	)
+
+	switch left := expr.Left.(type) {
+	case search.StringLiteral:
+		leftVal = c.compileKey(left.Value)
+		matchKey = c.compileMatchKey(expr, left.Value)
+	case search.CallExpression:
+		call, err := c.compileCall(left)
+		if err != nil {
+			return nil, err
+		}
+
+		leftVal = call
+	default:
+		return nil, errors.New("left operand must be a string literal or function call")
+	}
+
+	compare, err := c.compileComparison(expr, leftVal)
+	if err != nil {
+		return nil, err
+	}
+
+	if matchKey == nil {
+		return compare, nil
+	}
+
+	return func(reqLog RequestLog) bool {
+		if match, ok := matchKey(reqLog); ok {
+			return match
+		}
+
+		return compare(reqLog)
+	}, nil
+}
+
+// compileComparison compiles a comparison of the resolved left operand against
+// the right operand of expr.
+func (c compiler) compileComparison(expr search.InfixExpression, leftVal compiledValue) (compiledExpr, error) {
+	switch expr.Operator {
+	case search.TokOpApprox:
+		right, ok := expr.Right.(search.ToleranceLiteral)
+		if !ok {
+			return nil, errors.New("right operand must be a number with a tolerance")
+		}
+
+		return func(reqLog RequestLog) bool {
+			// Values that aren't numeric never match.
+			leftNum, err := strconv.ParseFloat(leftVal(reqLog), 64)
+			if err != nil {
+				return false
+			}
+
+			return right.Contains(leftNum)
+		}, nil
+	case search.TokOpIn:
+		right, ok := expr.Right.(search.ListLiteral)
+		if !ok {
+			return nil, errors.New("right operand must be a list")
+		}
+
+		values := make([]compiledValue, len(right.Values))
+		for i, v := range right.Values {
+			values[i] = c.compileKey(v.Value)
+		}
+
+		return func(reqLog RequestLog) bool {
+			left := leftVal(reqLog)
+
+			for _, v := range values {
+				if compareValues(left, v(reqLog)) == 0 {
+					return true
+				}
+			}
+
+			return false
+		}, nil
+	case search.TokOpRe, search.TokOpNotRe:
+		re, ok := expr.Right.(*regexp.Regexp)
+		if !ok {
+			return nil, errors.New("right operand must be a regular expression")
+		}
+
+		want := expr.Operator == search.TokOpRe
+
+		return func(reqLog RequestLog) bool { return re.MatchString(leftVal(reqLog)) == want }, nil
+	}
+
+	right, ok := expr.Right.(search.StringLiteral)
+	if !ok {
+		return nil, errors.New("right operand must be a string literal")
+	}
+
+	rightVal := c.compileKey(right.Value)
+	caseSensitive := c.cfg.CaseSensitive
+
+	// A right operand that isn't a search key is parsed as a typed literal
+	// once.
+	compare := func(reqLog RequestLog) int { return compareValues(leftVal(reqLog), rightVal(reqLog)) }
+	if !isSearchKey(right.Value) {
+		rightLit := right.Typed()
+		compare = func(reqLog RequestLog) int { return compareLiterals(search.ParseLiteral(leftVal(reqLog)), rightLit) }
+	}
+
+	switch op := expr.Operator; op {
+	case search.TokOpEq:
+		return func(reqLog RequestLog) bool {
+			return equalString(leftVal(reqLog), rightVal(reqLog), caseSensitive)
+		}, nil
+	case search.TokOpNotEq:
+		return func(reqLog RequestLog) bool {
+			return !equalString(leftVal(reqLog), rightVal(reqLog), caseSensitive)
+		}, nil
+	case search.TokOpGt, search.TokOpLt, search.TokOpGtEq, search.TokOpLtEq:
+		return func(reqLog RequestLog) bool {
+			match, _ := matchCompareResult(op, compare(reqLog))
+			return match
+		}, nil
+	default:
+		return nil, errors.New("unsupported operator")
+	}
+}
+
+// compileMatchKey compiles comparisons of request search keys that aren't
+// compared by their resolved value. It returns nil if the comparison of key is
+// always evaluated as usual. The returned func returns false for ok if the
+// comparison should be evaluated as usual for a request log.
+func (c compiler) compileMatchKey(expr search.InfixExpression, key string) func(RequestLog) (match, ok bool) {
+	// Without request, request keys resolve to an empty string.
+	if c.responseOnly {
+		return nil
+	}
+
+	switch {
+	case strings.HasPrefix(key, reqQueryKeyPrefix) &&
+		(expr.Operator == search.TokOpRe || expr.Operator == search.TokOpNotRe):
+		re, ok := expr.Right.(*regexp.Regexp)
+		if !ok {
+			return nil
+		}
+
+		// All values of a repeated query parameter are searched, instead of
+		// only the first.
+		name := strings.TrimPrefix(key, reqQueryKeyPrefix)
+		want := expr.Operator == search.TokOpRe
+
+		return func(reqLog RequestLog) (bool, bool) {
+			return re.MatchString(strings.Join(queryParamValues(reqLog.URL, name), ", ")) == want, true
+		}
+	case key == "req.timestamp":
+		right, ok := expr.Right.(search.StringLiteral)
+		if !ok {
+			return nil
+		}
+
+		// Timestamps are compared as time values if the right operand is a
+		// parseable time, e.g. `req.timestamp > 2023-01-01`.
+		if _, err := matchCompareResult(expr.Operator, 0); err != nil {
+			return nil
+		}
+
+		compare := func(reqLog RequestLog, rightTime time.Time) bool {
+			match, _ := matchCompareResult(expr.Operator, compareTimes(reqLog.timestamp(), rightTime))
+			return match
+		}
+
+		// A right operand that isn't a search key is parsed once.
+		if !isSearchKey(right.Value) {
+			rightTime, ok := parseTimestamp(right.Value)
+			if !ok {
+				return nil
+			}
+
+			return func(reqLog RequestLog) (bool, bool) { return compare(reqLog, rightTime), true }
+		}
+
+		rightVal := c.compileKey(right.Value)
+
+		return func(reqLog RequestLog) (bool, bool) {
+			rightTime, ok := parseTimestamp(rightVal(reqLog))
+			if !ok {
+				return false, false
+			}
+
+			return compare(reqLog, rightTime), true
+		}
+	case key == reqTagKey:
+		match := c.compileTagMatch(expr)
+		if match == nil {
+			return nil
+		}
+
+		return func(reqLog RequestLog) (bool, bool) { return match(reqLog), true }
+	}
+
+	return nil
+}
+
+func (c compiler) compileCall(expr search.CallExpression) (compiledValue, error) {
+	switch expr.Name {
+	case "extract":
+		return c.compileExtract(expr.Args)
+	default:
+		return nil, fmt.Errorf("function %q not supported", expr.Name)
+	}
+}
+
+// compileExtract compiles a call of `extract`, which resolves the first
+// argument (a search key) and returns the first capture group of the regular
+// expression in the second argument, e.g. `extract(req.url, /id=(\d+)/)`.
+// Without capture groups, the whole match is returned. It returns an empty
+// string if the expression doesn't match.
+func (c compiler) compileExtract(args []search.Expression) (compiledValue, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf("extract expects 2 arguments, got %v", len(args))
+	}
+
+	key, ok := args[0].(search.StringLiteral)
+	if !ok {
+		return nil, errors.New("first argument of extract must be a search key")
+	}
+
+	re, ok := args[1].(*regexp.Regexp)
+	if !ok {
+		return nil, errors.New("second argument of extract must be a regular expression")
+	}
+
+	keyVal := c.compileKey(key.Value)
+
+	return func(reqLog RequestLog) string { return extractMatch(re, keyVal(reqLog)) }, nil
+}
+
+// extractMatch returns the first capture group of re in s, or the whole match
+// if re has no capture groups. It returns an empty string if re doesn't match.
+func extractMatch(re *regexp.Regexp, s string) string {
+	match := re.FindStringSubmatch(s)
+
+	switch len(match) {
+	case 0:
+		return ""
+	case 1:
+		return match[0]
+	default:
+		return match[1]
+	}
+}
+
+// compileKey returns a func that resolves s like `RequestLog.getMappedStringLiteral`.
+// Built-in search keys are looked up once. Other keys, such as custom keys
+// (which can be registered after compiling), are resolved per request log.
+func (c compiler) compileKey(s string) compiledValue {
+	if !isSearchKey(s) {
+		return func(RequestLog) string { return s }
+	}
+
+	s = canonicalSearchKey(s)
+	cfg := c.cfg
+
+	if c.responseOnly {
+		if _, ok := resLogRequestKeyFns[s]; ok || strings.HasPrefix(s, "req.") {
+			return func(RequestLog) string { return "" }
+		}
+	}
+
+	if fn, ok := reqLogSearchKeyFns[s]; ok {
+		return fn
+	}
+
+	if fn, ok := reqLogComputedKeyFns[s]; ok {
+		return func(reqLog RequestLog) string { return fn(reqLog, cfg) }
+	}
+
+	// The value of a response key for a request log without response.
+	absent := RequestLog{}.getMappedStringLiteral(s, cfg)
+
+	if fn, ok := resLogRequestKeyFns[s]; ok {
+		return func(reqLog RequestLog) string {
+			if reqLog.Response == nil {
+				return absent
+			}
+
+			return fn(reqLog, cfg)
+		}
+	}
+
+	if fn, ok := resLogSearchKeyFns[s]; ok {
+		return func(reqLog RequestLog) string {
+			if reqLog.Response == nil {
+				return absent
+			}
+
+			return fn(*reqLog.Response)
+		}
+	}
+
+	if fn, ok := resLogComputedKeyFns[s]; ok {
+		return func(reqLog RequestLog) string {
+			if reqLog.Response == nil {
+				return absent
+			}
+
+			return fn(*reqLog.Response, cfg)
+		}
+	}
+
+	return func(reqLog RequestLog) string { return reqLog.getMappedStringLiteral(s, cfg) }
+}
+
+// isSearchKey returns true if s is a request or response search key, rather
+// than a value.
+func isSearchKey(s string) bool {
+	return strings.HasPrefix(s, "req.") || strings.HasPrefix(s, "res.")
+}
@@ -0,0 +1,296 @@
+package reqlog_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"testing"
+
+	"github.com/oklog/ulid"
+
+	"github.com/dstotijn/hetty/pkg/reqlog"
+	"github.com/dstotijn/hetty/pkg/search"
+)
+
+func TestCompileMatcher(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		expr          search.Expression
+		expectedError error
+	}{
+		{
+			name: "valid expression",
+			expr: search.InfixExpression{
+				Operator: search.TokOpRe,
+				Left:     search.StringLiteral{Value: "req.url"},
+				Right:    regexp.MustCompile("foo"),
+			},
+			expectedError: nil,
+		},
+		{
+			name: "regexp operator without regexp operand",
+			expr: search.InfixExpression{
+				Operator: search.TokOpRe,
+				Left:     search.StringLiteral{Value: "req.url"},
+				Right:    search.StringLiteral{Value: "foo"},
+			},
+			expectedError: errors.New("right operand must be a regular expression"),
+		},
+		{
+			name:          "unsupported prefix operator",
+			expr:          search.PrefixExpression{Operator: search.TokOpEq, Right: search.StringLiteral{Value: "foo"}},
+			expectedError: errors.New("operator is not supported"),
+		},
+		{
+			// Invalid sub-expressions are reported, even if they wouldn't be
+			// evaluated for a request log.
+			name: "invalid right operand of OR",
+			expr: search.InfixExpression{
+				Operator: search.TokOpOr,
+				Left:     search.StringLiteral{Value: "foo"},
+				Right: search.InfixExpression{
+					Operator: search.TokOpIn,
+					Left:     search.StringLiteral{Value: "req.method"},
+					Right:    search.StringLiteral{Value: "GET"},
+				},
+			},
+			expectedError: errors.New("right operand must be a list"),
+		},
+		{
+			name:          "unsupported function",
+			expr:          search.CallExpression{Name: "foo"},
+			expectedError: errors.New(`function "foo" not supported`),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := reqlog.CompileMatcher(tt.expr, reqlog.DefaultMatchConfig())
+			assertError(t, tt.expectedError, err)
+
+			if err == nil && got == nil {
+				t.Fatal("expected matcher, got nil")
+			}
+		})
+	}
+}
+
+func TestCompileMatcherMatch(t *testing.T) {
+	t.Parallel()
+
+	expr, err := search.ParseQuery(`req.method = POST AND res.statusCode >= 500 AND req.url !~ "logout"`)
+	if err != nil {
+		t.Fatalf("unexpected error parsing query: %v", err)
+	}
+
+	match, err := reqlog.CompileMatcher(expr, reqlog.DefaultMatchConfig())
+	if err != nil {
+		t.Fatalf("unexpected error compiling expression: %v", err)
+	}
+
+	tests := []struct {
+		name          string
+		requestLog    reqlog.RequestLog
+		expectedMatch bool
+	}{
+		{
+			name: "match",
+			requestLog: reqlog.RequestLog{
+				Method:   http.MethodPost,
+				URL:      mustParseURL(t, "https://example.com/foo"),
+				Response: &reqlog.ResponseLog{StatusCode: 503},
+			},
+			expectedMatch: true,
+		},
+		{
+			name: "excluded URL",
+			requestLog: reqlog.RequestLog{
+				Method:   http.MethodPost,
+				URL:      mustParseURL(t, "https://example.com/logout"),
+				Response: &reqlog.ResponseLog{StatusCode: 503},
+			},
+			expectedMatch: false,
+		},
+		{
+			name: "without response",
+			requestLog: reqlog.RequestLog{
+				Method: http.MethodPost,
+				URL:    mustParseURL(t, "https://example.com/foo"),
+			},
+			expectedMatch: false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := match(tt.requestLog); got != tt.expectedMatch {
+				t.Errorf("expected match result: %v, got: %v", tt.expectedMatch, got)
+			}
+
+			// The compiled matcher must agree with `MatchesWithConfig`.
+			got, err := tt.requestLog.MatchesWithConfig(expr, reqlog.DefaultMatchConfig())
+			assertError(t, nil, err)
+
+			if got != tt.expectedMatch {
+				t.Errorf("expected `MatchesWithConfig` result: %v, got: %v", tt.expectedMatch, got)
+			}
+		})
+	}
+}
+
+// TestCompileMatcherRequestAndResponse matches request logs and their response
+// logs against the same expressions, which are compiled the same way for
+// both. Without request, request keys resolve to an empty string.
+func TestCompileMatcherRequestAndResponse(t *testing.T) {
+	t.Parallel()
+
+	reqLog := reqlog.RequestLog{
+		Method: http.MethodPost,
+		URL:    mustParseURL(t, "https://example.com/login?next=/admin"),
+		Header: http.Header{"Content-Type": []string{"application/x-www-form-urlencoded"}},
+		Body:   []byte("username=admin"),
+		Response: &reqlog.ResponseLog{
+			Proto:      "HTTP/1.1",
+			StatusCode: 404,
+			Header:     http.Header{"Content-Type": []string{"text/plain"}},
+			Body:       []byte("page does not exist"),
+		},
+	}
+
+	tests := []struct {
+		name        string
+		query       string
+		expRequest  bool
+		expResponse bool
+	}{
+		{name: "response key", query: "res.statusCode = 404", expRequest: true, expResponse: true},
+		{name: "request key", query: "req.method = POST", expRequest: true, expResponse: false},
+		{name: "request key, empty", query: `req.method = ""`, expRequest: false, expResponse: true},
+		{name: "query parameter regexp", query: `req.query.next =~ "admin"`, expRequest: true, expResponse: false},
+		{name: "in", query: "res.statusCode IN [404, 500]", expRequest: true, expResponse: true},
+		{name: "approximately", query: "res.statusCode ~= 400 ± 2%", expRequest: true, expResponse: true},
+		{name: "regexp", query: `res.body =~ "^page"`, expRequest: true, expResponse: true},
+		{name: "comparison", query: "res.statusCode >= 500", expRequest: false, expResponse: false},
+		{name: "free text in request", query: "username", expRequest: true, expResponse: false},
+		{name: "free text in response", query: "does not exist", expRequest: true, expResponse: true},
+		{name: "function call", query: `extract(res.body, /(\w+) not/) = does`, expRequest: true, expResponse: true},
+		{name: "function call on request key", query: `extract(req.body, /=(\w+)/)`, expRequest: true, expResponse: false},
+		{name: "not", query: `NOT (req.method = POST)`, expRequest: false, expResponse: true},
+		{
+			name:        "logical operators",
+			query:       `res.statusCode = 404 AND (req.method = GET OR "text/plain")`,
+			expRequest:  true,
+			expResponse: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			expr, err := search.ParseQuery(tt.query)
+			assertError(t, nil, err)
+
+			got, err := reqLog.MatchesWithConfig(expr, reqlog.DefaultMatchConfig())
+			assertError(t, nil, err)
+
+			if got != tt.expRequest {
+				t.Errorf("expected request match result: %v, got: %v", tt.expRequest, got)
+			}
+
+			got, err = reqLog.Response.MatchesWithConfig(expr, reqlog.DefaultMatchConfig())
+			assertError(t, nil, err)
+
+			if got != tt.expResponse {
+				t.Errorf("expected response match result: %v, got: %v", tt.expResponse, got)
+			}
+		})
+	}
+}
+
+const benchmarkQuery = `req.method = POST AND req.headers.Content-Type =~ "json" AND res.statusCode >= 400 OR "secret"`
+
+// benchmarkRequestLogs returns request logs to match `benchmarkQuery` against,
+// to compare the throughput of compiled matchers with `MatchesWithConfig` on a
+// store of request logs.
+func benchmarkRequestLogs(b *testing.B, n int) []reqlog.RequestLog {
+	b.Helper()
+
+	reqLogs := make([]reqlog.RequestLog, n)
+	for i := range reqLogs {
+		reqLogs[i] = reqlog.RequestLog{
+			ID:     ulid.MustNew(ulid.Now(), ulidEntropy),
+			Method: []string{http.MethodGet, http.MethodPost}[i%2],
+			URL: &url.URL{
+				Scheme:   "https",
+				Host:     "example.com",
+				Path:     fmt.Sprintf("/items/%v", i),
+				RawQuery: fmt.Sprintf("page=%v", i%10),
+			},
+			Header: http.Header{"Content-Type": []string{"application/json"}},
+			Body:   []byte(`{"foo":"bar"}`),
+			Response: &reqlog.ResponseLog{
+				StatusCode: 200 + i%4*100,
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body:       []byte(`{"ok":true}`),
+			},
+		}
+	}
+
+	return reqLogs
+}
+
+func BenchmarkCompileMatcher(b *testing.B) {
+	expr, err := search.ParseQuery(benchmarkQuery)
+	if err != nil {
+		b.Fatalf("unexpected error parsing query: %v", err)
+	}
+
+	reqLogs := benchmarkRequestLogs(b, 10000)
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		match, err := reqlog.CompileMatcher(expr, reqlog.DefaultMatchConfig())
+		if err != nil {
+			b.Fatalf("unexpected error compiling expression: %v", err)
+		}
+
+		for _, reqLog := range reqLogs {
+			match(reqLog)
+		}
+	}
+}
+
+func BenchmarkMatchesWithConfig(b *testing.B) {
+	expr, err := search.ParseQuery(benchmarkQuery)
+	if err != nil {
+		b.Fatalf("unexpected error parsing query: %v", err)
+	}
+
+	reqLogs := benchmarkRequestLogs(b, 10000)
+	// The config is shared, like `BenchmarkCompileMatcher` does, so only the
+	// cost of evaluating the expression is compared.
+	cfg := reqlog.DefaultMatchConfig()
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for _, reqLog := range reqLogs {
+			if _, err := reqLog.MatchesWithConfig(expr, cfg); err != nil {
+				b.Fatalf("unexpected error matching: %v", err)
+			}
+		}
+	}
+}
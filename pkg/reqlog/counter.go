@@ -0,0 +1,96 @@
+package reqlog
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/oklog/ulid"
+)
+
+// CounterIncreaseFinding reports a numeric response header (e.g.
+// `X-RateLimit-Remaining`) that increased between two consecutive requests to
+// the same endpoint. For counters that are expected to only decrease, this
+// points to a reset, e.g. of a rate limit window.
+type CounterIncreaseFinding struct {
+	// Endpoint is the method and URL, without query string, both requests
+	// were sent to.
+	Endpoint string
+
+	PreviousReqLogID ulid.ULID
+	PreviousValue    int64
+	ReqLogID         ulid.ULID
+	Value            int64
+}
+
+// CounterIncreaseReport compares the value of a numeric response header
+// between consecutive requests (by ID, so in order of time) to the same
+// endpoint, and returns a finding for each increase. Requests without a
+// response or without a numeric header value are ignored. Findings are sorted
+// by endpoint, then by time.
+func CounterIncreaseReport(reqLogs []RequestLog, header string) []CounterIncreaseFinding {
+	sorted := make([]RequestLog, len(reqLogs))
+	copy(sorted, reqLogs)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].ID.Compare(sorted[j].ID) < 0
+	})
+
+	type counterValue struct {
+		reqLogID ulid.ULID
+		value    int64
+	}
+
+	previous := make(map[string]counterValue)
+	byEndpoint := make(map[string][]CounterIncreaseFinding)
+
+	for _, reqLog := range sorted {
+		if reqLog.URL == nil || reqLog.Response == nil {
+			continue
+		}
+
+		value, ok := counterHeaderValue(reqLog.Response.Header, header)
+		if !ok {
+			continue
+		}
+
+		endpoint := reqLog.Method + " " + verbAccessURL(reqLog.URL)
+
+		if prev, ok := previous[endpoint]; ok && value > prev.value {
+			byEndpoint[endpoint] = append(byEndpoint[endpoint], CounterIncreaseFinding{
+				Endpoint:         endpoint,
+				PreviousReqLogID: prev.reqLogID,
+				PreviousValue:    prev.value,
+				ReqLogID:         reqLog.ID,
+				Value:            value,
+			})
+		}
+
+		previous[endpoint] = counterValue{reqLogID: reqLog.ID, value: value}
+	}
+
+	endpoints := make([]string, 0, len(byEndpoint))
+	for endpoint := range byEndpoint {
+		endpoints = append(endpoints, endpoint)
+	}
+
+	sort.Strings(endpoints)
+
+	var findings []CounterIncreaseFinding
+
+	for _, endpoint := range endpoints {
+		findings = append(findings, byEndpoint[endpoint]...)
+	}
+
+	return findings
+}
+
+func counterHeaderValue(header http.Header, name string) (int64, bool) {
+	value, err := strconv.ParseInt(strings.TrimSpace(header.Get(name)), 10, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return value, true
+}
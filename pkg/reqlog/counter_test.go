@@ -0,0 +1,104 @@
+package reqlog_test
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/oklog/ulid"
+
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+func TestCounterIncreaseReport(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	// newReqLog returns a request log for the n-th request, with the given
+	// `X-RateLimit-Remaining` response header value.
+	newReqLog := func(n int, method, rawURL string, remaining int) reqlog.RequestLog {
+		return reqlog.RequestLog{
+			ID:     ulid.MustNew(ulid.Timestamp(start.Add(time.Duration(n)*time.Second)), nil),
+			Method: method,
+			URL:    mustParseURL(t, rawURL),
+			Response: &reqlog.ResponseLog{
+				Header: http.Header{"X-Ratelimit-Remaining": []string{strconv.Itoa(remaining)}},
+			},
+		}
+	}
+
+	decreasing := []reqlog.RequestLog{
+		newReqLog(0, "GET", "https://example.com/api/users?page=1", 10),
+		newReqLog(1, "GET", "https://example.com/api/users?page=2", 9),
+		newReqLog(2, "GET", "https://example.com/api/users?page=3", 8),
+		newReqLog(3, "GET", "https://example.com/api/users?page=4", 5),
+	}
+
+	reset := []reqlog.RequestLog{
+		// Out of order, to check requests are compared by time.
+		newReqLog(2, "GET", "https://example.com/api/users", 10),
+		newReqLog(0, "GET", "https://example.com/api/users", 2),
+		newReqLog(1, "GET", "https://example.com/api/users", 1),
+		newReqLog(3, "GET", "https://example.com/api/users", 9),
+		// Different endpoints, so not compared with the requests above.
+		newReqLog(4, "POST", "https://example.com/api/users", 50),
+		newReqLog(5, "GET", "https://example.com/api/orders", 100),
+		// Without response or numeric value, so ignored.
+		{ID: ulid.MustNew(ulid.Timestamp(start.Add(6*time.Second)), nil), Method: "GET", URL: mustParseURL(t, "https://example.com/api/users")},
+		{
+			ID:       ulid.MustNew(ulid.Timestamp(start.Add(7*time.Second)), nil),
+			Method:   "GET",
+			URL:      mustParseURL(t, "https://example.com/api/users"),
+			Response: &reqlog.ResponseLog{Header: http.Header{"X-Ratelimit-Remaining": []string{"unlimited"}}},
+		},
+		newReqLog(8, "GET", "https://example.com/api/users", 20),
+	}
+
+	tests := []struct {
+		name     string
+		reqLogs  []reqlog.RequestLog
+		expected []reqlog.CounterIncreaseFinding
+	}{
+		{
+			name:     "decreasing counter",
+			reqLogs:  decreasing,
+			expected: nil,
+		},
+		{
+			name:    "reset counter",
+			reqLogs: reset,
+			expected: []reqlog.CounterIncreaseFinding{
+				{
+					Endpoint:         "GET https://example.com/api/users",
+					PreviousReqLogID: reset[2].ID,
+					PreviousValue:    1,
+					ReqLogID:         reset[0].ID,
+					Value:            10,
+				},
+				{
+					Endpoint:         "GET https://example.com/api/users",
+					PreviousReqLogID: reset[3].ID,
+					PreviousValue:    9,
+					ReqLogID:         reset[8].ID,
+					Value:            20,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := reqlog.CounterIncreaseReport(tt.reqLogs, "X-RateLimit-Remaining")
+
+			if diff := cmp.Diff(tt.expected, got); diff != "" {
+				t.Errorf("findings not equal (-exp, +got):\n%v", diff)
+			}
+		})
+	}
+}
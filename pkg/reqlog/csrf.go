@@ -0,0 +1,90 @@
+package reqlog
+
+import (
+	"bytes"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"regexp"
+)
+
+// defaultCSRFTokenPattern matches the names of anti-CSRF tokens used by common
+// web frameworks (e.g. `X-CSRF-Token`, `_xsrf`, `authenticity_token` and
+// `__RequestVerificationToken`).
+var defaultCSRFTokenPattern = regexp.MustCompile(`(?i)csrf|xsrf|authenticity_token|requestverificationtoken`)
+
+// isCSRFCandidate returns true if the request is state-changing and is
+// authenticated by cookies only, without an anti-CSRF token in its headers,
+// query parameters or form body. Requests with an `Authorization` header are
+// not candidates, because browsers don't add these automatically.
+func isCSRFCandidate(rl RequestLog, tokenPatterns []*regexp.Regexp) bool {
+	switch rl.Method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+	default:
+		return false
+	}
+
+	if rl.Header.Get("Cookie") == "" || rl.Header.Get("Authorization") != "" {
+		return false
+	}
+
+	names := make([]string, 0, len(rl.Header))
+	for name := range rl.Header {
+		names = append(names, name)
+	}
+
+	if rl.URL != nil {
+		for name := range rl.URL.Query() {
+			names = append(names, name)
+		}
+	}
+
+	names = append(names, formFieldNames(rl)...)
+
+	for _, name := range names {
+		for _, pattern := range tokenPatterns {
+			if pattern.MatchString(name) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// formFieldNames returns the field names of a URL encoded or multipart form
+// request body.
+func formFieldNames(rl RequestLog) []string {
+	mediaType, params, err := mime.ParseMediaType(rl.Header.Get("Content-Type"))
+	if err != nil {
+		return nil
+	}
+
+	var names []string
+
+	switch mediaType {
+	case "application/x-www-form-urlencoded":
+		values, err := url.ParseQuery(string(rl.Body))
+		if err != nil {
+			return nil
+		}
+
+		for name := range values {
+			names = append(names, name)
+		}
+	case "multipart/form-data":
+		mr := multipart.NewReader(bytes.NewReader(rl.Body), params["boundary"])
+
+		for {
+			part, err := mr.NextPart()
+			if err != nil {
+				break
+			}
+
+			names = append(names, part.FormName())
+		}
+	}
+
+	return names
+}
@@ -0,0 +1,97 @@
+package reqlog
+
+import (
+	"bytes"
+	"encoding/base64"
+	"net/http"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// curlSkipHeaders are set by curl itself, based on the URL and body.
+var curlSkipHeaders = map[string]bool{
+	"Content-Length":    true,
+	"Transfer-Encoding": true,
+	"Connection":        true,
+}
+
+// Curl returns a curl command that sends the request, for a POSIX shell.
+// Headers are sorted by name, like in `Raw`. Text bodies are passed inline;
+// binary bodies (or bodies containing NUL bytes) are base64 encoded and piped
+// into curl, decoded.
+func (reqLog RequestLog) Curl() string {
+	var args []string
+
+	binaryBody := len(reqLog.Body) > 0 && isBinaryBody(reqLog.Body)
+
+	// Without `-X`, curl sends a GET request, or a POST request if there's a
+	// body.
+	if reqLog.Method != "" && (reqLog.Method != http.MethodGet || len(reqLog.Body) > 0) {
+		args = append(args, "-X "+shellQuote(reqLog.Method))
+	}
+
+	if strings.HasPrefix(reqLog.Proto, "HTTP/2") {
+		args = append(args, "--http2")
+	}
+
+	url := ""
+	if reqLog.URL != nil {
+		url = reqLog.URL.String()
+	}
+
+	args = append(args, shellQuote(url))
+
+	keys := make([]string, 0, len(reqLog.Header))
+	for key := range reqLog.Header {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if curlSkipHeaders[http.CanonicalHeaderKey(key)] {
+			continue
+		}
+
+		for _, value := range reqLog.Header[key] {
+			// Host headers that match the URL are redundant.
+			if http.CanonicalHeaderKey(key) == "Host" && reqLog.URL != nil && value == reqLog.URL.Host {
+				continue
+			}
+
+			args = append(args, "-H "+shellQuote(key+": "+value))
+		}
+	}
+
+	// Let curl decode the response body, as the client did.
+	if reqLog.Header.Get("Accept-Encoding") != "" {
+		args = append(args, "--compressed")
+	}
+
+	switch {
+	case binaryBody:
+		args = append(args, "--data-binary @-")
+	case len(reqLog.Body) > 0:
+		args = append(args, "--data-raw "+shellQuote(string(reqLog.Body)))
+	}
+
+	cmd := "curl " + strings.Join(args, " \\\n  ")
+
+	if binaryBody {
+		cmd = "printf '%s' " + shellQuote(base64.StdEncoding.EncodeToString(reqLog.Body)) + " | base64 -d | " + cmd
+	}
+
+	return cmd
+}
+
+// isBinaryBody returns true if body isn't valid UTF-8 or has NUL bytes, so it
+// can't be shown or passed around as text.
+func isBinaryBody(body []byte) bool {
+	return !utf8.Valid(body) || bytes.IndexByte(body, 0) != -1
+}
+
+// shellQuote quotes s as a single argument for a POSIX shell.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
@@ -0,0 +1,81 @@
+package reqlog_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+func TestRequestLogCurl(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		reqLog reqlog.RequestLog
+		exp    string
+	}{
+		{
+			name: "GET request",
+			reqLog: reqlog.RequestLog{
+				Method: http.MethodGet,
+				URL:    mustParseURL(t, "https://example.com/foo?bar=baz&qux=1"),
+				Proto:  "HTTP/1.1",
+				Header: http.Header{
+					"User-Agent": []string{"Hetty"},
+					"Host":       []string{"example.com"},
+					"Accept":     []string{"*/*"},
+				},
+			},
+			exp: "curl 'https://example.com/foo?bar=baz&qux=1' \\\n" +
+				"  -H 'Accept: */*' \\\n" +
+				"  -H 'User-Agent: Hetty'",
+		},
+		{
+			name: "POST request with quotes in text body",
+			reqLog: reqlog.RequestLog{
+				Method: http.MethodPost,
+				URL:    mustParseURL(t, "https://example.com/"),
+				Proto:  "HTTP/2.0",
+				Header: http.Header{
+					"Content-Type":    []string{"application/json"},
+					"Content-Length":  []string{"17"},
+					"Accept-Encoding": []string{"gzip"},
+				},
+				Body: []byte(`{"name":"O'Reilly"}`),
+			},
+			exp: "curl -X 'POST' \\\n" +
+				"  --http2 \\\n" +
+				"  'https://example.com/' \\\n" +
+				"  -H 'Accept-Encoding: gzip' \\\n" +
+				"  -H 'Content-Type: application/json' \\\n" +
+				"  --compressed \\\n" +
+				`  --data-raw '{"name":"O'\''Reilly"}'`,
+		},
+		{
+			name: "binary body",
+			reqLog: reqlog.RequestLog{
+				Method: http.MethodPut,
+				URL:    mustParseURL(t, "http://example.com/upload"),
+				Header: http.Header{"Host": []string{"upload.example.com"}},
+				Body:   []byte{0x00, 0xff, 0x10},
+			},
+			exp: "printf '%s' 'AP8Q' | base64 -d | curl -X 'PUT' \\\n" +
+				"  'http://example.com/upload' \\\n" +
+				"  -H 'Host: upload.example.com' \\\n" +
+				"  --data-binary @-",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tt.reqLog.Curl(); got != tt.exp {
+				t.Fatalf("incorrect curl command (expected: %q, got: %q)", tt.exp, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package reqlog
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+const (
+	customReqKeyPrefix = "req.custom."
+	customResKeyPrefix = "res.custom."
+)
+
+var (
+	customKeysMu       sync.RWMutex
+	customReqLogKeyFns = map[string]func(rl RequestLog) string{}
+	customResLogKeyFns = map[string]func(rl ResponseLog) string{}
+)
+
+// RegisterRequestKey registers a custom search key function for request logs,
+// which can then be used in queries as `req.custom.<name>`. It returns an error
+// if a key with the same name exists.
+func RegisterRequestKey(name string, fn func(RequestLog) string) error {
+	key, err := customKey(customReqKeyPrefix, name, fn == nil)
+	if err != nil {
+		return err
+	}
+
+	customKeysMu.Lock()
+	defer customKeysMu.Unlock()
+
+	_, builtin := reqLogSearchKeyFns[key]
+	_, computed := reqLogComputedKeyFns[key]
+	_, custom := customReqLogKeyFns[key]
+
+	if builtin || computed || custom {
+		return fmt.Errorf("reqlog: search key %q already exists", key)
+	}
+
+	customReqLogKeyFns[key] = fn
+
+	return nil
+}
+
+// RegisterResponseKey registers a custom search key function for response logs,
+// which can then be used in queries as `res.custom.<name>`. It returns an error
+// if a key with the same name exists.
+func RegisterResponseKey(name string, fn func(ResponseLog) string) error {
+	key, err := customKey(customResKeyPrefix, name, fn == nil)
+	if err != nil {
+		return err
+	}
+
+	customKeysMu.Lock()
+	defer customKeysMu.Unlock()
+
+	_, builtin := resLogSearchKeyFns[key]
+	_, computed := resLogComputedKeyFns[key]
+	_, requestComputed := resLogRequestKeyFns[key]
+	_, custom := customResLogKeyFns[key]
+
+	if builtin || computed || requestComputed || custom {
+		return fmt.Errorf("reqlog: search key %q already exists", key)
+	}
+
+	customResLogKeyFns[key] = fn
+
+	return nil
+}
+
+// customKey returns the search key for a custom key name, which may already
+// include the prefix.
+func customKey(prefix, name string, nilFn bool) (string, error) {
+	name = strings.TrimPrefix(name, prefix)
+	if name == "" {
+		return "", errors.New("reqlog: search key name cannot be empty")
+	}
+
+	if nilFn {
+		return "", errors.New("reqlog: search key function cannot be nil")
+	}
+
+	return prefix + name, nil
+}
+
+func customRequestKeyFn(key string) (func(RequestLog) string, bool) {
+	customKeysMu.RLock()
+	defer customKeysMu.RUnlock()
+
+	fn, ok := customReqLogKeyFns[key]
+
+	return fn, ok
+}
+
+func customResponseKeyFn(key string) (func(ResponseLog) string, bool) {
+	customKeysMu.RLock()
+	defer customKeysMu.RUnlock()
+
+	fn, ok := customResLogKeyFns[key]
+
+	return fn, ok
+}
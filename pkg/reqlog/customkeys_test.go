@@ -0,0 +1,52 @@
+package reqlog_test
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/dstotijn/hetty/pkg/reqlog"
+	"github.com/dstotijn/hetty/pkg/search"
+)
+
+func TestRegisterCustomKeys(t *testing.T) {
+	t.Parallel()
+
+	err := reqlog.RegisterRequestKey("userAgentLength", func(rl reqlog.RequestLog) string {
+		return strconv.Itoa(len(rl.Header.Get("User-Agent")))
+	})
+	assertError(t, nil, err)
+
+	err = reqlog.RegisterResponseKey("res.custom.server", func(rl reqlog.ResponseLog) string {
+		return strings.ToLower(rl.Header.Get("Server"))
+	})
+	assertError(t, nil, err)
+
+	err = reqlog.RegisterRequestKey("req.custom.userAgentLength", func(_ reqlog.RequestLog) string { return "" })
+	assertError(t, errors.New(`reqlog: search key "req.custom.userAgentLength" already exists`), err)
+
+	err = reqlog.RegisterResponseKey("", func(_ reqlog.ResponseLog) string { return "" })
+	assertError(t, errors.New("reqlog: search key name cannot be empty"), err)
+
+	err = reqlog.RegisterRequestKey("nilFunc", nil)
+	assertError(t, errors.New("reqlog: search key function cannot be nil"), err)
+
+	searchExpr, err := search.ParseQuery("req.custom.userAgentLength > 5 AND res.custom.server = nginx")
+	assertError(t, nil, err)
+
+	reqLog := reqlog.RequestLog{
+		Header: http.Header{"User-Agent": []string{"curl/7.79.1"}},
+		Response: &reqlog.ResponseLog{
+			Header: http.Header{"Server": []string{"NGINX"}},
+		},
+	}
+
+	got, err := reqLog.Matches(searchExpr)
+	assertError(t, nil, err)
+
+	if !got {
+		t.Error("expected request log to match custom keys")
+	}
+}
@@ -0,0 +1,185 @@
+package reqlog
+
+import (
+	"bufio"
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/andybalholm/brotli"
+)
+
+// withDecodedBodies returns a copy of the request log with its request and
+// response bodies decoded per their `Content-Encoding` header, so search keys
+// match on the decoded content. Bodies are decoded once per match, rather than
+// every time a search key reads them. Response bodies stored on disk are read
+// into memory if they need decoding. Bodies that can't be decoded are kept
+// as-is.
+func (reqLog RequestLog) withDecodedBodies() RequestLog {
+	if decoded, ok := decodeBody(reqLog.Body, reqLog.Header); ok {
+		reqLog.Body = decoded
+	}
+
+	if reqLog.Response != nil && hasContentEncoding(reqLog.Response.Header) {
+		resLog := reqLog.Response.withDecodedBody()
+		reqLog.Response = &resLog
+	}
+
+	return reqLog
+}
+
+// withDecodedBody returns a copy of the response log with its body decoded per
+// its `Content-Encoding` header. See `RequestLog.withDecodedBodies`.
+func (resLog ResponseLog) withDecodedBody() ResponseLog {
+	// Bodies that were decoded before they were stored are used as-is.
+	if resLog.ContentEncoding != "" || !hasContentEncoding(resLog.Header) {
+		return resLog
+	}
+
+	body, err := resLog.ReadBody()
+	if err != nil {
+		return resLog
+	}
+
+	if decoded, ok := decodeBody(body, resLog.Header); ok {
+		resLog.Body = decoded
+		resLog.BodyFile = ""
+	}
+
+	return resLog
+}
+
+// hasContentEncoding returns true if the header has a `Content-Encoding` other
+// than `identity`.
+func hasContentEncoding(header http.Header) bool {
+	for _, coding := range contentCodings(header) {
+		if coding != "identity" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// contentEncoding returns the `Content-Encoding` of a header, with multiple
+// header values joined.
+func contentEncoding(header http.Header) string {
+	return strings.Join(header.Values("Content-Encoding"), ", ")
+}
+
+// contentCodings returns the normalized content codings of a header, in the
+// order they were applied.
+func contentCodings(header http.Header) []string {
+	var codings []string
+
+	for _, value := range header.Values("Content-Encoding") {
+		for _, coding := range strings.Split(value, ",") {
+			if coding = normalizeContentCoding(strings.TrimSpace(coding)); coding != "" {
+				codings = append(codings, coding)
+			}
+		}
+	}
+
+	return codings
+}
+
+// canDecodeBody returns true if the header has a `Content-Encoding` other than
+// `identity`, and all of its codings can be decoded.
+func canDecodeBody(header http.Header) bool {
+	if !hasContentEncoding(header) {
+		return false
+	}
+
+	for _, coding := range contentCodings(header) {
+		switch coding {
+		case "identity", "gzip", "deflate", "br":
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+// decodeBody decodes a body per the `Content-Encoding` header. It returns false
+// if the body isn't encoded, or if any of the codings is unsupported or fails
+// to decode.
+func decodeBody(body []byte, header http.Header) ([]byte, bool) {
+	if len(body) == 0 || !canDecodeBody(header) {
+		return nil, false
+	}
+
+	r, err := newDecodingReader(bytes.NewReader(body), header)
+	if err != nil {
+		return nil, false
+	}
+
+	decoded, err := io.ReadAll(r)
+	if err != nil {
+		return nil, false
+	}
+
+	return decoded, true
+}
+
+// newDecodingReader returns a reader that decodes r per the `Content-Encoding`
+// header. It returns an error if any of the codings is unsupported, or if the
+// header of an encoded stream is invalid.
+func newDecodingReader(r io.Reader, header http.Header) (io.Reader, error) {
+	codings := contentCodings(header)
+
+	// Codings are listed in the order they were applied, so they're
+	// decoded in reverse.
+	for i := len(codings) - 1; i >= 0; i-- {
+		switch codings[i] {
+		case "identity":
+			continue
+		case "gzip":
+			gzipReader, err := gzip.NewReader(r)
+			if err != nil {
+				return nil, fmt.Errorf("could not create gzip reader: %w", err)
+			}
+
+			r = gzipReader
+		case "deflate":
+			deflateReader, err := newDeflateReader(r)
+			if err != nil {
+				return nil, fmt.Errorf("could not create deflate reader: %w", err)
+			}
+
+			r = deflateReader
+		case "br":
+			r = brotli.NewReader(r)
+		default:
+			return nil, fmt.Errorf("unsupported content coding %q", codings[i])
+		}
+	}
+
+	return r, nil
+}
+
+// newDeflateReader returns a reader for a `deflate` coded stream. Per RFC 9110
+// this is a zlib stream, but some servers send raw deflate data, so that's
+// read if the stream doesn't start with a zlib header.
+func newDeflateReader(r io.Reader) (io.Reader, error) {
+	br := bufio.NewReader(r)
+
+	if header, err := br.Peek(2); err == nil && isZlibHeader(header) {
+		return zlib.NewReader(br)
+	}
+
+	return flate.NewReader(br), nil
+}
+
+// isZlibHeader returns true if b is a zlib header (RFC 1950) for a deflate
+// stream without preset dictionary.
+func isZlibHeader(b []byte) bool {
+	cmf, flg := b[0], b[1]
+
+	return cmf&0x0f == 8 && cmf>>4 <= 7 && flg&0x20 == 0 && (uint16(cmf)<<8|uint16(flg))%31 == 0
+}
@@ -0,0 +1,81 @@
+package reqlog
+
+import "regexp"
+
+// LangSignature identifies the language or framework a response was generated
+// with. A signature matches if its header pattern matches any value of the
+// header, or its body pattern matches the body. Either pattern can be nil.
+type LangSignature struct {
+	Lang          string
+	Header        string
+	HeaderPattern *regexp.Regexp
+	BodyPattern   *regexp.Regexp
+}
+
+func defaultLangSignatures() []LangSignature {
+	return []LangSignature{
+		{
+			Lang:          "php",
+			Header:        "X-Powered-By",
+			HeaderPattern: regexp.MustCompile(`(?i)\bphp\b`),
+			BodyPattern:   regexp.MustCompile(`<\?php\b`),
+		},
+		{
+			Lang:          "asp.net",
+			Header:        "X-Aspnet-Version",
+			HeaderPattern: regexp.MustCompile(`.`),
+			BodyPattern:   regexp.MustCompile(`\b__VIEWSTATE\b|<%@\s*Page\b`),
+		},
+		{
+			Lang:          "asp.net",
+			Header:        "X-Powered-By",
+			HeaderPattern: regexp.MustCompile(`(?i)\basp\.net\b`),
+		},
+		{
+			Lang:          "java",
+			Header:        "Set-Cookie",
+			HeaderPattern: regexp.MustCompile(`^JSESSIONID=`),
+			BodyPattern:   regexp.MustCompile(`<%@\s*page\b[^%]*\blanguage="java"`),
+		},
+		{
+			Lang:          "node.js",
+			Header:        "X-Powered-By",
+			HeaderPattern: regexp.MustCompile(`(?i)\b(express|next\.js)\b`),
+		},
+	}
+}
+
+// detectLang returns the language of the first signature that matches the
+// response, or an empty string if none match.
+func detectLang(rl ResponseLog, signatures []LangSignature) string {
+	var body []byte
+
+	bodyRead := false
+
+	for _, sig := range signatures {
+		if sig.HeaderPattern != nil && sig.Header != "" {
+			for _, value := range rl.Header.Values(sig.Header) {
+				if sig.HeaderPattern.MatchString(value) {
+					return sig.Lang
+				}
+			}
+		}
+
+		if sig.BodyPattern == nil {
+			continue
+		}
+
+		if !bodyRead {
+			// Errors reading a body from disk are ignored; only headers are
+			// considered then.
+			body, _ = rl.ReadBody()
+			bodyRead = true
+		}
+
+		if sig.BodyPattern.Match(body) {
+			return sig.Lang
+		}
+	}
+
+	return ""
+}
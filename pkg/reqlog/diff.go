@@ -0,0 +1,433 @@
+package reqlog
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+)
+
+const (
+	// diffMaxEdits is the number of insertions and deletions above which a
+	// diff isn't minimized further; the remaining difference is then reported
+	// as removed and added as a whole, to bound the time diffing takes.
+	diffMaxEdits = 1000
+	// diffMaxBinarySize is the size (in bytes) of the differing part of binary
+	// bodies above which they're reported as a single changed byte range.
+	diffMaxBinarySize = 8 << 10
+)
+
+// DiffOp is the operation that turns part of an original request into the
+// corresponding part of a modified request.
+type DiffOp string
+
+const (
+	DiffOpEqual   DiffOp = "equal"
+	DiffOpAdded   DiffOp = "added"
+	DiffOpRemoved DiffOp = "removed"
+	DiffOpChanged DiffOp = "changed"
+)
+
+// RequestDiff is the difference between two requests, e.g. a request log and a
+// request that was modified from it to be sent again.
+type RequestDiff struct {
+	Method  StringDiff
+	URL     StringDiff
+	Proto   StringDiff
+	Headers []HeaderDiff
+	Body    BodyDiff
+}
+
+// StringDiff holds a value of an original and a modified request.
+type StringDiff struct {
+	Original string
+	Modified string
+}
+
+// Changed returns true if the original and modified values differ.
+func (sd StringDiff) Changed() bool {
+	return sd.Original != sd.Modified
+}
+
+// HeaderDiff holds the values of a header of an original and a modified
+// request. The op is equal if the values are, including their order.
+type HeaderDiff struct {
+	Name     string
+	Op       DiffOp
+	Original []string
+	Modified []string
+}
+
+// BodyDiff is the difference between the bodies of two requests. Text bodies
+// are compared by line; if either body is binary, the byte ranges that differ
+// are summarized instead.
+type BodyDiff struct {
+	Binary       bool
+	OriginalSize int
+	ModifiedSize int
+	Lines        []LineDiff
+	ByteRanges   []ByteRangeDiff
+}
+
+// LineDiff is a line of a text body and whether it was added, removed or left
+// equal. Line numbers start at 1; they're zero for lines the respective body
+// doesn't have.
+type LineDiff struct {
+	Op           DiffOp
+	Text         string
+	OriginalLine int
+	ModifiedLine int
+}
+
+// ByteRangeDiff is a range of bytes of an original binary body that was
+// replaced with a range of the modified body. Either range can be empty, for
+// added or removed bytes.
+type ByteRangeDiff struct {
+	OriginalOffset int
+	OriginalLength int
+	ModifiedOffset int
+	ModifiedLength int
+}
+
+// DiffRequests returns the difference between the request line, headers and
+// body of two requests. Responses aren't compared. Bodies are compared as
+// stored, i.e. without decoding them per their `Content-Encoding`.
+func DiffRequests(original, modified RequestLog) RequestDiff {
+	return RequestDiff{
+		Method:  StringDiff{Original: original.Method, Modified: modified.Method},
+		URL:     StringDiff{Original: urlString(original), Modified: urlString(modified)},
+		Proto:   StringDiff{Original: original.Proto, Modified: modified.Proto},
+		Headers: diffHeaders(original.Header, modified.Header),
+		Body:    diffBodies(original.Body, modified.Body),
+	}
+}
+
+func urlString(reqLog RequestLog) string {
+	if reqLog.URL == nil {
+		return ""
+	}
+
+	return reqLog.URL.String()
+}
+
+// diffHeaders compares headers by canonical name. Header diffs are sorted by
+// name.
+func diffHeaders(original, modified http.Header) []HeaderDiff {
+	values := func(header http.Header) map[string][]string {
+		m := make(map[string][]string, len(header))
+		for name, v := range header {
+			key := http.CanonicalHeaderKey(name)
+			m[key] = append(m[key], v...)
+		}
+
+		return m
+	}
+
+	originalValues, modifiedValues := values(original), values(modified)
+	diffs := make([]HeaderDiff, 0, len(originalValues))
+
+	for name, o := range originalValues {
+		m, ok := modifiedValues[name]
+
+		switch {
+		case !ok:
+			diffs = append(diffs, HeaderDiff{Name: name, Op: DiffOpRemoved, Original: o})
+		case equalStrings(o, m):
+			diffs = append(diffs, HeaderDiff{Name: name, Op: DiffOpEqual, Original: o, Modified: m})
+		default:
+			diffs = append(diffs, HeaderDiff{Name: name, Op: DiffOpChanged, Original: o, Modified: m})
+		}
+	}
+
+	for name, m := range modifiedValues {
+		if _, ok := originalValues[name]; !ok {
+			diffs = append(diffs, HeaderDiff{Name: name, Op: DiffOpAdded, Modified: m})
+		}
+	}
+
+	sort.Slice(diffs, func(i, j int) bool { return diffs[i].Name < diffs[j].Name })
+
+	return diffs
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func diffBodies(original, modified []byte) BodyDiff {
+	diff := BodyDiff{
+		Binary:       isBinaryBody(original) || isBinaryBody(modified),
+		OriginalSize: len(original),
+		ModifiedSize: len(modified),
+		Lines:        []LineDiff{},
+		ByteRanges:   []ByteRangeDiff{},
+	}
+
+	if diff.Binary {
+		diff.ByteRanges = diffByteRanges(original, modified)
+		return diff
+	}
+
+	originalLines, modifiedLines := bodyLines(original), bodyLines(modified)
+	edits := editScript(len(originalLines), len(modifiedLines), func(i, j int) bool {
+		return originalLines[i] == modifiedLines[j]
+	})
+
+	for _, e := range edits {
+		line := LineDiff{Op: e.op}
+
+		switch e.op {
+		case DiffOpEqual:
+			line.Text, line.OriginalLine, line.ModifiedLine = originalLines[e.i], e.i+1, e.j+1
+		case DiffOpRemoved:
+			line.Text, line.OriginalLine = originalLines[e.i], e.i+1
+		case DiffOpAdded:
+			line.Text, line.ModifiedLine = modifiedLines[e.j], e.j+1
+		}
+
+		diff.Lines = append(diff.Lines, line)
+	}
+
+	return diff
+}
+
+// bodyLines splits a text body into lines, without line endings. An empty body
+// has no lines.
+func bodyLines(body []byte) []string {
+	if len(body) == 0 {
+		return nil
+	}
+
+	lines := strings.Split(string(body), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSuffix(line, "\r")
+	}
+
+	return lines
+}
+
+// diffByteRanges returns the byte ranges that differ between two bodies, with
+// adjacent removed and added bytes merged into one range.
+func diffByteRanges(original, modified []byte) []ByteRangeDiff {
+	ranges := []ByteRangeDiff{}
+
+	prefix := commonPrefixLen(len(original), len(modified), func(i, j int) bool { return original[i] == modified[j] })
+	suffix := commonSuffixLen(len(original)-prefix, len(modified)-prefix, func(i, j int) bool {
+		return original[prefix+i] == modified[prefix+j]
+	})
+
+	o, m := original[prefix:len(original)-suffix], modified[prefix:len(modified)-suffix]
+
+	switch {
+	case len(o) == 0 && len(m) == 0:
+		return ranges
+	case len(o) > diffMaxBinarySize || len(m) > diffMaxBinarySize:
+		return append(ranges, ByteRangeDiff{
+			OriginalOffset: prefix,
+			OriginalLength: len(o),
+			ModifiedOffset: prefix,
+			ModifiedLength: len(m),
+		})
+	}
+
+	var cur *ByteRangeDiff
+
+	// Offsets in the original and modified body.
+	origOffset, modOffset := prefix, prefix
+
+	for _, e := range editScript(len(o), len(m), func(i, j int) bool { return o[i] == m[j] }) {
+		if e.op == DiffOpEqual {
+			if cur != nil {
+				ranges = append(ranges, *cur)
+				cur = nil
+			}
+
+			origOffset++
+			modOffset++
+
+			continue
+		}
+
+		if cur == nil {
+			cur = &ByteRangeDiff{OriginalOffset: origOffset, ModifiedOffset: modOffset}
+		}
+
+		if e.op == DiffOpRemoved {
+			cur.OriginalLength++
+			origOffset++
+		} else {
+			cur.ModifiedLength++
+			modOffset++
+		}
+	}
+
+	if cur != nil {
+		ranges = append(ranges, *cur)
+	}
+
+	return ranges
+}
+
+// edit is an operation of an edit script. Index i is of the original sequence
+// (for equal and removed elements) and j of the modified sequence (for equal
+// and added elements).
+type edit struct {
+	op   DiffOp
+	i, j int
+}
+
+// editScript returns the shortest edit script that turns an original sequence of
+// n elements into a modified sequence of m elements, per Myers' "An O(ND)
+// Difference Algorithm and Its Variations". The eq func reports whether element
+// i of the original equals element j of the modified sequence. Past
+// `diffMaxEdits`, the differing elements are removed and added as a whole.
+func editScript(n, m int, eq func(i, j int) bool) []edit {
+	prefix := commonPrefixLen(n, m, eq)
+	suffix := commonSuffixLen(n-prefix, m-prefix, func(i, j int) bool { return eq(prefix+i, prefix+j) })
+
+	edits := make([]edit, 0, n+m-suffix)
+
+	for k := 0; k < prefix; k++ {
+		edits = append(edits, edit{op: DiffOpEqual, i: k, j: k})
+	}
+
+	middle, ok := myersEdits(n-prefix-suffix, m-prefix-suffix, func(i, j int) bool { return eq(prefix+i, prefix+j) })
+	if !ok {
+		middle = middle[:0]
+
+		for i := 0; i < n-prefix-suffix; i++ {
+			middle = append(middle, edit{op: DiffOpRemoved, i: i})
+		}
+
+		for j := 0; j < m-prefix-suffix; j++ {
+			middle = append(middle, edit{op: DiffOpAdded, j: j})
+		}
+	}
+
+	for _, e := range middle {
+		edits = append(edits, edit{op: e.op, i: prefix + e.i, j: prefix + e.j})
+	}
+
+	for k := 0; k < suffix; k++ {
+		edits = append(edits, edit{op: DiffOpEqual, i: n - suffix + k, j: m - suffix + k})
+	}
+
+	return edits
+}
+
+// myersEdits returns the shortest edit script, or false if it has more than
+// `diffMaxEdits` insertions and deletions.
+func myersEdits(n, m int, eq func(i, j int) bool) ([]edit, bool) {
+	maxEdits := n + m
+	if maxEdits > diffMaxEdits {
+		maxEdits = diffMaxEdits
+	}
+
+	// v holds, per diagonal k (offset by maxEdits+1), the furthest x reached
+	// on it. The trace holds the diagonals -d to d of v before step d, for
+	// backtracking.
+	offset := maxEdits + 1
+	v := make([]int, 2*maxEdits+3)
+
+	var trace [][]int
+
+	for d := 0; d <= maxEdits; d++ {
+		trace = append(trace, append([]int(nil), v[offset-d:offset+d+1]...))
+
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+
+			y := x - k
+			for x < n && y < m && eq(x, y) {
+				x++
+				y++
+			}
+
+			v[offset+k] = x
+
+			if x >= n && y >= m {
+				return backtrackEdits(trace, n, m), true
+			}
+		}
+	}
+
+	return nil, false
+}
+
+func backtrackEdits(trace [][]int, n, m int) []edit {
+	var edits []edit
+
+	x, y := n, m
+
+	for d := len(trace) - 1; d > 0; d-- {
+		// Diagonal k of the trace of step d is at index k+d.
+		prev := trace[d]
+		k := x - y
+
+		prevK := k - 1
+		if k == -d || (k != d && prev[k-1+d] < prev[k+1+d]) {
+			prevK = k + 1
+		}
+
+		prevX := prev[prevK+d]
+		prevY := prevX - prevK
+
+		for x > prevX && y > prevY {
+			x--
+			y--
+			edits = append(edits, edit{op: DiffOpEqual, i: x, j: y})
+		}
+
+		if prevK == k+1 {
+			edits = append(edits, edit{op: DiffOpAdded, j: prevY})
+		} else {
+			edits = append(edits, edit{op: DiffOpRemoved, i: prevX})
+		}
+
+		x, y = prevX, prevY
+	}
+
+	for x > 0 && y > 0 {
+		x--
+		y--
+		edits = append(edits, edit{op: DiffOpEqual, i: x, j: y})
+	}
+
+	for i, j := 0, len(edits)-1; i < j; i, j = i+1, j-1 {
+		edits[i], edits[j] = edits[j], edits[i]
+	}
+
+	return edits
+}
+
+func commonPrefixLen(n, m int, eq func(i, j int) bool) int {
+	k := 0
+	for k < n && k < m && eq(k, k) {
+		k++
+	}
+
+	return k
+}
+
+func commonSuffixLen(n, m int, eq func(i, j int) bool) int {
+	k := 0
+	for k < n && k < m && eq(n-1-k, m-1-k) {
+		k++
+	}
+
+	return k
+}
@@ -0,0 +1,240 @@
+package reqlog_test
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+func TestDiffRequests(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		original reqlog.RequestLog
+		modified reqlog.RequestLog
+		exp      reqlog.RequestDiff
+	}{
+		{
+			name: "request line",
+			original: reqlog.RequestLog{
+				Method: http.MethodGet,
+				URL:    mustParseURL(t, "https://example.com/users?id=1"),
+				Proto:  "HTTP/1.1",
+			},
+			modified: reqlog.RequestLog{
+				Method: http.MethodPost,
+				URL:    mustParseURL(t, "https://example.com/users?id=2"),
+				Proto:  "HTTP/1.1",
+			},
+			exp: reqlog.RequestDiff{
+				Method:  reqlog.StringDiff{Original: "GET", Modified: "POST"},
+				URL:     reqlog.StringDiff{Original: "https://example.com/users?id=1", Modified: "https://example.com/users?id=2"},
+				Proto:   reqlog.StringDiff{Original: "HTTP/1.1", Modified: "HTTP/1.1"},
+				Headers: []reqlog.HeaderDiff{},
+				Body:    reqlog.BodyDiff{Lines: []reqlog.LineDiff{}, ByteRanges: []reqlog.ByteRangeDiff{}},
+			},
+		},
+		{
+			name: "headers",
+			original: reqlog.RequestLog{
+				Header: http.Header{
+					"Accept":        []string{"*/*"},
+					"Authorization": []string{"Bearer foo"},
+					"Cookie":        []string{"a=1", "b=2"},
+					"X-Removed":     []string{"yes"},
+				},
+			},
+			modified: reqlog.RequestLog{
+				Header: http.Header{
+					"accept":        []string{"*/*"},
+					"Authorization": []string{"Bearer bar"},
+					"Cookie":        []string{"b=2", "a=1"},
+					"X-Added":       []string{"yes"},
+				},
+			},
+			exp: reqlog.RequestDiff{
+				Headers: []reqlog.HeaderDiff{
+					{Name: "Accept", Op: reqlog.DiffOpEqual, Original: []string{"*/*"}, Modified: []string{"*/*"}},
+					{
+						Name:     "Authorization",
+						Op:       reqlog.DiffOpChanged,
+						Original: []string{"Bearer foo"},
+						Modified: []string{"Bearer bar"},
+					},
+					{Name: "Cookie", Op: reqlog.DiffOpChanged, Original: []string{"a=1", "b=2"}, Modified: []string{"b=2", "a=1"}},
+					{Name: "X-Added", Op: reqlog.DiffOpAdded, Modified: []string{"yes"}},
+					{Name: "X-Removed", Op: reqlog.DiffOpRemoved, Original: []string{"yes"}},
+				},
+				Body: reqlog.BodyDiff{Lines: []reqlog.LineDiff{}, ByteRanges: []reqlog.ByteRangeDiff{}},
+			},
+		},
+		{
+			name:     "text body",
+			original: reqlog.RequestLog{Body: []byte("{\r\n  \"id\": 1,\r\n  \"name\": \"foo\"\r\n}")},
+			modified: reqlog.RequestLog{Body: []byte("{\n  \"id\": 2,\n  \"name\": \"foo\",\n  \"admin\": true\n}")},
+			exp: reqlog.RequestDiff{
+				Headers: []reqlog.HeaderDiff{},
+				Body: reqlog.BodyDiff{
+					OriginalSize: 33,
+					ModifiedSize: 47,
+					Lines: []reqlog.LineDiff{
+						{Op: reqlog.DiffOpEqual, Text: "{", OriginalLine: 1, ModifiedLine: 1},
+						{Op: reqlog.DiffOpRemoved, Text: `  "id": 1,`, OriginalLine: 2},
+						{Op: reqlog.DiffOpRemoved, Text: `  "name": "foo"`, OriginalLine: 3},
+						{Op: reqlog.DiffOpAdded, Text: `  "id": 2,`, ModifiedLine: 2},
+						{Op: reqlog.DiffOpAdded, Text: `  "name": "foo",`, ModifiedLine: 3},
+						{Op: reqlog.DiffOpAdded, Text: `  "admin": true`, ModifiedLine: 4},
+						{Op: reqlog.DiffOpEqual, Text: "}", OriginalLine: 4, ModifiedLine: 5},
+					},
+					ByteRanges: []reqlog.ByteRangeDiff{},
+				},
+			},
+		},
+		{
+			name:     "text body, lines moved",
+			original: reqlog.RequestLog{Body: []byte("a\nb\nc\nd")},
+			modified: reqlog.RequestLog{Body: []byte("b\nc\na\nd")},
+			exp: reqlog.RequestDiff{
+				Headers: []reqlog.HeaderDiff{},
+				Body: reqlog.BodyDiff{
+					OriginalSize: 7,
+					ModifiedSize: 7,
+					Lines: []reqlog.LineDiff{
+						{Op: reqlog.DiffOpRemoved, Text: "a", OriginalLine: 1},
+						{Op: reqlog.DiffOpEqual, Text: "b", OriginalLine: 2, ModifiedLine: 1},
+						{Op: reqlog.DiffOpEqual, Text: "c", OriginalLine: 3, ModifiedLine: 2},
+						{Op: reqlog.DiffOpAdded, Text: "a", ModifiedLine: 3},
+						{Op: reqlog.DiffOpEqual, Text: "d", OriginalLine: 4, ModifiedLine: 4},
+					},
+					ByteRanges: []reqlog.ByteRangeDiff{},
+				},
+			},
+		},
+		{
+			name:     "text body, added",
+			original: reqlog.RequestLog{},
+			modified: reqlog.RequestLog{Body: []byte("foo=bar")},
+			exp: reqlog.RequestDiff{
+				Headers: []reqlog.HeaderDiff{},
+				Body: reqlog.BodyDiff{
+					ModifiedSize: 7,
+					Lines:        []reqlog.LineDiff{{Op: reqlog.DiffOpAdded, Text: "foo=bar", ModifiedLine: 1}},
+					ByteRanges:   []reqlog.ByteRangeDiff{},
+				},
+			},
+		},
+		{
+			name:     "binary body",
+			original: reqlog.RequestLog{Body: []byte{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06}},
+			modified: reqlog.RequestLog{Body: []byte{0x00, 0xff, 0x02, 0x03, 0x05, 0x06, 0x07}},
+			exp: reqlog.RequestDiff{
+				Headers: []reqlog.HeaderDiff{},
+				Body: reqlog.BodyDiff{
+					Binary:       true,
+					OriginalSize: 7,
+					ModifiedSize: 7,
+					Lines:        []reqlog.LineDiff{},
+					ByteRanges: []reqlog.ByteRangeDiff{
+						{OriginalOffset: 1, OriginalLength: 1, ModifiedOffset: 1, ModifiedLength: 1},
+						{OriginalOffset: 4, OriginalLength: 1, ModifiedOffset: 4},
+						{OriginalOffset: 7, ModifiedOffset: 6, ModifiedLength: 1},
+					},
+				},
+			},
+		},
+		{
+			name:     "binary body, equal",
+			original: reqlog.RequestLog{Body: []byte{0x00, 0xff}},
+			modified: reqlog.RequestLog{Body: []byte{0x00, 0xff}},
+			exp: reqlog.RequestDiff{
+				Headers: []reqlog.HeaderDiff{},
+				Body: reqlog.BodyDiff{
+					Binary:       true,
+					OriginalSize: 2,
+					ModifiedSize: 2,
+					Lines:        []reqlog.LineDiff{},
+					ByteRanges:   []reqlog.ByteRangeDiff{},
+				},
+			},
+		},
+		{
+			name:     "large binary body",
+			original: reqlog.RequestLog{Body: append([]byte{0x00}, bytes.Repeat([]byte{0x01}, 10000)...)},
+			modified: reqlog.RequestLog{Body: append([]byte{0x00}, bytes.Repeat([]byte{0x02}, 9000)...)},
+			exp: reqlog.RequestDiff{
+				Headers: []reqlog.HeaderDiff{},
+				Body: reqlog.BodyDiff{
+					Binary:       true,
+					OriginalSize: 10001,
+					ModifiedSize: 9001,
+					Lines:        []reqlog.LineDiff{},
+					ByteRanges: []reqlog.ByteRangeDiff{
+						{OriginalOffset: 1, OriginalLength: 10000, ModifiedOffset: 1, ModifiedLength: 9000},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := reqlog.DiffRequests(tt.original, tt.modified)
+
+			if diff := cmp.Diff(tt.exp, got); diff != "" {
+				t.Fatalf("request diff not equal (-exp, +got):\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestDiffRequestsMaxEdits(t *testing.T) {
+	t.Parallel()
+
+	// Every line differs, so the edit script exceeds the maximum number of
+	// edits and the lines are removed and added as a whole.
+	var original, modified []string
+	for i := 0; i < 800; i++ {
+		original = append(original, "a")
+		modified = append(modified, "b")
+	}
+
+	got := reqlog.DiffRequests(
+		reqlog.RequestLog{Body: []byte("start\n" + strings.Join(original, "\n") + "\nend")},
+		reqlog.RequestLog{Body: []byte("start\n" + strings.Join(modified, "\n") + "\nend")},
+	)
+
+	if exp := 2 + 2*800; len(got.Body.Lines) != exp {
+		t.Fatalf("incorrect number of lines (expected: %v, got: %v)", exp, len(got.Body.Lines))
+	}
+
+	for i, line := range got.Body.Lines {
+		var exp reqlog.DiffOp
+
+		switch {
+		case i == 0 || i == len(got.Body.Lines)-1:
+			exp = reqlog.DiffOpEqual
+		case i <= 800:
+			exp = reqlog.DiffOpRemoved
+		default:
+			exp = reqlog.DiffOpAdded
+		}
+
+		if line.Op != exp {
+			t.Fatalf("incorrect op for line %v (expected: %v, got: %v)", i, exp, line.Op)
+		}
+	}
+
+	if last := got.Body.Lines[len(got.Body.Lines)-1]; last.OriginalLine != 802 || last.ModifiedLine != 802 {
+		t.Fatalf("incorrect line numbers of last line (expected: 802, got: %v, %v)", last.OriginalLine, last.ModifiedLine)
+	}
+}
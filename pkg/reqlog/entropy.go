@@ -0,0 +1,95 @@
+package reqlog
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// tokenDelimiters are the characters (besides whitespace) that values are split
+// on to find tokens, for the `req.maxTokenEntropy` and `res.maxTokenEntropy`
+// search keys.
+const tokenDelimiters = `&=;,:"'<>(){}[]?#`
+
+func isTokenDelimiter(r rune) bool {
+	return unicode.IsSpace(r) || strings.ContainsRune(tokenDelimiters, r)
+}
+
+// maxTokenEntropy returns the Shannon entropy (in bits per byte) of the token
+// with the highest entropy in values.
+func maxTokenEntropy(values ...string) float64 {
+	max := 0.0
+
+	for _, value := range values {
+		for _, token := range strings.FieldsFunc(value, isTokenDelimiter) {
+			if entropy := shannonEntropy(token); entropy > max {
+				max = entropy
+			}
+		}
+	}
+
+	return max
+}
+
+func shannonEntropy(s string) float64 {
+	if s == "" {
+		return 0
+	}
+
+	var counts [256]int
+	for i := 0; i < len(s); i++ {
+		counts[s[i]]++
+	}
+
+	entropy := 0.0
+	n := float64(len(s))
+
+	for _, count := range counts {
+		if count == 0 {
+			continue
+		}
+
+		p := float64(count) / n
+		entropy -= p * math.Log2(p)
+	}
+
+	return entropy
+}
+
+func formatEntropy(entropy float64) string {
+	return strconv.FormatFloat(entropy, 'f', 2, 64)
+}
+
+func (reqLog RequestLog) maxTokenEntropy() float64 {
+	values := headerValues(reqLog.Header)
+	values = append(values, string(reqLog.Body))
+
+	if reqLog.URL != nil {
+		values = append(values, reqLog.URL.String())
+	}
+
+	return maxTokenEntropy(values...)
+}
+
+func (resLog ResponseLog) maxTokenEntropy() float64 {
+	values := headerValues(resLog.Header)
+
+	// Errors reading a body from disk are ignored; only the headers are
+	// considered then.
+	if body, err := resLog.ReadBody(); err == nil {
+		values = append(values, string(body))
+	}
+
+	return maxTokenEntropy(values...)
+}
+
+func headerValues(header http.Header) []string {
+	var values []string
+	for _, v := range header {
+		values = append(values, v...)
+	}
+
+	return values
+}
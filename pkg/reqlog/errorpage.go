@@ -0,0 +1,49 @@
+package reqlog
+
+import "regexp"
+
+// defaultErrorPageSignatures match response bodies of error pages and stack
+// traces of common languages and frameworks.
+func defaultErrorPageSignatures() []*regexp.Regexp {
+	return []*regexp.Regexp{
+		// Java stack trace frame, e.g. `at com.example.Foo.bar(Foo.java:42)`.
+		regexp.MustCompile(`\bat [\w$.]+\([\w$]+\.java:\d+\)`),
+		// Spring Boot.
+		regexp.MustCompile(`Whitelabel Error Page`),
+		// Python.
+		regexp.MustCompile(`Traceback \(most recent call last\)`),
+		// PHP.
+		regexp.MustCompile(`(?:Fatal error|Parse error|Warning)</b>?:.+ on line <b>?\d+`),
+		// ASP.NET.
+		regexp.MustCompile(`Server Error in '[^']*' Application`),
+		// Django debug page.
+		regexp.MustCompile(`You're seeing this error because you have <code>DEBUG = True</code>`),
+		// Ruby on Rails.
+		regexp.MustCompile(`Action Controller: Exception caught`),
+		// Go panic.
+		regexp.MustCompile(`goroutine \d+ \[running\]`),
+		// Node.js stack trace frame, e.g. `at foo (/app/index.js:12:34)`.
+		regexp.MustCompile(`\bat .+ \(.+\.js:\d+:\d+\)`),
+	}
+}
+
+// isErrorPage returns true if the response body matches any of the error page
+// signatures, regardless of the status code.
+func isErrorPage(rl ResponseLog, signatures []*regexp.Regexp) bool {
+	if len(signatures) == 0 {
+		return false
+	}
+
+	body, err := rl.ReadBody()
+	if err != nil || len(body) == 0 {
+		return false
+	}
+
+	for _, sig := range signatures {
+		if sig.Match(body) {
+			return true
+		}
+	}
+
+	return false
+}
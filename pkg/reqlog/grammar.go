@@ -0,0 +1,159 @@
+package reqlog
+
+import (
+	"sort"
+
+	"github.com/dstotijn/hetty/pkg/search"
+)
+
+// SearchKeyType is the type of the value a search key resolves to.
+type SearchKeyType string
+
+const (
+	SearchKeyTypeString  SearchKeyType = "string"
+	SearchKeyTypeNumber  SearchKeyType = "number"
+	SearchKeyTypeBoolean SearchKeyType = "boolean"
+)
+
+// SearchKey describes a search key for request logs.
+type SearchKey struct {
+	Name string        `json:"name"`
+	Type SearchKeyType `json:"type"`
+	// FreeText is true if the key's value is searched when matching a bare
+	// string literal.
+	FreeText bool `json:"freeText"`
+}
+
+// SearchGrammar describes the search query language for request logs, for use
+// by editors (e.g. for autocompletion and validation). It's JSON encodable.
+type SearchGrammar struct {
+	Operators   []search.Operator `json:"operators"`
+	KeyPrefixes []string          `json:"keyPrefixes"`
+	// HeaderKeyPrefixes are followed by a header name to form a key, e.g.
+	// `req.headers.content-type`.
+	HeaderKeyPrefixes []string `json:"headerKeyPrefixes"`
+	// HeaderLengthKeySuffix can follow a header key, to resolve to the byte
+	// length of the header value (a number), e.g. `res.headers.set-cookie.length`.
+	HeaderLengthKeySuffix string `json:"headerLengthKeySuffix"`
+	// QueryKeyPrefix is followed by a query parameter name to form a key, e.g.
+	// `req.query.redirect_uri`.
+	QueryKeyPrefix string `json:"queryKeyPrefix"`
+	// JSONKeyPrefixes are followed by a path into a JSON body to form a key,
+	// e.g. `req.body.json.user.id`.
+	JSONKeyPrefixes []string    `json:"jsonKeyPrefixes"`
+	Keys            []SearchKey `json:"keys"`
+	Macros          []string    `json:"macros"`
+	Functions       []string    `json:"functions"`
+}
+
+// searchKeyTypes holds the types of search keys that don't resolve to a plain
+// string.
+var searchKeyTypes = map[string]SearchKeyType{
+	"req.nonStandardMethod":   SearchKeyTypeBoolean,
+	"req.bodySize":            SearchKeyTypeNumber,
+	"req.bodyLength":          SearchKeyTypeNumber,
+	"req.timing.dnsMs":        SearchKeyTypeNumber,
+	"req.timing.connectMs":    SearchKeyTypeNumber,
+	"req.timing.tlsMs":        SearchKeyTypeNumber,
+	"req.timing.responseMs":   SearchKeyTypeNumber,
+	"req.crossOriginReferer":  SearchKeyTypeBoolean,
+	"req.crossSite":           SearchKeyTypeBoolean,
+	"req.bodyMalformed":       SearchKeyTypeBoolean,
+	"req.hasResponse":         SearchKeyTypeBoolean,
+	"req.hasApiKey":           SearchKeyTypeBoolean,
+	"req.maxTokenEntropy":     SearchKeyTypeNumber,
+	"req.cookieCount":         SearchKeyTypeNumber,
+	"req.bodySizeDelta":       SearchKeyTypeNumber,
+	"req.hasReflectedParam":   SearchKeyTypeBoolean,
+	"req.isTracker":           SearchKeyTypeBoolean,
+	"req.targetsPrivateIP":    SearchKeyTypeBoolean,
+	"req.pipelined":           SearchKeyTypeBoolean,
+	"req.malformedProtocol":   SearchKeyTypeBoolean,
+	"req.afterLogin":          SearchKeyTypeBoolean,
+	"req.cacheableByProxy":    SearchKeyTypeBoolean,
+	"req.hasIdempotencyKey":   SearchKeyTypeBoolean,
+	"req.csrfCandidate":       SearchKeyTypeBoolean,
+	"res.statusCode":          SearchKeyTypeNumber,
+	"res.bodySize":            SearchKeyTypeNumber,
+	"res.bodyLength":          SearchKeyTypeNumber,
+	"res.latency":             SearchKeyTypeNumber,
+	"res.duration":            SearchKeyTypeNumber,
+	"res.size":                SearchKeyTypeNumber,
+	"res.hasInsecureCookie":   SearchKeyTypeBoolean,
+	"res.hasCsp":              SearchKeyTypeBoolean,
+	"res.charsetMismatch":     SearchKeyTypeBoolean,
+	"res.isErrorPage":         SearchKeyTypeBoolean,
+	"res.cookieCount":         SearchKeyTypeNumber,
+	"res.cookieDomainCount":   SearchKeyTypeNumber,
+	"res.maxTokenEntropy":     SearchKeyTypeNumber,
+	"res.contentTypeMismatch": SearchKeyTypeBoolean,
+	"res.unexpectedEncoding":  SearchKeyTypeBoolean,
+}
+
+// SearchGrammarDescriptor returns a description of the supported search
+// operators, keys and macros. Keys are sorted by name.
+func SearchGrammarDescriptor() SearchGrammar {
+	var keys []SearchKey
+
+	addKey := func(name string, freeText bool) {
+		typ, ok := searchKeyTypes[name]
+		if !ok {
+			typ = SearchKeyTypeString
+		}
+
+		keys = append(keys, SearchKey{Name: name, Type: typ, FreeText: freeText})
+	}
+
+	for name := range reqLogSearchKeyFns {
+		addKey(name, true)
+	}
+
+	for name := range resLogSearchKeyFns {
+		addKey(name, true)
+	}
+
+	for name := range reqLogComputedKeyFns {
+		addKey(name, false)
+	}
+
+	for name := range resLogComputedKeyFns {
+		addKey(name, false)
+	}
+
+	for name := range resLogRequestKeyFns {
+		addKey(name, false)
+	}
+
+	for name := range searchKeyAliases {
+		addKey(name, false)
+	}
+
+	customKeysMu.RLock()
+
+	for name := range customReqLogKeyFns {
+		addKey(name, false)
+	}
+
+	for name := range customResLogKeyFns {
+		addKey(name, false)
+	}
+
+	customKeysMu.RUnlock()
+
+	sort.Slice(keys, func(i, j int) bool { return keys[i].Name < keys[j].Name })
+
+	macros := search.Macros()
+	sort.Strings(macros)
+
+	return SearchGrammar{
+		Operators:             search.Operators(),
+		KeyPrefixes:           []string{"req.", "res."},
+		HeaderKeyPrefixes:     []string{reqHeaderKeyPrefix, resHeaderKeyPrefix},
+		HeaderLengthKeySuffix: headerLengthKeySuffix,
+		QueryKeyPrefix:        reqQueryKeyPrefix,
+		JSONKeyPrefixes:       []string{reqBodyJSONKeyPrefix, resBodyJSONKeyPrefix},
+		Keys:                  keys,
+		Macros:                macros,
+		Functions:             search.Functions(),
+	}
+}
@@ -0,0 +1,111 @@
+package reqlog_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/dstotijn/hetty/pkg/reqlog"
+	"github.com/dstotijn/hetty/pkg/search"
+)
+
+func TestSearchGrammarDescriptor(t *testing.T) {
+	t.Parallel()
+
+	raw, err := json.Marshal(reqlog.SearchGrammarDescriptor())
+	if err != nil {
+		t.Fatalf("unexpected error encoding descriptor: %v", err)
+	}
+
+	var got reqlog.SearchGrammar
+	if err := json.Unmarshal(raw, &got); err != nil {
+		t.Fatalf("unexpected error decoding descriptor: %v", err)
+	}
+
+	operators := make(map[string]search.Operator)
+	for _, op := range got.Operators {
+		operators[op.Symbol] = op
+	}
+
+	for _, exp := range []search.Operator{
+		{Symbol: "NOT", Prefix: true},
+		{Symbol: "AND"},
+		{Symbol: "OR"},
+		{Symbol: "="},
+		{Symbol: "!="},
+		{Symbol: ">"},
+		{Symbol: "<"},
+		{Symbol: ">="},
+		{Symbol: "<="},
+		{Symbol: "=~"},
+		{Symbol: "!~"},
+		{Symbol: "~="},
+		{Symbol: "IN"},
+	} {
+		op, ok := operators[exp.Symbol]
+		if !ok {
+			t.Errorf("expected operator %q in descriptor", exp.Symbol)
+			continue
+		}
+
+		if op.Prefix != exp.Prefix {
+			t.Errorf("expected operator %q prefix to be %v, got: %v", exp.Symbol, exp.Prefix, op.Prefix)
+		}
+	}
+
+	if len(got.Operators) != 13 {
+		t.Errorf("expected 13 operators, got: %d", len(got.Operators))
+	}
+
+	if diff := cmp.Diff([]string{"req.", "res."}, got.KeyPrefixes); diff != "" {
+		t.Errorf("key prefixes not equal (-exp, +got):\n%v", diff)
+	}
+
+	if diff := cmp.Diff([]string{"req.headers.", "res.headers."}, got.HeaderKeyPrefixes); diff != "" {
+		t.Errorf("header key prefixes not equal (-exp, +got):\n%v", diff)
+	}
+
+	if got.HeaderLengthKeySuffix != ".length" {
+		t.Errorf("expected header length key suffix: %q, got: %q", ".length", got.HeaderLengthKeySuffix)
+	}
+
+	if diff := cmp.Diff([]string{"extract"}, got.Functions); diff != "" {
+		t.Errorf("functions not equal (-exp, +got):\n%v", diff)
+	}
+
+	if got.QueryKeyPrefix != "req.query." {
+		t.Errorf("expected query key prefix: %q, got: %q", "req.query.", got.QueryKeyPrefix)
+	}
+
+	if diff := cmp.Diff([]string{"req.body.json.", "res.body.json."}, got.JSONKeyPrefixes); diff != "" {
+		t.Errorf("JSON key prefixes not equal (-exp, +got):\n%v", diff)
+	}
+
+	keys := make(map[string]reqlog.SearchKey)
+	for _, key := range got.Keys {
+		keys[key.Name] = key
+	}
+
+	for _, exp := range []reqlog.SearchKey{
+		{Name: "req.method", Type: reqlog.SearchKeyTypeString, FreeText: true},
+		{Name: "res.body", Type: reqlog.SearchKeyTypeString, FreeText: true},
+		{Name: "res.statusCode", Type: reqlog.SearchKeyTypeNumber, FreeText: true},
+		{Name: "req.timing.responseMs", Type: reqlog.SearchKeyTypeNumber},
+		{Name: "req.hasApiKey", Type: reqlog.SearchKeyTypeBoolean},
+		{Name: "res.contentTypeMismatch", Type: reqlog.SearchKeyTypeBoolean},
+	} {
+		if diff := cmp.Diff(exp, keys[exp.Name]); diff != "" {
+			t.Errorf("search key not equal (-exp, +got):\n%v", diff)
+		}
+	}
+
+	macros := make(map[string]bool)
+	for _, name := range got.Macros {
+		macros[name] = true
+	}
+
+	if !macros["insecureCookie"] {
+		t.Errorf("expected macro %q in descriptor, got: %v", "insecureCookie", got.Macros)
+	}
+}
@@ -0,0 +1,49 @@
+package reqlog
+
+import (
+	"encoding/json"
+	"mime"
+	"regexp"
+	"strings"
+)
+
+// graphQLOperationRegexp matches the type and name of a named GraphQL operation
+// at the start of a query document.
+var graphQLOperationRegexp = regexp.MustCompile(`^\s*(?:query|mutation|subscription)\s+([_A-Za-z][_0-9A-Za-z]*)`)
+
+// graphQLRequest is the JSON body of a GraphQL request over HTTP.
+type graphQLRequest struct {
+	Query         string `json:"query"`
+	OperationName string `json:"operationName"`
+}
+
+// graphQLRequest parses the request body as a GraphQL request. It returns false
+// if the body isn't JSON, or has no `query` field.
+func (reqLog RequestLog) graphQLRequest() (graphQLRequest, bool) {
+	mediaType, _, err := mime.ParseMediaType(reqLog.Header.Get("Content-Type"))
+	if err != nil || (mediaType != "application/json" && !strings.HasSuffix(mediaType, "+json")) {
+		return graphQLRequest{}, false
+	}
+
+	var gqlReq graphQLRequest
+	if err := json.Unmarshal(reqLog.Body, &gqlReq); err != nil || gqlReq.Query == "" {
+		return graphQLRequest{}, false
+	}
+
+	return gqlReq, true
+}
+
+// graphQLOperationName returns the operation name of a GraphQL request. If the
+// request doesn't set `operationName`, the name of the operation in the query
+// document is used.
+func graphQLOperationName(gqlReq graphQLRequest) string {
+	if gqlReq.OperationName != "" {
+		return gqlReq.OperationName
+	}
+
+	if match := graphQLOperationRegexp.FindStringSubmatch(gqlReq.Query); match != nil {
+		return match[1]
+	}
+
+	return ""
+}
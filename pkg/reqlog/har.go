@@ -0,0 +1,553 @@
+package reqlog
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/oklog/ulid"
+
+	"github.com/dstotijn/hetty/pkg/proxy"
+	"github.com/dstotijn/hetty/pkg/search"
+)
+
+const harVersion = "1.2"
+
+// HAR 1.2 types, see: http://www.softwareishard.com/blog/har-12-spec/
+type harFile struct {
+	Log harLog `json:"log"`
+}
+
+type harLog struct {
+	Version string     `json:"version"`
+	Creator harCreator `json:"creator"`
+	Entries []harEntry `json:"entries"`
+}
+
+type harCreator struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type harEntry struct {
+	StartedDateTime string      `json:"startedDateTime"`
+	Time            float64     `json:"time"`
+	Request         harRequest  `json:"request"`
+	Response        harResponse `json:"response"`
+	Cache           struct{}    `json:"cache"`
+	Timings         harTimings  `json:"timings"`
+}
+
+type harRequest struct {
+	Method      string       `json:"method"`
+	URL         string       `json:"url"`
+	HTTPVersion string       `json:"httpVersion"`
+	Cookies     []harCookie  `json:"cookies"`
+	Headers     []harNV      `json:"headers"`
+	QueryString []harNV      `json:"queryString"`
+	PostData    *harPostData `json:"postData,omitempty"`
+	HeadersSize int64        `json:"headersSize"`
+	BodySize    int64        `json:"bodySize"`
+}
+
+type harResponse struct {
+	Status      int         `json:"status"`
+	StatusText  string      `json:"statusText"`
+	HTTPVersion string      `json:"httpVersion"`
+	Cookies     []harCookie `json:"cookies"`
+	Headers     []harNV     `json:"headers"`
+	Content     harContent  `json:"content"`
+	RedirectURL string      `json:"redirectURL"`
+	HeadersSize int64       `json:"headersSize"`
+	BodySize    int64       `json:"bodySize"`
+}
+
+type harCookie struct {
+	Name     string `json:"name"`
+	Value    string `json:"value"`
+	Path     string `json:"path,omitempty"`
+	Domain   string `json:"domain,omitempty"`
+	HTTPOnly bool   `json:"httpOnly,omitempty"`
+	Secure   bool   `json:"secure,omitempty"`
+}
+
+type harNV struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type harPostData struct {
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text"`
+}
+
+type harContent struct {
+	Size     int64  `json:"size"`
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text,omitempty"`
+	Encoding string `json:"encoding,omitempty"`
+}
+
+// harTimings are durations in milliseconds. Optional phases are -1 if they
+// don't apply to the request, e.g. `DNS` for reused connections.
+type harTimings struct {
+	Blocked float64 `json:"blocked"`
+	DNS     float64 `json:"dns"`
+	Connect float64 `json:"connect"`
+	Send    float64 `json:"send"`
+	Wait    float64 `json:"wait"`
+	Receive float64 `json:"receive"`
+	SSL     float64 `json:"ssl"`
+}
+
+// ExportHAR writes the request logs as a HAR 1.2 file to w. Entries are sorted
+// chronologically. Response bodies that aren't valid UTF-8 are base64 encoded.
+// Logs without a response get an empty response with status 0, like browsers
+// export failed requests.
+func ExportHAR(w io.Writer, reqLogs []RequestLog) error {
+	sorted := make([]RequestLog, len(reqLogs))
+	copy(sorted, reqLogs)
+
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].ID.Compare(sorted[j].ID) < 0
+	})
+
+	file := harFile{
+		Log: harLog{
+			Version: harVersion,
+			Creator: harCreator{Name: "Hetty"},
+			Entries: make([]harEntry, 0, len(sorted)),
+		},
+	}
+
+	for _, reqLog := range sorted {
+		entry, err := harEntryFromRequestLog(reqLog)
+		if err != nil {
+			return err
+		}
+
+		file.Log.Entries = append(file.Log.Entries, entry)
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	if err := enc.Encode(file); err != nil {
+		return fmt.Errorf("reqlog: could not encode HAR file: %w", err)
+	}
+
+	return nil
+}
+
+// ParseHAR parses the entries of a HAR file as request logs. Their IDs derive
+// from the entries' start times, so imported logs are ordered like they were
+// captured. Entries with status 0 (e.g. blocked requests) have no response.
+func ParseHAR(r io.Reader) ([]RequestLog, error) {
+	var file harFile
+
+	if err := json.NewDecoder(r).Decode(&file); err != nil {
+		return nil, fmt.Errorf("reqlog: could not decode HAR file: %w", err)
+	}
+
+	reqLogs := make([]RequestLog, 0, len(file.Log.Entries))
+
+	for i, entry := range file.Log.Entries {
+		reqLog, err := requestLogFromHAREntry(entry)
+		if err != nil {
+			return nil, fmt.Errorf("reqlog: invalid HAR entry %v: %w", i, err)
+		}
+
+		reqLogs = append(reqLogs, reqLog)
+	}
+
+	return reqLogs, nil
+}
+
+// ExportHAR writes the request logs of the active project that match expr to w,
+// as a HAR 1.2 file. If expr is nil, all request logs are exported.
+func (svc *Service) ExportHAR(ctx context.Context, w io.Writer, expr search.Expression) error {
+	matchCfg := svc.matchConfig
+	filter := FindRequestsFilter{
+		ProjectID:   svc.ActiveProjectID,
+		SearchExpr:  expr,
+		MatchConfig: &matchCfg,
+	}
+
+	filter, err := svc.withCandidates(ctx, filter)
+	if err != nil {
+		return err
+	}
+
+	reqLogs, err := svc.repo.FindRequestLogs(ctx, filter, svc.scope)
+	if err != nil {
+		return fmt.Errorf("reqlog: could not find requests: %w", err)
+	}
+
+	return ExportHAR(w, reqLogs)
+}
+
+// ImportHAR stores the entries of a HAR file as request logs of the active
+// project, and returns the number of stored logs. The file is parsed fully
+// before storing, so nothing is stored if it's invalid.
+func (svc *Service) ImportHAR(ctx context.Context, r io.Reader) (int, error) {
+	if svc.ActiveProjectID.Compare(ulid.ULID{}) == 0 {
+		return 0, ErrProjectIDMustBeSet
+	}
+
+	reqLogs, err := ParseHAR(r)
+	if err != nil {
+		return 0, err
+	}
+
+	for i, reqLog := range reqLogs {
+		reqLog.ProjectID = svc.ActiveProjectID
+		resLog := reqLog.Response
+		reqLog.Response = nil
+
+		if err := svc.repo.StoreRequestLog(ctx, reqLog); err != nil {
+			return i, fmt.Errorf("reqlog: could not store request log: %w", err)
+		}
+
+		svc.index.addRequest(reqLog)
+
+		key, hasKey := newSiteMapKey(reqLog)
+		if hasKey {
+			svc.siteMap.addRequest(reqLog.ProjectID, key)
+		}
+
+		if resLog == nil {
+			continue
+		}
+
+		if err := svc.repo.StoreResponseLog(ctx, reqLog.ID, *resLog); err != nil {
+			return i, fmt.Errorf("reqlog: could not store response log: %w", err)
+		}
+
+		if hasKey {
+			svc.siteMap.addResponse(reqLog.ProjectID, key, resLog.StatusCode)
+		}
+
+		svc.index.addResponse(reqLog.ProjectID, reqLog.ID, *resLog)
+	}
+
+	return len(reqLogs), nil
+}
+
+func harEntryFromRequestLog(reqLog RequestLog) (harEntry, error) {
+	reqURL := ""
+	if reqLog.URL != nil {
+		reqURL = reqLog.URL.String()
+	}
+
+	entry := harEntry{
+		StartedDateTime: ulid.Time(reqLog.ID.Time()).UTC().Format(time.RFC3339Nano),
+		Request: harRequest{
+			Method:      reqLog.Method,
+			URL:         reqURL,
+			HTTPVersion: reqLog.Proto,
+			Cookies:     harCookies((&http.Request{Header: reqLog.Header}).Cookies()),
+			Headers:     harHeaders(reqLog.Header),
+			QueryString: harQueryString(reqLog.URL),
+			HeadersSize: -1,
+			BodySize:    int64(len(reqLog.Body)),
+		},
+		Response: harResponse{
+			Cookies: []harCookie{},
+			Headers: []harNV{},
+			// The response is unknown, so its sizes are too.
+			HeadersSize: -1,
+			BodySize:    -1,
+		},
+		Timings: harTimings{Blocked: -1, DNS: -1, Connect: -1, SSL: -1},
+	}
+
+	if len(reqLog.Body) > 0 {
+		entry.Request.PostData = &harPostData{
+			MimeType: reqLog.Header.Get("Content-Type"),
+			Text:     string(reqLog.Body),
+		}
+	}
+
+	if reqLog.Response == nil {
+		return entry, nil
+	}
+
+	resLog := reqLog.Response
+
+	body, err := resLog.ReadBody()
+	if err != nil {
+		return harEntry{}, err
+	}
+
+	entry.Response = harResponse{
+		Status:      resLog.StatusCode,
+		StatusText:  strings.TrimPrefix(resLog.Status, strconv.Itoa(resLog.StatusCode)+" "),
+		HTTPVersion: resLog.Proto,
+		Cookies:     harCookies((&http.Response{Header: resLog.Header}).Cookies()),
+		Headers:     harHeaders(resLog.Header),
+		Content: harContent{
+			Size:     int64(len(body)),
+			MimeType: resLog.Header.Get("Content-Type"),
+		},
+		RedirectURL: resLog.Header.Get("Location"),
+		HeadersSize: -1,
+		BodySize:    -1,
+	}
+
+	// Logged bodies are decoded, so their size only equals the transferred size
+	// if the response had no `Content-Encoding`, unless the size as received was
+	// recorded.
+	switch {
+	case resLog.Size > 0:
+		entry.Response.BodySize = resLog.Size
+	case isPlainResponseBody(resLog.Header):
+		entry.Response.BodySize = int64(len(body))
+	}
+
+	if utf8.Valid(body) {
+		entry.Response.Content.Text = string(body)
+	} else {
+		entry.Response.Content.Text = base64.StdEncoding.EncodeToString(body)
+		entry.Response.Content.Encoding = "base64"
+	}
+
+	entry.Timings = harTimingsFromTiming(resLog.Timing, resLog.Duration)
+	entry.Time = entry.Timings.total()
+
+	return entry, nil
+}
+
+func requestLogFromHAREntry(entry harEntry) (RequestLog, error) {
+	startedAt, err := time.Parse(time.RFC3339Nano, entry.StartedDateTime)
+	if err != nil {
+		return RequestLog{}, fmt.Errorf("invalid start time: %w", err)
+	}
+
+	u, err := url.Parse(entry.Request.URL)
+	if err != nil {
+		return RequestLog{}, fmt.Errorf("invalid URL: %w", err)
+	}
+
+	reqLog := RequestLog{
+		ID:     ulid.MustNew(ulid.Timestamp(startedAt), ulidEntropy),
+		URL:    u,
+		Method: entry.Request.Method,
+		Proto:  entry.Request.HTTPVersion,
+		Header: headerFromHAR(entry.Request.Headers),
+	}
+
+	if entry.Request.PostData != nil && entry.Request.PostData.Text != "" {
+		reqLog.Body = []byte(entry.Request.PostData.Text)
+	}
+
+	if entry.Response.Status == 0 {
+		return reqLog, nil
+	}
+
+	resLog := &ResponseLog{
+		Proto:      entry.Response.HTTPVersion,
+		StatusCode: entry.Response.Status,
+		Status:     strings.TrimSpace(strconv.Itoa(entry.Response.Status) + " " + entry.Response.StatusText),
+		Header:     headerFromHAR(entry.Response.Headers),
+		Timing:     timingFromHAR(entry.Timings),
+	}
+	resLog.Latency = resLog.Timing.Total
+
+	if entry.Response.BodySize > 0 {
+		resLog.Size = entry.Response.BodySize
+	}
+
+	if entry.Timings.Receive > 0 {
+		resLog.Duration = resLog.Timing.Total + duration(entry.Timings.Receive)
+	}
+
+	switch entry.Response.Content.Encoding {
+	case "":
+		resLog.Body = []byte(entry.Response.Content.Text)
+	case "base64":
+		resLog.Body, err = base64.StdEncoding.DecodeString(entry.Response.Content.Text)
+		if err != nil {
+			return RequestLog{}, fmt.Errorf("invalid base64 response body: %w", err)
+		}
+	default:
+		return RequestLog{}, fmt.Errorf("unsupported response body encoding %q", entry.Response.Content.Encoding)
+	}
+
+	if len(resLog.Body) == 0 {
+		resLog.Body = nil
+	}
+
+	reqLog.Response = resLog
+
+	return reqLog, nil
+}
+
+// harHeaders returns the header fields, sorted by name.
+func harHeaders(header http.Header) []harNV {
+	keys := make([]string, 0, len(header))
+	for key := range header {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	fields := []harNV{}
+
+	for _, key := range keys {
+		for _, value := range header[key] {
+			fields = append(fields, harNV{Name: key, Value: value})
+		}
+	}
+
+	return fields
+}
+
+// headerFromHAR returns the header fields of a HAR request or response. HTTP/2
+// pseudo-header fields (e.g. `:authority`), as exported by browsers, are left
+// out.
+func headerFromHAR(fields []harNV) http.Header {
+	header := make(http.Header, len(fields))
+
+	for _, field := range fields {
+		if strings.HasPrefix(field.Name, ":") {
+			continue
+		}
+
+		header.Add(field.Name, field.Value)
+	}
+
+	return header
+}
+
+func harQueryString(u *url.URL) []harNV {
+	params := []harNV{}
+
+	if u == nil {
+		return params
+	}
+
+	query := u.Query()
+
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		for _, value := range query[key] {
+			params = append(params, harNV{Name: key, Value: value})
+		}
+	}
+
+	return params
+}
+
+func harCookies(cookies []*http.Cookie) []harCookie {
+	harCookies := make([]harCookie, len(cookies))
+
+	for i, cookie := range cookies {
+		harCookies[i] = harCookie{
+			Name:     cookie.Name,
+			Value:    cookie.Value,
+			Path:     cookie.Path,
+			Domain:   cookie.Domain,
+			HTTPOnly: cookie.HttpOnly,
+			Secure:   cookie.Secure,
+		}
+	}
+
+	return harCookies
+}
+
+func isPlainResponseBody(header http.Header) bool {
+	encoding := header.Get("Content-Encoding")
+	return encoding == "" || strings.EqualFold(encoding, "identity")
+}
+
+// harTimingsFromTiming converts logged timing, and the duration of the full
+// response, to HAR timings. HAR's `connect` includes the TLS handshake, whereas
+// `proxy.Timing.Connect` doesn't. Sending isn't recorded, so it's 0, as is
+// receiving if the duration wasn't recorded.
+func harTimingsFromTiming(timing proxy.Timing, total time.Duration) harTimings {
+	timings := harTimings{
+		Blocked: -1,
+		DNS:     optionalMillis(timing.DNS),
+		Connect: optionalMillis(timing.Connect),
+		Wait:    millis(timing.Response),
+		SSL:     optionalMillis(timing.TLSHandshake),
+	}
+
+	if total > timing.Total {
+		timings.Receive = millis(total - timing.Total)
+	}
+
+	if timings.Connect >= 0 && timings.SSL >= 0 {
+		timings.Connect += timings.SSL
+	}
+
+	return timings
+}
+
+func timingFromHAR(timings harTimings) proxy.Timing {
+	timing := proxy.Timing{
+		DNS:          duration(timings.DNS),
+		Connect:      duration(timings.Connect),
+		TLSHandshake: duration(timings.SSL),
+		Response:     duration(timings.Wait),
+	}
+
+	if timing.Connect >= timing.TLSHandshake {
+		timing.Connect -= timing.TLSHandshake
+	}
+
+	timing.Total = timing.DNS + timing.Connect + timing.TLSHandshake + duration(timings.Send) + timing.Response
+
+	return timing
+}
+
+// total returns the sum of the timings that apply, which HAR requires to equal
+// the entry's `time`.
+func (t harTimings) total() float64 {
+	total := 0.0
+
+	for _, ms := range []float64{t.Blocked, t.DNS, t.Connect, t.Send, t.Wait, t.Receive} {
+		if ms > 0 {
+			total += ms
+		}
+	}
+
+	return total
+}
+
+func millis(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+// optionalMillis returns -1 for zero durations, as these phases didn't occur.
+func optionalMillis(d time.Duration) float64 {
+	if d == 0 {
+		return -1
+	}
+
+	return millis(d)
+}
+
+// duration returns the duration of a HAR timing, treating -1 as zero.
+func duration(ms float64) time.Duration {
+	if ms <= 0 {
+		return 0
+	}
+
+	return time.Duration(ms * float64(time.Millisecond))
+}
@@ -0,0 +1,288 @@
+package reqlog_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/oklog/ulid"
+
+	"github.com/dstotijn/hetty/pkg/proxy"
+	"github.com/dstotijn/hetty/pkg/reqlog"
+	"github.com/dstotijn/hetty/pkg/scope"
+)
+
+func TestExportHARRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	reqLogs := []reqlog.RequestLog{
+		{
+			// Logs are exported chronologically, regardless of input order.
+			ID:     ulid.MustNew(ulid.Timestamp(now.Add(time.Second)), ulidEntropy),
+			Method: "GET",
+			URL:    mustParseURL(t, "https://example.com/blocked"),
+			Proto:  "HTTP/1.1",
+			Header: http.Header{},
+		},
+		{
+			ID:     ulid.MustNew(ulid.Timestamp(now), ulidEntropy),
+			Method: "POST",
+			URL:    mustParseURL(t, "https://example.com/api?foo=bar"),
+			Proto:  "HTTP/1.1",
+			Header: http.Header{
+				"Content-Type": []string{"application/json"},
+				"Cookie":       []string{"session=foo"},
+			},
+			Body: []byte(`{"name":"foo"}`),
+			Response: &reqlog.ResponseLog{
+				Proto:      "HTTP/1.1",
+				StatusCode: 200,
+				Status:     "200 OK",
+				Header:     http.Header{"Content-Type": []string{"application/octet-stream"}},
+				Body:       []byte{0xff, 0xfe, 0x00},
+				Timing: proxy.Timing{
+					DNS:          5 * time.Millisecond,
+					Connect:      10 * time.Millisecond,
+					TLSHandshake: 20 * time.Millisecond,
+					Response:     30 * time.Millisecond,
+					Total:        65 * time.Millisecond,
+				},
+				Latency:  65 * time.Millisecond,
+				Duration: 80 * time.Millisecond,
+				Size:     3,
+			},
+		},
+	}
+
+	buf := bytes.Buffer{}
+
+	if err := reqlog.ExportHAR(&buf, reqLogs); err != nil {
+		t.Fatalf("unexpected error (expected: nil, got: %v)", err)
+	}
+
+	var har struct {
+		Log struct {
+			Version string
+			Entries []struct {
+				Time     float64
+				Response struct {
+					Status  int
+					Content struct {
+						Encoding string
+					}
+				}
+				Timings map[string]float64
+			}
+		}
+	}
+
+	if err := json.Unmarshal(buf.Bytes(), &har); err != nil {
+		t.Fatalf("unexpected error decoding HAR file: %v", err)
+	}
+
+	if har.Log.Version != "1.2" || len(har.Log.Entries) != 2 {
+		t.Fatalf("unexpected HAR log (version: %v, entries: %v)", har.Log.Version, len(har.Log.Entries))
+	}
+
+	entry := har.Log.Entries[0]
+
+	// HAR's `connect` includes the TLS handshake, and `time` is the sum of the
+	// timings that apply.
+	expTimings := map[string]float64{
+		"blocked": -1, "dns": 5, "connect": 30, "ssl": 20, "send": 0, "wait": 30, "receive": 15,
+	}
+	if diff := cmp.Diff(expTimings, entry.Timings); diff != "" {
+		t.Errorf("timings not equal (-exp, +got):\n%v", diff)
+	}
+
+	if entry.Time != 80 {
+		t.Errorf("incorrect time (expected: 80, got: %v)", entry.Time)
+	}
+
+	if entry.Response.Content.Encoding != "base64" {
+		t.Errorf("expected binary response body to be base64 encoded, got: %q", entry.Response.Content.Encoding)
+	}
+
+	if status := har.Log.Entries[1].Response.Status; status != 0 {
+		t.Errorf("expected status 0 for log without response, got: %v", status)
+	}
+
+	got, err := reqlog.ParseHAR(&buf)
+	if err != nil {
+		t.Fatalf("unexpected error parsing HAR file: %v", err)
+	}
+
+	exp := []reqlog.RequestLog{reqLogs[1], reqLogs[0]}
+
+	if len(got) != len(exp) {
+		t.Fatalf("incorrect number of request logs (expected: %v, got: %v)", len(exp), len(got))
+	}
+
+	// Imported logs get new IDs, with the same timestamps.
+	for i := range got {
+		if got[i].ID.Time() != exp[i].ID.Time() {
+			t.Errorf("incorrect ID timestamp (expected: %v, got: %v)", exp[i].ID.Time(), got[i].ID.Time())
+		}
+
+		got[i].ID = exp[i].ID
+	}
+
+	if diff := cmp.Diff(exp, got); diff != "" {
+		t.Fatalf("request logs not equal (-exp, +got):\n%v", diff)
+	}
+}
+
+func TestParseHAR(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		har           string
+		expectedError error
+	}{
+		{
+			name: "browser export",
+			har: `{"log": {"version": "1.2", "entries": [{
+				"startedDateTime": "2022-01-02T15:04:05.123Z",
+				"request": {
+					"method": "GET",
+					"url": "https://example.com/",
+					"httpVersion": "http/2.0",
+					"headers": [{"name": ":authority", "value": "example.com"}, {"name": "accept", "value": "*/*"}]
+				},
+				"response": {"status": 204, "statusText": "", "headers": [], "content": {"size": 0}},
+				"timings": {"blocked": 1.5, "dns": -1, "connect": -1, "send": 0, "wait": 12.5, "receive": 0.5}
+			}]}}`,
+		},
+		{
+			name:          "invalid JSON",
+			har:           `{"log": `,
+			expectedError: errors.New("reqlog: could not decode HAR file: unexpected EOF"),
+		},
+		{
+			name: "invalid start time",
+			har:  `{"log": {"entries": [{"startedDateTime": "yesterday", "request": {"url": "/"}}]}}`,
+			expectedError: errors.New(`reqlog: invalid HAR entry 0: invalid start time: parsing time "yesterday" ` +
+				`as "2006-01-02T15:04:05.999999999Z07:00": cannot parse "yesterday" as "2006"`),
+		},
+		{
+			name: "unsupported body encoding",
+			har: `{"log": {"entries": [{
+				"startedDateTime": "2022-01-02T15:04:05Z",
+				"request": {"url": "/"},
+				"response": {"status": 200, "content": {"text": "foo", "encoding": "hex"}}
+			}]}}`,
+			expectedError: errors.New(`reqlog: invalid HAR entry 0: unsupported response body encoding "hex"`),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := reqlog.ParseHAR(strings.NewReader(tt.har))
+			assertError(t, tt.expectedError, err)
+
+			if tt.expectedError != nil {
+				return
+			}
+
+			if len(got) != 1 {
+				t.Fatalf("incorrect number of request logs (expected: 1, got: %v)", len(got))
+			}
+
+			// HTTP/2 pseudo-header fields are left out.
+			expHeader := http.Header{"Accept": []string{"*/*"}}
+			if diff := cmp.Diff(expHeader, got[0].Header); diff != "" {
+				t.Errorf("header not equal (-exp, +got):\n%v", diff)
+			}
+
+			if got[0].Response == nil || got[0].Response.Status != "204" {
+				t.Fatalf("incorrect response: %+v", got[0].Response)
+			}
+
+			if exp := 12500 * time.Microsecond; got[0].Response.Timing.Total != exp {
+				t.Errorf("incorrect total timing (expected: %v, got: %v)", exp, got[0].Response.Timing.Total)
+			}
+		})
+	}
+}
+
+func TestImportHAR(t *testing.T) {
+	t.Parallel()
+
+	har := `{"log": {"entries": [
+		{"startedDateTime": "2022-01-02T15:04:05Z", "request": {"method": "GET", "url": "https://example.com/"},
+			"response": {"status": 200, "statusText": "OK", "content": {"text": "foo"}}},
+		{"startedDateTime": "2022-01-02T15:04:06Z", "request": {"method": "GET", "url": "https://example.com/x"},
+			"response": {"status": 0}}
+	]}}`
+
+	t.Run("without active project", func(t *testing.T) {
+		t.Parallel()
+
+		svc := reqlog.NewService(reqlog.Config{Repository: &RepoMock{}})
+
+		_, err := svc.ImportHAR(context.Background(), strings.NewReader(har))
+		assertError(t, reqlog.ErrProjectIDMustBeSet, err)
+	})
+
+	t.Run("stores request and response logs", func(t *testing.T) {
+		t.Parallel()
+
+		repoMock := &RepoMock{
+			StoreRequestLogFunc: func(_ context.Context, _ reqlog.RequestLog) error {
+				return nil
+			},
+			StoreResponseLogFunc: func(_ context.Context, _ ulid.ULID, _ reqlog.ResponseLog) error {
+				return nil
+			},
+		}
+		svc := reqlog.NewService(reqlog.Config{
+			Repository: repoMock,
+			Scope:      &scope.Scope{},
+		})
+		svc.ActiveProjectID = ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+
+		n, err := svc.ImportHAR(context.Background(), strings.NewReader(har))
+		assertError(t, nil, err)
+
+		if n != 2 {
+			t.Errorf("incorrect number of imported logs (expected: 2, got: %v)", n)
+		}
+
+		reqCalls := repoMock.StoreRequestLogCalls()
+		if len(reqCalls) != 2 {
+			t.Fatalf("incorrect number of stored request logs (expected: 2, got: %v)", len(reqCalls))
+		}
+
+		for _, call := range reqCalls {
+			if call.ReqLog.ProjectID != svc.ActiveProjectID {
+				t.Errorf("incorrect project ID (expected: %v, got: %v)", svc.ActiveProjectID, call.ReqLog.ProjectID)
+			}
+
+			if call.ReqLog.Response != nil {
+				t.Error("expected response to be stored separately")
+			}
+		}
+
+		// The entry with status 0 has no response.
+		resCalls := repoMock.StoreResponseLogCalls()
+		if len(resCalls) != 1 {
+			t.Fatalf("incorrect number of stored response logs (expected: 1, got: %v)", len(resCalls))
+		}
+
+		if resCalls[0].ReqLogID != reqCalls[0].ReqLog.ID || string(resCalls[0].ResLog.Body) != "foo" {
+			t.Errorf("incorrect stored response log: %+v", resCalls[0])
+		}
+	})
+}
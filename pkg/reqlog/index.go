@@ -0,0 +1,593 @@
+package reqlog
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"regexp/syntax"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/oklog/ulid"
+
+	"github.com/dstotijn/hetty/pkg/search"
+)
+
+// indexTextLimit is the size (in bytes) above which values of text keys aren't
+// indexed, to bound the memory the index takes. Request logs with larger values
+// are candidates for any search of the key.
+const indexTextLimit = 64 << 10
+
+// indexValueKeys are the search keys for which the index holds the request logs
+// per value. Their values are few, so comparisons are evaluated once per value
+// instead of once per request log.
+var indexValueKeys = []string{"req.method", "req.host", "res.statusCode"}
+
+// indexTextKeyFns resolve the search keys for which the index holds the request
+// logs per trigram (three consecutive bytes) of the case folded value. It
+// returns false if the value isn't indexed, e.g. for bodies stored in a file.
+var indexTextKeyFns = map[string]func(reqLog RequestLog) (string, bool){
+	"req.url": func(reqLog RequestLog) (string, bool) {
+		if reqLog.URL == nil {
+			return "", true
+		}
+
+		return reqLog.URL.String(), true
+	},
+	"req.body": func(reqLog RequestLog) (string, bool) {
+		return indexBody(reqLog.Body)
+	},
+	"res.body": func(reqLog RequestLog) (string, bool) {
+		if reqLog.Response == nil {
+			return "", true
+		}
+
+		if reqLog.Response.BodyFile != "" {
+			return "", false
+		}
+
+		return indexBody(reqLog.Response.Body)
+	},
+}
+
+func indexBody(body []byte) (string, bool) {
+	if len(body) > indexTextLimit {
+		return "", false
+	}
+
+	return string(body), true
+}
+
+type trigram [3]byte
+
+// postings are ordinals of request logs in an index, in ascending order.
+type postings []uint32
+
+// index is an in-memory index of the request logs of a single project, used to
+// find the request logs that can match a search expression before matching it
+// (see `compileCandidates`). Like the site map, it's built from the stored
+// request logs when it's first needed, and updated as requests and responses are
+// logged.
+type index struct {
+	mu        sync.RWMutex
+	projectID ulid.ULID
+	loaded    bool
+	// ids are the IDs of the indexed request logs, by ordinal. Ordinals are
+	// assigned in the order request logs are indexed.
+	ids      []ulid.ULID
+	entries  map[ulid.ULID]*indexEntry
+	values   map[string]map[string]postings
+	trigrams map[string]map[trigram]postings
+	// unindexed are the request logs with a value that isn't indexed, per
+	// text key.
+	unindexed map[string]postings
+}
+
+type indexEntry struct {
+	ord         uint32
+	hasResponse bool
+}
+
+// addRequest indexes a logged request log. Request logs of a project other than
+// the loaded one are ignored; they're indexed when the index of their project is
+// built.
+func (idx *index) addRequest(reqLog RequestLog) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	if idx.loaded && idx.projectID.Compare(reqLog.ProjectID) == 0 {
+		idx.addLocked(reqLog)
+	}
+}
+
+// addResponse indexes the logged response of a request log that was indexed
+// before.
+func (idx *index) addResponse(projectID, reqLogID ulid.ULID, resLog ResponseLog) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	if !idx.loaded || idx.projectID.Compare(projectID) != 0 {
+		return
+	}
+
+	entry, ok := idx.entries[reqLogID]
+	if !ok || entry.hasResponse {
+		return
+	}
+
+	entry.hasResponse = true
+	reqLog := RequestLog{Response: &resLog}.withDecodedBodies()
+
+	for _, key := range indexValueKeys {
+		if !strings.HasPrefix(key, "res.") {
+			continue
+		}
+
+		// Request logs without response were indexed by the value of the
+		// key for an absent response.
+		absent := compiler{}.compileKey(key)(RequestLog{})
+		values := idx.values[key]
+
+		if values[absent] = values[absent].remove(entry.ord); len(values[absent]) == 0 {
+			delete(values, absent)
+		}
+
+		idx.addValueLocked(key, entry.ord, reqLog)
+	}
+
+	for key := range indexTextKeyFns {
+		if strings.HasPrefix(key, "res.") {
+			idx.addTextLocked(key, entry.ord, reqLog)
+		}
+	}
+}
+
+// addLocked indexes a request log, unless it's indexed already. The caller must
+// hold the lock.
+func (idx *index) addLocked(reqLog RequestLog) {
+	if _, ok := idx.entries[reqLog.ID]; ok {
+		return
+	}
+
+	ord := uint32(len(idx.ids))
+	idx.ids = append(idx.ids, reqLog.ID)
+	idx.entries[reqLog.ID] = &indexEntry{ord: ord, hasResponse: reqLog.Response != nil}
+
+	// Bodies are indexed as they're matched, i.e. decoded.
+	reqLog = reqLog.withDecodedBodies()
+
+	for _, key := range indexValueKeys {
+		idx.addValueLocked(key, ord, reqLog)
+	}
+
+	for key := range indexTextKeyFns {
+		idx.addTextLocked(key, ord, reqLog)
+	}
+}
+
+func (idx *index) addValueLocked(key string, ord uint32, reqLog RequestLog) {
+	// Value keys don't depend on the match config.
+	value := compiler{}.compileKey(key)(reqLog)
+	idx.values[key][value] = idx.values[key][value].insert(ord)
+}
+
+func (idx *index) addTextLocked(key string, ord uint32, reqLog RequestLog) {
+	text, ok := indexTextKeyFns[key](reqLog)
+	if !ok || len(text) > indexTextLimit {
+		idx.unindexed[key] = idx.unindexed[key].insert(ord)
+		return
+	}
+
+	trigrams := idx.trigrams[key]
+
+	for _, t := range textTrigrams(text) {
+		trigrams[t] = trigrams[t].insert(ord)
+	}
+}
+
+// reset discards the index, so it's built again when it's next needed.
+func (idx *index) reset() {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	idx.loaded = false
+	idx.ids = nil
+	idx.entries = nil
+	idx.values = nil
+	idx.trigrams = nil
+	idx.unindexed = nil
+}
+
+// resetLocked empties the index for projectID. The caller must hold the lock.
+func (idx *index) resetLocked(projectID ulid.ULID) {
+	idx.projectID = projectID
+	idx.ids = nil
+	idx.entries = make(map[ulid.ULID]*indexEntry)
+	idx.values = make(map[string]map[string]postings, len(indexValueKeys))
+	idx.trigrams = make(map[string]map[trigram]postings, len(indexTextKeyFns))
+	idx.unindexed = make(map[string]postings, len(indexTextKeyFns))
+
+	for _, key := range indexValueKeys {
+		idx.values[key] = make(map[string]postings)
+	}
+
+	for key := range indexTextKeyFns {
+		idx.trigrams[key] = make(map[trigram]postings)
+	}
+}
+
+// loadIndex builds the index of the active project from its stored request
+// logs, unless it's already built.
+func (svc *Service) loadIndex(ctx context.Context) error {
+	projectID := svc.ActiveProjectID
+	if projectID.Compare(ulid.ULID{}) == 0 {
+		return ErrProjectIDMustBeSet
+	}
+
+	idx := svc.index
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	if idx.loaded && idx.projectID.Compare(projectID) == 0 {
+		return nil
+	}
+
+	reqLogs, err := svc.repo.FindRequestLogs(ctx, FindRequestsFilter{ProjectID: projectID}, nil)
+	if err != nil {
+		return fmt.Errorf("reqlog: could not find requests: %w", err)
+	}
+
+	idx.resetLocked(projectID)
+
+	for _, reqLog := range reqLogs {
+		idx.addLocked(reqLog)
+	}
+
+	idx.loaded = true
+
+	return nil
+}
+
+// withCandidates returns filter with the candidates the index finds for its
+// search expression, building the index of the active project if needed. If
+// the expression can't be narrowed down by the index, filter is returned as-is.
+func (svc *Service) withCandidates(ctx context.Context, filter FindRequestsFilter) (FindRequestsFilter, error) {
+	if filter.SearchExpr == nil || filter.ProjectID.Compare(svc.ActiveProjectID) != 0 {
+		return filter, nil
+	}
+
+	matchCfg := DefaultMatchConfig()
+	if filter.MatchConfig != nil {
+		matchCfg = *filter.MatchConfig
+	}
+
+	find := compileCandidates(filter.SearchExpr, matchCfg)
+	if find == nil {
+		return filter, nil
+	}
+
+	if err := svc.loadIndex(ctx); err != nil {
+		return FindRequestsFilter{}, err
+	}
+
+	idx := svc.index
+
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	// The index may have been built for another project in the meantime.
+	if !idx.loaded || idx.projectID.Compare(filter.ProjectID) != 0 {
+		return filter, nil
+	}
+
+	ords := find(idx)
+	ids := make([]ulid.ULID, len(ords))
+
+	for i, ord := range ords {
+		ids[i] = idx.ids[ord]
+	}
+
+	filter.Candidates = NewCandidateSet(ids)
+
+	return filter, nil
+}
+
+// candidatesFn returns the request logs in an index that can match a search
+// expression. The caller must hold the read lock of the index.
+type candidatesFn func(idx *index) postings
+
+// compileCandidates compiles a func that finds the candidates for expr in an
+// index, i.e. a superset of the request logs that match it. Request logs that
+// aren't candidates can be skipped without matching expr. It returns nil if any
+// request log can match, because expr has a comparison the index can't narrow
+// down (for example, free text search) outside of an `AND` expression.
+func compileCandidates(expr search.Expression, cfg MatchConfig) candidatesFn {
+	e, ok := expr.(search.InfixExpression)
+	if !ok {
+		return nil
+	}
+
+	switch e.Operator {
+	case search.TokOpAnd:
+		// Either side narrows down the candidates by itself.
+		left, right := compileCandidates(e.Left, cfg), compileCandidates(e.Right, cfg)
+
+		switch {
+		case left == nil:
+			return right
+		case right == nil:
+			return left
+		}
+
+		return func(idx *index) postings { return left(idx).intersect(right(idx)) }
+	case search.TokOpOr:
+		left, right := compileCandidates(e.Left, cfg), compileCandidates(e.Right, cfg)
+		if left == nil || right == nil {
+			return nil
+		}
+
+		return func(idx *index) postings { return left(idx).union(right(idx)) }
+	}
+
+	key, ok := e.Left.(search.StringLiteral)
+	if !ok {
+		return nil
+	}
+
+	for _, valueKey := range indexValueKeys {
+		if key.Value == valueKey {
+			return compileValueCandidates(e, key.Value, cfg)
+		}
+	}
+
+	if _, ok := indexTextKeyFns[key.Value]; ok {
+		return compileTextCandidates(e, key.Value)
+	}
+
+	return nil
+}
+
+// compileValueCandidates compiles the candidates for a comparison of a value
+// key. The comparison is evaluated like `compiler.compileInfixExpr` does, for each value
+// of the key in the index, so the candidates are exactly the matches.
+func compileValueCandidates(expr search.InfixExpression, key string, cfg MatchConfig) candidatesFn {
+	if hasSearchKey(expr.Right) {
+		return nil
+	}
+
+	return func(idx *index) postings {
+		var value string
+
+		compare, err := compiler{cfg: cfg}.compileComparison(expr, func(RequestLog) string { return value })
+		if err != nil {
+			// Invalid expressions fail when they're compiled for matching.
+			return nil
+		}
+
+		var ords postings
+
+		for v, p := range idx.values[key] {
+			if value = v; compare(RequestLog{}) {
+				ords = append(ords, p...)
+			}
+		}
+
+		sort.Slice(ords, func(i, j int) bool { return ords[i] < ords[j] })
+
+		return ords
+	}
+}
+
+// compileTextCandidates compiles the candidates for a comparison of a text key,
+// from the trigrams of literal strings that matching values contain. It returns
+// nil for operators that don't require literal strings, or literal strings too
+// short to have trigrams.
+func compileTextCandidates(expr search.InfixExpression, key string) candidatesFn {
+	var literals []string
+
+	switch expr.Operator {
+	case search.TokOpEq:
+		right, ok := expr.Right.(search.StringLiteral)
+		if !ok || isSearchKey(right.Value) {
+			return nil
+		}
+
+		literals = []string{right.Value}
+	case search.TokOpRe:
+		re, ok := expr.Right.(*regexp.Regexp)
+		if !ok {
+			return nil
+		}
+
+		literals = requiredLiterals(re)
+	default:
+		return nil
+	}
+
+	var trigrams []trigram
+	for _, literal := range literals {
+		trigrams = append(trigrams, textTrigrams(literal)...)
+	}
+
+	if len(trigrams) == 0 {
+		return nil
+	}
+
+	return func(idx *index) postings {
+		ords := idx.trigrams[key][trigrams[0]]
+		for _, t := range trigrams[1:] {
+			ords = ords.intersect(idx.trigrams[key][t])
+		}
+
+		return ords.union(idx.unindexed[key])
+	}
+}
+
+// hasSearchKey returns true if the right operand of a comparison refers to a
+// search key, so its value depends on the request log.
+func hasSearchKey(right search.Expression) bool {
+	switch r := right.(type) {
+	case search.StringLiteral:
+		return isSearchKey(r.Value)
+	case search.ListLiteral:
+		for _, v := range r.Values {
+			if isSearchKey(v.Value) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// requiredLiterals returns literal strings that any match of re contains.
+// Case-insensitive literals are left out, as are literals in alternations and
+// optional parts.
+func requiredLiterals(re *regexp.Regexp) []string {
+	parsed, err := syntax.Parse(re.String(), syntax.Perl)
+	if err != nil {
+		return nil
+	}
+
+	return regexpLiterals(parsed.Simplify())
+}
+
+func regexpLiterals(re *syntax.Regexp) []string {
+	switch re.Op {
+	case syntax.OpLiteral:
+		if re.Flags&syntax.FoldCase != 0 {
+			return nil
+		}
+
+		return []string{string(re.Rune)}
+	case syntax.OpCapture, syntax.OpPlus:
+		return regexpLiterals(re.Sub[0])
+	case syntax.OpConcat:
+		var literals []string
+		for _, sub := range re.Sub {
+			literals = append(literals, regexpLiterals(sub)...)
+		}
+
+		return literals
+	default:
+		return nil
+	}
+}
+
+// textTrigrams returns the unique trigrams of s, case folded.
+func textTrigrams(s string) []trigram {
+	folded := strings.Map(foldRune, s)
+	if len(folded) < len(trigram{}) {
+		return nil
+	}
+
+	seen := make(map[trigram]struct{}, len(folded)-2)
+	trigrams := make([]trigram, 0, len(folded)-2)
+
+	for i := 0; i+len(trigram{}) <= len(folded); i++ {
+		t := trigram{folded[i], folded[i+1], folded[i+2]}
+		if _, ok := seen[t]; ok {
+			continue
+		}
+
+		seen[t] = struct{}{}
+		trigrams = append(trigrams, t)
+	}
+
+	return trigrams
+}
+
+// foldRune maps r to the smallest rune that's equal to it under simple case
+// folding, like `strings.EqualFold` compares runes. Values that are equal when
+// ignoring case therefore have the same trigrams.
+func foldRune(r rune) rune {
+	if r < utf8.RuneSelf {
+		if 'a' <= r && r <= 'z' {
+			return r - 'a' + 'A'
+		}
+
+		return r
+	}
+
+	folded := r
+	for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+		if f < folded {
+			folded = f
+		}
+	}
+
+	return folded
+}
+
+// insert returns the postings with ord added, if they don't have it already.
+func (p postings) insert(ord uint32) postings {
+	i := sort.Search(len(p), func(i int) bool { return p[i] >= ord })
+	if i < len(p) && p[i] == ord {
+		return p
+	}
+
+	p = append(p, 0)
+	copy(p[i+1:], p[i:])
+	p[i] = ord
+
+	return p
+}
+
+// remove returns the postings without ord.
+func (p postings) remove(ord uint32) postings {
+	i := sort.Search(len(p), func(i int) bool { return p[i] >= ord })
+	if i == len(p) || p[i] != ord {
+		return p
+	}
+
+	return append(p[:i], p[i+1:]...)
+}
+
+// intersect returns the ordinals in both p and other, as new postings.
+func (p postings) intersect(other postings) postings {
+	var out postings
+
+	for i, j := 0, 0; i < len(p) && j < len(other); {
+		switch {
+		case p[i] < other[j]:
+			i++
+		case p[i] > other[j]:
+			j++
+		default:
+			out = append(out, p[i])
+			i++
+			j++
+		}
+	}
+
+	return out
+}
+
+// union returns the ordinals in either p or other, as new postings.
+func (p postings) union(other postings) postings {
+	out := make(postings, 0, len(p)+len(other))
+	i, j := 0, 0
+
+	for i < len(p) && j < len(other) {
+		switch {
+		case p[i] < other[j]:
+			out = append(out, p[i])
+			i++
+		case p[i] > other[j]:
+			out = append(out, other[j])
+			j++
+		default:
+			out = append(out, p[i])
+			i++
+			j++
+		}
+	}
+
+	out = append(out, p[i:]...)
+
+	return append(out, other[j:]...)
+}
@@ -0,0 +1,324 @@
+package reqlog_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/oklog/ulid"
+
+	"github.com/dstotijn/hetty/pkg/reqlog"
+	"github.com/dstotijn/hetty/pkg/scope"
+	"github.com/dstotijn/hetty/pkg/search"
+)
+
+// newIndexRepoMock returns a repository mock that keeps request logs in memory
+// and matches them like repositories do, and the filters it's queried with.
+func newIndexRepoMock(reqLogs []reqlog.RequestLog) (*RepoMock, *[]reqlog.FindRequestsFilter) {
+	var (
+		mu      sync.Mutex
+		filters []reqlog.FindRequestsFilter
+	)
+
+	repoMock := &RepoMock{
+		FindRequestLogsFunc: func(
+			_ context.Context,
+			filter reqlog.FindRequestsFilter,
+			s *scope.Scope,
+		) ([]reqlog.RequestLog, error) {
+			match, err := reqlog.CompileFilter(filter, s)
+			if err != nil {
+				return nil, err
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			filters = append(filters, filter)
+			matched := make([]reqlog.RequestLog, 0)
+
+			for _, reqLog := range reqLogs {
+				if match(reqLog) {
+					matched = append(matched, reqLog)
+				}
+			}
+
+			return matched, nil
+		},
+		StoreRequestLogFunc: func(_ context.Context, reqLog reqlog.RequestLog) error {
+			mu.Lock()
+			defer mu.Unlock()
+
+			reqLogs = append(reqLogs, reqLog)
+
+			return nil
+		},
+		StoreResponseLogFunc: func(_ context.Context, reqLogID ulid.ULID, resLog reqlog.ResponseLog) error {
+			mu.Lock()
+			defer mu.Unlock()
+
+			for i := range reqLogs {
+				if reqLogs[i].ID == reqLogID {
+					reqLogs[i].Response = &resLog
+				}
+			}
+
+			return nil
+		},
+		ClearRequestLogsFunc: func(_ context.Context, _ ulid.ULID) error {
+			mu.Lock()
+			defer mu.Unlock()
+
+			reqLogs = nil
+
+			return nil
+		},
+	}
+
+	return repoMock, &filters
+}
+
+func TestFindRequestsIndex(t *testing.T) {
+	t.Parallel()
+
+	projectID := ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+
+	newReqLog := func(method, rawURL, body string, resLog *reqlog.ResponseLog) reqlog.RequestLog {
+		u, err := url.Parse(rawURL)
+		if err != nil {
+			t.Fatalf("unexpected error parsing URL: %v", err)
+		}
+
+		return reqlog.RequestLog{
+			ID:        ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy),
+			ProjectID: projectID,
+			Method:    method,
+			URL:       u,
+			Body:      []byte(body),
+			Response:  resLog,
+		}
+	}
+
+	reqLogs := []reqlog.RequestLog{
+		newReqLog(http.MethodGet, "https://example.com/users?id=1", "",
+			&reqlog.ResponseLog{StatusCode: 200, Body: []byte(`{"user":"Alice"}`)}),
+		newReqLog(http.MethodPost, "https://example.com/login", "username=admin&password=hunter2",
+			&reqlog.ResponseLog{StatusCode: 302}),
+		newReqLog(http.MethodGet, "https://api.example.org/items/42", "",
+			&reqlog.ResponseLog{StatusCode: 404, Body: []byte("Not Found")}),
+		newReqLog(http.MethodGet, "https://example.com/", "", nil),
+		// The body is too large to be indexed.
+		newReqLog(http.MethodPut, "https://api.example.org/items/42", strings.Repeat("a", 100000),
+			&reqlog.ResponseLog{StatusCode: 500}),
+		// The Kelvin sign equals `k`, ignoring case.
+		newReqLog(http.MethodGet, "https://example.com/temperature", "",
+			&reqlog.ResponseLog{StatusCode: 200, Body: []byte("5 K")}),
+	}
+
+	tests := []struct {
+		name  string
+		query string
+		// expCandidates is the number of candidates the index finds, or -1
+		// if the index doesn't narrow down the request logs.
+		expCandidates int
+	}{
+		{name: "method", query: "req.method = GET", expCandidates: 4},
+		{name: "method, ignoring case", query: "req.method = get", expCandidates: 4},
+		{name: "method, list", query: "req.method in [POST, PUT]", expCandidates: 2},
+		{name: "status code, range", query: "res.statusCode >= 400", expCandidates: 2},
+		{name: "status code, without response", query: "res.statusCode != 200", expCandidates: 4},
+		{name: "host and status code", query: "req.host = example.com AND res.statusCode = 200", expCandidates: 2},
+		{name: "method or status code", query: "req.method = POST OR res.statusCode = 404", expCandidates: 2},
+		{name: "URL, regular expression", query: `req.url =~ "/items/\d+$"`, expCandidates: 2},
+		{name: "request body, with unindexed body", query: `req.body =~ "hunter2"`, expCandidates: 2},
+		{name: "response body, ignoring case", query: `res.body = "5 k"`, expCandidates: 1},
+		{name: "response body, no candidates", query: `res.body =~ "secret"`, expCandidates: 0},
+		{name: "and with free text search", query: `req.method = GET AND "admin"`, expCandidates: 4},
+		{name: "case-insensitive regular expression", query: `req.body =~ "(?i)HUNTER"`, expCandidates: -1},
+		{name: "free text search", query: `"admin"`, expCandidates: -1},
+		{name: "or with free text search", query: `req.method = POST OR "admin"`, expCandidates: -1},
+		{name: "not", query: "NOT (req.method = GET)", expCandidates: -1},
+		{name: "short literal", query: `req.url =~ "id"`, expCandidates: -1},
+		{name: "search key as right operand", query: "req.host = req.method", expCandidates: -1},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			expr, err := search.ParseQuery(tt.query)
+			if err != nil {
+				t.Fatalf("unexpected error parsing query: %v", err)
+			}
+
+			// The expected request logs are matched without index.
+			match, err := reqlog.CompileMatcher(expr, reqlog.DefaultMatchConfig())
+			if err != nil {
+				t.Fatalf("unexpected error compiling expression: %v", err)
+			}
+
+			exp := make([]reqlog.RequestLog, 0)
+
+			for _, reqLog := range reqLogs {
+				if match(reqLog) {
+					exp = append(exp, reqLog)
+				}
+			}
+
+			repoMock, filters := newIndexRepoMock(reqLogs)
+			svc := reqlog.NewService(reqlog.Config{Repository: repoMock})
+			svc.ActiveProjectID = projectID
+			svc.FindReqsFilter = reqlog.FindRequestsFilter{ProjectID: projectID, SearchExpr: expr}
+
+			got, err := svc.FindRequests(context.Background())
+			if err != nil {
+				t.Fatalf("unexpected error finding requests: %v", err)
+			}
+
+			if diff := cmp.Diff(exp, got); diff != "" {
+				t.Fatalf("request logs not equal (-exp, +got):\n%v", diff)
+			}
+
+			last := (*filters)[len(*filters)-1]
+			if got := last.Candidates.Len(); got != tt.expCandidates {
+				t.Fatalf("incorrect number of candidates (expected: %v, got: %v)", tt.expCandidates, got)
+			}
+		})
+	}
+}
+
+func TestFindRequestsIndexUpdates(t *testing.T) {
+	t.Parallel()
+
+	repoMock, _ := newIndexRepoMock(nil)
+	svc := reqlog.NewService(reqlog.Config{Repository: repoMock})
+	svc.ActiveProjectID = ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+
+	findRequests := func(query string) []reqlog.RequestLog {
+		t.Helper()
+
+		expr, err := search.ParseQuery(query)
+		if err != nil {
+			t.Fatalf("unexpected error parsing query: %v", err)
+		}
+
+		svc.FindReqsFilter = reqlog.FindRequestsFilter{ProjectID: svc.ActiveProjectID, SearchExpr: expr}
+
+		reqLogs, err := svc.FindRequests(context.Background())
+		if err != nil {
+			t.Fatalf("unexpected error finding requests: %v", err)
+		}
+
+		return reqLogs
+	}
+
+	// The index is built for the first search.
+	if got := findRequests("res.statusCode = 201"); len(got) != 0 {
+		t.Fatalf("expected no request logs, got: %v", len(got))
+	}
+
+	har := `{"log": {"entries": [
+		{"startedDateTime": "2022-01-02T15:04:05Z", "request": {"method": "POST", "url": "https://example.com/users"},
+			"response": {"status": 201, "statusText": "Created", "content": {"text": "{\"id\": 42}"}}}
+	]}}`
+
+	if _, err := svc.ImportHAR(context.Background(), strings.NewReader(har)); err != nil {
+		t.Fatalf("unexpected error importing HAR: %v", err)
+	}
+
+	// Logged requests and responses are added to the index.
+	for _, query := range []string{"res.statusCode = 201", `req.url =~ "/users$"`, `res.body =~ "42"`} {
+		if got := findRequests(query); len(got) != 1 {
+			t.Fatalf("incorrect number of request logs for %q (expected: 1, got: %v)", query, len(got))
+		}
+	}
+
+	if err := svc.ClearRequests(context.Background(), svc.ActiveProjectID); err != nil {
+		t.Fatalf("unexpected error clearing requests: %v", err)
+	}
+
+	// The index is built again after clearing.
+	if got := findRequests("res.statusCode = 201"); len(got) != 0 {
+		t.Fatalf("expected no request logs after clearing, got: %v", len(got))
+	}
+}
+
+// benchmarkIndexQuery matches few of the request logs of
+// `benchmarkIndexRequestLogs`.
+const benchmarkIndexQuery = `req.method = POST AND res.statusCode >= 500 AND req.body =~ "user_\d+_42"`
+
+func benchmarkIndexRequestLogs(b *testing.B, projectID ulid.ULID, n int) []reqlog.RequestLog {
+	b.Helper()
+
+	reqLogs := make([]reqlog.RequestLog, n)
+	for i := range reqLogs {
+		reqLogs[i] = reqlog.RequestLog{
+			ID:        ulid.MustNew(ulid.Now(), ulidEntropy),
+			ProjectID: projectID,
+			Method:    []string{http.MethodGet, http.MethodPost}[i%2],
+			URL: &url.URL{
+				Scheme: "https",
+				Host:   fmt.Sprintf("%v.example.com", i%20),
+				Path:   fmt.Sprintf("/items/%v", i),
+			},
+			Header: http.Header{"Content-Type": []string{"application/json"}},
+			Body:   []byte(fmt.Sprintf(`{"user":"user_%v_%v","data":%q}`, i, i%100, bytes.Repeat([]byte("x"), 512))),
+			Response: &reqlog.ResponseLog{
+				StatusCode: 200 + i%4*100,
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body:       []byte(`{"ok":true}`),
+			},
+		}
+	}
+
+	return reqLogs
+}
+
+// BenchmarkFindRequests compares finding request logs with the index of the
+// service with matching all of them, like repositories do without index.
+func BenchmarkFindRequests(b *testing.B) {
+	expr, err := search.ParseQuery(benchmarkIndexQuery)
+	if err != nil {
+		b.Fatalf("unexpected error parsing query: %v", err)
+	}
+
+	projectID := ulid.MustNew(ulid.Now(), ulidEntropy)
+	repoMock, _ := newIndexRepoMock(benchmarkIndexRequestLogs(b, projectID, 50000))
+	filter := reqlog.FindRequestsFilter{ProjectID: projectID, SearchExpr: expr}
+
+	b.Run("without index", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := repoMock.FindRequestLogs(context.Background(), filter, nil); err != nil {
+				b.Fatalf("unexpected error finding requests: %v", err)
+			}
+		}
+	})
+
+	b.Run("with index", func(b *testing.B) {
+		svc := reqlog.NewService(reqlog.Config{Repository: repoMock})
+		svc.ActiveProjectID = projectID
+		svc.FindReqsFilter = filter
+
+		// The index is built for the first search.
+		if _, err := svc.FindRequests(context.Background()); err != nil {
+			b.Fatalf("unexpected error finding requests: %v", err)
+		}
+
+		b.ResetTimer()
+
+		for i := 0; i < b.N; i++ {
+			if _, err := svc.FindRequests(context.Background()); err != nil {
+				b.Fatalf("unexpected error finding requests: %v", err)
+			}
+		}
+	})
+}
@@ -0,0 +1,107 @@
+package reqlog
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Prefixes of search keys that resolve to a value in a JSON body, e.g.
+// `req.body.json.user.id`. The path after the prefix consists of object keys
+// and array indexes, separated by dots, e.g. `items.0.id`. Indexes can also be
+// written in brackets, e.g. `items[0].id`. Object keys are case-sensitive.
+const (
+	reqBodyJSONKeyPrefix = "req.body.json."
+	resBodyJSONKeyPrefix = "res.body.json."
+)
+
+// jsonBodyValue returns the value at path in a JSON body. Strings resolve to
+// their value, other scalars to their JSON literal (e.g. `42` or `true`), and
+// objects and arrays to compact JSON. The body is only parsed when a JSON key
+// is resolved. If the body isn't valid JSON or has no value at path, it returns
+// an empty string, so the key doesn't match instead of failing the search.
+func jsonBodyValue(body []byte, path string) string {
+	dec := json.NewDecoder(bytes.NewReader(body))
+	// Numbers are kept as written, instead of being converted to floats.
+	dec.UseNumber()
+
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return ""
+	}
+
+	// Trailing data makes the body invalid JSON.
+	if _, err := dec.Token(); !errors.Is(err, io.EOF) {
+		return ""
+	}
+
+	for _, segment := range jsonPathSegments(path) {
+		switch node := v.(type) {
+		case map[string]interface{}:
+			child, ok := node[segment]
+			if !ok {
+				return ""
+			}
+
+			v = child
+		case []interface{}:
+			i, err := strconv.Atoi(segment)
+			if err != nil || i < 0 || i >= len(node) {
+				return ""
+			}
+
+			v = node[i]
+		default:
+			return ""
+		}
+	}
+
+	switch value := v.(type) {
+	case string:
+		return value
+	case json.Number:
+		return value.String()
+	case bool:
+		return strconv.FormatBool(value)
+	case nil:
+		return "null"
+	default:
+		// Objects and arrays were decoded from JSON, so encoding them can't
+		// fail.
+		b, _ := json.Marshal(value)
+		return string(b)
+	}
+}
+
+// jsonPathSegments splits a JSON key path into object keys and array indexes,
+// e.g. `items[0].id` into `items`, `0` and `id`.
+func jsonPathSegments(path string) []string {
+	var segments []string
+
+	for _, part := range strings.Split(path, ".") {
+		for {
+			open := strings.IndexByte(part, '[')
+			if open == -1 || !strings.HasSuffix(part, "]") {
+				break
+			}
+
+			if open > 0 {
+				segments = append(segments, part[:open])
+			}
+
+			rest := part[open+1:]
+			closing := strings.IndexByte(rest, ']')
+			segments = append(segments, rest[:closing])
+			part = rest[closing+1:]
+		}
+
+		if part != "" {
+			segments = append(segments, part)
+		}
+	}
+
+	return segments
+}
@@ -0,0 +1,25 @@
+package reqlog
+
+import "github.com/dstotijn/hetty/pkg/search"
+
+// securityMacros are search macros for common security checks, expressed with
+// the computed request and response log search keys.
+var securityMacros = map[string]string{
+	"insecureCookie":     "res.hasInsecureCookie = true",
+	"crossOriginReferer": "req.crossOriginReferer = true",
+	"malformedBody":      "req.bodyMalformed = true",
+	"apiKey":             "req.hasApiKey = true",
+	"nonStandardMethod":  "req.nonStandardMethod = true",
+	"noResponse":         "req.hasResponse = false",
+	"likelySecret":       "(req.maxTokenEntropy > 4.5 OR res.maxTokenEntropy > 4.5)",
+	"missingCSP":         "res.hasCsp = false",
+	"reflectedParam":     "req.hasReflectedParam = true",
+}
+
+func init() {
+	for name, query := range securityMacros {
+		if err := search.RegisterMacro(name, query); err != nil {
+			panic(err)
+		}
+	}
+}
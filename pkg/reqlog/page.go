@@ -0,0 +1,125 @@
+package reqlog
+
+import (
+	"context"
+
+	"github.com/oklog/ulid"
+
+	"github.com/dstotijn/hetty/pkg/search"
+)
+
+// SortField is the field request logs are sorted by.
+type SortField int
+
+const (
+	// SortByTimestamp sorts request logs by the time they were logged, i.e.
+	// by ID.
+	SortByTimestamp SortField = iota
+	// SortByStatusCode sorts request logs by response status code. Request logs
+	// without response sort before any other.
+	SortByStatusCode
+	// SortByDuration sorts request logs by the time it took for the full
+	// response to be received (see `ResponseLog.Duration`). Request logs
+	// without response sort before any other.
+	SortByDuration
+)
+
+// PageOptions select a page of request logs.
+type PageOptions struct {
+	// First is the maximum number of request logs of the page. If zero, all
+	// request logs after the cursor are returned.
+	First int
+	// After is the cursor of the page: the ID of the last request log of the
+	// previous page. If zero, the page starts at the first request log.
+	After ulid.ULID
+
+	SortBy     SortField
+	Descending bool
+}
+
+// RequestLogPage is a page of request logs, in sort order.
+type RequestLogPage struct {
+	RequestLogs []RequestLog
+	// HasNextPage is true if there are request logs after the page. Use the
+	// ID of the last request log as the cursor of the next page.
+	HasNextPage bool
+}
+
+// Less reports whether a sorts before b. Request logs with equal sort keys are
+// ordered by ID, so the sort order is stable and any request log can be used
+// as a cursor.
+func (opts PageOptions) Less(a, b RequestLog) bool {
+	keyA, keyB := opts.sortKey(a), opts.sortKey(b)
+
+	cmp := a.ID.Compare(b.ID)
+	if keyA != keyB {
+		cmp = -1
+		if keyA > keyB {
+			cmp = 1
+		}
+	}
+
+	if opts.Descending {
+		return cmp > 0
+	}
+
+	return cmp < 0
+}
+
+func (opts PageOptions) sortKey(reqLog RequestLog) int64 {
+	switch opts.SortBy {
+	case SortByStatusCode:
+		if reqLog.Response == nil {
+			return -1
+		}
+
+		return int64(reqLog.Response.StatusCode)
+	case SortByDuration:
+		if reqLog.Response == nil {
+			return -1
+		}
+
+		return int64(reqLog.Response.Duration)
+	default:
+		return 0
+	}
+}
+
+// FindRequestsPage returns a page of the request logs that match the service's
+// request log filter, like `FindRequests`.
+func (svc *Service) FindRequestsPage(ctx context.Context, opts PageOptions) (RequestLogPage, error) {
+	filter, err := svc.withCandidates(ctx, svc.findRequestsFilter())
+	if err != nil {
+		return RequestLogPage{}, err
+	}
+
+	return svc.repo.FindRequestLogsPage(ctx, filter, opts, svc.scope)
+}
+
+// SearchRequestsPage returns a page of the request logs of the active project
+// that match expr, which may be nil. Unlike `FindRequestsPage`, the service's
+// request log filter is not used, so callers can search without affecting the
+// filter of the admin interface.
+func (svc *Service) SearchRequestsPage(
+	ctx context.Context,
+	expr search.Expression,
+	onlyInScope bool,
+	opts PageOptions,
+) (RequestLogPage, error) {
+	matchCfg := svc.matchConfig
+	matchCfg.LoginBoundary = svc.LoginBoundary
+
+	filter := FindRequestsFilter{
+		ProjectID:   svc.ActiveProjectID,
+		OnlyInScope: onlyInScope,
+		SearchExpr:  expr,
+		MatchConfig: &matchCfg,
+	}
+
+	filter, err := svc.withCandidates(ctx, filter)
+	if err != nil {
+		return RequestLogPage{}, err
+	}
+
+	return svc.repo.FindRequestLogsPage(ctx, filter, opts, svc.scope)
+}
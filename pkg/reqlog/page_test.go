@@ -0,0 +1,142 @@
+package reqlog_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/oklog/ulid"
+
+	"github.com/dstotijn/hetty/pkg/reqlog"
+	"github.com/dstotijn/hetty/pkg/scope"
+	"github.com/dstotijn/hetty/pkg/search"
+)
+
+func TestPageOptionsLess(t *testing.T) {
+	t.Parallel()
+
+	now := ulid.Timestamp(time.Now())
+	older := reqlog.RequestLog{
+		ID:       ulid.MustNew(now, ulidEntropy),
+		Response: &reqlog.ResponseLog{StatusCode: 404, Duration: 10 * time.Millisecond},
+	}
+	newer := reqlog.RequestLog{
+		ID:       ulid.MustNew(now+1, ulidEntropy),
+		Response: &reqlog.ResponseLog{StatusCode: 200, Duration: 10 * time.Millisecond},
+	}
+	noResponse := reqlog.RequestLog{ID: ulid.MustNew(now+2, ulidEntropy)}
+
+	tests := []struct {
+		name     string
+		opts     reqlog.PageOptions
+		a, b     reqlog.RequestLog
+		expected bool
+	}{
+		{
+			name:     "by timestamp",
+			opts:     reqlog.PageOptions{},
+			a:        older,
+			b:        newer,
+			expected: true,
+		},
+		{
+			name:     "by timestamp, descending",
+			opts:     reqlog.PageOptions{Descending: true},
+			a:        older,
+			b:        newer,
+			expected: false,
+		},
+		{
+			name:     "by status code",
+			opts:     reqlog.PageOptions{SortBy: reqlog.SortByStatusCode},
+			a:        older,
+			b:        newer,
+			expected: false,
+		},
+		{
+			name:     "by status code, without response",
+			opts:     reqlog.PageOptions{SortBy: reqlog.SortByStatusCode},
+			a:        noResponse,
+			b:        newer,
+			expected: true,
+		},
+		{
+			name:     "by duration, equal durations are ordered by ID",
+			opts:     reqlog.PageOptions{SortBy: reqlog.SortByDuration},
+			a:        older,
+			b:        newer,
+			expected: true,
+		},
+		{
+			name:     "equal request logs",
+			opts:     reqlog.PageOptions{SortBy: reqlog.SortByDuration},
+			a:        older,
+			b:        older,
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tt.opts.Less(tt.a, tt.b); got != tt.expected {
+				t.Fatalf("incorrect result (expected: %v, got: %v)", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestSearchRequestsPage(t *testing.T) {
+	t.Parallel()
+
+	projectID := ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+	opts := reqlog.PageOptions{First: 10, SortBy: reqlog.SortByStatusCode}
+	expr := search.StringLiteral{Value: "foobar"}
+
+	repoMock := &RepoMock{
+		FindRequestLogsPageFunc: func(
+			_ context.Context,
+			filter reqlog.FindRequestsFilter,
+			gotOpts reqlog.PageOptions,
+			_ *scope.Scope,
+		) (reqlog.RequestLogPage, error) {
+			if filter.ProjectID != projectID {
+				t.Errorf("incorrect project ID (expected: %v, got: %v)", projectID, filter.ProjectID)
+			}
+
+			// The service's request log filter isn't used.
+			if filter.OnlyInScope {
+				t.Error("expected `OnlyInScope` to be false")
+			}
+
+			if diff := cmp.Diff(search.Expression(expr), filter.SearchExpr); diff != "" {
+				t.Errorf("search expression not equal (-exp, +got):\n%v", diff)
+			}
+
+			if filter.MatchConfig == nil {
+				t.Error("expected match config to be set")
+			}
+
+			if diff := cmp.Diff(opts, gotOpts); diff != "" {
+				t.Errorf("page options not equal (-exp, +got):\n%v", diff)
+			}
+
+			return reqlog.RequestLogPage{}, nil
+		},
+	}
+	svc := reqlog.NewService(reqlog.Config{Repository: repoMock})
+	svc.ActiveProjectID = projectID
+	svc.FindReqsFilter = reqlog.FindRequestsFilter{ProjectID: projectID, OnlyInScope: true}
+
+	if _, err := svc.SearchRequestsPage(context.Background(), expr, false, opts); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if n := len(repoMock.FindRequestLogsPageCalls()); n != 1 {
+		t.Fatalf("incorrect number of repository calls (expected: 1, got: %v)", n)
+	}
+}
@@ -0,0 +1,168 @@
+package reqlog
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+const postmanSchemaURL = "https://schema.getpostman.com/json/collection/v2.1.0/collection.json"
+
+type postmanCollection struct {
+	Info postmanInfo   `json:"info"`
+	Item []postmanItem `json:"item"`
+}
+
+type postmanInfo struct {
+	Name   string `json:"name"`
+	Schema string `json:"schema"`
+}
+
+// postmanItem is either a folder (with `Item` set) or a request.
+type postmanItem struct {
+	Name    string          `json:"name"`
+	Item    []postmanItem   `json:"item,omitempty"`
+	Request *postmanRequest `json:"request,omitempty"`
+}
+
+type postmanRequest struct {
+	Method string          `json:"method"`
+	Header []postmanKV     `json:"header"`
+	URL    postmanURL      `json:"url"`
+	Body   *postmanRawBody `json:"body,omitempty"`
+}
+
+type postmanURL struct {
+	Raw      string      `json:"raw"`
+	Protocol string      `json:"protocol,omitempty"`
+	Host     []string    `json:"host,omitempty"`
+	Port     string      `json:"port,omitempty"`
+	Path     []string    `json:"path,omitempty"`
+	Query    []postmanKV `json:"query,omitempty"`
+}
+
+type postmanKV struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+type postmanRawBody struct {
+	Mode string `json:"mode"`
+	Raw  string `json:"raw"`
+}
+
+// ExportPostmanCollection writes the request logs as a Postman v2.1 collection
+// to w. Requests are grouped in folders by host, sorted by host name; within a
+// folder, the order of reqLogs is kept.
+func ExportPostmanCollection(w io.Writer, name string, reqLogs []RequestLog) error {
+	folders := make(map[string][]postmanItem)
+
+	for _, reqLog := range reqLogs {
+		host := ""
+		if reqLog.URL != nil {
+			host = reqLog.URL.Host
+		}
+
+		folders[host] = append(folders[host], postmanRequestItem(reqLog))
+	}
+
+	hosts := make([]string, 0, len(folders))
+	for host := range folders {
+		hosts = append(hosts, host)
+	}
+
+	sort.Strings(hosts)
+
+	collection := postmanCollection{
+		Info: postmanInfo{
+			Name:   name,
+			Schema: postmanSchemaURL,
+		},
+		Item: make([]postmanItem, 0, len(hosts)),
+	}
+
+	for _, host := range hosts {
+		collection.Item = append(collection.Item, postmanItem{
+			Name: host,
+			Item: folders[host],
+		})
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	if err := enc.Encode(collection); err != nil {
+		return fmt.Errorf("reqlog: could not encode Postman collection: %w", err)
+	}
+
+	return nil
+}
+
+func postmanRequestItem(reqLog RequestLog) postmanItem {
+	req := &postmanRequest{
+		Method: reqLog.Method,
+		Header: []postmanKV{},
+	}
+
+	keys := make([]string, 0, len(reqLog.Header))
+	for key := range reqLog.Header {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		for _, value := range reqLog.Header[key] {
+			req.Header = append(req.Header, postmanKV{Key: key, Value: value})
+		}
+	}
+
+	path := "/"
+
+	if u := reqLog.URL; u != nil {
+		req.URL = postmanURL{
+			Raw:      u.String(),
+			Protocol: u.Scheme,
+			Port:     u.Port(),
+		}
+
+		if hostname := u.Hostname(); hostname != "" {
+			req.URL.Host = strings.Split(hostname, ".")
+		}
+
+		if p := strings.Trim(u.EscapedPath(), "/"); p != "" {
+			req.URL.Path = strings.Split(p, "/")
+		}
+
+		for _, kv := range strings.Split(u.RawQuery, "&") {
+			if kv == "" {
+				continue
+			}
+
+			key, value := kv, ""
+			if i := strings.Index(kv, "="); i >= 0 {
+				key, value = kv[:i], kv[i+1:]
+			}
+
+			req.URL.Query = append(req.URL.Query, postmanKV{Key: key, Value: value})
+		}
+
+		if u.Path != "" {
+			path = u.Path
+		}
+	}
+
+	if len(reqLog.Body) > 0 {
+		req.Body = &postmanRawBody{
+			Mode: "raw",
+			Raw:  string(reqLog.Body),
+		}
+	}
+
+	return postmanItem{
+		Name:    reqLog.Method + " " + path,
+		Request: req,
+	}
+}
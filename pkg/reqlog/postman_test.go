@@ -0,0 +1,120 @@
+package reqlog_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+func TestExportPostmanCollection(t *testing.T) {
+	t.Parallel()
+
+	reqLogs := []reqlog.RequestLog{
+		{
+			Method: "POST",
+			URL:    mustParseURL(t, "https://www.example.com/api/users?page=2&sort=name"),
+			Header: http.Header{
+				"Content-Type": []string{"application/json"},
+				"X-Foo":        []string{"bar"},
+			},
+			Body: []byte(`{"name": "foo"}`),
+		},
+		{
+			Method: "GET",
+			URL:    mustParseURL(t, "http://api.example.org:8080/"),
+		},
+		{
+			Method: "GET",
+			URL:    mustParseURL(t, "https://www.example.com/health"),
+		},
+	}
+
+	buf := bytes.Buffer{}
+
+	if err := reqlog.ExportPostmanCollection(&buf, "foobar", reqLogs); err != nil {
+		t.Fatalf("unexpected error (expected: nil, got: %v)", err)
+	}
+
+	var got interface{}
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("unexpected error decoding collection: %v", err)
+	}
+
+	exp := map[string]interface{}{
+		"info": map[string]interface{}{
+			"name":   "foobar",
+			"schema": "https://schema.getpostman.com/json/collection/v2.1.0/collection.json",
+		},
+		"item": []interface{}{
+			map[string]interface{}{
+				"name": "api.example.org:8080",
+				"item": []interface{}{
+					map[string]interface{}{
+						"name": "GET /",
+						"request": map[string]interface{}{
+							"method": "GET",
+							"header": []interface{}{},
+							"url": map[string]interface{}{
+								"raw":      "http://api.example.org:8080/",
+								"protocol": "http",
+								"host":     []interface{}{"api", "example", "org"},
+								"port":     "8080",
+							},
+						},
+					},
+				},
+			},
+			map[string]interface{}{
+				"name": "www.example.com",
+				"item": []interface{}{
+					map[string]interface{}{
+						"name": "POST /api/users",
+						"request": map[string]interface{}{
+							"method": "POST",
+							"header": []interface{}{
+								map[string]interface{}{"key": "Content-Type", "value": "application/json"},
+								map[string]interface{}{"key": "X-Foo", "value": "bar"},
+							},
+							"url": map[string]interface{}{
+								"raw":      "https://www.example.com/api/users?page=2&sort=name",
+								"protocol": "https",
+								"host":     []interface{}{"www", "example", "com"},
+								"path":     []interface{}{"api", "users"},
+								"query": []interface{}{
+									map[string]interface{}{"key": "page", "value": "2"},
+									map[string]interface{}{"key": "sort", "value": "name"},
+								},
+							},
+							"body": map[string]interface{}{
+								"mode": "raw",
+								"raw":  `{"name": "foo"}`,
+							},
+						},
+					},
+					map[string]interface{}{
+						"name": "GET /health",
+						"request": map[string]interface{}{
+							"method": "GET",
+							"header": []interface{}{},
+							"url": map[string]interface{}{
+								"raw":      "https://www.example.com/health",
+								"protocol": "https",
+								"host":     []interface{}{"www", "example", "com"},
+								"path":     []interface{}{"health"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if diff := cmp.Diff(exp, got); diff != "" {
+		t.Fatalf("collection not equal (-exp, +got):\n%v", diff)
+	}
+}
@@ -0,0 +1,47 @@
+package reqlog
+
+import "net"
+
+// targetsPrivateIP returns true if the request host is a private, loopback,
+// link-local or unspecified IP address. Host names are only resolved if
+// lookupIP is set; the request then targets a private IP if any of the
+// resolved addresses is private.
+func targetsPrivateIP(rl RequestLog, lookupIP func(host string) ([]net.IP, error)) bool {
+	if rl.URL == nil {
+		return false
+	}
+
+	host := rl.URL.Hostname()
+	if host == "" {
+		return false
+	}
+
+	if ip := net.ParseIP(host); ip != nil {
+		return isPrivateIP(ip)
+	}
+
+	if lookupIP == nil {
+		return false
+	}
+
+	ips, err := lookupIP(host)
+	if err != nil {
+		return false
+	}
+
+	for _, ip := range ips {
+		if isPrivateIP(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isPrivateIP(ip net.IP) bool {
+	return ip.IsPrivate() ||
+		ip.IsLoopback() ||
+		ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() ||
+		ip.IsUnspecified()
+}
@@ -0,0 +1,137 @@
+package reqlog
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// rawFlowSeparator separates the raw request and response in RawFlow.
+var rawFlowSeparator = []byte("\r\n###\r\n")
+
+// Raw returns the request as a raw HTTP/1.x message. Because the original
+// message isn't stored as-is, it's reconstructed: HTTP/2 messages are written
+// as HTTP/1.1, headers are sorted by name, the `Host` header is derived from
+// the URL when absent, and `Content-Length` reflects the stored body.
+func (reqLog RequestLog) Raw() []byte {
+	buf := bytes.Buffer{}
+
+	proto := rawProto(reqLog.Proto)
+
+	requestURI := "/"
+	host := ""
+
+	if reqLog.URL != nil {
+		requestURI = reqLog.URL.RequestURI()
+		host = reqLog.URL.Host
+	}
+
+	fmt.Fprintf(&buf, "%s %s %s\r\n", reqLog.Method, requestURI, proto)
+
+	header := reqLog.Header.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+
+	if header.Get("Host") == "" && host != "" {
+		header.Set("Host", host)
+	}
+
+	writeRawHeaderAndBody(&buf, header, reqLog.Body)
+
+	return buf.Bytes()
+}
+
+// Raw returns the response as a raw HTTP/1.x message. Because the original
+// message isn't stored as-is, it's reconstructed: headers are sorted by name
+// and `Content-Length` reflects the stored body. For bodies that were decoded
+// before they were stored, the `Content-Encoding` header is omitted.
+func (resLog ResponseLog) Raw() []byte {
+	buf := bytes.Buffer{}
+
+	proto := rawProto(resLog.Proto)
+
+	status := resLog.Status
+	if status == "" {
+		status = strconv.Itoa(resLog.StatusCode) + " " + http.StatusText(resLog.StatusCode)
+	}
+
+	fmt.Fprintf(&buf, "%s %s\r\n", proto, status)
+
+	header := resLog.Header.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+
+	// Response logs stored before the content encoding was recorded only
+	// have gzipped bodies decoded.
+	if resLog.ContentEncoding != "" || header.Get("Content-Encoding") == "gzip" {
+		header.Del("Content-Encoding")
+	}
+
+	// Errors reading a body from disk are ignored; the message is written
+	// without a body instead.
+	body, _ := resLog.ReadBody()
+
+	writeRawHeaderAndBody(&buf, header, body)
+
+	return buf.Bytes()
+}
+
+// RawFlow returns the raw request, followed by a separator (`###` on its own
+// line) and the raw response, if there is one. This is suitable for storing a
+// request/response pair as a `.http` file.
+func (reqLog RequestLog) RawFlow() []byte {
+	buf := bytes.Buffer{}
+	buf.Write(reqLog.Raw())
+
+	if reqLog.Response != nil {
+		buf.Write(rawFlowSeparator)
+		buf.Write(reqLog.Response.Raw())
+	}
+
+	return buf.Bytes()
+}
+
+// rawProto returns the protocol version for the start line of a raw message.
+// HTTP/2 has no textual message format, so it's written as HTTP/1.1.
+func rawProto(proto string) string {
+	if !strings.HasPrefix(proto, "HTTP/1.") {
+		return "HTTP/1.1"
+	}
+
+	return proto
+}
+
+func writeRawHeaderAndBody(buf *bytes.Buffer, header http.Header, body []byte) {
+	// Bodies are stored without transfer coding, so the length is always known.
+	header.Del("Transfer-Encoding")
+
+	if len(body) > 0 || header.Get("Content-Length") != "" {
+		header.Set("Content-Length", strconv.Itoa(len(body)))
+	}
+
+	keys := make([]string, 0, len(header))
+	for key := range header {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		for _, value := range header[key] {
+			buf.WriteString(key)
+			buf.WriteString(": ")
+			// Header values can't contain newlines; replace them to not break
+			// the message format.
+			buf.WriteString(strings.NewReplacer("\r", " ", "\n", " ").Replace(value))
+			buf.WriteString("\r\n")
+		}
+	}
+
+	buf.WriteString("\r\n")
+	buf.Write(body)
+}
@@ -0,0 +1,119 @@
+package reqlog_test
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+func TestRequestLogRawFlow(t *testing.T) {
+	t.Parallel()
+
+	reqLog := reqlog.RequestLog{
+		Method: http.MethodPost,
+		URL:    mustParseURL(t, "https://example.com/foo?bar=baz"),
+		Proto:  "HTTP/1.1",
+		Header: http.Header{
+			"Content-Type": []string{"application/json"},
+			"X-Foo":        []string{"foo", "bar"},
+		},
+		Body: []byte(`{"foo":"bar"}`),
+		Response: &reqlog.ResponseLog{
+			Proto:      "HTTP/1.1",
+			StatusCode: http.StatusCreated,
+			Status:     "201 Created",
+			Header: http.Header{
+				"Content-Type": []string{"text/plain"},
+			},
+			Body: []byte("created"),
+		},
+	}
+
+	raw := reqLog.RawFlow()
+	r := bufio.NewReader(bytes.NewReader(raw))
+
+	req, err := http.ReadRequest(r)
+	if err != nil {
+		t.Fatalf("unexpected error reading request: %v", err)
+	}
+
+	t.Run("request line", func(t *testing.T) {
+		if exp := reqLog.Method; exp != req.Method {
+			t.Errorf("incorrect method (expected: %v, got: %v)", exp, req.Method)
+		}
+
+		if exp := "/foo?bar=baz"; exp != req.RequestURI {
+			t.Errorf("incorrect request URI (expected: %v, got: %v)", exp, req.RequestURI)
+		}
+
+		if exp := "example.com"; exp != req.Host {
+			t.Errorf("incorrect host (expected: %v, got: %v)", exp, req.Host)
+		}
+	})
+
+	t.Run("request headers and body", func(t *testing.T) {
+		if exp, got := []string{"foo", "bar"}, req.Header.Values("X-Foo"); len(got) != 2 || got[0] != exp[0] || got[1] != exp[1] {
+			t.Errorf("incorrect `X-Foo` header values (expected: %v, got: %v)", exp, got)
+		}
+
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			t.Fatalf("unexpected error reading request body: %v", err)
+		}
+
+		if exp := string(reqLog.Body); exp != string(body) {
+			t.Errorf("incorrect request body (expected: %v, got: %v)", exp, string(body))
+		}
+	})
+
+	sep := make([]byte, len("\r\n###\r\n"))
+	if _, err := io.ReadFull(r, sep); err != nil {
+		t.Fatalf("unexpected error reading separator: %v", err)
+	}
+
+	if exp := "\r\n###\r\n"; exp != string(sep) {
+		t.Fatalf("incorrect separator (expected: %q, got: %q)", exp, string(sep))
+	}
+
+	res, err := http.ReadResponse(r, req)
+	if err != nil {
+		t.Fatalf("unexpected error reading response: %v", err)
+	}
+
+	t.Run("response", func(t *testing.T) {
+		if exp := reqLog.Response.StatusCode; exp != res.StatusCode {
+			t.Errorf("incorrect status code (expected: %v, got: %v)", exp, res.StatusCode)
+		}
+
+		if exp := "text/plain"; exp != res.Header.Get("Content-Type") {
+			t.Errorf("incorrect `Content-Type` header (expected: %v, got: %v)", exp, res.Header.Get("Content-Type"))
+		}
+
+		body, err := io.ReadAll(res.Body)
+		if err != nil {
+			t.Fatalf("unexpected error reading response body: %v", err)
+		}
+
+		if exp := string(reqLog.Response.Body); exp != string(body) {
+			t.Errorf("incorrect response body (expected: %v, got: %v)", exp, string(body))
+		}
+	})
+}
+
+func TestRequestLogRawFlowWithoutResponse(t *testing.T) {
+	t.Parallel()
+
+	reqLog := reqlog.RequestLog{
+		Method: http.MethodGet,
+		URL:    mustParseURL(t, "https://example.com/"),
+	}
+
+	exp := "GET / HTTP/1.1\r\nHost: example.com\r\n\r\n"
+	if got := string(reqLog.RawFlow()); exp != got {
+		t.Fatalf("incorrect raw flow (expected: %q, got: %q)", exp, got)
+	}
+}
@@ -0,0 +1,68 @@
+package reqlog
+
+import (
+	"archive/zip"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/dstotijn/hetty/pkg/search"
+)
+
+// ExportRawFlowsZip writes the request logs of the active project that match
+// expr to w, as a zip archive with a raw `.http` file (see `RawFlow`) per log.
+// Files are named by request log ID and host. If expr is nil, all request logs
+// are exported.
+func (svc *Service) ExportRawFlowsZip(ctx context.Context, w io.Writer, expr search.Expression) error {
+	matchCfg := svc.matchConfig
+	filter := FindRequestsFilter{
+		ProjectID:   svc.ActiveProjectID,
+		SearchExpr:  expr,
+		MatchConfig: &matchCfg,
+	}
+
+	filter, err := svc.withCandidates(ctx, filter)
+	if err != nil {
+		return err
+	}
+
+	reqLogs, err := svc.repo.FindRequestLogs(ctx, filter, svc.scope)
+	if err != nil {
+		return fmt.Errorf("reqlog: could not find requests: %w", err)
+	}
+
+	zw := zip.NewWriter(w)
+
+	for _, reqLog := range reqLogs {
+		f, err := zw.Create(rawFlowFilename(reqLog))
+		if err != nil {
+			return fmt.Errorf("reqlog: could not create zip entry: %w", err)
+		}
+
+		if _, err := f.Write(reqLog.RawFlow()); err != nil {
+			return fmt.Errorf("reqlog: could not write zip entry: %w", err)
+		}
+	}
+
+	if err := zw.Close(); err != nil {
+		return fmt.Errorf("reqlog: could not close zip writer: %w", err)
+	}
+
+	return nil
+}
+
+// rawFlowFilename returns the name of a request log's file in a zip archive,
+// e.g. `01FQ2QW5D1M2JRA8CFDBX6ZYRE_example.com.http`.
+func rawFlowFilename(reqLog RequestLog) string {
+	name := reqLog.ID.String()
+
+	if reqLog.URL != nil && reqLog.URL.Host != "" {
+		// Replace characters that aren't allowed in filenames on all platforms
+		// (e.g. the port separator).
+		host := strings.NewReplacer(":", "_", "/", "_", `\`, "_").Replace(reqLog.URL.Host)
+		name += "_" + host
+	}
+
+	return name + ".http"
+}
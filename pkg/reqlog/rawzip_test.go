@@ -0,0 +1,115 @@
+package reqlog_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/oklog/ulid"
+
+	"github.com/dstotijn/hetty/pkg/reqlog"
+	"github.com/dstotijn/hetty/pkg/scope"
+	"github.com/dstotijn/hetty/pkg/search"
+)
+
+func TestExportRawFlowsZip(t *testing.T) {
+	t.Parallel()
+
+	reqLogs := []reqlog.RequestLog{
+		{
+			ID:     ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy),
+			Method: "GET",
+			URL:    mustParseURL(t, "https://example.com/foo"),
+			Response: &reqlog.ResponseLog{
+				StatusCode: 200,
+				Body:       []byte("foo"),
+			},
+		},
+		{
+			ID:     ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy),
+			Method: "POST",
+			URL:    mustParseURL(t, "http://api.example.org:8080/bar"),
+		},
+		{
+			ID:     ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy),
+			Method: "GET",
+			URL:    mustParseURL(t, "https://example.com/baz"),
+		},
+	}
+
+	repoMock := &RepoMock{
+		FindRequestLogsFunc: func(_ context.Context, filter reqlog.FindRequestsFilter, _ *scope.Scope) ([]reqlog.RequestLog, error) {
+			var matched []reqlog.RequestLog
+
+			// The index of the service is built without search expression.
+			if filter.SearchExpr == nil {
+				return reqLogs, nil
+			}
+
+			for _, reqLog := range reqLogs {
+				match, err := reqLog.Matches(filter.SearchExpr)
+				if err != nil {
+					return nil, err
+				}
+
+				if match {
+					matched = append(matched, reqLog)
+				}
+			}
+
+			return matched, nil
+		},
+	}
+	svc := reqlog.NewService(reqlog.Config{
+		Repository: repoMock,
+		Scope:      &scope.Scope{},
+	})
+	svc.ActiveProjectID = ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+
+	searchExpr, err := search.ParseQuery("req.url =~ foo OR req.method = POST")
+	if err != nil {
+		t.Fatalf("unexpected error parsing query: %v", err)
+	}
+
+	buf := bytes.Buffer{}
+
+	if err := svc.ExportRawFlowsZip(context.Background(), &buf, searchExpr); err != nil {
+		t.Fatalf("unexpected error (expected: nil, got: %v)", err)
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("unexpected error reading zip archive: %v", err)
+	}
+
+	got := make(map[string]string)
+
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatalf("unexpected error opening zip entry: %v", err)
+		}
+
+		content, err := io.ReadAll(rc)
+		rc.Close()
+
+		if err != nil {
+			t.Fatalf("unexpected error reading zip entry: %v", err)
+		}
+
+		got[f.Name] = string(content)
+	}
+
+	exp := map[string]string{
+		reqLogs[0].ID.String() + "_example.com.http":          string(reqLogs[0].RawFlow()),
+		reqLogs[1].ID.String() + "_api.example.org_8080.http": string(reqLogs[1].RawFlow()),
+	}
+
+	if diff := cmp.Diff(exp, got); diff != "" {
+		t.Fatalf("zip entries not equal (-exp, +got):\n%v", diff)
+	}
+}
@@ -10,8 +10,17 @@ import (
 
 type Repository interface {
 	FindRequestLogs(ctx context.Context, filter FindRequestsFilter, scope *scope.Scope) ([]RequestLog, error)
+	FindRequestLogsPage(
+		ctx context.Context,
+		filter FindRequestsFilter,
+		opts PageOptions,
+		scope *scope.Scope,
+	) (RequestLogPage, error)
 	FindRequestLogByID(ctx context.Context, id ulid.ULID) (RequestLog, error)
 	StoreRequestLog(ctx context.Context, reqLog RequestLog) error
 	StoreResponseLog(ctx context.Context, reqLogID ulid.ULID, resLog ResponseLog) error
 	ClearRequestLogs(ctx context.Context, projectID ulid.ULID) error
+	DeleteRequestLogs(ctx context.Context, projectID ulid.ULID, reqLogIDs []ulid.ULID) error
+	DistinctHosts(ctx context.Context, projectID ulid.ULID) ([]string, error)
+	Neighbors(ctx context.Context, id ulid.ULID) (prev, next *RequestLog, err error)
 }
@@ -24,12 +24,24 @@ var _ reqlog.Repository = &RepoMock{}
 // 			ClearRequestLogsFunc: func(ctx context.Context, projectID ulid.ULID) error {
 // 				panic("mock out the ClearRequestLogs method")
 // 			},
+// 			DeleteRequestLogsFunc: func(ctx context.Context, projectID ulid.ULID, reqLogIDs []ulid.ULID) error {
+// 				panic("mock out the DeleteRequestLogs method")
+// 			},
+// 			DistinctHostsFunc: func(ctx context.Context, projectID ulid.ULID) ([]string, error) {
+// 				panic("mock out the DistinctHosts method")
+// 			},
 // 			FindRequestLogByIDFunc: func(ctx context.Context, id ulid.ULID) (reqlog.RequestLog, error) {
 // 				panic("mock out the FindRequestLogByID method")
 // 			},
 // 			FindRequestLogsFunc: func(ctx context.Context, filter reqlog.FindRequestsFilter, scopeMoqParam *scope.Scope) ([]reqlog.RequestLog, error) {
 // 				panic("mock out the FindRequestLogs method")
 // 			},
+// 			FindRequestLogsPageFunc: func(ctx context.Context, filter reqlog.FindRequestsFilter, opts reqlog.PageOptions, scopeMoqParam *scope.Scope) (reqlog.RequestLogPage, error) {
+// 				panic("mock out the FindRequestLogsPage method")
+// 			},
+// 			NeighborsFunc: func(ctx context.Context, id ulid.ULID) (*reqlog.RequestLog, *reqlog.RequestLog, error) {
+// 				panic("mock out the Neighbors method")
+// 			},
 // 			StoreRequestLogFunc: func(ctx context.Context, reqLog reqlog.RequestLog) error {
 // 				panic("mock out the StoreRequestLog method")
 // 			},
@@ -46,12 +58,24 @@ type RepoMock struct {
 	// ClearRequestLogsFunc mocks the ClearRequestLogs method.
 	ClearRequestLogsFunc func(ctx context.Context, projectID ulid.ULID) error
 
+	// DeleteRequestLogsFunc mocks the DeleteRequestLogs method.
+	DeleteRequestLogsFunc func(ctx context.Context, projectID ulid.ULID, reqLogIDs []ulid.ULID) error
+
+	// DistinctHostsFunc mocks the DistinctHosts method.
+	DistinctHostsFunc func(ctx context.Context, projectID ulid.ULID) ([]string, error)
+
 	// FindRequestLogByIDFunc mocks the FindRequestLogByID method.
 	FindRequestLogByIDFunc func(ctx context.Context, id ulid.ULID) (reqlog.RequestLog, error)
 
 	// FindRequestLogsFunc mocks the FindRequestLogs method.
 	FindRequestLogsFunc func(ctx context.Context, filter reqlog.FindRequestsFilter, scopeMoqParam *scope.Scope) ([]reqlog.RequestLog, error)
 
+	// FindRequestLogsPageFunc mocks the FindRequestLogsPage method.
+	FindRequestLogsPageFunc func(ctx context.Context, filter reqlog.FindRequestsFilter, opts reqlog.PageOptions, scopeMoqParam *scope.Scope) (reqlog.RequestLogPage, error)
+
+	// NeighborsFunc mocks the Neighbors method.
+	NeighborsFunc func(ctx context.Context, id ulid.ULID) (*reqlog.RequestLog, *reqlog.RequestLog, error)
+
 	// StoreRequestLogFunc mocks the StoreRequestLog method.
 	StoreRequestLogFunc func(ctx context.Context, reqLog reqlog.RequestLog) error
 
@@ -67,6 +91,22 @@ type RepoMock struct {
 			// ProjectID is the projectID argument value.
 			ProjectID ulid.ULID
 		}
+		// DeleteRequestLogs holds details about calls to the DeleteRequestLogs method.
+		DeleteRequestLogs []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ProjectID is the projectID argument value.
+			ProjectID ulid.ULID
+			// ReqLogIDs is the reqLogIDs argument value.
+			ReqLogIDs []ulid.ULID
+		}
+		// DistinctHosts holds details about calls to the DistinctHosts method.
+		DistinctHosts []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ProjectID is the projectID argument value.
+			ProjectID ulid.ULID
+		}
 		// FindRequestLogByID holds details about calls to the FindRequestLogByID method.
 		FindRequestLogByID []struct {
 			// Ctx is the ctx argument value.
@@ -83,6 +123,24 @@ type RepoMock struct {
 			// ScopeMoqParam is the scopeMoqParam argument value.
 			ScopeMoqParam *scope.Scope
 		}
+		// FindRequestLogsPage holds details about calls to the FindRequestLogsPage method.
+		FindRequestLogsPage []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Filter is the filter argument value.
+			Filter reqlog.FindRequestsFilter
+			// Opts is the opts argument value.
+			Opts reqlog.PageOptions
+			// ScopeMoqParam is the scopeMoqParam argument value.
+			ScopeMoqParam *scope.Scope
+		}
+		// Neighbors holds details about calls to the Neighbors method.
+		Neighbors []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID ulid.ULID
+		}
 		// StoreRequestLog holds details about calls to the StoreRequestLog method.
 		StoreRequestLog []struct {
 			// Ctx is the ctx argument value.
@@ -100,11 +158,15 @@ type RepoMock struct {
 			ResLog reqlog.ResponseLog
 		}
 	}
-	lockClearRequestLogs   sync.RWMutex
-	lockFindRequestLogByID sync.RWMutex
-	lockFindRequestLogs    sync.RWMutex
-	lockStoreRequestLog    sync.RWMutex
-	lockStoreResponseLog   sync.RWMutex
+	lockClearRequestLogs    sync.RWMutex
+	lockDeleteRequestLogs   sync.RWMutex
+	lockDistinctHosts       sync.RWMutex
+	lockFindRequestLogByID  sync.RWMutex
+	lockFindRequestLogs     sync.RWMutex
+	lockFindRequestLogsPage sync.RWMutex
+	lockNeighbors           sync.RWMutex
+	lockStoreRequestLog     sync.RWMutex
+	lockStoreResponseLog    sync.RWMutex
 }
 
 // ClearRequestLogs calls ClearRequestLogsFunc.
@@ -142,6 +204,80 @@ func (mock *RepoMock) ClearRequestLogsCalls() []struct {
 	return calls
 }
 
+// DeleteRequestLogs calls DeleteRequestLogsFunc.
+func (mock *RepoMock) DeleteRequestLogs(ctx context.Context, projectID ulid.ULID, reqLogIDs []ulid.ULID) error {
+	if mock.DeleteRequestLogsFunc == nil {
+		panic("RepoMock.DeleteRequestLogsFunc: method is nil but Repository.DeleteRequestLogs was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ProjectID ulid.ULID
+		ReqLogIDs []ulid.ULID
+	}{
+		Ctx:       ctx,
+		ProjectID: projectID,
+		ReqLogIDs: reqLogIDs,
+	}
+	mock.lockDeleteRequestLogs.Lock()
+	mock.calls.DeleteRequestLogs = append(mock.calls.DeleteRequestLogs, callInfo)
+	mock.lockDeleteRequestLogs.Unlock()
+	return mock.DeleteRequestLogsFunc(ctx, projectID, reqLogIDs)
+}
+
+// DeleteRequestLogsCalls gets all the calls that were made to DeleteRequestLogs.
+// Check the length with:
+//     len(mockedRepository.DeleteRequestLogsCalls())
+func (mock *RepoMock) DeleteRequestLogsCalls() []struct {
+	Ctx       context.Context
+	ProjectID ulid.ULID
+	ReqLogIDs []ulid.ULID
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ProjectID ulid.ULID
+		ReqLogIDs []ulid.ULID
+	}
+	mock.lockDeleteRequestLogs.RLock()
+	calls = mock.calls.DeleteRequestLogs
+	mock.lockDeleteRequestLogs.RUnlock()
+	return calls
+}
+
+// DistinctHosts calls DistinctHostsFunc.
+func (mock *RepoMock) DistinctHosts(ctx context.Context, projectID ulid.ULID) ([]string, error) {
+	if mock.DistinctHostsFunc == nil {
+		panic("RepoMock.DistinctHostsFunc: method is nil but Repository.DistinctHosts was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ProjectID ulid.ULID
+	}{
+		Ctx:       ctx,
+		ProjectID: projectID,
+	}
+	mock.lockDistinctHosts.Lock()
+	mock.calls.DistinctHosts = append(mock.calls.DistinctHosts, callInfo)
+	mock.lockDistinctHosts.Unlock()
+	return mock.DistinctHostsFunc(ctx, projectID)
+}
+
+// DistinctHostsCalls gets all the calls that were made to DistinctHosts.
+// Check the length with:
+//     len(mockedRepository.DistinctHostsCalls())
+func (mock *RepoMock) DistinctHostsCalls() []struct {
+	Ctx       context.Context
+	ProjectID ulid.ULID
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ProjectID ulid.ULID
+	}
+	mock.lockDistinctHosts.RLock()
+	calls = mock.calls.DistinctHosts
+	mock.lockDistinctHosts.RUnlock()
+	return calls
+}
+
 // FindRequestLogByID calls FindRequestLogByIDFunc.
 func (mock *RepoMock) FindRequestLogByID(ctx context.Context, id ulid.ULID) (reqlog.RequestLog, error) {
 	if mock.FindRequestLogByIDFunc == nil {
@@ -216,6 +352,84 @@ func (mock *RepoMock) FindRequestLogsCalls() []struct {
 	return calls
 }
 
+// FindRequestLogsPage calls FindRequestLogsPageFunc.
+func (mock *RepoMock) FindRequestLogsPage(ctx context.Context, filter reqlog.FindRequestsFilter, opts reqlog.PageOptions, scopeMoqParam *scope.Scope) (reqlog.RequestLogPage, error) {
+	if mock.FindRequestLogsPageFunc == nil {
+		panic("RepoMock.FindRequestLogsPageFunc: method is nil but Repository.FindRequestLogsPage was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		Filter        reqlog.FindRequestsFilter
+		Opts          reqlog.PageOptions
+		ScopeMoqParam *scope.Scope
+	}{
+		Ctx:           ctx,
+		Filter:        filter,
+		Opts:          opts,
+		ScopeMoqParam: scopeMoqParam,
+	}
+	mock.lockFindRequestLogsPage.Lock()
+	mock.calls.FindRequestLogsPage = append(mock.calls.FindRequestLogsPage, callInfo)
+	mock.lockFindRequestLogsPage.Unlock()
+	return mock.FindRequestLogsPageFunc(ctx, filter, opts, scopeMoqParam)
+}
+
+// FindRequestLogsPageCalls gets all the calls that were made to FindRequestLogsPage.
+// Check the length with:
+//     len(mockedRepository.FindRequestLogsPageCalls())
+func (mock *RepoMock) FindRequestLogsPageCalls() []struct {
+	Ctx           context.Context
+	Filter        reqlog.FindRequestsFilter
+	Opts          reqlog.PageOptions
+	ScopeMoqParam *scope.Scope
+} {
+	var calls []struct {
+		Ctx           context.Context
+		Filter        reqlog.FindRequestsFilter
+		Opts          reqlog.PageOptions
+		ScopeMoqParam *scope.Scope
+	}
+	mock.lockFindRequestLogsPage.RLock()
+	calls = mock.calls.FindRequestLogsPage
+	mock.lockFindRequestLogsPage.RUnlock()
+	return calls
+}
+
+// Neighbors calls NeighborsFunc.
+func (mock *RepoMock) Neighbors(ctx context.Context, id ulid.ULID) (*reqlog.RequestLog, *reqlog.RequestLog, error) {
+	if mock.NeighborsFunc == nil {
+		panic("RepoMock.NeighborsFunc: method is nil but Repository.Neighbors was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  ulid.ULID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockNeighbors.Lock()
+	mock.calls.Neighbors = append(mock.calls.Neighbors, callInfo)
+	mock.lockNeighbors.Unlock()
+	return mock.NeighborsFunc(ctx, id)
+}
+
+// NeighborsCalls gets all the calls that were made to Neighbors.
+// Check the length with:
+//     len(mockedRepository.NeighborsCalls())
+func (mock *RepoMock) NeighborsCalls() []struct {
+	Ctx context.Context
+	ID  ulid.ULID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  ulid.ULID
+	}
+	mock.lockNeighbors.RLock()
+	calls = mock.calls.Neighbors
+	mock.lockNeighbors.RUnlock()
+	return calls
+}
+
 // StoreRequestLog calls StoreRequestLogFunc.
 func (mock *RepoMock) StoreRequestLog(ctx context.Context, reqLog reqlog.RequestLog) error {
 	if mock.StoreRequestLogFunc == nil {
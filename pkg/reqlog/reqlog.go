@@ -2,8 +2,8 @@ package reqlog
 
 import (
 	"bytes"
-	"compress/gzip"
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"io"
@@ -12,6 +12,10 @@ import (
 	"math/rand"
 	"net/http"
 	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/oklog/ulid"
@@ -23,7 +27,12 @@ import (
 
 type contextKey int
 
-const LogBypassedKey contextKey = 0
+const (
+	LogBypassedKey contextKey = iota
+	projectIDKey
+	baselineKey
+	siteMapKeyKey
+)
 
 var (
 	ErrRequestNotFound    = errors.New("reqlog: request not found")
@@ -37,93 +46,478 @@ type RequestLog struct {
 	ID        ulid.ULID
 	ProjectID ulid.ULID
 
-	URL    *url.URL
+	// URL is the URL as requested by the client.
+	URL *url.URL
+	// EffectiveURL is the URL the request was sent to, if a request modifier
+	// rewrote it. It's nil if the URL wasn't rewritten.
+	EffectiveURL *url.URL
+
 	Method string
 	Proto  string
 	Header http.Header
 	Body   []byte
 
+	// TLS holds details of the client connection's TLS state. It's nil for
+	// plaintext requests.
+	TLS *TLSInfo
+	// TLSError is the reason the TLS handshake with the client failed. Logs
+	// for failed handshakes only hold the CONNECT request; there is no full
+	// request or response.
+	TLSError string
+
+	// ParseError is the status of the response the proxy rejected the request
+	// with, because it couldn't be parsed, e.g. `400 Bad Request`. Logs for
+	// rejected requests only hold what could be read from the request line;
+	// there is no header, body or response.
+	ParseError string
+
+	// Baseline references the request this request was derived from, if any.
+	// See `WithBaseline`.
+	Baseline *Baseline
+
+	// Pipelined is true if the client sent the request before the response to
+	// its previous request on the same connection was written.
+	Pipelined bool
+
+	// ParseWarnings are the ways in which the raw request header is malformed
+	// or ambiguous, though tolerated by the proxy (see `proxy.ParseWarningsKey`).
+	ParseWarnings []string
+
+	// Annotations are the note, tags and highlight color set by the user.
+	Annotations Annotations
+
 	Response *ResponseLog
 }
 
+// TLSInfo holds details of the TLS connection a request was received on.
+type TLSInfo struct {
+	Version     uint16
+	CipherSuite uint16
+	// JA3 is the JA3 fingerprint (MD5 hash) of the client's ClientHello.
+	JA3 string
+}
+
+func tlsInfoFromConnectionState(cs *tls.ConnectionState) *TLSInfo {
+	if cs == nil {
+		return nil
+	}
+
+	return &TLSInfo{
+		Version:     cs.Version,
+		CipherSuite: cs.CipherSuite,
+	}
+}
+
 type ResponseLog struct {
 	Proto      string
 	StatusCode int
 	Status     string
 	Header     http.Header
 	Body       []byte
+
+	// BodyFile is the path of the file that holds the body, for bodies that
+	// exceeded `Config.BodyFileThreshold`. When set, `Body` is empty; use
+	// `ReadBody` to get the body regardless of where it's stored.
+	BodyFile string
+
+	// ContentEncoding is the `Content-Encoding` of the body as it was
+	// received (e.g. `gzip` or `br`), if the body was decoded before it was
+	// stored. `Body` and `BodyFile` then hold the decoded body, and `RawBody`
+	// and `RawBodyFile` the body as received; use `ReadRawBody` to get it.
+	// It's empty for bodies that are stored as received.
+	ContentEncoding string
+	RawBody         []byte
+	RawBodyFile     string
+
+	// Timing holds the durations of the phases of the upstream request.
+	Timing proxy.Timing
+
+	// Latency is the time it took for the response to be received, from
+	// getting a connection to the upstream server until the first response
+	// byte. It's zero if it wasn't recorded.
+	Latency time.Duration
+
+	// Duration is the time it took for the full response to be received, from
+	// getting a connection to the upstream server until the last body byte.
+	// It's zero if it wasn't recorded.
+	Duration time.Duration
+
+	// Size is the number of body bytes as received, before decoding (e.g. of
+	// gzip). It's zero for response logs stored before it was recorded.
+	Size int64
+
+	// ParseWarnings are the ways in which the raw response header is malformed
+	// or ambiguous, though tolerated by the proxy. They're only recorded for
+	// plaintext HTTP/1.x upstream connections.
+	ParseWarnings []string
 }
 
 type Service struct {
 	BypassOutOfScopeRequests bool
 	FindReqsFilter           FindRequestsFilter
 	ActiveProjectID          ulid.ULID
-
-	scope *scope.Scope
-	repo  Repository
+	// LogFilter, if set, is evaluated against each incoming request before
+	// it's logged (see `ShouldLog`). Requests that don't match aren't logged.
+	LogFilter search.Expression
+	// LoginBoundary is the ID of the request log marked as the login request,
+	// used by the `req.afterLogin` search key.
+	LoginBoundary ulid.ULID
+	// DisableBodyFiles keeps all response bodies in the repository, regardless
+	// of `Config.BodyFileThreshold`. It's set for encrypted projects, because
+	// body files aren't encrypted.
+	DisableBodyFiles bool
+	// Retention limits the request logs that are kept for the active project.
+	// It's enforced by `Prune`.
+	Retention RetentionPolicy
+
+	scope             *scope.Scope
+	repo              Repository
+	bodyFileThreshold int64
+	bodyFileDir       string
+	matchConfig       MatchConfig
+	searchHistory     *searchHistory
+	siteMap           *siteMap
+	index             *index
+
+	pruneMu    sync.Mutex
+	pruneStats map[ulid.ULID]PruneStats
+
+	annotateMu sync.Mutex
+
+	subscribersMu       sync.Mutex
+	subscribers         map[chan RequestLog]Matcher
+	onResponseStoredFns []OnResponseStoredFn
 }
 
 type FindRequestsFilter struct {
 	ProjectID   ulid.ULID
 	OnlyInScope bool
 	SearchExpr  search.Expression
+	// MatchConfig is used when matching SearchExpr. If nil, the default match
+	// config is used.
+	MatchConfig *MatchConfig
+	// Candidates, if not nil, are the only request logs that can match
+	// SearchExpr, as found by the index of the service. Repositories can skip
+	// other request logs without retrieving them.
+	Candidates *CandidateSet
+}
+
+// CandidateSet holds the IDs of the request logs that can match a filter.
+type CandidateSet struct {
+	ids map[ulid.ULID]struct{}
+}
+
+// NewCandidateSet returns a set of candidates with the request logs with ids.
+func NewCandidateSet(ids []ulid.ULID) *CandidateSet {
+	cs := &CandidateSet{ids: make(map[ulid.ULID]struct{}, len(ids))}
+	for _, id := range ids {
+		cs.ids[id] = struct{}{}
+	}
+
+	return cs
+}
+
+// Contains returns true if the request log with id is a candidate. A nil set
+// contains all request logs.
+func (cs *CandidateSet) Contains(id ulid.ULID) bool {
+	if cs == nil {
+		return true
+	}
+
+	_, ok := cs.ids[id]
+
+	return ok
+}
+
+// Len returns the number of candidates. It's -1 for a nil set.
+func (cs *CandidateSet) Len() int {
+	if cs == nil {
+		return -1
+	}
+
+	return len(cs.ids)
 }
 
 type Config struct {
 	Scope      *scope.Scope
 	Repository Repository
+
+	// BodyFileThreshold is the size (in bytes) above which response bodies are
+	// streamed to a file in BodyFileDir, instead of being kept in memory and
+	// stored in the repository. Zero disables storing bodies on disk.
+	BodyFileThreshold int64
+	BodyFileDir       string
+
+	// MatchConfig configures search keys that check request logs against a
+	// policy (e.g. `res.hasInsecureCookie`). If nil, DefaultMatchConfig is
+	// used.
+	MatchConfig *MatchConfig
+
+	// SearchHistorySize is the number of search expressions kept for
+	// RecentSearches. Defaults to 50.
+	SearchHistorySize int
 }
 
 func NewService(cfg Config) *Service {
+	matchCfg := DefaultMatchConfig()
+	if cfg.MatchConfig != nil {
+		matchCfg = *cfg.MatchConfig
+	}
+
+	historySize := cfg.SearchHistorySize
+	if historySize <= 0 {
+		historySize = defaultSearchHistorySize
+	}
+
 	return &Service{
-		repo:  cfg.Repository,
-		scope: cfg.Scope,
+		repo:              cfg.Repository,
+		scope:             cfg.Scope,
+		bodyFileThreshold: cfg.BodyFileThreshold,
+		bodyFileDir:       cfg.BodyFileDir,
+		matchConfig:       matchCfg,
+		searchHistory:     &searchHistory{size: historySize},
+		siteMap:           &siteMap{},
+		index:             &index{},
+		subscribers:       make(map[chan RequestLog]Matcher),
 	}
 }
 
+// FindRequests returns the request logs that match the service's find filter,
+// using the service's match config.
 func (svc *Service) FindRequests(ctx context.Context) ([]RequestLog, error) {
-	return svc.repo.FindRequestLogs(ctx, svc.FindReqsFilter, svc.scope)
+	filter, err := svc.withCandidates(ctx, svc.findRequestsFilter())
+	if err != nil {
+		return nil, err
+	}
+
+	return svc.repo.FindRequestLogs(ctx, filter, svc.scope)
+}
+
+// findRequestsFilter returns the service's request log filter, with the match
+// config of the service.
+func (svc *Service) findRequestsFilter() FindRequestsFilter {
+	filter := svc.FindReqsFilter
+	matchCfg := svc.matchConfig
+	matchCfg.LoginBoundary = svc.LoginBoundary
+	filter.MatchConfig = &matchCfg
+
+	return filter
 }
 
 func (svc *Service) FindRequestLogByID(ctx context.Context, id ulid.ULID) (RequestLog, error) {
 	return svc.repo.FindRequestLogByID(ctx, id)
 }
 
+// DistinctHosts returns the unique hosts of the request logs of the active
+// project, sorted.
+func (svc *Service) DistinctHosts(ctx context.Context) ([]string, error) {
+	return svc.repo.DistinctHosts(ctx, svc.ActiveProjectID)
+}
+
+// Neighbors returns the request logs immediately preceding and following the
+// request log with the given ID, for stepping through traffic. Either is nil at
+// the boundaries of the project's request logs.
+func (svc *Service) Neighbors(ctx context.Context, id ulid.ULID) (prev, next *RequestLog, err error) {
+	return svc.repo.Neighbors(ctx, id)
+}
+
 func (svc *Service) ClearRequests(ctx context.Context, projectID ulid.ULID) error {
-	return svc.repo.ClearRequestLogs(ctx, projectID)
+	if err := svc.repo.ClearRequestLogs(ctx, projectID); err != nil {
+		return err
+	}
+
+	svc.siteMap.reset()
+	svc.index.reset()
+
+	return svc.DeleteBodyFiles(projectID)
 }
 
-func (svc *Service) storeResponse(ctx context.Context, reqLogID ulid.ULID, res *http.Response) error {
-	if res.Header.Get("Content-Encoding") == "gzip" {
-		gzipReader, err := gzip.NewReader(res.Body)
-		if err != nil {
-			return fmt.Errorf("could not create gzip reader: %w", err)
-		}
-		defer gzipReader.Close()
+func (svc *Service) storeResponse(
+	ctx context.Context,
+	projectID, reqLogID ulid.ULID,
+	res *http.Response,
+	rcv received,
+) error {
+	resLog := newResponseLog(res, rcv)
+
+	if err := svc.setResponseBody(projectID, reqLogID, &resLog, res); err != nil {
+		return err
+	}
+
+	if err := svc.repo.StoreResponseLog(ctx, reqLogID, resLog); err != nil {
+		return err
+	}
+
+	svc.addSiteMapResponse(projectID, res)
+	svc.index.addResponse(projectID, reqLogID, resLog)
+	svc.publishResponse(ctx, reqLogID)
+
+	return nil
+}
+
+// setResponseBody reads the body of res as the body of resLog. When body files
+// are used, an encoded body is recorded to a raw body file, like it's done by
+// `recordResponseBody`; otherwise, it's kept in memory as raw body.
+func (svc *Service) setResponseBody(projectID, reqLogID ulid.ULID, resLog *ResponseLog, res *http.Response) error {
+	if !canDecodeBody(res.Header) {
+		return svc.writeBody(projectID, reqLogID, resLog, res.Body)
+	}
 
-		buf := &bytes.Buffer{}
+	if svc.useBodyFiles() {
+		rec := svc.newBodyRecorder(projectID, reqLogID, true)
 
-		if _, err := io.Copy(buf, gzipReader); err != nil {
-			return fmt.Errorf("could not read gzipped response body: %w", err)
+		if _, err := io.Copy(rec, res.Body); err != nil {
+			rec.discard()
+			return fmt.Errorf("could not read body: %w", err)
 		}
 
-		res.Body = io.NopCloser(buf)
+		return svc.setRecordedBody(projectID, reqLogID, resLog, rec, true)
 	}
 
-	body, err := io.ReadAll(res.Body)
+	raw, err := io.ReadAll(res.Body)
 	if err != nil {
 		return fmt.Errorf("could not read body: %w", err)
 	}
 
+	// Bodies that fail to decode are stored as received.
+	body := raw
+
+	if decoded, ok := decodeBody(raw, res.Header); ok {
+		body = decoded
+		resLog.ContentEncoding = contentEncoding(res.Header)
+		resLog.RawBody = raw
+	}
+
+	return svc.writeBody(projectID, reqLogID, resLog, bytes.NewReader(body))
+}
+
+func (svc *Service) useBodyFiles() bool {
+	return svc.bodyFileThreshold > 0 && !svc.DisableBodyFiles
+}
+
+// writeBody reads the body of a response log from r. Bodies that exceed
+// `Config.BodyFileThreshold` are written to a file.
+func (svc *Service) writeBody(projectID, reqLogID ulid.ULID, resLog *ResponseLog, r io.Reader) error {
+	if !svc.useBodyFiles() {
+		b, err := io.ReadAll(r)
+		if err != nil {
+			return fmt.Errorf("could not read body: %w", err)
+		}
+
+		resLog.Body = b
+
+		return nil
+	}
+
+	rec := &bodyRecorder{
+		threshold: svc.bodyFileThreshold,
+		newFile: func() (*os.File, error) {
+			return svc.createBodyFile(projectID, reqLogID.String())
+		},
+	}
+
+	if _, err := io.Copy(rec, r); err != nil {
+		rec.discard()
+		return fmt.Errorf("could not read body: %w", err)
+	}
+
+	if err := rec.close(); err != nil {
+		return fmt.Errorf("could not close body file: %w", err)
+	}
+
+	if rec.file != nil {
+		resLog.BodyFile = rec.file.Name()
+	} else {
+		resLog.Body = rec.buf.Bytes()
+	}
+
+	return nil
+}
+
+// received describes how the body of a response was received.
+type received struct {
+	// size is the number of body bytes, before decoding.
+	size int64
+	// duration is the time it took to read the body, from the first response
+	// byte.
+	duration time.Duration
+}
+
+// newResponseLog returns a response log for res, without body.
+func newResponseLog(res *http.Response, rcv received) ResponseLog {
 	resLog := ResponseLog{
 		Proto:      res.Proto,
 		StatusCode: res.StatusCode,
 		Status:     res.Status,
 		Header:     res.Header,
-		Body:       body,
+		Size:       rcv.size,
+	}
+
+	if res.Request != nil {
+		if timing, ok := proxy.TimingFromContext(res.Request.Context()); ok {
+			resLog.Timing = timing
+			resLog.Latency = timing.Total
+			resLog.Duration = timing.Total + rcv.duration
+		}
+
+		resLog.ParseWarnings = proxy.ResponseParseWarningsFromContext(res.Request.Context())
+	}
+
+	return resLog
+}
+
+// ParseHTTPResponse returns a response log for res, including its body, for
+// responses that weren't proxied (e.g. sent with `sender`). Like for logged
+// responses, bodies with a `Content-Encoding` are decoded if possible. The body
+// of res is closed.
+func ParseHTTPResponse(res *http.Response) (ResponseLog, error) {
+	defer res.Body.Close()
+
+	start := time.Now()
+
+	raw, err := io.ReadAll(res.Body)
+	if err != nil {
+		return ResponseLog{}, fmt.Errorf("reqlog: could not read body: %w", err)
+	}
+
+	resLog := newResponseLog(res, received{size: int64(len(raw)), duration: time.Since(start)})
+	resLog.Body = raw
+
+	if decoded, ok := decodeBody(raw, res.Header); ok {
+		resLog.Body = decoded
+		resLog.ContentEncoding = contentEncoding(res.Header)
+		resLog.RawBody = raw
+	}
+
+	return resLog, nil
+}
+
+func (svc *Service) createBodyFile(projectID ulid.ULID, name string) (*os.File, error) {
+	dir := svc.projectBodyDir(projectID)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+
+	return os.Create(filepath.Join(dir, name))
+}
+
+// ShouldLog returns true if a request log, built from an incoming request, matches
+// the service's log filter, or if there is no log filter. It's used before the
+// request is sent, so any response set on reqLog is ignored: response search
+// keys resolve to empty strings.
+func (svc *Service) ShouldLog(reqLog RequestLog) (bool, error) {
+	if svc.LogFilter == nil {
+		return true, nil
 	}
 
-	return svc.repo.StoreResponseLog(ctx, reqLogID, resLog)
+	reqLog.Response = nil
+
+	match, err := reqLog.MatchesWithConfig(svc.LogFilter, svc.matchConfig)
+	if err != nil {
+		return false, fmt.Errorf("reqlog: could not match log filter: %w", err)
+	}
+
+	return match, nil
 }
 
 func (svc *Service) RequestModifier(next proxy.RequestModifyFunc) proxy.RequestModifyFunc {
@@ -173,19 +567,306 @@ func (svc *Service) RequestModifier(next proxy.RequestModifyFunc) proxy.RequestM
 			Proto:     clone.Proto,
 			Header:    clone.Header,
 			Body:      body,
+			TLS:       tlsInfoFromConnectionState(req.TLS),
+			Baseline:  baselineFromContext(req.Context()),
+		}
+
+		if reqLog.TLS != nil {
+			reqLog.TLS.JA3, _ = req.Context().Value(proxy.JA3Key).(string)
+		}
+
+		reqLog.Pipelined, _ = req.Context().Value(proxy.PipelinedKey).(bool)
+		reqLog.ParseWarnings, _ = req.Context().Value(proxy.ParseWarningsKey).([]string)
+
+		// If a request modifier rewrote the URL, log the URL as requested by
+		// the client, next to the effective URL.
+		origURL, ok := req.Context().Value(proxy.OriginalURLKey).(*url.URL)
+		if ok && origURL.String() != clone.URL.String() {
+			reqLog.URL = origURL
+			reqLog.EffectiveURL = clone.URL
+		}
+
+		shouldLog, err := svc.ShouldLog(reqLog)
+		if err != nil {
+			// Log the request anyway, so a faulty filter doesn't drop traffic.
+			log.Printf("[ERROR] Could not match request against log filter: %v", err)
+
+			shouldLog = true
 		}
 
-		err := svc.repo.StoreRequestLog(req.Context(), reqLog)
+		if !shouldLog {
+			ctx := context.WithValue(req.Context(), LogBypassedKey, true)
+			*req = *req.WithContext(ctx)
+
+			return
+		}
+
+		err = svc.repo.StoreRequestLog(req.Context(), reqLog)
 		if err != nil {
 			log.Printf("[ERROR] Could not store request log: %v", err)
 			return
 		}
 
+		svc.index.addRequest(reqLog)
+		svc.publish(reqLog)
+
 		ctx := context.WithValue(req.Context(), proxy.ReqLogIDKey, reqLog.ID)
+		ctx = context.WithValue(ctx, projectIDKey, reqLog.ProjectID)
+
+		if key, ok := newSiteMapKey(reqLog); ok {
+			svc.siteMap.addRequest(reqLog.ProjectID, key)
+			ctx = context.WithValue(ctx, siteMapKeyKey, key)
+		}
+
 		*req = *req.WithContext(ctx)
 	}
 }
 
+// HandleTLSError logs a failed TLS handshake with a client. It's a
+// `proxy.TLSErrorFunc`.
+func (svc *Service) HandleTLSError(connectReq *http.Request, tlsErr error) {
+	// Bypass logging if no project is active.
+	if svc.ActiveProjectID.Compare(ulid.ULID{}) == 0 {
+		return
+	}
+
+	u := &url.URL{Scheme: "https", Host: connectReq.Host}
+	req := connectReq.Clone(connectReq.Context())
+	req.URL = u
+
+	// Bypass logging if this setting is enabled and the host doesn't match
+	// any scope rules.
+	if svc.BypassOutOfScopeRequests && !svc.scope.Match(req, nil) {
+		return
+	}
+
+	reqLog := RequestLog{
+		ID:        ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy),
+		ProjectID: svc.ActiveProjectID,
+		Method:    connectReq.Method,
+		URL:       u,
+		Proto:     connectReq.Proto,
+		Header:    req.Header,
+		TLSError:  tlsErr.Error(),
+	}
+
+	if err := svc.repo.StoreRequestLog(connectReq.Context(), reqLog); err != nil {
+		log.Printf("[ERROR] Could not store request log for TLS error: %v", err)
+		return
+	}
+
+	svc.index.addRequest(reqLog)
+	svc.publish(reqLog)
+}
+
+// HandleMalformedRequest logs a request that the proxy rejected, because it
+// couldn't be parsed. Its method, URL and protocol are read from the request
+// line, as far as possible. It's a `proxy.MalformedRequestFunc`.
+func (svc *Service) HandleMalformedRequest(connectReq *http.Request, requestLine, status string) {
+	// Bypass logging if no project is active.
+	if svc.ActiveProjectID.Compare(ulid.ULID{}) == 0 {
+		return
+	}
+
+	method, target, proto := parseRequestLine(requestLine)
+
+	u, err := url.ParseRequestURI(target)
+	if err != nil {
+		u = &url.URL{Path: target}
+	}
+
+	if connectReq != nil {
+		u.Scheme = "https"
+		u.Host = connectReq.Host
+	}
+
+	req := &http.Request{Method: method, URL: u, Host: u.Host, Header: http.Header{}}
+
+	// Bypass logging if this setting is enabled and the host doesn't match
+	// any scope rules.
+	if svc.BypassOutOfScopeRequests && !svc.scope.Match(req, nil) {
+		return
+	}
+
+	reqLog := RequestLog{
+		ID:         ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy),
+		ProjectID:  svc.ActiveProjectID,
+		Method:     method,
+		URL:        u,
+		Proto:      proto,
+		Header:     req.Header,
+		ParseError: status,
+	}
+
+	if err := svc.repo.StoreRequestLog(context.Background(), reqLog); err != nil {
+		log.Printf("[ERROR] Could not store request log for malformed request: %v", err)
+		return
+	}
+
+	svc.index.addRequest(reqLog)
+	svc.publish(reqLog)
+}
+
+// parseRequestLine splits a raw request line in its method, request target and
+// protocol. Unlike net/http, it's lenient: fields are separated by any amount
+// of whitespace, and missing fields are empty, e.g. the protocol of an HTTP/0.9
+// request.
+func parseRequestLine(line string) (method, target, proto string) {
+	fields := strings.Fields(line)
+
+	switch len(fields) {
+	case 0:
+		return "", "", ""
+	case 1:
+		return fields[0], "", ""
+	case 2:
+		return fields[0], fields[1], ""
+	default:
+		return fields[0], fields[1], strings.Join(fields[2:], " ")
+	}
+}
+
+// recordResponseBody wraps the body of res, so that it's recorded while it's
+// read. Once the body is closed, the response log is stored. Bodies that aren't
+// encoded are recorded to their final body file directly; encoded bodies are
+// recorded to a raw body file, and decoded to the body file when storing.
+func (svc *Service) recordResponseBody(res, clone *http.Response, projectID, reqLogID ulid.ULID) {
+	decodable := canDecodeBody(res.Header)
+	rec := svc.newBodyRecorder(projectID, reqLogID, decodable)
+
+	res.Body = &recordingBody{
+		ReadCloser: res.Body,
+		rec:        rec,
+		start:      time.Now(),
+		onClose: func(rec *bodyRecorder, rcv received, err error) {
+			go svc.storeRecordedResponse(clone, projectID, reqLogID, rec, rcv, decodable, err)
+		},
+	}
+}
+
+func (svc *Service) storeRecordedResponse(
+	res *http.Response,
+	projectID, reqLogID ulid.ULID,
+	rec *bodyRecorder,
+	rcv received,
+	decodable bool,
+	recErr error,
+) {
+	if recErr != nil {
+		rec.discard()
+		log.Printf("[ERROR] Could not record response body: %v", recErr)
+
+		return
+	}
+
+	resLog := newResponseLog(res, rcv)
+
+	if err := svc.setRecordedBody(projectID, reqLogID, &resLog, rec, decodable); err != nil {
+		log.Printf("[ERROR] Could not store response body: %v", err)
+		return
+	}
+
+	if err := svc.repo.StoreResponseLog(context.Background(), reqLogID, resLog); err != nil {
+		log.Printf("[ERROR] Could not store response log: %v", err)
+		return
+	}
+
+	svc.addSiteMapResponse(projectID, res)
+	svc.index.addResponse(projectID, reqLogID, resLog)
+	svc.publishResponse(context.Background(), reqLogID)
+}
+
+// newBodyRecorder returns a recorder for the body of a response log. The body
+// of a decodable response is recorded to a raw body file.
+func (svc *Service) newBodyRecorder(projectID, reqLogID ulid.ULID, decodable bool) *bodyRecorder {
+	fileName := reqLogID.String()
+	if decodable {
+		fileName += ".raw"
+	}
+
+	return &bodyRecorder{
+		threshold: svc.bodyFileThreshold,
+		newFile: func() (*os.File, error) {
+			return svc.createBodyFile(projectID, fileName)
+		},
+	}
+}
+
+// setRecordedBody sets the body of resLog to the body recorded by rec. If
+// decodable, the recorded body is decoded, and kept as raw body. Bodies that
+// fail to decode are stored as received.
+func (svc *Service) setRecordedBody(
+	projectID, reqLogID ulid.ULID,
+	resLog *ResponseLog,
+	rec *bodyRecorder,
+	decodable bool,
+) error {
+	if decodable {
+		if err := svc.decodeRecordedBody(projectID, reqLogID, resLog, rec); err != nil {
+			log.Printf("[ERROR] Could not decode response body, storing it as received: %v", err)
+		}
+	}
+
+	if err := rec.close(); err != nil {
+		rec.discard()
+		return fmt.Errorf("could not close body file: %w", err)
+	}
+
+	if resLog.ContentEncoding == "" {
+		if rec.file != nil {
+			resLog.BodyFile = rec.file.Name()
+		} else {
+			resLog.Body = rec.buf.Bytes()
+		}
+	}
+
+	return nil
+}
+
+// decodeRecordedBody decodes a recorded body as the body of a response log,
+// and keeps the recorded body as its raw body. If decoding fails, resLog is
+// left as-is.
+func (svc *Service) decodeRecordedBody(
+	projectID, reqLogID ulid.ULID,
+	resLog *ResponseLog,
+	rec *bodyRecorder,
+) error {
+	raw, err := rec.reader()
+	if err != nil {
+		return err
+	}
+
+	body, err := newDecodingReader(raw, resLog.Header)
+	if err != nil {
+		return err
+	}
+
+	decoded := *resLog
+
+	if err := svc.writeBody(projectID, reqLogID, &decoded, body); err != nil {
+		return err
+	}
+
+	decoded.ContentEncoding = contentEncoding(resLog.Header)
+
+	if rec.file != nil {
+		decoded.RawBodyFile = rec.file.Name()
+	} else {
+		decoded.RawBody = rec.buf.Bytes()
+	}
+
+	*resLog = decoded
+
+	return nil
+}
+
+// ProjectIDFromContext returns the ID of the project that the request, with
+// context ctx, was logged in by `RequestModifier`.
+func ProjectIDFromContext(ctx context.Context) (ulid.ULID, bool) {
+	projectID, ok := ctx.Value(projectIDKey).(ulid.ULID)
+	return projectID, ok
+}
+
 func (svc *Service) ResponseModifier(next proxy.ResponseModifyFunc) proxy.ResponseModifyFunc {
 	return func(res *http.Response) error {
 		if err := next(res); err != nil {
@@ -201,19 +882,49 @@ func (svc *Service) ResponseModifier(next proxy.ResponseModifyFunc) proxy.Respon
 			return errors.New("reqlog: request is missing ID")
 		}
 
+		projectID, ok := res.Request.Context().Value(projectIDKey).(ulid.ULID)
+		if !ok {
+			projectID = svc.ActiveProjectID
+		}
+
 		clone := *res
 
+		// The body of an upgraded response (e.g. for WebSocket) is the
+		// connection to the server, so it's left as-is and not logged.
+		if res.StatusCode == http.StatusSwitchingProtocols {
+			clone.Body = http.NoBody
+
+			go func() {
+				if err := svc.storeResponse(context.Background(), projectID, reqLogID, &clone, received{}); err != nil {
+					log.Printf("[ERROR] Could not store response log: %v", err)
+				}
+			}()
+
+			return nil
+		}
+
+		// When storing large bodies on disk, record the body while it's being
+		// streamed to the client, instead of reading it into memory first.
+		if svc.useBodyFiles() {
+			svc.recordResponseBody(res, &clone, projectID, reqLogID)
+			return nil
+		}
+
+		start := time.Now()
+
 		// TODO: Use io.LimitReader.
 		body, err := ioutil.ReadAll(res.Body)
 		if err != nil {
 			return fmt.Errorf("reqlog: could not read response body: %w", err)
 		}
 
+		rcv := received{size: int64(len(body)), duration: time.Since(start)}
+
 		res.Body = ioutil.NopCloser(bytes.NewBuffer(body))
 		clone.Body = ioutil.NopCloser(bytes.NewBuffer(body))
 
 		go func() {
-			if err := svc.storeResponse(context.Background(), reqLogID, &clone); err != nil {
+			if err := svc.storeResponse(context.Background(), projectID, reqLogID, &clone, rcv); err != nil {
 				log.Printf("[ERROR] Could not store response log: %v", err)
 			}
 		}()
@@ -3,11 +3,21 @@ package reqlog_test
 //go:generate go run github.com/matryer/moq -out repo_mock_test.go -pkg reqlog_test . Repository:RepoMock
 
 import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
 	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
 	"io"
 	"math/rand"
+	"net"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -18,6 +28,7 @@ import (
 	"github.com/dstotijn/hetty/pkg/proxy"
 	"github.com/dstotijn/hetty/pkg/reqlog"
 	"github.com/dstotijn/hetty/pkg/scope"
+	"github.com/dstotijn/hetty/pkg/search"
 )
 
 //nolint:gosec
@@ -58,6 +69,9 @@ func TestRequestModifier(t *testing.T) {
 			Proto:     req.Proto,
 			Header:    req.Header,
 			Body:      []byte("modified body"),
+			TLS: &reqlog.TLSInfo{
+				Version: req.TLS.Version,
+			},
 		}
 		got := repoMock.StoreRequestLogCalls()[0].ReqLog
 		got.ID = ulid.ULID{} // Override to empty value so we can compare against expected value.
@@ -68,6 +82,505 @@ func TestRequestModifier(t *testing.T) {
 	})
 }
 
+func TestRequestModifierTLS(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		target    string
+		ja3       string
+		connState *tls.ConnectionState
+		expTLS    *reqlog.TLSInfo
+	}{
+		{
+			name:   "TLS connection",
+			target: "https://example.com/",
+			connState: &tls.ConnectionState{
+				Version:     tls.VersionTLS12,
+				CipherSuite: tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+			},
+			expTLS: &reqlog.TLSInfo{
+				Version:     tls.VersionTLS12,
+				CipherSuite: tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+			},
+		},
+		{
+			name:   "TLS connection with JA3 fingerprint",
+			target: "https://example.com/",
+			ja3:    "fd17f1f9b9c56d4bfda8900c8900ec06",
+			connState: &tls.ConnectionState{
+				Version:     tls.VersionTLS13,
+				CipherSuite: tls.TLS_AES_128_GCM_SHA256,
+			},
+			expTLS: &reqlog.TLSInfo{
+				Version:     tls.VersionTLS13,
+				CipherSuite: tls.TLS_AES_128_GCM_SHA256,
+				JA3:         "fd17f1f9b9c56d4bfda8900c8900ec06",
+			},
+		},
+		{
+			name:      "plaintext connection",
+			target:    "http://example.com/",
+			connState: nil,
+			expTLS:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repoMock := &RepoMock{
+				StoreRequestLogFunc: func(_ context.Context, _ reqlog.RequestLog) error {
+					return nil
+				},
+			}
+			svc := reqlog.NewService(reqlog.Config{
+				Repository: repoMock,
+				Scope:      &scope.Scope{},
+			})
+			svc.ActiveProjectID = ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+
+			req := httptest.NewRequest("GET", tt.target, nil)
+			req.TLS = tt.connState
+
+			if tt.ja3 != "" {
+				req = req.WithContext(context.WithValue(req.Context(), proxy.JA3Key, tt.ja3))
+			}
+
+			svc.RequestModifier(func(_ *http.Request) {})(req)
+
+			if got := len(repoMock.StoreRequestLogCalls()); got != 1 {
+				t.Fatalf("incorrect `StoreRequestLog` calls (expected: 1, got: %v)", got)
+			}
+
+			got := repoMock.StoreRequestLogCalls()[0].ReqLog.TLS
+			if diff := cmp.Diff(tt.expTLS, got); diff != "" {
+				t.Fatalf("TLS info not equal (-exp, +got):\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestRequestModifierEffectiveURL(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		rewriteTo       string
+		expURL          string
+		expEffectiveURL *url.URL
+	}{
+		{
+			name:            "rewritten request",
+			rewriteTo:       "https://staging.example.com/foo",
+			expURL:          "https://example.com/foo",
+			expEffectiveURL: &url.URL{Scheme: "https", Host: "staging.example.com", Path: "/foo"},
+		},
+		{
+			name:            "unmodified request",
+			rewriteTo:       "",
+			expURL:          "https://example.com/foo",
+			expEffectiveURL: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repoMock := &RepoMock{
+				StoreRequestLogFunc: func(_ context.Context, _ reqlog.RequestLog) error {
+					return nil
+				},
+			}
+			svc := reqlog.NewService(reqlog.Config{
+				Repository: repoMock,
+				Scope:      &scope.Scope{},
+			})
+			svc.ActiveProjectID = ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+
+			req := httptest.NewRequest("GET", "https://example.com/foo", nil)
+			origURL := *req.URL
+			req = req.WithContext(context.WithValue(req.Context(), proxy.OriginalURLKey, &origURL))
+
+			next := func(req *http.Request) {
+				if tt.rewriteTo == "" {
+					return
+				}
+
+				u, err := url.Parse(tt.rewriteTo)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				req.URL = u
+			}
+
+			svc.RequestModifier(next)(req)
+
+			if got := len(repoMock.StoreRequestLogCalls()); got != 1 {
+				t.Fatalf("incorrect `StoreRequestLog` calls (expected: 1, got: %v)", got)
+			}
+
+			got := repoMock.StoreRequestLogCalls()[0].ReqLog
+
+			if got.URL.String() != tt.expURL {
+				t.Errorf("expected URL: %v, got: %v", tt.expURL, got.URL)
+			}
+
+			if diff := cmp.Diff(tt.expEffectiveURL, got.EffectiveURL); diff != "" {
+				t.Errorf("effective URL not equal (-exp, +got):\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestRequestModifierBaseline(t *testing.T) {
+	t.Parallel()
+
+	repoMock := &RepoMock{
+		StoreRequestLogFunc: func(_ context.Context, _ reqlog.RequestLog) error {
+			return nil
+		},
+	}
+	svc := reqlog.NewService(reqlog.Config{
+		Repository: repoMock,
+		Scope:      &scope.Scope{},
+	})
+	svc.ActiveProjectID = ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+
+	baseline := reqlog.RequestLog{
+		ID:   ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy),
+		Body: []byte("foo=bar"),
+	}
+
+	req := httptest.NewRequest("POST", "https://example.com/", strings.NewReader("foo=barbarbar"))
+	req = req.WithContext(reqlog.WithBaseline(req.Context(), baseline))
+
+	svc.RequestModifier(func(_ *http.Request) {})(req)
+
+	if got := len(repoMock.StoreRequestLogCalls()); got != 1 {
+		t.Fatalf("incorrect `StoreRequestLog` calls (expected: 1, got: %v)", got)
+	}
+
+	exp := &reqlog.Baseline{ReqLogID: baseline.ID, BodySize: 7}
+	got := repoMock.StoreRequestLogCalls()[0].ReqLog.Baseline
+
+	if diff := cmp.Diff(exp, got); diff != "" {
+		t.Fatalf("baseline not equal (-exp, +got):\n%v", diff)
+	}
+}
+
+func TestRequestModifierLogFilter(t *testing.T) {
+	t.Parallel()
+
+	repoMock := &RepoMock{
+		StoreRequestLogFunc: func(_ context.Context, _ reqlog.RequestLog) error {
+			return nil
+		},
+	}
+	svc := reqlog.NewService(reqlog.Config{
+		Repository: repoMock,
+		Scope:      &scope.Scope{},
+	})
+	svc.ActiveProjectID = ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+
+	logFilter, err := search.ParseQuery(`NOT (req.url =~ "\.(png|css)$")`)
+	if err != nil {
+		t.Fatalf("unexpected error parsing query: %v", err)
+	}
+
+	svc.LogFilter = logFilter
+
+	logged := httptest.NewRequest("GET", "https://example.com/foo", nil)
+	svc.RequestModifier(func(_ *http.Request) {})(logged)
+
+	bypassed := httptest.NewRequest("GET", "https://example.com/logo.png", nil)
+	svc.RequestModifier(func(_ *http.Request) {})(bypassed)
+
+	if got := len(repoMock.StoreRequestLogCalls()); got != 1 {
+		t.Fatalf("incorrect `StoreRequestLog` calls (expected: 1, got: %v)", got)
+	}
+
+	if got := repoMock.StoreRequestLogCalls()[0].ReqLog.URL.Path; got != "/foo" {
+		t.Errorf("expected request log for `/foo` to be stored, got: %v", got)
+	}
+
+	if bypassed, _ := bypassed.Context().Value(reqlog.LogBypassedKey).(bool); !bypassed {
+		t.Error("expected request not matching log filter to be bypassed")
+	}
+}
+
+func TestShouldLog(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		logFilter string
+		reqLog    reqlog.RequestLog
+		expected  bool
+	}{
+		{
+			name:     "without log filter",
+			reqLog:   reqlog.RequestLog{Method: http.MethodGet},
+			expected: true,
+		},
+		{
+			name:      "request keys",
+			logFilter: "req.method = POST AND req.headers.content-type =~ json",
+			reqLog: reqlog.RequestLog{
+				Method: http.MethodPost,
+				Header: http.Header{"Content-Type": []string{"application/json"}},
+			},
+			expected: true,
+		},
+		{
+			name:      "response keys resolve to empty strings",
+			logFilter: `res.statusCode = "" AND res.hasCsp = "" AND res.contentTypeMismatch = ""`,
+			reqLog:    reqlog.RequestLog{Method: http.MethodGet},
+			expected:  true,
+		},
+		{
+			name:      "response is ignored",
+			logFilter: "res.statusCode = 200",
+			reqLog: reqlog.RequestLog{
+				Method:   http.MethodGet,
+				Response: &reqlog.ResponseLog{StatusCode: 200},
+			},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := reqlog.NewService(reqlog.Config{})
+
+			if tt.logFilter != "" {
+				logFilter, err := search.ParseQuery(tt.logFilter)
+				if err != nil {
+					t.Fatalf("unexpected error parsing query: %v", err)
+				}
+
+				svc.LogFilter = logFilter
+			}
+
+			got, err := svc.ShouldLog(tt.reqLog)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got != tt.expected {
+				t.Errorf("expected: %v, got: %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestHandleTLSError(t *testing.T) {
+	t.Parallel()
+
+	stored := make(chan reqlog.RequestLog, 1)
+	repoMock := &RepoMock{
+		StoreRequestLogFunc: func(_ context.Context, reqLog reqlog.RequestLog) error {
+			stored <- reqLog
+			return nil
+		},
+	}
+	svc := reqlog.NewService(reqlog.Config{
+		Repository: repoMock,
+		Scope:      &scope.Scope{},
+	})
+	svc.ActiveProjectID = ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+
+	ca, key, err := proxy.NewCA("Hetty", "Hetty CA", time.Hour)
+	if err != nil {
+		t.Fatalf("unexpected error creating CA: %v", err)
+	}
+
+	p, err := proxy.NewProxy(ca, key)
+	if err != nil {
+		t.Fatalf("unexpected error creating proxy: %v", err)
+	}
+
+	p.OnTLSError(svc.HandleTLSError)
+
+	srv := httptest.NewServer(p)
+	defer srv.Close()
+
+	conn, err := net.Dial("tcp", srv.Listener.Addr().String())
+	if err != nil {
+		t.Fatalf("unexpected error connecting to proxy: %v", err)
+	}
+	defer conn.Close()
+
+	fmt.Fprint(conn, "CONNECT example.com:443 HTTP/1.1\r\nHost: example.com:443\r\n\r\n")
+
+	// The tunnel is established once the response header is read. The body
+	// isn't read: it would block, because the proxy doesn't end it.
+	res, err := http.ReadResponse(bufio.NewReader(conn), &http.Request{Method: http.MethodConnect})
+	if err != nil {
+		t.Fatalf("unexpected error reading CONNECT response: %v", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("expected CONNECT response status code: %v, got: %v", http.StatusOK, res.StatusCode)
+	}
+
+	// The client doesn't trust the proxy's CA, so it aborts the handshake.
+	client := tls.Client(conn, &tls.Config{ServerName: "example.com", MinVersion: tls.VersionTLS12})
+	if err := client.Handshake(); err == nil {
+		t.Fatal("expected TLS handshake error, got: nil")
+	}
+
+	var got reqlog.RequestLog
+
+	select {
+	case got = <-stored:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for request log to be stored")
+	}
+
+	if exp := "https://example.com:443"; got.URL.String() != exp {
+		t.Errorf("expected URL: %v, got: %v", exp, got.URL)
+	}
+
+	expr, err := search.ParseQuery(`req.tlsError =~ "handshake error"`)
+	if err != nil {
+		t.Fatalf("unexpected error parsing query: %v", err)
+	}
+
+	match, err := got.Matches(expr)
+	if err != nil {
+		t.Fatalf("unexpected error matching request log: %v", err)
+	}
+
+	if !match {
+		t.Errorf("expected request log with TLS error %q to match", got.TLSError)
+	}
+}
+
+func TestHandleMalformedRequest(t *testing.T) {
+	t.Parallel()
+
+	stored := make(chan reqlog.RequestLog, 1)
+	repoMock := &RepoMock{
+		StoreRequestLogFunc: func(_ context.Context, reqLog reqlog.RequestLog) error {
+			stored <- reqLog
+			return nil
+		},
+	}
+	svc := reqlog.NewService(reqlog.Config{
+		Repository: repoMock,
+		Scope:      &scope.Scope{},
+	})
+	svc.ActiveProjectID = ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+
+	ca, key, err := proxy.NewCA("Hetty", "Hetty CA", time.Hour)
+	if err != nil {
+		t.Fatalf("unexpected error creating CA: %v", err)
+	}
+
+	p, err := proxy.NewProxy(ca, key)
+	if err != nil {
+		t.Fatalf("unexpected error creating proxy: %v", err)
+	}
+
+	p.OnMalformedRequest(svc.HandleMalformedRequest)
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("unexpected error listening: %v", err)
+	}
+
+	srv := &http.Server{Handler: p, ConnContext: proxy.ConnContext}
+
+	go srv.Serve(proxy.PipelineListener{Listener: l, Proxy: p})
+	defer srv.Close()
+
+	conn, err := net.Dial("tcp", l.Addr().String())
+	if err != nil {
+		t.Fatalf("unexpected error connecting to proxy: %v", err)
+	}
+	defer conn.Close()
+
+	// An HTTP/0.9 request line, which has no protocol.
+	fmt.Fprint(conn, "GET http://example.com/foo\r\n")
+
+	res, err := http.ReadResponse(bufio.NewReader(conn), nil)
+	if err != nil {
+		t.Fatalf("unexpected error reading response: %v", err)
+	}
+	res.Body.Close()
+
+	if res.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected response status code: %v, got: %v", http.StatusBadRequest, res.StatusCode)
+	}
+
+	var got reqlog.RequestLog
+
+	select {
+	case got = <-stored:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for request log to be stored")
+	}
+
+	if exp := "http://example.com/foo"; got.URL.String() != exp {
+		t.Errorf("expected URL: %v, got: %v", exp, got.URL)
+	}
+
+	if got.Method != http.MethodGet || got.Proto != "" {
+		t.Errorf("expected method %q and empty protocol, got: %q, %q", http.MethodGet, got.Method, got.Proto)
+	}
+
+	expr, err := search.ParseQuery(`req.malformedProtocol = true AND req.parseError = "400 Bad Request"`)
+	if err != nil {
+		t.Fatalf("unexpected error parsing query: %v", err)
+	}
+
+	match, err := got.Matches(expr)
+	if err != nil {
+		t.Fatalf("unexpected error matching request log: %v", err)
+	}
+
+	if !match {
+		t.Errorf("expected request log with parse error %q to match", got.ParseError)
+	}
+}
+
+func TestFindRequestsMatchConfig(t *testing.T) {
+	t.Parallel()
+
+	matchCfg := reqlog.MatchConfig{
+		SecureCookieAttrs: reqlog.CookieAttrs{Secure: true},
+	}
+
+	repoMock := &RepoMock{
+		FindRequestLogsFunc: func(_ context.Context, _ reqlog.FindRequestsFilter, _ *scope.Scope) ([]reqlog.RequestLog, error) {
+			return nil, nil
+		},
+	}
+	svc := reqlog.NewService(reqlog.Config{
+		Repository:  repoMock,
+		Scope:       &scope.Scope{},
+		MatchConfig: &matchCfg,
+	})
+
+	if _, err := svc.FindRequests(context.Background()); err != nil {
+		t.Fatalf("unexpected error (expected: nil, got: %v)", err)
+	}
+
+	got := repoMock.FindRequestLogsCalls()[0].Filter.MatchConfig
+	if diff := cmp.Diff(&matchCfg, got); diff != "" {
+		t.Fatalf("match config not equal (-exp, +got):\n%v", diff)
+	}
+}
+
 //nolint:paralleltest
 func TestResponseModifier(t *testing.T) {
 	repoMock := &RepoMock{
@@ -120,5 +633,427 @@ func TestResponseModifier(t *testing.T) {
 				t.Fatalf("incorrect `reqLogID` argument for `Repository.AddResponseLogCalls` (expected: %v, got: %v)", exp.String(), got.String())
 			}
 		})
+
+		t.Run("recorded size of body as received", func(t *testing.T) {
+			got := repoMock.StoreResponseLogCalls()[0].ResLog.Size
+			if exp := int64(len("modified body")); exp != got {
+				t.Fatalf("incorrect `ResponseLog.Size` value (expected: %v, got: %v)", exp, got)
+			}
+		})
+	})
+}
+
+func TestResponseModifierSwitchingProtocols(t *testing.T) {
+	t.Parallel()
+
+	repoMock := &RepoMock{
+		StoreResponseLogFunc: func(_ context.Context, _ ulid.ULID, _ reqlog.ResponseLog) error {
+			return nil
+		},
+	}
+	svc := reqlog.NewService(reqlog.Config{
+		Repository: repoMock,
+	})
+
+	req := httptest.NewRequest("GET", "https://example.com/ws", nil)
+	reqLogID := ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+	req = req.WithContext(context.WithValue(req.Context(), proxy.ReqLogIDKey, reqLogID))
+
+	// The body of an upgraded response is the connection to the server, which
+	// must be left as-is.
+	client, server := net.Pipe()
+	defer client.Close()
+	defer server.Close()
+
+	res := &http.Response{
+		StatusCode: http.StatusSwitchingProtocols,
+		Header:     http.Header{"Upgrade": []string{"websocket"}},
+		Request:    req,
+		Body:       server,
+	}
+
+	if err := svc.ResponseModifier(func(*http.Response) error { return nil })(res); err != nil {
+		t.Fatalf("unexpected error (expected: nil, got: %v)", err)
+	}
+
+	if res.Body != server {
+		t.Fatalf("expected response body to be unchanged, got: %T", res.Body)
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for len(repoMock.StoreResponseLogCalls()) == 0 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+
+	calls := repoMock.StoreResponseLogCalls()
+	if len(calls) != 1 {
+		t.Fatalf("incorrect `Repository.StoreResponseLog` calls (expected: 1, got: %v)", len(calls))
+	}
+
+	if got := calls[0].ResLog.StatusCode; got != http.StatusSwitchingProtocols {
+		t.Fatalf("incorrect status code (expected: %v, got: %v)", http.StatusSwitchingProtocols, got)
+	}
+}
+
+//nolint:paralleltest
+func TestResponseModifierBodyFile(t *testing.T) {
+	repoMock := &RepoMock{
+		StoreResponseLogFunc: func(_ context.Context, _ ulid.ULID, _ reqlog.ResponseLog) error {
+			return nil
+		},
+	}
+	svc := reqlog.NewService(reqlog.Config{
+		Repository:        repoMock,
+		BodyFileThreshold: 8,
+		BodyFileDir:       t.TempDir(),
+	})
+	svc.ActiveProjectID = ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+
+	resModFn := svc.ResponseModifier(func(res *http.Response) error { return nil })
+
+	req := httptest.NewRequest("GET", "https://example.com/", nil)
+	reqLogID := ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+	req = req.WithContext(context.WithValue(req.Context(), proxy.ReqLogIDKey, reqLogID))
+
+	res := &http.Response{
+		Request: req,
+		Body:    io.NopCloser(strings.NewReader("a body that exceeds the threshold")),
+	}
+
+	if err := resModFn(res); err != nil {
+		t.Fatalf("unexpected error (expected: nil, got: %v)", err)
+	}
+
+	// Mimic the proxy writing the response to the client.
+	gotBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		t.Fatalf("unexpected error reading body: %v", err)
+	}
+
+	if exp := "a body that exceeds the threshold"; exp != string(gotBody) {
+		t.Fatalf("incorrect body streamed to client (expected: %v, got: %v)", exp, string(gotBody))
+	}
+
+	res.Body.Close()
+
+	// Dirty (but simple) wait for other goroutine to finish calling repository.
+	time.Sleep(10 * time.Millisecond)
+
+	if got := len(repoMock.StoreResponseLogCalls()); got != 1 {
+		t.Fatalf("incorrect `Repository.StoreResponseLog` calls (expected: 1, got: %v)", got)
+	}
+
+	resLog := repoMock.StoreResponseLogCalls()[0].ResLog
+
+	t.Run("body is stored in file", func(t *testing.T) {
+		if resLog.BodyFile == "" {
+			t.Fatal("expected `ResponseLog.BodyFile` to be set")
+		}
+
+		if len(resLog.Body) != 0 {
+			t.Fatalf("expected empty `ResponseLog.Body`, got: %v", string(resLog.Body))
+		}
+
+		body, err := resLog.ReadBody()
+		if err != nil {
+			t.Fatalf("unexpected error reading body: %v", err)
+		}
+
+		if exp := "a body that exceeds the threshold"; exp != string(body) {
+			t.Fatalf("incorrect body (expected: %v, got: %v)", exp, string(body))
+		}
+	})
+
+	t.Run("size of body as received is recorded", func(t *testing.T) {
+		if exp := int64(len("a body that exceeds the threshold")); resLog.Size != exp {
+			t.Fatalf("incorrect `ResponseLog.Size` (expected: %v, got: %v)", exp, resLog.Size)
+		}
+	})
+
+	t.Run("body is written to a single file", func(t *testing.T) {
+		entries, err := os.ReadDir(filepath.Dir(resLog.BodyFile))
+		if err != nil {
+			t.Fatalf("unexpected error reading body dir: %v", err)
+		}
+
+		if len(entries) != 1 || entries[0].Name() != reqLogID.String() {
+			t.Fatalf("expected only body file %q, got: %v", reqLogID.String(), entries)
+		}
+	})
+
+	t.Run("search matches body stored in file", func(t *testing.T) {
+		searchExpr, err := search.ParseQuery(`res.body =~ "exceeds"`)
+		if err != nil {
+			t.Fatalf("unexpected error parsing query: %v", err)
+		}
+
+		reqLog := reqlog.RequestLog{ID: reqLogID, Response: &resLog}
+
+		match, err := reqLog.Matches(searchExpr)
+		if err != nil {
+			t.Fatalf("unexpected error matching: %v", err)
+		}
+
+		if !match {
+			t.Fatal("expected search expression to match")
+		}
+	})
+
+	t.Run("body files are removed when clearing requests", func(t *testing.T) {
+		repoMock.ClearRequestLogsFunc = func(_ context.Context, _ ulid.ULID) error { return nil }
+
+		if err := svc.ClearRequests(context.Background(), svc.ActiveProjectID); err != nil {
+			t.Fatalf("unexpected error clearing requests: %v", err)
+		}
+
+		if _, err := os.Stat(resLog.BodyFile); !os.IsNotExist(err) {
+			t.Fatalf("expected body file to be removed, got: %v", err)
+		}
+	})
+}
+
+//nolint:paralleltest
+func TestResponseModifierEncodedBodyFile(t *testing.T) {
+	repoMock := &RepoMock{
+		StoreResponseLogFunc: func(_ context.Context, _ ulid.ULID, _ reqlog.ResponseLog) error {
+			return nil
+		},
+	}
+	svc := reqlog.NewService(reqlog.Config{
+		Repository:        repoMock,
+		BodyFileThreshold: 8,
+		BodyFileDir:       t.TempDir(),
 	})
+	svc.ActiveProjectID = ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+
+	resModFn := svc.ResponseModifier(func(res *http.Response) error { return nil })
+
+	req := httptest.NewRequest("GET", "https://example.com/", nil)
+	reqLogID := ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+	req = req.WithContext(context.WithValue(req.Context(), proxy.ReqLogIDKey, reqLogID))
+
+	raw := brotliBytes(t, "a brotli body that exceeds the threshold")
+
+	res := &http.Response{
+		Request: req,
+		Header:  http.Header{"Content-Encoding": []string{"br"}},
+		Body:    io.NopCloser(bytes.NewReader(raw)),
+	}
+
+	if err := resModFn(res); err != nil {
+		t.Fatalf("unexpected error (expected: nil, got: %v)", err)
+	}
+
+	// Mimic the proxy writing the response to the client.
+	if _, err := io.Copy(io.Discard, res.Body); err != nil {
+		t.Fatalf("unexpected error reading body: %v", err)
+	}
+
+	res.Body.Close()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for len(repoMock.StoreResponseLogCalls()) == 0 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+
+	if got := len(repoMock.StoreResponseLogCalls()); got != 1 {
+		t.Fatalf("incorrect `Repository.StoreResponseLog` calls (expected: 1, got: %v)", got)
+	}
+
+	resLog := repoMock.StoreResponseLogCalls()[0].ResLog
+
+	if exp := "br"; resLog.ContentEncoding != exp {
+		t.Fatalf("incorrect `ResponseLog.ContentEncoding` (expected: %v, got: %v)", exp, resLog.ContentEncoding)
+	}
+
+	body, err := resLog.ReadBody()
+	if err != nil {
+		t.Fatalf("unexpected error reading body: %v", err)
+	}
+
+	if exp := "a brotli body that exceeds the threshold"; exp != string(body) {
+		t.Fatalf("incorrect decoded body (expected: %v, got: %v)", exp, string(body))
+	}
+
+	rawBody, err := resLog.ReadRawBody()
+	if err != nil {
+		t.Fatalf("unexpected error reading raw body: %v", err)
+	}
+
+	if !bytes.Equal(raw, rawBody) {
+		t.Fatalf("incorrect raw body (expected: %x, got: %x)", raw, rawBody)
+	}
+}
+
+type errReader struct{}
+
+func (errReader) Read(_ []byte) (int, error) {
+	return 0, errors.New("connection reset")
+}
+
+//nolint:paralleltest
+func TestResponseModifierBodyFileIncomplete(t *testing.T) {
+	tests := []struct {
+		name string
+		body io.Reader
+		read func(r io.Reader) error
+	}{
+		{
+			name: "read error",
+			body: io.MultiReader(strings.NewReader("a body that exceeds the threshold"), errReader{}),
+			read: func(r io.Reader) error {
+				_, err := io.ReadAll(r)
+				return err
+			},
+		},
+		{
+			name: "closed before EOF",
+			body: strings.NewReader("a body that exceeds the threshold"),
+			read: func(r io.Reader) error {
+				_, err := r.Read(make([]byte, 16))
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repoMock := &RepoMock{
+				StoreResponseLogFunc: func(_ context.Context, _ ulid.ULID, _ reqlog.ResponseLog) error {
+					return nil
+				},
+			}
+			bodyDir := t.TempDir()
+			svc := reqlog.NewService(reqlog.Config{
+				Repository:        repoMock,
+				BodyFileThreshold: 8,
+				BodyFileDir:       bodyDir,
+			})
+			svc.ActiveProjectID = ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+
+			resModFn := svc.ResponseModifier(func(res *http.Response) error { return nil })
+
+			req := httptest.NewRequest("GET", "https://example.com/", nil)
+			reqLogID := ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+			req = req.WithContext(context.WithValue(req.Context(), proxy.ReqLogIDKey, reqLogID))
+
+			res := &http.Response{
+				Request: req,
+				Body:    io.NopCloser(tt.body),
+			}
+
+			if err := resModFn(res); err != nil {
+				t.Fatalf("unexpected error (expected: nil, got: %v)", err)
+			}
+
+			_ = tt.read(res.Body)
+			res.Body.Close()
+
+			// Dirty (but simple) wait for other goroutine to finish.
+			time.Sleep(10 * time.Millisecond)
+
+			if got := len(repoMock.StoreResponseLogCalls()); got != 0 {
+				t.Fatalf("incorrect `Repository.StoreResponseLog` calls (expected: 0, got: %v)", got)
+			}
+
+			entries, err := os.ReadDir(filepath.Join(bodyDir, svc.ActiveProjectID.String()))
+			if err != nil && !os.IsNotExist(err) {
+				t.Fatalf("unexpected error reading body dir: %v", err)
+			}
+
+			if len(entries) != 0 {
+				t.Fatalf("expected incomplete body file to be removed, got: %v", entries)
+			}
+		})
+	}
+}
+
+//nolint:paralleltest
+func TestResponseModifierBodyFileBelowThreshold(t *testing.T) {
+	repoMock := &RepoMock{
+		StoreResponseLogFunc: func(_ context.Context, _ ulid.ULID, _ reqlog.ResponseLog) error {
+			return nil
+		},
+	}
+	svc := reqlog.NewService(reqlog.Config{
+		Repository:        repoMock,
+		BodyFileThreshold: 1024,
+		BodyFileDir:       t.TempDir(),
+	})
+
+	resModFn := svc.ResponseModifier(func(res *http.Response) error { return nil })
+
+	req := httptest.NewRequest("GET", "https://example.com/", nil)
+	req = req.WithContext(context.WithValue(req.Context(), proxy.ReqLogIDKey, ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)))
+
+	res := &http.Response{
+		Request: req,
+		Body:    io.NopCloser(strings.NewReader("small")),
+	}
+
+	if err := resModFn(res); err != nil {
+		t.Fatalf("unexpected error (expected: nil, got: %v)", err)
+	}
+
+	if _, err := io.ReadAll(res.Body); err != nil {
+		t.Fatalf("unexpected error reading body: %v", err)
+	}
+
+	res.Body.Close()
+
+	// Dirty (but simple) wait for other goroutine to finish calling repository.
+	time.Sleep(10 * time.Millisecond)
+
+	if got := len(repoMock.StoreResponseLogCalls()); got != 1 {
+		t.Fatalf("incorrect `Repository.StoreResponseLog` calls (expected: 1, got: %v)", got)
+	}
+
+	resLog := repoMock.StoreResponseLogCalls()[0].ResLog
+
+	if resLog.BodyFile != "" {
+		t.Fatalf("expected empty `ResponseLog.BodyFile`, got: %v", resLog.BodyFile)
+	}
+
+	if exp := "small"; exp != string(resLog.Body) {
+		t.Fatalf("incorrect `ResponseLog.Body` (expected: %v, got: %v)", exp, string(resLog.Body))
+	}
+}
+
+func TestParseHTTPResponse(t *testing.T) {
+	t.Parallel()
+
+	gzipBody := bytes.Buffer{}
+	gw := gzip.NewWriter(&gzipBody)
+	gw.Write([]byte("foobar")) //nolint:errcheck
+	gw.Close()
+
+	raw := append([]byte(nil), gzipBody.Bytes()...)
+	size := int64(len(raw))
+
+	res := &http.Response{
+		Proto:      "HTTP/1.1",
+		StatusCode: http.StatusOK,
+		Status:     "200 OK",
+		Header:     http.Header{"Content-Encoding": []string{"gzip"}},
+		Body:       io.NopCloser(&gzipBody),
+	}
+
+	resLog, err := reqlog.ParseHTTPResponse(res)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	exp := reqlog.ResponseLog{
+		Proto:           "HTTP/1.1",
+		StatusCode:      http.StatusOK,
+		Status:          "200 OK",
+		Header:          http.Header{"Content-Encoding": []string{"gzip"}},
+		Body:            []byte("foobar"),
+		ContentEncoding: "gzip",
+		RawBody:         raw,
+		Size:            size,
+	}
+	if diff := cmp.Diff(exp, resLog); diff != "" {
+		t.Fatalf("response log not equal (-exp, +got):\n%v", diff)
+	}
 }
@@ -0,0 +1,197 @@
+package reqlog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/oklog/ulid"
+)
+
+// RetentionPolicy limits the request logs that are kept for a project. Request
+// logs that exceed a limit are pruned, oldest first. Zero values disable a
+// limit.
+type RetentionPolicy struct {
+	// MaxEntries is the maximum number of request logs.
+	MaxEntries int
+	// MaxAge is the maximum age of a request log.
+	MaxAge time.Duration
+	// MaxBodyBytes is the maximum total size of the request and response
+	// bodies of all request logs.
+	MaxBodyBytes int64
+}
+
+// Enabled returns true if any limit of the policy is set.
+func (policy RetentionPolicy) Enabled() bool {
+	return policy.MaxEntries > 0 || policy.MaxAge > 0 || policy.MaxBodyBytes > 0
+}
+
+// PruneStats counts the request logs that were pruned for a project.
+type PruneStats struct {
+	Entries   int
+	BodyBytes int64
+	// LastPrunedAt is the time request logs were last pruned, or a zero value
+	// if none were pruned yet.
+	LastPrunedAt time.Time
+}
+
+// Prune removes the request logs of the active project that exceed
+// `Service.Retention`, including their body files, and returns what was
+// pruned.
+func (svc *Service) Prune(ctx context.Context) (PruneStats, error) {
+	projectID := svc.ActiveProjectID
+	if projectID.Compare(ulid.ULID{}) == 0 {
+		return PruneStats{}, ErrProjectIDMustBeSet
+	}
+
+	policy := svc.Retention
+	if !policy.Enabled() {
+		return PruneStats{}, nil
+	}
+
+	svc.pruneMu.Lock()
+	defer svc.pruneMu.Unlock()
+
+	reqLogs, err := svc.repo.FindRequestLogs(ctx, FindRequestsFilter{ProjectID: projectID}, nil)
+	if err != nil {
+		return PruneStats{}, fmt.Errorf("reqlog: could not find requests: %w", err)
+	}
+
+	pruned, stats := selectPruned(reqLogs, policy, time.Now())
+	if len(pruned) == 0 {
+		return PruneStats{}, nil
+	}
+
+	ids := make([]ulid.ULID, len(pruned))
+	for i, reqLog := range pruned {
+		ids[i] = reqLog.ID
+	}
+
+	if err := svc.repo.DeleteRequestLogs(ctx, projectID, ids); err != nil {
+		return PruneStats{}, fmt.Errorf("reqlog: could not delete requests: %w", err)
+	}
+
+	for _, reqLog := range pruned {
+		removeBodyFiles(reqLog)
+	}
+
+	svc.siteMap.reset()
+	svc.index.reset()
+
+	if svc.pruneStats == nil {
+		svc.pruneStats = make(map[ulid.ULID]PruneStats)
+	}
+
+	total := svc.pruneStats[projectID]
+	total.Entries += stats.Entries
+	total.BodyBytes += stats.BodyBytes
+	total.LastPrunedAt = stats.LastPrunedAt
+	svc.pruneStats[projectID] = total
+
+	return stats, nil
+}
+
+// ProjectPruneStats returns the total of the request logs that were pruned for
+// a project, since the service was created.
+func (svc *Service) ProjectPruneStats(projectID ulid.ULID) PruneStats {
+	svc.pruneMu.Lock()
+	defer svc.pruneMu.Unlock()
+
+	return svc.pruneStats[projectID]
+}
+
+// RunPruning prunes the request logs of the active project every interval,
+// until ctx is done.
+func (svc *Service) RunPruning(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if _, err := svc.Prune(ctx); err != nil && !errors.Is(err, ErrProjectIDMustBeSet) {
+			log.Printf("[ERROR] Could not prune request logs: %v", err)
+		}
+	}
+}
+
+// selectPruned returns the request logs that exceed policy, and stats of them.
+func selectPruned(reqLogs []RequestLog, policy RetentionPolicy, now time.Time) ([]RequestLog, PruneStats) {
+	sorted := make([]RequestLog, len(reqLogs))
+	copy(sorted, reqLogs)
+
+	// Newest first, so request logs are kept until a limit is reached.
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].ID.Compare(sorted[j].ID) > 0
+	})
+
+	var (
+		pruned    []RequestLog
+		stats     PruneStats
+		bodyBytes int64
+	)
+
+	for i, reqLog := range sorted {
+		size := storedBodySize(reqLog)
+		bodyBytes += size
+
+		exceeds := policy.MaxEntries > 0 && i >= policy.MaxEntries ||
+			policy.MaxAge > 0 && now.Sub(ulid.Time(reqLog.ID.Time())) > policy.MaxAge ||
+			policy.MaxBodyBytes > 0 && bodyBytes > policy.MaxBodyBytes
+		if !exceeds {
+			continue
+		}
+
+		pruned = append(pruned, reqLog)
+		stats.Entries++
+		stats.BodyBytes += size
+	}
+
+	if len(pruned) > 0 {
+		stats.LastPrunedAt = now
+	}
+
+	return pruned, stats
+}
+
+// storedBodySize returns the number of bytes used for storing the bodies of a
+// request log, in the repository or in body files.
+func storedBodySize(reqLog RequestLog) int64 {
+	size := int64(len(reqLog.Body))
+
+	if resLog := reqLog.Response; resLog != nil {
+		size += resLog.bodySize()
+
+		if resLog.RawBodyFile == "" {
+			size += int64(len(resLog.RawBody))
+		} else if fi, err := os.Stat(resLog.RawBodyFile); err == nil {
+			size += fi.Size()
+		}
+	}
+
+	return size
+}
+
+func removeBodyFiles(reqLog RequestLog) {
+	if reqLog.Response == nil {
+		return
+	}
+
+	for _, file := range []string{reqLog.Response.BodyFile, reqLog.Response.RawBodyFile} {
+		if file == "" {
+			continue
+		}
+
+		if err := os.Remove(file); err != nil && !errors.Is(err, os.ErrNotExist) {
+			log.Printf("[ERROR] Could not remove body file: %v", err)
+		}
+	}
+}
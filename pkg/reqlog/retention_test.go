@@ -0,0 +1,119 @@
+package reqlog_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/oklog/ulid"
+
+	"github.com/dstotijn/hetty/pkg/reqlog"
+	"github.com/dstotijn/hetty/pkg/scope"
+)
+
+func TestPrune(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	newID := func(age time.Duration) ulid.ULID {
+		return ulid.MustNew(ulid.Timestamp(now.Add(-age)), ulidEntropy)
+	}
+
+	// Oldest first.
+	reqLogs := []reqlog.RequestLog{
+		{ID: newID(3 * time.Hour), Body: []byte("foo")},
+		{ID: newID(2 * time.Hour), Response: &reqlog.ResponseLog{Body: []byte("foobar")}},
+		{ID: newID(time.Hour), Body: []byte("baz")},
+		{ID: newID(time.Minute)},
+	}
+
+	tests := []struct {
+		name        string
+		policy      reqlog.RetentionPolicy
+		expPruned   []ulid.ULID
+		expBodySize int64
+	}{
+		{
+			name:   "no limits",
+			policy: reqlog.RetentionPolicy{},
+		},
+		{
+			name:        "max entries",
+			policy:      reqlog.RetentionPolicy{MaxEntries: 2},
+			expPruned:   []ulid.ULID{reqLogs[1].ID, reqLogs[0].ID},
+			expBodySize: 9,
+		},
+		{
+			name:        "max age",
+			policy:      reqlog.RetentionPolicy{MaxAge: 90 * time.Minute},
+			expPruned:   []ulid.ULID{reqLogs[1].ID, reqLogs[0].ID},
+			expBodySize: 9,
+		},
+		{
+			name:        "max body bytes",
+			policy:      reqlog.RetentionPolicy{MaxBodyBytes: 10},
+			expPruned:   []ulid.ULID{reqLogs[0].ID},
+			expBodySize: 3,
+		},
+		{
+			name:        "combined limits",
+			policy:      reqlog.RetentionPolicy{MaxEntries: 3, MaxBodyBytes: 5},
+			expPruned:   []ulid.ULID{reqLogs[1].ID, reqLogs[0].ID},
+			expBodySize: 9,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var deleted []ulid.ULID
+
+			repoMock := &RepoMock{
+				FindRequestLogsFunc: func(context.Context, reqlog.FindRequestsFilter, *scope.Scope) ([]reqlog.RequestLog, error) {
+					return reqLogs, nil
+				},
+				DeleteRequestLogsFunc: func(_ context.Context, _ ulid.ULID, reqLogIDs []ulid.ULID) error {
+					deleted = reqLogIDs
+					return nil
+				},
+			}
+			svc := reqlog.NewService(reqlog.Config{Repository: repoMock})
+			svc.ActiveProjectID = ulid.MustNew(ulid.Timestamp(now), ulidEntropy)
+			svc.Retention = tt.policy
+
+			stats, err := svc.Prune(context.Background())
+			if err != nil {
+				t.Fatalf("unexpected error (expected: nil, got: %v)", err)
+			}
+
+			if diff := cmp.Diff(tt.expPruned, deleted); diff != "" {
+				t.Fatalf("pruned request logs not equal (-exp, +got):\n%v", diff)
+			}
+
+			if stats.Entries != len(tt.expPruned) || stats.BodyBytes != tt.expBodySize {
+				t.Fatalf("incorrect prune stats (expected: %v entries, %v bytes, got: %+v)",
+					len(tt.expPruned), tt.expBodySize, stats)
+			}
+
+			if got := svc.ProjectPruneStats(svc.ActiveProjectID); got != stats {
+				t.Fatalf("incorrect project prune stats (expected: %+v, got: %+v)", stats, got)
+			}
+		})
+	}
+}
+
+func TestPruneWithoutActiveProject(t *testing.T) {
+	t.Parallel()
+
+	svc := reqlog.NewService(reqlog.Config{Repository: &RepoMock{}})
+	svc.Retention = reqlog.RetentionPolicy{MaxEntries: 1}
+
+	if _, err := svc.Prune(context.Background()); !errors.Is(err, reqlog.ErrProjectIDMustBeSet) {
+		t.Fatalf("expected `reqlog.ErrProjectIDMustBeSet`, got: %v", err)
+	}
+}
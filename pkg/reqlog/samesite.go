@@ -0,0 +1,58 @@
+package reqlog
+
+import (
+	"net"
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/publicsuffix"
+)
+
+// isCrossSite returns true if the request was initiated from a different site
+// than its target, based on the `Origin` header, or the `Referer` header if
+// there's no `Origin`. Two URLs are same-site if they have the same scheme and
+// registrable domain (eTLD+1), so ports and subdomains are ignored. A `null`
+// origin is opaque, so it's always cross-site. Requests without an initiator
+// (e.g. typed in the address bar) aren't cross-site.
+func isCrossSite(rl RequestLog) bool {
+	if rl.URL == nil {
+		return false
+	}
+
+	initiator := rl.Header.Get("Origin")
+	if initiator == "null" {
+		return true
+	}
+
+	if initiator == "" {
+		initiator = rl.Header.Get("Referer")
+	}
+
+	if initiator == "" {
+		return false
+	}
+
+	initiatorURL, err := url.Parse(initiator)
+	if err != nil || initiatorURL.Host == "" {
+		return false
+	}
+
+	return !strings.EqualFold(initiatorURL.Scheme, rl.URL.Scheme) || site(initiatorURL) != site(rl.URL)
+}
+
+// site returns the registrable domain of a URL's host. IP addresses and hosts
+// without a known public suffix are returned as-is.
+func site(u *url.URL) string {
+	host := strings.ToLower(strings.TrimSuffix(u.Hostname(), "."))
+
+	if net.ParseIP(host) != nil {
+		return host
+	}
+
+	domain, err := publicsuffix.EffectiveTLDPlusOne(host)
+	if err != nil {
+		return host
+	}
+
+	return domain
+}
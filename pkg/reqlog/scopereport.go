@@ -0,0 +1,72 @@
+package reqlog
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dstotijn/hetty/pkg/scope"
+)
+
+// ScopeRuleSummary summarizes the in-scope request logs that match a single
+// scope rule. Request logs that match multiple rules are counted for each.
+type ScopeRuleSummary struct {
+	// RuleIndex is the index of the rule in the scope's rules.
+	RuleIndex    int
+	RequestCount int
+	// NotableStatuses counts the responses per status code, for 4xx and 5xx
+	// status codes.
+	NotableStatuses map[int]int
+}
+
+// ScopeRuleSummaries returns a summary per rule of the current scope, for the
+// request logs that match the service's find filter and are in scope. Summaries
+// are in order of the scope's rules, including rules without matches. Exclude
+// rules never have matches, because request logs that match them aren't in
+// scope.
+func (svc *Service) ScopeRuleSummaries(ctx context.Context) ([]ScopeRuleSummary, error) {
+	reqLogs, err := svc.FindRequests(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("reqlog: could not find requests: %w", err)
+	}
+
+	return scopeRuleSummaries(reqLogs, svc.scope), nil
+}
+
+func scopeRuleSummaries(reqLogs []RequestLog, s *scope.Scope) []ScopeRuleSummary {
+	rules := s.Rules()
+	mode := s.MatchMode()
+
+	summaries := make([]ScopeRuleSummary, len(rules))
+	for i := range summaries {
+		summaries[i] = ScopeRuleSummary{
+			RuleIndex:       i,
+			NotableStatuses: make(map[int]int),
+		}
+	}
+
+	matched := make([]bool, len(rules))
+
+	for _, reqLog := range reqLogs {
+		for i, rule := range rules {
+			matched[i] = reqLog.matchScopeRule(rule)
+		}
+
+		if !mode.CombineRules(rules, func(i int) bool { return matched[i] }) {
+			continue
+		}
+
+		for i := range rules {
+			if !matched[i] {
+				continue
+			}
+
+			summaries[i].RequestCount++
+
+			if reqLog.Response != nil && reqLog.Response.StatusCode >= 400 {
+				summaries[i].NotableStatuses[reqLog.Response.StatusCode]++
+			}
+		}
+	}
+
+	return summaries
+}
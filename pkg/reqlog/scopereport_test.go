@@ -0,0 +1,131 @@
+package reqlog_test
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/dstotijn/hetty/pkg/reqlog"
+	"github.com/dstotijn/hetty/pkg/scope"
+)
+
+func TestScopeRuleSummaries(t *testing.T) {
+	t.Parallel()
+
+	rules := []scope.Rule{
+		{URL: regexp.MustCompile(`^https://example\.com/`)},
+		{URL: regexp.MustCompile(`/admin`)},
+		{Header: scope.Header{Key: regexp.MustCompile(`(?i)^x-debug$`)}},
+	}
+
+	reqLogs := []reqlog.RequestLog{
+		{
+			// Matches the first and second rule.
+			URL:      mustParseURL(t, "https://example.com/admin"),
+			Response: &reqlog.ResponseLog{StatusCode: 403},
+		},
+		{
+			// Matches the first rule only.
+			URL:      mustParseURL(t, "https://example.com/"),
+			Response: &reqlog.ResponseLog{StatusCode: 200},
+		},
+		{
+			// Matches all rules.
+			URL:      mustParseURL(t, "https://example.com/admin/debug"),
+			Header:   http.Header{"X-Debug": []string{"1"}},
+			Response: &reqlog.ResponseLog{StatusCode: 500},
+		},
+		{
+			// Matches the second rule only. Without response.
+			URL: mustParseURL(t, "https://api.example.org/admin"),
+		},
+		{
+			// Out of scope.
+			URL:      mustParseURL(t, "https://example.org/"),
+			Response: &reqlog.ResponseLog{StatusCode: 404},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		matchMode scope.MatchMode
+		expected  []reqlog.ScopeRuleSummary
+	}{
+		{
+			name:      "match any rule",
+			matchMode: scope.MatchAny,
+			expected: []reqlog.ScopeRuleSummary{
+				{
+					RuleIndex:       0,
+					RequestCount:    3,
+					NotableStatuses: map[int]int{403: 1, 500: 1},
+				},
+				{
+					RuleIndex:       1,
+					RequestCount:    3,
+					NotableStatuses: map[int]int{403: 1, 500: 1},
+				},
+				{
+					RuleIndex:       2,
+					RequestCount:    1,
+					NotableStatuses: map[int]int{500: 1},
+				},
+			},
+		},
+		{
+			name:      "match all rules",
+			matchMode: scope.MatchAll,
+			expected: []reqlog.ScopeRuleSummary{
+				{
+					RuleIndex:       0,
+					RequestCount:    1,
+					NotableStatuses: map[int]int{500: 1},
+				},
+				{
+					RuleIndex:       1,
+					RequestCount:    1,
+					NotableStatuses: map[int]int{500: 1},
+				},
+				{
+					RuleIndex:       2,
+					RequestCount:    1,
+					NotableStatuses: map[int]int{500: 1},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repoMock := &RepoMock{
+				FindRequestLogsFunc: func(_ context.Context, _ reqlog.FindRequestsFilter, _ *scope.Scope) ([]reqlog.RequestLog, error) {
+					return reqLogs, nil
+				},
+			}
+
+			s := &scope.Scope{}
+			s.SetRules(rules)
+			s.SetMatchMode(tt.matchMode)
+
+			svc := reqlog.NewService(reqlog.Config{
+				Repository: repoMock,
+				Scope:      s,
+			})
+
+			got, err := svc.ScopeRuleSummaries(context.Background())
+			if err != nil {
+				t.Fatalf("unexpected error (expected: nil, got: %v)", err)
+			}
+
+			if diff := cmp.Diff(tt.expected, got); diff != "" {
+				t.Fatalf("scope rule summaries not equal (-exp, +got):\n%v", diff)
+			}
+		})
+	}
+}
@@ -1,14 +1,26 @@
 package reqlog
 
 import (
+	"bytes"
+	"crypto/tls"
+	"encoding/json"
+	"encoding/xml"
 	"errors"
-	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"net/url"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
+	"unicode/utf8"
 
 	"github.com/oklog/ulid"
 
+	"github.com/dstotijn/hetty/pkg/proxy"
 	"github.com/dstotijn/hetty/pkg/scope"
 	"github.com/dstotijn/hetty/pkg/search"
 )
@@ -22,212 +34,1234 @@ var reqLogSearchKeyFns = map[string]func(rl RequestLog) string{
 		}
 		return rl.URL.String()
 	},
+	"req.host":      urlKeyFn(func(u *url.URL) string { return u.Host }),
+	"req.path":      urlKeyFn(func(u *url.URL) string { return u.Path }),
+	"req.scheme":    urlKeyFn(func(u *url.URL) string { return u.Scheme }),
+	"req.query":     urlKeyFn(func(u *url.URL) string { return u.RawQuery }),
+	"req.fragment":  urlKeyFn(func(u *url.URL) string { return u.Fragment }),
 	"req.method":    func(rl RequestLog) string { return rl.Method },
+	"req.headers":   func(rl RequestLog) string { return headerString(rl.Header) },
 	"req.body":      func(rl RequestLog) string { return string(rl.Body) },
 	"req.timestamp": func(rl RequestLog) string { return ulid.Time(rl.ID.Time()).String() },
 }
 
+// urlKeyFn returns a search key func that resolves to a component of the
+// request URL, or an empty string if the request has no URL.
+func urlKeyFn(fn func(u *url.URL) string) func(rl RequestLog) string {
+	return func(rl RequestLog) string {
+		if rl.URL == nil {
+			return ""
+		}
+		return fn(rl.URL)
+	}
+}
+
 var resLogSearchKeyFns = map[string]func(rl ResponseLog) string{
 	"res.proto":        func(rl ResponseLog) string { return rl.Proto },
 	"res.statusCode":   func(rl ResponseLog) string { return strconv.Itoa(rl.StatusCode) },
 	"res.statusReason": func(rl ResponseLog) string { return rl.Status },
-	"res.body":         func(rl ResponseLog) string { return string(rl.Body) },
+	"res.headers":      func(rl ResponseLog) string { return headerString(rl.Header) },
+	"res.body": func(rl ResponseLog) string {
+		// Errors reading a body from disk are ignored, so a missing body file
+		// doesn't fail the search as a whole.
+		body, _ := rl.ReadBody()
+		return string(body)
+	},
 }
 
-// TODO: Request and response headers search key functions.
+// Prefixes of search keys that resolve to the value of a header, e.g.
+// `req.headers.content-type`. Header names are case-insensitive. Without a
+// header name, `req.headers` and `res.headers` resolve to all headers.
+const (
+	reqHeaderKeyPrefix = "req.headers."
+	resHeaderKeyPrefix = "res.headers."
+)
 
-// Matches returns true if the supplied search expression evaluates to true.
-func (reqLog RequestLog) Matches(expr search.Expression) (bool, error) {
-	switch e := expr.(type) {
-	case search.PrefixExpression:
-		return reqLog.matchPrefixExpr(e)
-	case search.InfixExpression:
-		return reqLog.matchInfixExpr(e)
-	case search.StringLiteral:
-		return reqLog.matchStringLiteral(e)
+// reqQueryKeyPrefix is the prefix of search keys that resolve to the value of a
+// query parameter, e.g. `req.query.redirect_uri`. Parameter names are
+// case-sensitive.
+const reqQueryKeyPrefix = "req.query."
+
+// defaultFreeTextKeys is the order in which search keys are scanned when
+// matching a bare string literal. The header key prefixes stand for all headers,
+// which are scanned in order of their name. Bodies are scanned last, as they're
+// the most expensive to search.
+var defaultFreeTextKeys = []string{
+	"req.url",
+	"req.host",
+	"req.path",
+	"req.scheme",
+	"req.query",
+	"req.fragment",
+	"req.method",
+	reqHeaderKeyPrefix,
+	"req.proto",
+	"req.id",
+	"req.timestamp",
+	"res.statusCode",
+	"res.statusReason",
+	"res.proto",
+	resHeaderKeyPrefix,
+	"req.body",
+	"res.body",
+}
+
+// headerLengthKeySuffix can be appended to a header key to resolve to the byte
+// length of the header value instead, e.g. `res.headers.set-cookie.length`.
+const headerLengthKeySuffix = ".length"
+
+// searchKeyAliases maps alternative names of search keys to the key they're an
+// alias of.
+var searchKeyAliases = map[string]string{
+	"req.bodyLength": "req.bodySize",
+	"res.bodyLength": "res.bodySize",
+}
+
+// canonicalSearchKey returns the key that s is an alias of, or s itself.
+func canonicalSearchKey(s string) string {
+	if key, ok := searchKeyAliases[s]; ok {
+		return key
+	}
+
+	return s
+}
+
+// Computed search keys. Unlike the keys above, these aren't used when matching
+// a bare string literal, because their values (e.g. booleans) aren't useful for
+// free-text search.
+var reqLogComputedKeyFns = map[string]func(rl RequestLog, _ MatchConfig) string{
+	"req.nonStandardMethod": func(rl RequestLog, _ MatchConfig) string {
+		return strconv.FormatBool(!isStandardMethod(rl.Method))
+	},
+	"req.signature":         func(rl RequestLog, _ MatchConfig) string { return rl.Signature() },
+	"req.bodySize":          func(rl RequestLog, _ MatchConfig) string { return strconv.Itoa(len(rl.Body)) },
+	"req.timing.dnsMs":      timingKeyFn(func(t proxy.Timing) time.Duration { return t.DNS }),
+	"req.timing.connectMs":  timingKeyFn(func(t proxy.Timing) time.Duration { return t.Connect }),
+	"req.timing.tlsMs":      timingKeyFn(func(t proxy.Timing) time.Duration { return t.TLSHandshake }),
+	"req.timing.responseMs": timingKeyFn(func(t proxy.Timing) time.Duration { return t.Response }),
+	"req.crossOriginReferer": func(rl RequestLog, _ MatchConfig) string {
+		return strconv.FormatBool(hasCrossOriginReferer(rl))
+	},
+	"req.crossSite": func(rl RequestLog, _ MatchConfig) string {
+		return strconv.FormatBool(isCrossSite(rl))
+	},
+	"req.parseWarnings": func(rl RequestLog, _ MatchConfig) string {
+		return strings.Join(rl.ParseWarnings, "; ")
+	},
+	"req.bodyMalformed": func(rl RequestLog, _ MatchConfig) string { return strconv.FormatBool(isBodyMalformed(rl)) },
+	"req.tls.cipherSuite": func(rl RequestLog, _ MatchConfig) string {
+		if rl.TLS == nil {
+			return ""
+		}
+		return tls.CipherSuiteName(rl.TLS.CipherSuite)
+	},
+	"req.hasResponse": func(rl RequestLog, _ MatchConfig) string { return strconv.FormatBool(rl.Response != nil) },
+	"req.effectiveUrl": func(rl RequestLog, _ MatchConfig) string {
+		switch {
+		case rl.EffectiveURL != nil:
+			return rl.EffectiveURL.String()
+		case rl.URL != nil:
+			return rl.URL.String()
+		default:
+			return ""
+		}
+	},
+	"req.hasApiKey": func(rl RequestLog, cfg MatchConfig) string {
+		return strconv.FormatBool(hasAPIKey(rl, cfg.APIKeyLocations))
+	},
+	"req.maxTokenEntropy": func(rl RequestLog, _ MatchConfig) string { return formatEntropy(rl.maxTokenEntropy()) },
+	"req.cookieCount": func(rl RequestLog, _ MatchConfig) string {
+		return strconv.Itoa(len((&http.Request{Header: rl.Header}).Cookies()))
+	},
+	"req.bodySizeDelta": func(rl RequestLog, _ MatchConfig) string {
+		delta, ok := rl.bodySizeDelta()
+		if !ok {
+			return ""
+		}
+		return strconv.Itoa(delta)
+	},
+	"req.ja3": func(rl RequestLog, _ MatchConfig) string {
+		if rl.TLS == nil {
+			return ""
+		}
+		return rl.TLS.JA3
+	},
+	"req.tlsError":   func(rl RequestLog, _ MatchConfig) string { return rl.TLSError },
+	"req.parseError": func(rl RequestLog, _ MatchConfig) string { return rl.ParseError },
+	"req.hasReflectedParam": func(rl RequestLog, _ MatchConfig) string {
+		return strconv.FormatBool(hasReflectedParam(rl))
+	},
+	"req.graphql.operationName": func(rl RequestLog, _ MatchConfig) string {
+		gqlReq, ok := rl.graphQLRequest()
+		if !ok {
+			return ""
+		}
+		return graphQLOperationName(gqlReq)
+	},
+	"req.graphql.query": func(rl RequestLog, _ MatchConfig) string {
+		gqlReq, _ := rl.graphQLRequest()
+		return gqlReq.Query
+	},
+	"req.cacheableByProxy": func(rl RequestLog, _ MatchConfig) string {
+		return strconv.FormatBool(isCacheableByProxy(rl))
+	},
+	"req.idempotencyKey": func(rl RequestLog, _ MatchConfig) string { return rl.Header.Get("Idempotency-Key") },
+	"req.hasIdempotencyKey": func(rl RequestLog, _ MatchConfig) string {
+		return strconv.FormatBool(strings.TrimSpace(rl.Header.Get("Idempotency-Key")) != "")
+	},
+	"req.csrfCandidate": func(rl RequestLog, cfg MatchConfig) string {
+		return strconv.FormatBool(isCSRFCandidate(rl, cfg.CSRFTokenPatterns))
+	},
+	"req.isTracker": func(rl RequestLog, cfg MatchConfig) string {
+		return strconv.FormatBool(isTracker(rl, cfg.Trackers))
+	},
+	"req.pipelined": func(rl RequestLog, _ MatchConfig) string { return strconv.FormatBool(rl.Pipelined) },
+	"req.malformedProtocol": func(rl RequestLog, _ MatchConfig) string {
+		return strconv.FormatBool(isMalformedProtocol(rl))
+	},
+	"req.targetsPrivateIP": func(rl RequestLog, cfg MatchConfig) string {
+		return strconv.FormatBool(targetsPrivateIP(rl, cfg.LookupIP))
+	},
+	"req.rawQuery": func(rl RequestLog, _ MatchConfig) string {
+		if rl.URL == nil {
+			return ""
+		}
+		return rl.URL.RawQuery
+	},
+	"req.afterLogin": func(rl RequestLog, cfg MatchConfig) string {
+		// Without a login boundary, requests are neither before nor after it.
+		if cfg.LoginBoundary.Compare(ulid.ULID{}) == 0 {
+			return ""
+		}
+		return strconv.FormatBool(rl.ID.Compare(cfg.LoginBoundary) > 0)
+	},
+	"req.note": func(rl RequestLog, _ MatchConfig) string { return rl.Annotations.Note },
+	// Tags are compared one by one (see `compileTagMatch`), except with
+	// ordering operators, which compare the joined tags.
+	reqTagKey: func(rl RequestLog, _ MatchConfig) string { return strings.Join(rl.Annotations.Tags, ", ") },
+	"req.highlightColor": func(rl RequestLog, _ MatchConfig) string {
+		return string(rl.Annotations.HighlightColor)
+	},
+}
+
+var resLogComputedKeyFns = map[string]func(rl ResponseLog, _ MatchConfig) string{
+	"res.bodySize": func(rl ResponseLog, _ MatchConfig) string { return strconv.FormatInt(rl.bodySize(), 10) },
+	"res.parseWarnings": func(rl ResponseLog, _ MatchConfig) string {
+		return strings.Join(rl.ParseWarnings, "; ")
+	},
+	"res.latency": func(rl ResponseLog, _ MatchConfig) string { return strconv.FormatInt(rl.Latency.Milliseconds(), 10) },
+	"res.duration": func(rl ResponseLog, _ MatchConfig) string {
+		return strconv.FormatInt(rl.Duration.Milliseconds(), 10)
+	},
+	"res.size": func(rl ResponseLog, _ MatchConfig) string { return strconv.FormatInt(rl.size(), 10) },
+	"res.hasInsecureCookie": func(rl ResponseLog, cfg MatchConfig) string {
+		return strconv.FormatBool(hasInsecureCookie(rl, cfg.SecureCookieAttrs))
+	},
+	"res.hasCsp": func(rl ResponseLog, _ MatchConfig) string {
+		return strconv.FormatBool(rl.Header.Get("Content-Security-Policy") != "")
+	},
+	"res.language": func(rl ResponseLog, _ MatchConfig) string {
+		// Only the first language of a `Content-Language` list is used.
+		lang := strings.Split(rl.Header.Get("Content-Language"), ",")[0]
+		return strings.TrimSpace(lang)
+	},
+	"res.charsetMismatch": func(rl ResponseLog, _ MatchConfig) string {
+		return strconv.FormatBool(isCharsetMismatch(rl))
+	},
+	"res.detectedLang": func(rl ResponseLog, cfg MatchConfig) string { return detectLang(rl, cfg.LangSignatures) },
+	"res.cookieDomains": func(rl ResponseLog, _ MatchConfig) string {
+		return strings.Join(cookieDomains(rl), ",")
+	},
+	"res.isErrorPage": func(rl ResponseLog, cfg MatchConfig) string {
+		return strconv.FormatBool(isErrorPage(rl, cfg.ErrorPageSignatures))
+	},
+	"res.cookieDomainCount": func(rl ResponseLog, _ MatchConfig) string { return strconv.Itoa(len(cookieDomains(rl))) },
+	"res.cookieCount":       func(rl ResponseLog, _ MatchConfig) string { return strconv.Itoa(len(responseCookies(rl))) },
+	"res.maxTokenEntropy":   func(rl ResponseLog, _ MatchConfig) string { return formatEntropy(rl.maxTokenEntropy()) },
+}
+
+// Computed response search keys that (also) depend on the request.
+var resLogRequestKeyFns = map[string]func(rl RequestLog, _ MatchConfig) string{
+	"res.contentTypeMismatch": func(rl RequestLog, _ MatchConfig) string {
+		return strconv.FormatBool(isContentTypeMismatch(rl))
+	},
+	"res.unexpectedEncoding": func(rl RequestLog, _ MatchConfig) string {
+		return strconv.FormatBool(hasUnexpectedEncoding(rl))
+	},
+}
+
+// MatchConfig configures the computed search keys that check request and
+// response logs against a policy.
+type MatchConfig struct {
+	// SecureCookieAttrs are the attributes a cookie set by a response must
+	// have to not be considered insecure by the `res.hasInsecureCookie` key.
+	SecureCookieAttrs CookieAttrs
+	// APIKeyLocations are where the `req.hasApiKey` key looks for an API key.
+	APIKeyLocations APIKeyLocations
+	// Trackers are the analytics and tracking endpoints the `req.isTracker`
+	// key matches.
+	Trackers Trackers
+	// CSRFTokenPatterns match the header and parameter names of anti-CSRF
+	// tokens, for the `req.csrfCandidate` key.
+	CSRFTokenPatterns []*regexp.Regexp
+	// LangSignatures are checked in order by the `res.detectedLang` key.
+	LangSignatures []LangSignature
+	// ErrorPageSignatures match response bodies for the `res.isErrorPage`
+	// key.
+	ErrorPageSignatures []*regexp.Regexp
+	// LoginBoundary is the ID of the request log marked as the login request.
+	// The `req.afterLogin` key is true for requests logged after it. It's
+	// unset if it's a zero value.
+	LoginBoundary ulid.ULID
+	// LookupIP, if set, resolves host names for the `req.targetsPrivateIP`
+	// key (e.g. `net.LookupIP`). If nil, only IP address hosts are matched.
+	LookupIP func(host string) ([]net.IP, error)
+	// FreeTextKeys are the search keys scanned when matching a bare string
+	// literal, in order. Use `req.headers.` and `res.headers.` for all headers.
+	// Keys that aren't searchable as free text are ignored. If nil, all keys
+	// are scanned, with URL components first and bodies last.
+	FreeTextKeys []string
+	// CaseSensitive makes free text (substring) search and the `=` and `!=`
+	// operators match case-sensitively. By default, case is ignored.
+	CaseSensitive bool
+}
+
+// CookieAttrs holds cookie attributes.
+type CookieAttrs struct {
+	Secure   bool
+	HTTPOnly bool
+}
+
+// DefaultMatchConfig returns the match config that's used when none is given.
+func DefaultMatchConfig() MatchConfig {
+	return MatchConfig{
+		SecureCookieAttrs: CookieAttrs{
+			Secure:   true,
+			HTTPOnly: true,
+		},
+		APIKeyLocations: APIKeyLocations{
+			Headers:     []string{"X-Api-Key", "Authorization"},
+			QueryParams: []string{"api_key", "apikey"},
+		},
+		Trackers:            defaultTrackers(),
+		CSRFTokenPatterns:   []*regexp.Regexp{defaultCSRFTokenPattern},
+		LangSignatures:      defaultLangSignatures(),
+		ErrorPageSignatures: defaultErrorPageSignatures(),
+	}
+}
+
+// hasInsecureCookie returns true if the response sets a cookie that lacks any
+// of the required attributes.
+func hasInsecureCookie(rl ResponseLog, required CookieAttrs) bool {
+	for _, cookie := range responseCookies(rl) {
+		if required.Secure && !cookie.Secure {
+			return true
+		}
+
+		if required.HTTPOnly && !cookie.HttpOnly {
+			return true
+		}
+	}
+
+	return false
+}
+
+// responseCookies parses the cookies set by the response's `Set-Cookie` headers.
+func responseCookies(rl ResponseLog) []*http.Cookie {
+	return (&http.Response{Header: rl.Header}).Cookies()
+}
+
+// cookieDomains returns the distinct `Domain` attributes of the cookies set by
+// the response, lowercased and sorted. Cookies without a `Domain` attribute
+// (host-only cookies) are ignored.
+func cookieDomains(rl ResponseLog) []string {
+	seen := make(map[string]bool)
+	domains := make([]string, 0)
+
+	for _, cookie := range responseCookies(rl) {
+		domain := strings.ToLower(strings.TrimPrefix(cookie.Domain, "."))
+		if domain == "" || seen[domain] {
+			continue
+		}
+
+		seen[domain] = true
+		domains = append(domains, domain)
+	}
+
+	sort.Strings(domains)
+
+	return domains
+}
+
+// APIKeyLocations holds the locations of a request where an API key can be
+// passed. Header names are canonicalized; query parameter names are matched
+// case-insensitively.
+type APIKeyLocations struct {
+	Headers     []string
+	QueryParams []string
+}
+
+// hasAPIKey returns true if the request has a non-empty value in any of the
+// given locations.
+func hasAPIKey(rl RequestLog, locations APIKeyLocations) bool {
+	for _, header := range locations.Headers {
+		if strings.TrimSpace(rl.Header.Get(header)) != "" {
+			return true
+		}
+	}
+
+	if rl.URL == nil {
+		return false
+	}
+
+	for key, values := range rl.URL.Query() {
+		for _, param := range locations.QueryParams {
+			if !strings.EqualFold(key, param) {
+				continue
+			}
+
+			for _, value := range values {
+				if value != "" {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+// minReflectedParamLen is the minimum length of a query parameter value for it
+// to be considered reflected by a response, as short values (e.g. `1`) occur in
+// most response bodies by chance.
+const minReflectedParamLen = 4
+
+// hasReflectedParam returns true if the value of any of the request's query
+// parameters is contained verbatim in the response body.
+func hasReflectedParam(rl RequestLog) bool {
+	if rl.URL == nil || rl.Response == nil {
+		return false
+	}
+
+	body, _ := rl.Response.ReadBody()
+	if len(body) == 0 {
+		return false
+	}
+
+	for _, values := range rl.URL.Query() {
+		for _, value := range values {
+			if len(value) >= minReflectedParamLen && bytes.Contains(body, []byte(value)) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// timingKeyFn returns a search key function for a phase of the upstream request
+// timing, in milliseconds. The timing is recorded with the response, so it's
+// zero for requests without a response.
+func timingKeyFn(phase func(proxy.Timing) time.Duration) func(rl RequestLog, _ MatchConfig) string {
+	return func(rl RequestLog, _ MatchConfig) string {
+		if rl.Response == nil {
+			return "0"
+		}
+
+		return strconv.FormatInt(phase(rl.Response.Timing).Milliseconds(), 10)
+	}
+}
+
+// isBodyMalformed returns true if the request has a JSON, XML or form encoded
+// `Content-Type` header, but its body can't be parsed as such. Empty bodies and
+// other content types are never considered malformed.
+func isBodyMalformed(rl RequestLog) bool {
+	if len(rl.Body) == 0 {
+		return false
+	}
+
+	mediaType, _, err := mime.ParseMediaType(rl.Header.Get("Content-Type"))
+	if err != nil {
+		return false
+	}
+
+	switch {
+	case mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"):
+		return !json.Valid(rl.Body)
+	case mediaType == "application/xml" || mediaType == "text/xml" || strings.HasSuffix(mediaType, "+xml"):
+		return !isValidXML(rl.Body)
+	case mediaType == "application/x-www-form-urlencoded":
+		_, err := url.ParseQuery(string(rl.Body))
+		return err != nil
 	default:
-		return false, fmt.Errorf("expression type (%T) not supported", expr)
+		return false
 	}
 }
 
-func (reqLog RequestLog) matchPrefixExpr(expr search.PrefixExpression) (bool, error) {
-	switch expr.Operator {
-	case search.TokOpNot:
-		match, err := reqLog.Matches(expr.Right)
+func isValidXML(data []byte) bool {
+	dec := xml.NewDecoder(bytes.NewReader(data))
+	hasElement := false
+
+	for {
+		tok, err := dec.Token()
+		if errors.Is(err, io.EOF) {
+			return hasElement
+		}
+
 		if err != nil {
-			return false, err
+			return false
+		}
+
+		if _, ok := tok.(xml.StartElement); ok {
+			hasElement = true
 		}
+	}
+}
+
+// isMalformedProtocol returns true if the protocol of a request is malformed, or
+// isn't HTTP/1.0, HTTP/1.1 or HTTP/2.0: e.g. HTTP/0.9, whose request line has
+// no protocol, or a newer HTTP/1.x version that the proxy tolerated. Only logs
+// of rejected requests can have a protocol that doesn't parse; for other logs,
+// e.g. imported ones, the protocol isn't checked then.
+func isMalformedProtocol(rl RequestLog) bool {
+	major, minor, ok := http.ParseHTTPVersion(rl.Proto)
 
-		return !match, nil
+	switch {
+	case !ok:
+		return rl.ParseError != ""
+	case major == 1:
+		return minor > 1
 	default:
-		return false, errors.New("operator is not supported")
+		return major != 2 || minor != 0
 	}
 }
 
-func (reqLog RequestLog) matchInfixExpr(expr search.InfixExpression) (bool, error) {
-	switch expr.Operator {
-	case search.TokOpAnd:
-		left, err := reqLog.Matches(expr.Left)
-		if err != nil {
-			return false, err
+// isCharsetMismatch returns true if the response body isn't valid in the charset
+// declared by the `Content-Type` header. Only UTF-8 and US-ASCII are validated;
+// bodies in other charsets, or with a content encoding that wasn't decoded when
+// stored, are never considered mismatched.
+func isCharsetMismatch(rl ResponseLog) bool {
+	if rl.ContentEncoding == "" && hasContentEncoding(rl.Header) && !canDecodeBody(rl.Header) {
+		return false
+	}
+
+	_, params, err := mime.ParseMediaType(rl.Header.Get("Content-Type"))
+	if err != nil {
+		return false
+	}
+
+	body, err := rl.ReadBody()
+	if err != nil {
+		return false
+	}
+
+	switch strings.ToLower(params["charset"]) {
+	case "utf-8", "utf8":
+		return !utf8.Valid(body)
+	case "us-ascii", "ascii":
+		for _, b := range body {
+			if b >= utf8.RuneSelf {
+				return true
+			}
 		}
 
-		right, err := reqLog.Matches(expr.Right)
-		if err != nil {
-			return false, err
+		return false
+	default:
+		return false
+	}
+}
+
+// isContentTypeMismatch returns true if the response has a content type that
+// isn't accepted by the request's `Accept` header. Requests without an `Accept`
+// header accept any content type.
+func isContentTypeMismatch(rl RequestLog) bool {
+	accept := rl.Header.Get("Accept")
+	if accept == "" || rl.Response == nil {
+		return false
+	}
+
+	mediaType, _, err := mime.ParseMediaType(rl.Response.Header.Get("Content-Type"))
+	if err != nil {
+		return false
+	}
+
+	for _, mediaRange := range strings.Split(accept, ",") {
+		accepted, params, err := mime.ParseMediaType(mediaRange)
+		if err != nil || isZeroQuality(params["q"]) {
+			continue
 		}
 
-		return left && right, nil
-	case search.TokOpOr:
-		left, err := reqLog.Matches(expr.Left)
-		if err != nil {
-			return false, err
+		if acceptsMediaType(accepted, mediaType) {
+			return false
 		}
+	}
 
-		right, err := reqLog.Matches(expr.Right)
-		if err != nil {
-			return false, err
+	return true
+}
+
+// hasUnexpectedEncoding returns true if the response has a content coding that
+// isn't listed in the request's `Accept-Encoding` header. A request without this
+// header lists no codings.
+func hasUnexpectedEncoding(rl RequestLog) bool {
+	if rl.Response == nil {
+		return false
+	}
+
+	accepted := make(map[string]bool)
+
+	for _, value := range rl.Header.Values("Accept-Encoding") {
+		for _, coding := range strings.Split(value, ",") {
+			name, params, err := mime.ParseMediaType(coding)
+			if err != nil {
+				continue
+			}
+
+			accepted[normalizeContentCoding(name)] = !isZeroQuality(params["q"])
 		}
+	}
+
+	for _, value := range rl.Response.Header.Values("Content-Encoding") {
+		for _, coding := range strings.Split(value, ",") {
+			coding = normalizeContentCoding(strings.TrimSpace(coding))
+			if coding == "" || coding == "identity" {
+				continue
+			}
 
-		return left || right, nil
+			ok, listed := accepted[coding]
+			if !listed {
+				ok = accepted["*"]
+			}
+
+			if !ok {
+				return true
+			}
+		}
 	}
 
-	left, ok := expr.Left.(search.StringLiteral)
-	if !ok {
-		return false, errors.New("left operand must be a string literal")
+	return false
+}
+
+// normalizeContentCoding lowercases a content coding name and maps the legacy
+// `x-gzip` and `x-compress` names to their standard names.
+func normalizeContentCoding(coding string) string {
+	coding = strings.ToLower(coding)
+
+	switch coding {
+	case "x-gzip":
+		return "gzip"
+	case "x-compress":
+		return "compress"
+	default:
+		return coding
+	}
+}
+
+// acceptsMediaType returns true if mediaRange (e.g. `text/*`) includes the media
+// type.
+func acceptsMediaType(mediaRange, mediaType string) bool {
+	if mediaRange == "*/*" || mediaRange == "*" || mediaRange == mediaType {
+		return true
 	}
 
-	leftVal := reqLog.getMappedStringLiteral(left.Value)
+	if !strings.HasSuffix(mediaRange, "/*") {
+		return false
+	}
 
-	if expr.Operator == search.TokOpRe || expr.Operator == search.TokOpNotRe {
-		right, ok := expr.Right.(*regexp.Regexp)
-		if !ok {
-			return false, errors.New("right operand must be a regular expression")
+	return strings.HasPrefix(mediaType, strings.TrimSuffix(mediaRange, "*"))
+}
+
+func isZeroQuality(q string) bool {
+	if q == "" {
+		return false
+	}
+
+	v, err := strconv.ParseFloat(q, 64)
+
+	return err == nil && v == 0
+}
+
+// hasCrossOriginReferer returns true if the request has a `Referer` header with
+// a host that differs from the request's host.
+func hasCrossOriginReferer(rl RequestLog) bool {
+	referer := rl.Header.Get("Referer")
+	if referer == "" || rl.URL == nil {
+		return false
+	}
+
+	refererURL, err := url.Parse(referer)
+	if err != nil || refererURL.Host == "" {
+		return false
+	}
+
+	return !strings.EqualFold(refererURL.Host, rl.URL.Host)
+}
+
+// Signature returns a string that identifies the "shape" of the request's
+// endpoint: the method, the URL path and the sorted query parameter names, with
+// parameter values stripped. For example, both `GET /users?id=1` and
+// `GET /users?id=2` have signature `GET /users?id`.
+func (reqLog RequestLog) Signature() string {
+	b := strings.Builder{}
+	b.WriteString(reqLog.Method)
+	b.WriteString(" ")
+
+	if reqLog.URL == nil {
+		return b.String()
+	}
+
+	b.WriteString(reqLog.URL.Path)
+
+	query := reqLog.URL.Query()
+	if len(query) == 0 {
+		return b.String()
+	}
+
+	names := make([]string, 0, len(query))
+	for name := range query {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	b.WriteString("?")
+	b.WriteString(strings.Join(names, "&"))
+
+	return b.String()
+}
+
+// standardMethods are the request methods defined in RFC 7231 and RFC 5789.
+var standardMethods = map[string]struct{}{
+	http.MethodGet:     {},
+	http.MethodHead:    {},
+	http.MethodPost:    {},
+	http.MethodPut:     {},
+	http.MethodPatch:   {},
+	http.MethodDelete:  {},
+	http.MethodConnect: {},
+	http.MethodOptions: {},
+	http.MethodTrace:   {},
+}
+
+// isStandardMethod returns true if method is a standard request method. Method
+// names are case-sensitive, so e.g. `get` is not considered standard.
+func isStandardMethod(method string) bool {
+	_, ok := standardMethods[method]
+	return ok
+}
+
+// Matches returns true if the supplied search expression evaluates to true. It
+// uses the default match config.
+func (reqLog RequestLog) Matches(expr search.Expression) (bool, error) {
+	return reqLog.MatchesWithConfig(expr, DefaultMatchConfig())
+}
+
+// MatchesWithConfig returns true if the supplied search expression evaluates to
+// true, using cfg for the computed search keys that depend on it. Bodies with a
+// `Content-Encoding` (gzip, deflate or br) are decoded before they're searched. To
+// match many request logs against the same expression, use `CompileMatcher`.
+func (reqLog RequestLog) MatchesWithConfig(expr search.Expression, cfg MatchConfig) (bool, error) {
+	match, err := CompileMatcher(expr, cfg)
+	if err != nil {
+		return false, err
+	}
+
+	return match(reqLog), nil
+}
+
+// Matches returns true if the supplied search expression evaluates to true for
+// the response log, without its request. Request search keys resolve to an
+// empty string.
+func (resLog ResponseLog) Matches(expr search.Expression) (bool, error) {
+	return resLog.MatchesWithConfig(expr, DefaultMatchConfig())
+}
+
+// MatchesWithConfig returns true if the supplied search expression evaluates to
+// true for the response log, using cfg for the computed search keys that
+// depend on it. A body with a `Content-Encoding` is decoded before it's
+// searched.
+func (resLog ResponseLog) MatchesWithConfig(expr search.Expression, cfg MatchConfig) (bool, error) {
+	match, err := compiler{cfg: cfg, responseOnly: true}.compile(expr)
+	if err != nil {
+		return false, err
+	}
+
+	return match(RequestLog{Response: &resLog}), nil
+}
+
+// MatchesWithFields is like `MatchesWithConfig`, but also returns the search
+// keys in which the bare string literals of the expression were found, e.g.
+// for highlighting. Keys are reported once, in the order of the literals in
+// the expression and then of `MatchConfig.FreeTextKeys`. No keys are returned
+// if the expression doesn't match.
+func (reqLog RequestLog) MatchesWithFields(expr search.Expression, cfg MatchConfig) (bool, []string, error) {
+	match, err := CompileMatcher(expr, cfg)
+	if err != nil || !match(reqLog) {
+		return false, nil, err
+	}
+
+	reqLog = reqLog.withDecodedBodies()
+
+	var fields []string
+
+	seen := make(map[string]bool)
+
+	for _, s := range freeTextLiterals(expr) {
+		for _, key := range reqLog.FreeTextMatchKeys(s, cfg) {
+			if !seen[key] {
+				seen[key] = true
+				fields = append(fields, key)
+			}
 		}
+	}
+
+	return true, fields, nil
+}
 
-		switch expr.Operator {
-		case search.TokOpRe:
-			return right.MatchString(leftVal), nil
-		case search.TokOpNotRe:
-			return !right.MatchString(leftVal), nil
+// freeTextLiterals returns the bare string literals of expr, i.e. the ones
+// that are matched as free text, in order. Literals of negated expressions are
+// included too.
+func freeTextLiterals(expr search.Expression) []string {
+	switch e := expr.(type) {
+	case search.PrefixExpression:
+		return freeTextLiterals(e.Right)
+	case search.InfixExpression:
+		if e.Operator == search.TokOpAnd || e.Operator == search.TokOpOr {
+			return append(freeTextLiterals(e.Left), freeTextLiterals(e.Right)...)
 		}
+	case search.StringLiteral:
+		return []string{e.Value}
 	}
 
-	right, ok := expr.Right.(search.StringLiteral)
-	if !ok {
-		return false, errors.New("right operand must be a string literal")
+	return nil
+}
+
+// EvalCall returns the value a function call resolves to for the request log,
+// e.g. the captured value of `extract(req.url, /id=(\d+)/)`, for display or
+// aggregation.
+func (reqLog RequestLog) EvalCall(expr search.CallExpression, cfg MatchConfig) (string, error) {
+	call, err := compiler{cfg: cfg}.compileCall(expr)
+	if err != nil {
+		return "", err
 	}
 
-	rightVal := reqLog.getMappedStringLiteral(right.Value)
+	return call(reqLog.withDecodedBodies()), nil
+}
 
-	switch expr.Operator {
+// parseTimestamp parses the right operand of a comparison against
+// `req.timestamp` (see `search.DatetimeLayouts`).
+func parseTimestamp(s string) (time.Time, bool) {
+	return search.ParseDatetime(s)
+}
+
+// timestamp returns the time a request log was created, derived from its ID.
+// Request logs without an ID have a zero time.
+func (reqLog RequestLog) timestamp() time.Time {
+	if reqLog.ID.Compare(ulid.ULID{}) == 0 {
+		return time.Time{}
+	}
+
+	return ulid.Time(reqLog.ID.Time())
+}
+
+func compareTimes(a, b time.Time) int {
+	switch {
+	case a.Before(b):
+		return -1
+	case a.After(b):
+		return 1
+	default:
+		return 0
+	}
+}
+
+// matchCompareResult returns if a comparison result (see `compareValues`)
+// satisfies an equality or comparison operator.
+func matchCompareResult(op search.TokenType, cmp int) (bool, error) {
+	switch op {
 	case search.TokOpEq:
-		return leftVal == rightVal, nil
+		return cmp == 0, nil
 	case search.TokOpNotEq:
-		return leftVal != rightVal, nil
+		return cmp != 0, nil
 	case search.TokOpGt:
-		// TODO(?) attempt to parse as int.
-		return leftVal > rightVal, nil
+		return cmp > 0, nil
 	case search.TokOpLt:
-		// TODO(?) attempt to parse as int.
-		return leftVal < rightVal, nil
+		return cmp < 0, nil
 	case search.TokOpGtEq:
-		// TODO(?) attempt to parse as int.
-		return leftVal >= rightVal, nil
+		return cmp >= 0, nil
 	case search.TokOpLtEq:
-		// TODO(?) attempt to parse as int.
-		return leftVal <= rightVal, nil
+		return cmp <= 0, nil
 	default:
 		return false, errors.New("unsupported operator")
 	}
 }
 
-func (reqLog RequestLog) getMappedStringLiteral(s string) string {
+// compareValues compares two values numerically if both are numbers,
+// chronologically if both are datetimes (see `search.ParseLiteral`), by
+// precedence if both are semantic versions (e.g. `2.10.0`), and lexically
+// otherwise. It returns -1 if a < b, 0 if a == b and 1 if a > b.
+func compareValues(a, b string) int {
+	return compareLiterals(search.ParseLiteral(a), search.ParseLiteral(b))
+}
+
+// compareLiterals is like `compareValues`, for values that were already parsed.
+func compareLiterals(a, b search.TypedLiteral) int {
+	if cmp, ok := a.Compare(b); ok {
+		return cmp
+	}
+
+	aVer, aOK := parseSemver(a.Value)
+	bVer, bOK := parseSemver(b.Value)
+
+	if aOK && bOK {
+		return compareSemver(aVer, bVer)
+	}
+
+	return strings.Compare(a.Value, b.Value)
+}
+
+func (reqLog RequestLog) getMappedStringLiteral(s string, cfg MatchConfig) string {
+	s = canonicalSearchKey(s)
+
 	switch {
 	case strings.HasPrefix(s, "req."):
 		fn, ok := reqLogSearchKeyFns[s]
 		if ok {
 			return fn(reqLog)
 		}
+
+		if fn, ok := reqLogComputedKeyFns[s]; ok {
+			return fn(reqLog, cfg)
+		}
+
+		if fn, ok := customRequestKeyFn(s); ok {
+			return fn(reqLog)
+		}
+
+		if strings.HasPrefix(s, reqBodyJSONKeyPrefix) {
+			return jsonBodyValue(reqLog.Body, strings.TrimPrefix(s, reqBodyJSONKeyPrefix))
+		}
+
+		if strings.HasPrefix(s, reqHeaderKeyPrefix) {
+			return headerKeyValue(reqLog.Header, strings.TrimPrefix(s, reqHeaderKeyPrefix))
+		}
+
+		if strings.HasPrefix(s, reqQueryKeyPrefix) {
+			if values := queryParamValues(reqLog.URL, strings.TrimPrefix(s, reqQueryKeyPrefix)); len(values) > 0 {
+				return values[0]
+			}
+
+			return ""
+		}
 	case strings.HasPrefix(s, "res."):
 		if reqLog.Response == nil {
+			// An absent response has no body or headers, so their size is
+			// known. Its latency and duration weren't recorded.
+			if s == "res.bodySize" || s == "res.latency" || s == "res.duration" || s == "res.size" ||
+				(strings.HasPrefix(s, resHeaderKeyPrefix) && strings.HasSuffix(s, headerLengthKeySuffix)) {
+				return "0"
+			}
 			return ""
 		}
 
-		fn, ok := resLogSearchKeyFns[s]
-		if ok {
-			return fn(*reqLog.Response)
+		if fn, ok := resLogRequestKeyFns[s]; ok {
+			return fn(reqLog, cfg)
 		}
+
+		return reqLog.Response.getMappedStringLiteral(s, cfg)
 	}
 
 	return s
 }
 
-func (reqLog RequestLog) matchStringLiteral(strLiteral search.StringLiteral) (bool, error) {
-	for _, fn := range reqLogSearchKeyFns {
-		if strings.Contains(
-			strings.ToLower(fn(reqLog)),
-			strings.ToLower(strLiteral.Value),
-		) {
-			return true, nil
+// getMappedStringLiteral returns the value of a response search key. Request
+// search keys, and response keys that depend on the request, resolve to an
+// empty string. Other strings are returned as-is.
+func (resLog ResponseLog) getMappedStringLiteral(s string, cfg MatchConfig) string {
+	s = canonicalSearchKey(s)
+
+	switch {
+	case strings.HasPrefix(s, "req."):
+		return ""
+	case strings.HasPrefix(s, "res."):
+		if fn, ok := resLogSearchKeyFns[s]; ok {
+			return fn(resLog)
+		}
+
+		if fn, ok := resLogComputedKeyFns[s]; ok {
+			return fn(resLog, cfg)
 		}
+
+		if _, ok := resLogRequestKeyFns[s]; ok {
+			return ""
+		}
+
+		if fn, ok := customResponseKeyFn(s); ok {
+			return fn(resLog)
+		}
+
+		if strings.HasPrefix(s, resBodyJSONKeyPrefix) {
+			// Like for `res.body`, errors reading a body from disk are ignored.
+			body, _ := resLog.ReadBody()
+			return jsonBodyValue(body, strings.TrimPrefix(s, resBodyJSONKeyPrefix))
+		}
+
+		if strings.HasPrefix(s, resHeaderKeyPrefix) {
+			return headerKeyValue(resLog.Header, strings.TrimPrefix(s, resHeaderKeyPrefix))
+		}
+	}
+
+	return s
+}
+
+// queryParamValues returns the decoded values of a URL query parameter. It
+// returns nil if the parameter is absent or the URL is nil.
+func queryParamValues(u *url.URL, name string) []string {
+	if u == nil {
+		return nil
+	}
+
+	return u.Query()[name]
+}
+
+// headerKeyValue resolves the part of a header search key after its prefix. If
+// it has the `.length` suffix, it's the byte length of the header value, which
+// is 0 if the header is absent. Otherwise, it's the header value.
+func headerKeyValue(header http.Header, key string) string {
+	if strings.HasSuffix(key, headerLengthKeySuffix) {
+		return strconv.Itoa(len(headerValue(header, strings.TrimSuffix(key, headerLengthKeySuffix))))
+	}
+
+	return headerValue(header, key)
+}
+
+// headerValue returns the values of a header, joined by commas. It returns an
+// empty string if the header is absent.
+func headerValue(header http.Header, name string) string {
+	return strings.Join(header.Values(name), ", ")
+}
+
+// headerString returns all headers in wire format, i.e. a `Name: value` line
+// per value, terminated by CRLF and sorted by name, so they can be matched as a
+// whole, e.g. `req.headers =~ "(?m)^X-Api-Key:"`.
+func headerString(header http.Header) string {
+	var b strings.Builder
+
+	// Writes to a strings.Builder don't fail.
+	_ = header.Write(&b)
+
+	return b.String()
+}
+
+// FreeTextMatchKey returns the first search key, in the order of
+// `MatchConfig.FreeTextKeys`, whose value contains s. Header matches are
+// reported with the canonical header name, e.g. `req.headers.Content-Type`. It
+// returns an empty string if no key matches.
+func (reqLog RequestLog) FreeTextMatchKey(s string, cfg MatchConfig) string {
+	if keys := reqLog.freeTextMatchKeys(s, cfg, true); len(keys) > 0 {
+		return keys[0]
 	}
 
-	if reqLog.Response != nil {
-		for _, fn := range resLogSearchKeyFns {
-			if strings.Contains(
-				strings.ToLower(fn(*reqLog.Response)),
-				strings.ToLower(strLiteral.Value),
-			) {
-				return true, nil
+	return ""
+}
+
+// FreeTextMatchKeys returns all search keys whose value contains s, in the
+// order of `MatchConfig.FreeTextKeys`. See `FreeTextMatchKey`.
+func (reqLog RequestLog) FreeTextMatchKeys(s string, cfg MatchConfig) []string {
+	return reqLog.freeTextMatchKeys(s, cfg, false)
+}
+
+// freeTextMatchKeys returns the search keys whose value contains s. If first
+// is true, it stops at the first match.
+func (reqLog RequestLog) freeTextMatchKeys(s string, cfg MatchConfig, first bool) []string {
+	var matched []string
+
+	for _, key := range freeTextKeys(cfg) {
+		switch {
+		case strings.HasPrefix(key, "res."):
+			if reqLog.Response != nil {
+				matched = append(matched, reqLog.Response.freeTextMatchKeys(key, s, cfg, first)...)
+			}
+		case key == reqHeaderKeyPrefix:
+			for _, name := range matchHeaderNames(reqLog.Header, s, cfg.CaseSensitive, first) {
+				matched = append(matched, reqHeaderKeyPrefix+name)
 			}
+		default:
+			if fn, ok := reqLogSearchKeyFns[key]; ok && containsString(fn(reqLog), s, cfg.CaseSensitive) {
+				matched = append(matched, key)
+			}
+		}
+
+		if first && len(matched) > 0 {
+			return matched[:1]
 		}
 	}
 
-	return false, nil
+	return matched
 }
 
-func (reqLog RequestLog) MatchScope(s *scope.Scope) bool {
-	for _, rule := range s.Rules() {
-		if rule.URL != nil && reqLog.URL != nil {
-			if matches := rule.URL.MatchString(reqLog.URL.String()); matches {
-				return true
+// freeTextMatchKeys returns the response search keys, out of key itself or the
+// headers for `res.headers.`, whose value contains s. If first is true, it
+// stops at the first match.
+func (resLog ResponseLog) freeTextMatchKeys(key, s string, cfg MatchConfig, first bool) []string {
+	if key == resHeaderKeyPrefix {
+		var matched []string
+
+		for _, name := range matchHeaderNames(resLog.Header, s, cfg.CaseSensitive, first) {
+			matched = append(matched, resHeaderKeyPrefix+name)
+		}
+
+		return matched
+	}
+
+	if fn, ok := resLogSearchKeyFns[key]; ok && containsString(fn(resLog), s, cfg.CaseSensitive) {
+		return []string{key}
+	}
+
+	return nil
+}
+
+// freeTextMatch returns true if any response search key of
+// `MatchConfig.FreeTextKeys` contains s. It returns false for a nil response
+// log.
+func (resLog *ResponseLog) freeTextMatch(s string, cfg MatchConfig) bool {
+	if resLog == nil {
+		return false
+	}
+
+	for _, key := range freeTextKeys(cfg) {
+		if strings.HasPrefix(key, "res.") && len(resLog.freeTextMatchKeys(key, s, cfg, true)) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// freeTextKeys returns the search keys to scan when matching a bare string
+// literal.
+func freeTextKeys(cfg MatchConfig) []string {
+	if cfg.FreeTextKeys == nil {
+		return defaultFreeTextKeys
+	}
+
+	return cfg.FreeTextKeys
+}
+
+// matchHeaderNames returns the canonical names of the headers, in sorted order,
+// with a value that contains s. If first is true, it stops at the first match.
+func matchHeaderNames(header http.Header, s string, caseSensitive, first bool) []string {
+	names := make([]string, 0, len(header))
+	for name := range header {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	var matched []string
+
+	for _, name := range names {
+		for _, value := range header[name] {
+			if containsString(value, s, caseSensitive) {
+				matched = append(matched, http.CanonicalHeaderKey(name))
+				break
 			}
 		}
 
-		for key, values := range reqLog.Header {
-			var keyMatches, valueMatches bool
+		if first && len(matched) > 0 {
+			break
+		}
+	}
 
-			if rule.Header.Key != nil {
-				if matches := rule.Header.Key.MatchString(key); matches {
-					keyMatches = true
-				}
+	return matched
+}
+
+// containsString returns true if substr is within s, ignoring case unless
+// caseSensitive is true.
+func containsString(s, substr string, caseSensitive bool) bool {
+	if caseSensitive {
+		return strings.Contains(s, substr)
+	}
+
+	return strings.Contains(strings.ToLower(s), strings.ToLower(substr))
+}
+
+// equalString returns true if a and b are equal, ignoring case unless
+// caseSensitive is true.
+func equalString(a, b string, caseSensitive bool) bool {
+	if caseSensitive {
+		return a == b
+	}
+
+	return strings.EqualFold(a, b)
+}
+
+// MatchScope returns true if the request log is in scope, according to the
+// scope's rules and match mode (see `scope.MatchMode.CombineRules`).
+func (reqLog RequestLog) MatchScope(s *scope.Scope) bool {
+	rules := s.Rules()
+
+	return s.MatchMode().CombineRules(rules, func(i int) bool {
+		return reqLog.matchScopeRule(rules[i])
+	})
+}
+
+// matchScopeRule returns true if the request log matches the rule. Rules with a
+// status code never match request logs without a response.
+func (reqLog RequestLog) matchScopeRule(rule scope.Rule) bool {
+	if rule.Method != nil && !rule.Method.MatchString(reqLog.Method) {
+		return false
+	}
+
+	if rule.StatusCode.IsSet() && (reqLog.Response == nil || !rule.StatusCode.Contains(reqLog.Response.StatusCode)) {
+		return false
+	}
+
+	if rule.Port.IsSet() && (reqLog.URL == nil || !rule.Port.Contains(scope.URLPort(reqLog.URL))) {
+		return false
+	}
+
+	if !rule.HasRequestMatcher() {
+		return rule.Method != nil || rule.StatusCode.IsSet() || rule.Port.IsSet()
+	}
+
+	if rule.URL != nil && reqLog.URL != nil {
+		if matches := rule.URL.MatchString(reqLog.URL.String()); matches {
+			return true
+		}
+	}
+
+	for key, values := range reqLog.Header {
+		var keyMatches, valueMatches bool
+
+		if rule.Header.Key != nil {
+			if matches := rule.Header.Key.MatchString(key); matches {
+				keyMatches = true
 			}
+		}
 
-			if rule.Header.Value != nil {
-				for _, value := range values {
-					if matches := rule.Header.Value.MatchString(value); matches {
-						valueMatches = true
-						break
-					}
+		if rule.Header.Value != nil {
+			for _, value := range values {
+				if matches := rule.Header.Value.MatchString(value); matches {
+					valueMatches = true
+					break
 				}
 			}
-			// When only key or value is set, match on whatever is set.
-			// When both are set, both must match.
-			switch {
-			case rule.Header.Key != nil && rule.Header.Value == nil && keyMatches:
-				return true
-			case rule.Header.Key == nil && rule.Header.Value != nil && valueMatches:
-				return true
-			case rule.Header.Key != nil && rule.Header.Value != nil && keyMatches && valueMatches:
-				return true
-			}
 		}
+		// When only key or value is set, match on whatever is set.
+		// When both are set, both must match.
+		switch {
+		case rule.Header.Key != nil && rule.Header.Value == nil && keyMatches:
+			return true
+		case rule.Header.Key == nil && rule.Header.Value != nil && valueMatches:
+			return true
+		case rule.Header.Key != nil && rule.Header.Value != nil && keyMatches && valueMatches:
+			return true
+		}
+	}
 
-		if rule.Body != nil {
-			if matches := rule.Body.Match(reqLog.Body); matches {
-				return true
-			}
+	if rule.Body != nil {
+		if matches := rule.Body.Match(reqLog.Body); matches {
+			return true
 		}
 	}
 
@@ -1,9 +1,27 @@
 package reqlog_test
 
 import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"crypto/tls"
+	"errors"
+	"net"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/andybalholm/brotli"
+	"github.com/google/go-cmp/cmp"
+	"github.com/oklog/ulid"
+
+	"github.com/dstotijn/hetty/pkg/proxy"
 	"github.com/dstotijn/hetty/pkg/reqlog"
+	"github.com/dstotijn/hetty/pkg/scope"
 	"github.com/dstotijn/hetty/pkg/search"
 )
 
@@ -169,35 +187,3351 @@ func TestRequestLogMatch(t *testing.T) {
 			expectedMatch: true,
 			expectedError: nil,
 		},
-	}
-
-	for _, tt := range tests {
-		tt := tt
-		t.Run(tt.name, func(t *testing.T) {
-			t.Parallel()
-
-			searchExpr, err := search.ParseQuery(tt.query)
-			assertError(t, nil, err)
-
-			got, err := tt.requestLog.Matches(searchExpr)
-			assertError(t, tt.expectedError, err)
-
-			if tt.expectedMatch != got {
-				t.Errorf("expected match result: %v, got: %v", tt.expectedMatch, got)
-			}
-		})
-	}
-}
-
-func assertError(t *testing.T, exp, got error) {
-	t.Helper()
-
-	switch {
-	case exp == nil && got != nil:
-		t.Fatalf("expected: nil, got: %v", got)
-	case exp != nil && got == nil:
-		t.Fatalf("expected: %v, got: nil", exp.Error())
-	case exp != nil && got != nil && exp.Error() != got.Error():
-		t.Fatalf("expected: %v, got: %v", exp.Error(), got.Error())
-	}
+		{
+			name:  "non-standard method key, standard method",
+			query: "req.nonStandardMethod = true",
+			requestLog: reqlog.RequestLog{
+				Method: "GET",
+			},
+			expectedMatch: false,
+			expectedError: nil,
+		},
+		{
+			name:  "non-standard method key, custom method",
+			query: "req.nonStandardMethod = true",
+			requestLog: reqlog.RequestLog{
+				Method: "FOO",
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "non-standard method key, lowercase standard method",
+			query: "req.nonStandardMethod = true",
+			requestLog: reqlog.RequestLog{
+				Method: "get",
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "approximately equal operator, within tolerance",
+			query: "res.bodySize ~= 1024 ± 10%",
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{
+					Body: make([]byte, 1100),
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "approximately equal operator, on tolerance boundary",
+			query: "res.bodySize ~= 1000 ± 10%",
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{
+					Body: make([]byte, 900),
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "approximately equal operator, outside tolerance",
+			query: "res.bodySize ~= 1024 ± 10%",
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{
+					Body: make([]byte, 1200),
+				},
+			},
+			expectedMatch: false,
+			expectedError: nil,
+		},
+		{
+			name:  "approximately equal operator, non-numeric value",
+			query: "req.method ~= 1024 ± 10%",
+			requestLog: reqlog.RequestLog{
+				Method: "GET",
+			},
+			expectedMatch: false,
+			expectedError: nil,
+		},
+		{
+			name:  "timing keys",
+			query: "req.timing.dnsMs = 12 AND req.timing.connectMs = 34 AND req.timing.tlsMs = 56 AND req.timing.responseMs = 78",
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{
+					Timing: proxy.Timing{
+						DNS:          12 * time.Millisecond,
+						Connect:      34 * time.Millisecond,
+						TLSHandshake: 56 * time.Millisecond,
+						Response:     78 * time.Millisecond,
+					},
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:          "timing keys, without response",
+			query:         "req.timing.dnsMs = 0",
+			requestLog:    reqlog.RequestLog{},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "greater than operator, numeric values",
+			query: "req.timing.responseMs > 500",
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{
+					Timing: proxy.Timing{Response: 1200 * time.Millisecond},
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "greater than operator, numeric values, no match",
+			query: "req.timing.responseMs > 500",
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{
+					Timing: proxy.Timing{Response: 78 * time.Millisecond},
+				},
+			},
+			expectedMatch: false,
+			expectedError: nil,
+		},
+		{
+			name:  "less than or equal operator, numeric values",
+			query: "res.bodySize <= 100",
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{
+					Body: make([]byte, 20),
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "greater than or equal operator, numeric values",
+			query: "req.cookieCount >= 10 OR res.cookieCount >= 10",
+			requestLog: reqlog.RequestLog{
+				Header: http.Header{"Cookie": []string{"a=1; b=2"}},
+				Response: &reqlog.ResponseLog{
+					Header: http.Header{"Set-Cookie": []string{"c=3"}},
+				},
+			},
+			expectedMatch: false,
+			expectedError: nil,
+		},
+		{
+			name:  "cross-origin referer key, same-origin referer",
+			query: "req.crossOriginReferer = true",
+			requestLog: reqlog.RequestLog{
+				URL:    mustParseURL(t, "https://example.com/foo"),
+				Header: http.Header{"Referer": []string{"https://example.com/bar"}},
+			},
+			expectedMatch: false,
+			expectedError: nil,
+		},
+		{
+			name:  "cross-origin referer key, cross-origin referer",
+			query: "req.crossOriginReferer = true",
+			requestLog: reqlog.RequestLog{
+				URL:    mustParseURL(t, "https://example.com/foo"),
+				Header: http.Header{"Referer": []string{"https://evil.example.org/bar"}},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "cross-origin referer key, without referer",
+			query: "req.crossOriginReferer = false",
+			requestLog: reqlog.RequestLog{
+				URL: mustParseURL(t, "https://example.com/foo"),
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "insecure cookie key, secure cookie",
+			query: "res.hasInsecureCookie = true",
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{
+					Header: http.Header{"Set-Cookie": []string{"session=foo; Secure; HttpOnly"}},
+				},
+			},
+			expectedMatch: false,
+			expectedError: nil,
+		},
+		{
+			name:  "insecure cookie key, cookie without HttpOnly",
+			query: "res.hasInsecureCookie = true",
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{
+					Header: http.Header{"Set-Cookie": []string{
+						"session=foo; Secure; HttpOnly",
+						"tracking=bar; Secure",
+					}},
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "insecure cookie key, cookie without Secure",
+			query: "res.hasInsecureCookie = true",
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{
+					Header: http.Header{"Set-Cookie": []string{"session=foo; HttpOnly"}},
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "insecure cookie key, no cookies",
+			query: "res.hasInsecureCookie = false",
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "raw query key, encoded query string",
+			query: `req.rawQuery = "q=foo%20bar&redirect=%2Fadmin"`,
+			requestLog: reqlog.RequestLog{
+				URL: mustParseURL(t, "https://example.com/?q=foo%20bar&redirect=%2Fadmin"),
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "raw query key, decoded value doesn't match",
+			query: `req.rawQuery = "q=foo bar&redirect=/admin"`,
+			requestLog: reqlog.RequestLog{
+				URL: mustParseURL(t, "https://example.com/?q=foo%20bar&redirect=%2Fadmin"),
+			},
+			expectedMatch: false,
+			expectedError: nil,
+		},
+		{
+			name:          "raw query key, nil URL",
+			query:         `req.rawQuery = ""`,
+			requestLog:    reqlog.RequestLog{},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "cookie count key, no cookies",
+			query: "res.cookieCount = 0",
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "cookie count key, one cookie",
+			query: "res.cookieCount = 1",
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{
+					Header: http.Header{"Set-Cookie": []string{"session=foo"}},
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "cookie count key, multiple cookies",
+			query: "res.cookieCount = 3",
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{
+					Header: http.Header{"Set-Cookie": []string{
+						"session=foo",
+						"tracking=bar; Secure",
+						"theme=dark; Path=/",
+					}},
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "body malformed key, valid JSON body",
+			query: "req.bodyMalformed = false",
+			requestLog: reqlog.RequestLog{
+				Header: http.Header{"Content-Type": []string{"application/json; charset=utf-8"}},
+				Body:   []byte(`{"foo": "bar"}`),
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "body malformed key, invalid JSON body",
+			query: "req.bodyMalformed = true",
+			requestLog: reqlog.RequestLog{
+				Header: http.Header{"Content-Type": []string{"application/json"}},
+				Body:   []byte(`{"foo": "bar"`),
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "body malformed key, invalid XML body",
+			query: "req.bodyMalformed = true",
+			requestLog: reqlog.RequestLog{
+				Header: http.Header{"Content-Type": []string{"application/xml"}},
+				Body:   []byte(`<foo>bar</baz>`),
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "body malformed key, invalid form body",
+			query: "req.bodyMalformed = true",
+			requestLog: reqlog.RequestLog{
+				Header: http.Header{"Content-Type": []string{"application/x-www-form-urlencoded"}},
+				Body:   []byte(`foo=%zz`),
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "body malformed key, unknown content type",
+			query: "req.bodyMalformed = false",
+			requestLog: reqlog.RequestLog{
+				Header: http.Header{"Content-Type": []string{"text/plain"}},
+				Body:   []byte(`{"foo": "bar"`),
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "TLS cipher suite key, TLS request",
+			query: "req.tls.cipherSuite = TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256",
+			requestLog: reqlog.RequestLog{
+				TLS: &reqlog.TLSInfo{CipherSuite: tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:          "TLS cipher suite key, plaintext request",
+			query:         `req.tls.cipherSuite = ""`,
+			requestLog:    reqlog.RequestLog{},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "has response key, request with response",
+			query: "req.hasResponse = true",
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{StatusCode: 200},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:          "has response key, request without response",
+			query:         "req.hasResponse = false",
+			requestLog:    reqlog.RequestLog{},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:          "has response key, negated",
+			query:         "NOT (req.hasResponse = true)",
+			requestLog:    reqlog.RequestLog{},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "effective URL key, rewritten request",
+			query: `req.effectiveUrl = "https://staging.example.com/foo"`,
+			requestLog: reqlog.RequestLog{
+				URL:          mustParseURL(t, "https://example.com/foo"),
+				EffectiveURL: mustParseURL(t, "https://staging.example.com/foo"),
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "effective URL key, unmodified request",
+			query: `req.effectiveUrl = "https://example.com/foo"`,
+			requestLog: reqlog.RequestLog{
+				URL: mustParseURL(t, "https://example.com/foo"),
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "API key key, key in header",
+			query: "req.hasApiKey = true",
+			requestLog: reqlog.RequestLog{
+				URL:    mustParseURL(t, "https://example.com/"),
+				Header: http.Header{"X-Api-Key": []string{"s3cr3t"}},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "API key key, key in query param",
+			query: "req.hasApiKey = true",
+			requestLog: reqlog.RequestLog{
+				URL: mustParseURL(t, "https://example.com/?foo=bar&apiKey=s3cr3t"),
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "API key key, empty query param",
+			query: "req.hasApiKey = false",
+			requestLog: reqlog.RequestLog{
+				URL: mustParseURL(t, "https://example.com/?api_key="),
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "max token entropy key, high entropy token",
+			query: "req.maxTokenEntropy > 4.0",
+			requestLog: reqlog.RequestLog{
+				Header: http.Header{"Authorization": []string{"Bearer 9fK2xQ7vLp3ZmT8wRb1NcY6h"}},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "max token entropy key, low entropy sentence",
+			query: "req.maxTokenEntropy > 4.0",
+			requestLog: reqlog.RequestLog{
+				Body: []byte("the cat sat on the mat and then it sat on the hat"),
+			},
+			expectedMatch: false,
+			expectedError: nil,
+		},
+		{
+			name:  "max token entropy key, response body",
+			query: "res.maxTokenEntropy > 4.0",
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{
+					Body: []byte(`{"api_key": "AKIAIOSFODNN7EXAMPLEwJalrXUtnFEMI"}`),
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:          "request cookie count key, no cookies",
+			query:         "req.cookieCount = 0",
+			requestLog:    reqlog.RequestLog{},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "request cookie count key, one cookie",
+			query: "req.cookieCount = 1",
+			requestLog: reqlog.RequestLog{
+				Header: http.Header{"Cookie": []string{"session=foo"}},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "request cookie count key, multiple cookies",
+			query: "req.cookieCount = 3",
+			requestLog: reqlog.RequestLog{
+				Header: http.Header{"Cookie": []string{"session=foo; theme=dark", "tracking=bar"}},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "insecure cookie macro",
+			query: "@insecureCookie",
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{
+					Header: http.Header{"Set-Cookie": []string{"session=foo"}},
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "no response macro, negated",
+			query: "NOT @noResponse",
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "missing CSP macro",
+			query: "@missingCSP",
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{
+					Header: http.Header{"Content-Type": []string{"text/html"}},
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "missing CSP macro, response with CSP",
+			query: "@missingCSP",
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{
+					Header: http.Header{"Content-Security-Policy": []string{"default-src 'self'"}},
+				},
+			},
+			expectedMatch: false,
+			expectedError: nil,
+		},
+		{
+			name:  "reflected param macro",
+			query: "@reflectedParam",
+			requestLog: reqlog.RequestLog{
+				URL: mustParseURL(t, "https://example.com/search?q=foobar&page=1"),
+				Response: &reqlog.ResponseLog{
+					Body: []byte("<p>No results for foobar</p>"),
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "reflected param macro, short value is ignored",
+			query: "@reflectedParam",
+			requestLog: reqlog.RequestLog{
+				URL: mustParseURL(t, "https://example.com/search?page=1"),
+				Response: &reqlog.ResponseLog{
+					Body: []byte("<p>Page 1</p>"),
+				},
+			},
+			expectedMatch: false,
+			expectedError: nil,
+		},
+		{
+			name:  "likely secret macro",
+			query: "@likelySecret",
+			requestLog: reqlog.RequestLog{
+				Header: http.Header{"Authorization": []string{"Bearer 9fK2xQ7vLp3ZmT8wRb1NcY6h"}},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "unregistered macro is a string literal",
+			query: "@example.com",
+			requestLog: reqlog.RequestLog{
+				Body: []byte("user@example.com"),
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "body size delta key, grown body",
+			query: "req.bodySizeDelta = 6",
+			requestLog: reqlog.RequestLog{
+				Body:     []byte("foo=barbarbar"),
+				Baseline: &reqlog.Baseline{BodySize: 7},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "body size delta key, unchanged body",
+			query: "req.bodySizeDelta = 0",
+			requestLog: reqlog.RequestLog{
+				Body:     []byte("foo=bar"),
+				Baseline: &reqlog.Baseline{BodySize: 7},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "body size delta key, grown beyond threshold",
+			query: "req.bodySizeDelta > 5",
+			requestLog: reqlog.RequestLog{
+				Body:     make([]byte, 27),
+				Baseline: &reqlog.Baseline{BodySize: 7},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "body size delta key, grown within threshold",
+			query: "req.bodySizeDelta > 100",
+			requestLog: reqlog.RequestLog{
+				Body:     make([]byte, 27),
+				Baseline: &reqlog.Baseline{BodySize: 7},
+			},
+			expectedMatch: false,
+			expectedError: nil,
+		},
+		{
+			name:  "body size delta key, shrunk beyond threshold",
+			query: "req.bodySizeDelta < -10",
+			requestLog: reqlog.RequestLog{
+				Body:     make([]byte, 10),
+				Baseline: &reqlog.Baseline{BodySize: 60},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "body size delta key, shrunk within threshold",
+			query: "req.bodySizeDelta < -100",
+			requestLog: reqlog.RequestLog{
+				Body:     make([]byte, 10),
+				Baseline: &reqlog.Baseline{BodySize: 60},
+			},
+			expectedMatch: false,
+			expectedError: nil,
+		},
+		{
+			name:  "body size delta key, without baseline",
+			query: "req.bodySizeDelta = 0",
+			requestLog: reqlog.RequestLog{
+				Body: []byte("foo=bar"),
+			},
+			expectedMatch: false,
+			expectedError: nil,
+		},
+		{
+			name:  "JA3 key, TLS request",
+			query: "req.ja3 = fd17f1f9b9c56d4bfda8900c8900ec06",
+			requestLog: reqlog.RequestLog{
+				TLS: &reqlog.TLSInfo{JA3: "fd17f1f9b9c56d4bfda8900c8900ec06"},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:          "JA3 key, plaintext request",
+			query:         `req.ja3 = ""`,
+			requestLog:    reqlog.RequestLog{},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "TLS error key",
+			query: `req.tlsError =~ "first record does not look like a TLS handshake"`,
+			requestLog: reqlog.RequestLog{
+				Method:   "CONNECT",
+				TLSError: "handshake error: tls: first record does not look like a TLS handshake",
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "GraphQL keys, named query",
+			query: `req.graphql.operationName = GetUser AND req.graphql.query =~ "user\(id: 1\)"`,
+			requestLog: reqlog.RequestLog{
+				Header: http.Header{"Content-Type": []string{"application/json"}},
+				Body:   []byte(`{"query": "query GetUser { user(id: 1) { name } }"}`),
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "GraphQL keys, mutation with operation name field",
+			query: "req.graphql.operationName = CreateUser",
+			requestLog: reqlog.RequestLog{
+				Header: http.Header{"Content-Type": []string{"application/json; charset=utf-8"}},
+				Body: []byte(`{"query": "mutation CreateUser($name: String!) { createUser(name: $name) { id } }",` +
+					`"operationName": "CreateUser", "variables": {"name": "foo"}}`),
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "GraphQL keys, non-JSON body",
+			query: `req.graphql.query != ""`,
+			requestLog: reqlog.RequestLog{
+				Header: http.Header{"Content-Type": []string{"application/x-www-form-urlencoded"}},
+				Body:   []byte(`query=query+GetUser+{+user+{+name+}+}`),
+			},
+			expectedMatch: false,
+			expectedError: nil,
+		},
+		{
+			name:  "GraphQL keys, JSON body without query field",
+			query: `req.graphql.operationName != ""`,
+			requestLog: reqlog.RequestLog{
+				Header: http.Header{"Content-Type": []string{"application/json"}},
+				Body:   []byte(`{"operationName": "GetUser"}`),
+			},
+			expectedMatch: false,
+			expectedError: nil,
+		},
+		{
+			name:  "language key, single language",
+			query: "res.language = nl-NL",
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{
+					Header: http.Header{"Content-Language": []string{"nl-NL"}},
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "language key, first of multiple languages",
+			query: "res.language = de-DE",
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{
+					Header: http.Header{"Content-Language": []string{"de-DE, en-CA"}},
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "language key, absent header",
+			query: `res.language = ""`,
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "content type mismatch key, accepted content type",
+			query: "res.contentTypeMismatch = false",
+			requestLog: reqlog.RequestLog{
+				Header: http.Header{"Accept": []string{"application/json, text/plain;q=0.5"}},
+				Response: &reqlog.ResponseLog{
+					Header: http.Header{"Content-Type": []string{"application/json; charset=utf-8"}},
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "content type mismatch key, accepted by wildcard range",
+			query: "res.contentTypeMismatch = false",
+			requestLog: reqlog.RequestLog{
+				Header: http.Header{"Accept": []string{"text/*"}},
+				Response: &reqlog.ResponseLog{
+					Header: http.Header{"Content-Type": []string{"text/html"}},
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "content type mismatch key, JSON requested, HTML returned",
+			query: "res.contentTypeMismatch = true",
+			requestLog: reqlog.RequestLog{
+				Header: http.Header{"Accept": []string{"application/json"}},
+				Response: &reqlog.ResponseLog{
+					Header: http.Header{"Content-Type": []string{"text/html; charset=utf-8"}},
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "content type mismatch key, rejected with zero quality",
+			query: "res.contentTypeMismatch = true",
+			requestLog: reqlog.RequestLog{
+				Header: http.Header{"Accept": []string{"application/json, text/html;q=0"}},
+				Response: &reqlog.ResponseLog{
+					Header: http.Header{"Content-Type": []string{"text/html"}},
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "content type mismatch key, without accept header",
+			query: "res.contentTypeMismatch = false",
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{
+					Header: http.Header{"Content-Type": []string{"text/html"}},
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "greater than operator, semantic versions",
+			query: "res.body > 2.9.0",
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{
+					Body: []byte("2.10.0"),
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "less than operator, semantic versions with prefix and build metadata",
+			query: "res.body < v2.3.0+build.5",
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{
+					Body: []byte("v2.3.0-rc.1"),
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:          "less than operator, semantic version pre-release precedence",
+			query:         "1.0.0-alpha < 1.0.0-alpha.1 AND 1.0.0-alpha.1 < 1.0.0-alpha.beta AND 1.0.0-beta.2 < 1.0.0-beta.11",
+			requestLog:    reqlog.RequestLog{},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:          "greater than or equal operator, equal semantic versions",
+			query:         "1.2.3+a >= 1.2.3+b AND 1.2.3 <= 1.2.3",
+			requestLog:    reqlog.RequestLog{},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:          "greater than operator, semantic version and other value",
+			query:         "2.10.0 > 2.9.x",
+			requestLog:    reqlog.RequestLog{},
+			expectedMatch: false,
+			expectedError: nil,
+		},
+		{
+			name:  "tracker key, known tracker host",
+			query: "req.isTracker = true",
+			requestLog: reqlog.RequestLog{
+				URL: mustParseURL(t, "https://www.google-analytics.com/analytics.js"),
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "tracker key, known tracker path",
+			query: "req.isTracker = true",
+			requestLog: reqlog.RequestLog{
+				URL: mustParseURL(t, "https://example.com/g/collect?v=2"),
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "tracker key, normal request",
+			query: "req.isTracker = false",
+			requestLog: reqlog.RequestLog{
+				URL: mustParseURL(t, "https://example.com/collections/foo"),
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "charset mismatch key, valid UTF-8",
+			query: "res.charsetMismatch = false",
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{
+					Header: http.Header{"Content-Type": []string{"text/html; charset=UTF-8"}},
+					Body:   []byte("<p>héllo wörld</p>"),
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "charset mismatch key, invalid UTF-8",
+			query: "res.charsetMismatch = true",
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{
+					Header: http.Header{"Content-Type": []string{"text/html; charset=utf-8"}},
+					// "héllo" encoded as ISO-8859-1.
+					Body: []byte{'h', 0xe9, 'l', 'l', 'o'},
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "charset mismatch key, deflate encoded invalid UTF-8",
+			query: "res.charsetMismatch = true",
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{
+					Header: http.Header{
+						"Content-Type":     []string{"text/html; charset=utf-8"},
+						"Content-Encoding": []string{"deflate"},
+					},
+					ContentEncoding: "deflate",
+					Body:            []byte{'h', 0xe9, 'l', 'l', 'o'},
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "charset mismatch key, br encoded valid UTF-8",
+			query: "res.charsetMismatch = false",
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{
+					Header: http.Header{
+						"Content-Type":     []string{"text/html; charset=utf-8"},
+						"Content-Encoding": []string{"br"},
+					},
+					ContentEncoding: "br",
+					Body:            []byte("<p>héllo wörld</p>"),
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "charset mismatch key, br encoded invalid UTF-8",
+			query: "res.charsetMismatch = true",
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{
+					Header: http.Header{
+						"Content-Type":     []string{"text/html; charset=utf-8"},
+						"Content-Encoding": []string{"br"},
+					},
+					ContentEncoding: "br",
+					Body:            []byte{'h', 0xe9, 'l', 'l', 'o'},
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "charset mismatch key, undecodable content encoding",
+			query: "res.charsetMismatch = false",
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{
+					Header: http.Header{
+						"Content-Type":     []string{"text/html; charset=utf-8"},
+						"Content-Encoding": []string{"zstd"},
+					},
+					Body: []byte{'h', 0xe9, 'l', 'l', 'o'},
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "charset mismatch key, undeclared charset",
+			query: "res.charsetMismatch = false",
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{
+					Header: http.Header{"Content-Type": []string{"text/html"}},
+					Body:   []byte{'h', 0xe9, 'l', 'l', 'o'},
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "cacheable by proxy key, public response",
+			query: "req.cacheableByProxy = true",
+			requestLog: reqlog.RequestLog{
+				Method: http.MethodGet,
+				Response: &reqlog.ResponseLog{
+					StatusCode: http.StatusOK,
+					Header:     http.Header{"Cache-Control": []string{"public, max-age=3600"}},
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "cacheable by proxy key, heuristically cacheable status",
+			query: "req.cacheableByProxy = true",
+			requestLog: reqlog.RequestLog{
+				Method: http.MethodHead,
+				Response: &reqlog.ResponseLog{
+					StatusCode: http.StatusNotFound,
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "cacheable by proxy key, POST request",
+			query: "req.cacheableByProxy = false",
+			requestLog: reqlog.RequestLog{
+				Method: http.MethodPost,
+				Response: &reqlog.ResponseLog{
+					StatusCode: http.StatusOK,
+					Header:     http.Header{"Cache-Control": []string{"public, max-age=3600"}},
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "cacheable by proxy key, no-store request",
+			query: "req.cacheableByProxy = false",
+			requestLog: reqlog.RequestLog{
+				Method: http.MethodGet,
+				Header: http.Header{"Cache-Control": []string{"no-store"}},
+				Response: &reqlog.ResponseLog{
+					StatusCode: http.StatusOK,
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "cacheable by proxy key, private response",
+			query: "req.cacheableByProxy = false",
+			requestLog: reqlog.RequestLog{
+				Method: http.MethodGet,
+				Response: &reqlog.ResponseLog{
+					StatusCode: http.StatusOK,
+					Header:     http.Header{"Cache-Control": []string{"private, max-age=60"}},
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "cacheable by proxy key, authorized request",
+			query: "req.cacheableByProxy = false",
+			requestLog: reqlog.RequestLog{
+				Method: http.MethodGet,
+				Header: http.Header{"Authorization": []string{"Bearer foo"}},
+				Response: &reqlog.ResponseLog{
+					StatusCode: http.StatusOK,
+					Header:     http.Header{"Cache-Control": []string{"max-age=60"}},
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "cacheable by proxy key, authorized request with s-maxage",
+			query: "req.cacheableByProxy = true",
+			requestLog: reqlog.RequestLog{
+				Method: http.MethodGet,
+				Header: http.Header{"Authorization": []string{"Bearer foo"}},
+				Response: &reqlog.ResponseLog{
+					StatusCode: http.StatusOK,
+					Header:     http.Header{"Cache-Control": []string{"s-maxage=60"}},
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "idempotency key keys, present header",
+			query: "req.hasIdempotencyKey = true AND req.idempotencyKey = 8e03978e-40d5-43e8-bc93-6894a57f9324",
+			requestLog: reqlog.RequestLog{
+				Method: http.MethodPost,
+				Header: http.Header{"Idempotency-Key": []string{"8e03978e-40d5-43e8-bc93-6894a57f9324"}},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "idempotency key keys, absent header",
+			query: `req.hasIdempotencyKey = false AND req.idempotencyKey = ""`,
+			requestLog: reqlog.RequestLog{
+				Method: http.MethodPost,
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "CSRF candidate key, cookie-only POST",
+			query: "req.csrfCandidate = true",
+			requestLog: reqlog.RequestLog{
+				Method: http.MethodPost,
+				URL:    mustParseURL(t, "https://example.com/account/email"),
+				Header: http.Header{
+					"Cookie":       []string{"session=foo"},
+					"Content-Type": []string{"application/x-www-form-urlencoded"},
+				},
+				Body: []byte("email=foo%40example.com"),
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "CSRF candidate key, POST with token in form body",
+			query: "req.csrfCandidate = false",
+			requestLog: reqlog.RequestLog{
+				Method: http.MethodPost,
+				URL:    mustParseURL(t, "https://example.com/account/email"),
+				Header: http.Header{
+					"Cookie":       []string{"session=foo"},
+					"Content-Type": []string{"application/x-www-form-urlencoded"},
+				},
+				Body: []byte("email=foo%40example.com&authenticity_token=bar"),
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "CSRF candidate key, DELETE with token header",
+			query: "req.csrfCandidate = false",
+			requestLog: reqlog.RequestLog{
+				Method: http.MethodDelete,
+				URL:    mustParseURL(t, "https://example.com/api/posts/1"),
+				Header: http.Header{
+					"Cookie":       []string{"session=foo"},
+					"X-Csrf-Token": []string{"bar"},
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "CSRF candidate key, GET request",
+			query: "req.csrfCandidate = false",
+			requestLog: reqlog.RequestLog{
+				Method: http.MethodGet,
+				URL:    mustParseURL(t, "https://example.com/"),
+				Header: http.Header{"Cookie": []string{"session=foo"}},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "CSRF candidate key, multipart POST with token field",
+			query: "req.csrfCandidate = false",
+			requestLog: reqlog.RequestLog{
+				Method: http.MethodPost,
+				URL:    mustParseURL(t, "https://example.com/upload"),
+				Header: http.Header{
+					"Cookie":       []string{"session=foo"},
+					"Content-Type": []string{"multipart/form-data; boundary=foobar"},
+				},
+				Body: []byte("--foobar\r\nContent-Disposition: form-data; name=\"_csrf\"\r\n\r\nbaz\r\n--foobar--\r\n"),
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "detected language key, PHP tag in body",
+			query: "res.detectedLang = php",
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{
+					Body: []byte("<html><?php echo $foo; ?></html>"),
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "detected language key, ASP.NET view state",
+			query: "res.detectedLang = asp.net",
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{
+					Body: []byte(`<input type="hidden" name="__VIEWSTATE" value="foo" />`),
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "detected language key, framework header",
+			query: "res.detectedLang = node.js",
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{
+					Header: http.Header{"X-Powered-By": []string{"Express"}},
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "detected language key, undetermined",
+			query: `res.detectedLang = ""`,
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{
+					Body: []byte("<html><p>foo</p></html>"),
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "unexpected encoding key, allowed encoding",
+			query: "res.unexpectedEncoding = false",
+			requestLog: reqlog.RequestLog{
+				Header: http.Header{"Accept-Encoding": []string{"gzip, deflate, br"}},
+				Response: &reqlog.ResponseLog{
+					Header: http.Header{"Content-Encoding": []string{"br"}},
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "unexpected encoding key, disallowed encoding",
+			query: "res.unexpectedEncoding = true",
+			requestLog: reqlog.RequestLog{
+				Header: http.Header{"Accept-Encoding": []string{"gzip, deflate"}},
+				Response: &reqlog.ResponseLog{
+					Header: http.Header{"Content-Encoding": []string{"br"}},
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "unexpected encoding key, encoding rejected with zero quality",
+			query: "res.unexpectedEncoding = true",
+			requestLog: reqlog.RequestLog{
+				Header: http.Header{"Accept-Encoding": []string{"*, gzip;q=0"}},
+				Response: &reqlog.ResponseLog{
+					Header: http.Header{"Content-Encoding": []string{"x-gzip"}},
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "unexpected encoding key, without content encoding",
+			query: "res.unexpectedEncoding = false",
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "cookie domains keys, multiple domains",
+			query: "res.cookieDomains = example.com,shop.example.com AND res.cookieDomainCount = 2",
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{
+					Header: http.Header{"Set-Cookie": []string{
+						"a=1; Domain=shop.example.com",
+						"b=2; Domain=.Example.com",
+						"c=3; Domain=example.com",
+						"d=4",
+					}},
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "cookie domains keys, host-only cookies",
+			query: `res.cookieDomains = "" AND res.cookieDomainCount = 0`,
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{
+					Header: http.Header{"Set-Cookie": []string{"a=1"}},
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "error page key, stack trace with 200 status",
+			query: "res.isErrorPage = true",
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{
+					StatusCode: http.StatusOK,
+					Body: []byte("java.lang.NullPointerException\n" +
+						"\tat com.example.UserController.show(UserController.java:42)\n"),
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "error page key, Spring Boot error page",
+			query: "res.isErrorPage = true",
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{
+					StatusCode: http.StatusInternalServerError,
+					Body:       []byte("<html><body><h1>Whitelabel Error Page</h1></body></html>"),
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "error page key, normal 200 body",
+			query: "res.isErrorPage = false",
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{
+					StatusCode: http.StatusOK,
+					Body:       []byte("<html><body><p>Meet us at the café (opens 9:00)</p></body></html>"),
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "header keys, case-insensitive header name",
+			query: `req.headers.content-type =~ "json" AND res.headers.X-FOO = bar`,
+			requestLog: reqlog.RequestLog{
+				Header: http.Header{"Content-Type": []string{"application/json"}},
+				Response: &reqlog.ResponseLog{
+					Header: http.Header{"X-Foo": []string{"bar"}},
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "header keys, multiple values are joined",
+			query: `res.headers.set-cookie = "a=1, b=2" AND res.headers.set-cookie =~ "b=2"`,
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{
+					Header: http.Header{"Set-Cookie": []string{"a=1", "b=2"}},
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "header keys, absent header",
+			query: `req.headers.authorization = ""`,
+			requestLog: reqlog.RequestLog{
+				Header: http.Header{"X-Foo": []string{"bar"}},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:          "header keys, without response",
+			query:         `res.headers.content-type = ""`,
+			requestLog:    reqlog.RequestLog{},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "string literal expression, match in request header",
+			query: "s3cr3t",
+			requestLog: reqlog.RequestLog{
+				Header: http.Header{"Authorization": []string{"Bearer S3CR3T"}},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "string literal expression, match in response header",
+			query: "nginx",
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{
+					Header: http.Header{"Server": []string{"nginx/1.21.6"}},
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "greater than or equal operator, status code",
+			query: "res.statusCode >= 500",
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{StatusCode: 503},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "greater than or equal operator, status code, no match",
+			query: "res.statusCode >= 500",
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{StatusCode: 404},
+			},
+			expectedMatch: false,
+			expectedError: nil,
+		},
+		{
+			name:  "greater than operator, numbers with different digit counts",
+			query: "res.statusCode > 1000",
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{StatusCode: 500},
+			},
+			expectedMatch: false,
+			expectedError: nil,
+		},
+		{
+			name:  "greater than operator, mixed numeric and non-numeric operands",
+			query: "req.method > 100",
+			requestLog: reqlog.RequestLog{
+				// Compared as strings: "GET" sorts after "100".
+				Method: "GET",
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:          "less than operator, negative numbers",
+			query:         "-10 < -2 AND -2 < 0 AND -0.5 > -1",
+			requestLog:    reqlog.RequestLog{},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:          "greater than or equal operator, zero",
+			query:         "0 >= -0 AND 0 <= 0.0 AND 0 > -1",
+			requestLog:    reqlog.RequestLog{},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "timestamp after date-only value, match",
+			query: `req.timestamp > "2020-01-01"`,
+			requestLog: reqlog.RequestLog{
+				ID: ulid.MustNew(ulid.Timestamp(time.Now()), nil),
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "timestamp before date-only value, no match",
+			query: `req.timestamp < "2020-01-01"`,
+			requestLog: reqlog.RequestLog{
+				ID: ulid.MustNew(ulid.Timestamp(time.Now()), nil),
+			},
+			expectedMatch: false,
+			expectedError: nil,
+		},
+		{
+			name:  "timestamp compared with RFC3339 value, match",
+			query: `req.timestamp >= "2021-06-01T12:00:00+02:00"`,
+			requestLog: reqlog.RequestLog{
+				ID: ulid.MustNew(ulid.Timestamp(time.Date(2021, 6, 1, 10, 0, 0, 0, time.UTC)), nil),
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "timestamp equal to RFC3339 value, match",
+			query: `req.timestamp = "2021-06-01T10:00:00Z"`,
+			requestLog: reqlog.RequestLog{
+				ID: ulid.MustNew(ulid.Timestamp(time.Date(2021, 6, 1, 10, 0, 0, 0, time.UTC)), nil),
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "timestamp not equal to RFC3339 value, match",
+			query: `req.timestamp != "2021-06-01T10:00:00.001Z"`,
+			requestLog: reqlog.RequestLog{
+				ID: ulid.MustNew(ulid.Timestamp(time.Date(2021, 6, 1, 10, 0, 0, 0, time.UTC)), nil),
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:          "zero timestamp compares as zero time, match",
+			query:         `req.timestamp < "0001-01-02"`,
+			requestLog:    reqlog.RequestLog{},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "timestamp compared with unparseable value, falls back to string compare",
+			query: `req.timestamp > "1970"`,
+			requestLog: reqlog.RequestLog{
+				ID: ulid.MustNew(ulid.Timestamp(time.Now()), nil),
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "host key, match",
+			query: `req.host =~ "^internal\."`,
+			requestLog: reqlog.RequestLog{
+				URL: mustParseURL(t, "https://internal.example.com:8443/admin/users?q=foo#top"),
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "host and path keys, match",
+			query: `req.host = "internal.example.com:8443" AND req.path =~ "^/admin"`,
+			requestLog: reqlog.RequestLog{
+				URL: mustParseURL(t, "https://internal.example.com:8443/admin/users?q=foo#top"),
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "scheme, query and fragment keys, match",
+			query: `req.scheme = https AND req.query = "q=foo%20bar" AND req.fragment = top`,
+			requestLog: reqlog.RequestLog{
+				URL: mustParseURL(t, "https://example.com/?q=foo%20bar#top"),
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "path key, no match",
+			query: `req.path =~ "^/admin"`,
+			requestLog: reqlog.RequestLog{
+				URL: mustParseURL(t, "https://example.com/adm/admin"),
+			},
+			expectedMatch: false,
+			expectedError: nil,
+		},
+		{
+			name:          "URL component keys, nil URL",
+			query:         `req.host = "" AND req.path = "" AND req.scheme = "" AND req.query = "" AND req.fragment = ""`,
+			requestLog:    reqlog.RequestLog{},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "string literal expression, match in fragment",
+			query: "section-2",
+			requestLog: reqlog.RequestLog{
+				URL: &url.URL{Scheme: "https", Host: "example.com", Fragment: "section-2"},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:          "string literal expression, nil URL",
+			query:         "example.com",
+			requestLog:    reqlog.RequestLog{},
+			expectedMatch: false,
+			expectedError: nil,
+		},
+		{
+			name:  "gzip encoded response body, match after decoding",
+			query: `res.body =~ "password"`,
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{
+					Header: http.Header{"Content-Encoding": []string{"gzip"}},
+					Body:   gzipBytes(t, `{"password": "s3cr3t"}`),
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "gzip encoded response body, string literal match after decoding",
+			query: "s3cr3t",
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{
+					Header: http.Header{"Content-Encoding": []string{"gzip"}},
+					Body:   gzipBytes(t, `{"password": "s3cr3t"}`),
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "deflate encoded request body, match after decoding",
+			query: `req.body = "foo=bar"`,
+			requestLog: reqlog.RequestLog{
+				Header: http.Header{"Content-Encoding": []string{"deflate"}},
+				Body:   zlibBytes(t, "foo=bar"),
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "gzip and deflate encoded response body, match after decoding",
+			query: `res.body = "foobar"`,
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{
+					Header: http.Header{"Content-Encoding": []string{"deflate, gzip"}},
+					Body:   gzipBytes(t, string(zlibBytes(t, "foobar"))),
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "brotli encoded response body, match after decoding",
+			query: `res.body = "foobar"`,
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{
+					Header: http.Header{"Content-Encoding": []string{"br"}},
+					Body:   brotliBytes(t, "foobar"),
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "raw deflate encoded request body, match after decoding",
+			query: `req.body = "foo=bar"`,
+			requestLog: reqlog.RequestLog{
+				Header: http.Header{"Content-Encoding": []string{"deflate"}},
+				Body:   flateBytes(t, "foo=bar"),
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "response body decoded when stored, not decoded again",
+			query: `res.body = "foobar"`,
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{
+					Header:          http.Header{"Content-Encoding": []string{"br"}},
+					Body:            []byte("foobar"),
+					ContentEncoding: "br",
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "response body with bogus encoding, falls back to raw body",
+			query: `res.body = "password"`,
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{
+					Header: http.Header{"Content-Encoding": []string{"bogus"}},
+					Body:   []byte("password"),
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "response body that isn't gzip encoded, falls back to raw body",
+			query: `res.body = "password"`,
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{
+					Header: http.Header{"Content-Encoding": []string{"gzip"}},
+					Body:   []byte("password"),
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "set membership operator, numeric member, match",
+			query: "res.statusCode in [200, 301, 302]",
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{StatusCode: 301},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "set membership operator, numeric member compared numerically, match",
+			query: "res.statusCode IN [404, 200.0]",
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{StatusCode: 200},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "set membership operator, numeric member, no match",
+			query: "res.statusCode in [200, 301, 302]",
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{StatusCode: 500},
+			},
+			expectedMatch: false,
+			expectedError: nil,
+		},
+		{
+			name:          "set membership operator, string member, match",
+			query:         `req.method in ["GET", "POST"]`,
+			requestLog:    reqlog.RequestLog{Method: "POST"},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:          "set membership operator, string member, no match",
+			query:         `req.method in ["GET", "POST"]`,
+			requestLog:    reqlog.RequestLog{Method: "DELETE"},
+			expectedMatch: false,
+			expectedError: nil,
+		},
+		{
+			name:          "set membership operator, empty list, no match",
+			query:         "req.method in []",
+			requestLog:    reqlog.RequestLog{Method: "GET"},
+			expectedMatch: false,
+			expectedError: nil,
+		},
+		{
+			name:          "pipelined key, match",
+			query:         "req.pipelined = true",
+			requestLog:    reqlog.RequestLog{Pipelined: true},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:          "pipelined key, no match",
+			query:         "req.pipelined = true",
+			requestLog:    reqlog.RequestLog{},
+			expectedMatch: false,
+			expectedError: nil,
+		},
+		{
+			name:          "malformed protocol key, HTTP/1.1",
+			query:         "req.malformedProtocol = false",
+			requestLog:    reqlog.RequestLog{Proto: "HTTP/1.1"},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:          "malformed protocol key, tolerated HTTP/1.x version",
+			query:         "req.malformedProtocol = true",
+			requestLog:    reqlog.RequestLog{Proto: "HTTP/1.7"},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:          "malformed protocol key, rejected HTTP/0.9 request",
+			query:         `req.malformedProtocol = true AND req.parseError = "400 Bad Request"`,
+			requestLog:    reqlog.RequestLog{Method: "GET", ParseError: "400 Bad Request"},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:          "malformed protocol key, rejected for missing host",
+			query:         "req.malformedProtocol = false",
+			requestLog:    reqlog.RequestLog{Proto: "HTTP/1.1", ParseError: "400 Bad Request: missing required Host header"},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:          "malformed protocol key, unparsed protocol of imported request",
+			query:         "req.malformedProtocol = false",
+			requestLog:    reqlog.RequestLog{Proto: "h3"},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:          "tag key, equal to any tag",
+			query:         `req.tag = "auth-bypass"`,
+			requestLog:    reqlog.RequestLog{Annotations: reqlog.Annotations{Tags: []string{"idor", "auth-bypass"}}},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:          "tag key, not equal to any tag",
+			query:         `req.tag != "idor"`,
+			requestLog:    reqlog.RequestLog{Annotations: reqlog.Annotations{Tags: []string{"idor", "auth-bypass"}}},
+			expectedMatch: false,
+			expectedError: nil,
+		},
+		{
+			name:          "tag key, without tags",
+			query:         `req.tag != "idor"`,
+			requestLog:    reqlog.RequestLog{},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:          "tag key, regular expression",
+			query:         `req.tag =~ "^auth"`,
+			requestLog:    reqlog.RequestLog{Annotations: reqlog.Annotations{Tags: []string{"idor", "auth-bypass"}}},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:          "tag key, in list",
+			query:         `req.tag in ["sqli", "idor"]`,
+			requestLog:    reqlog.RequestLog{Annotations: reqlog.Annotations{Tags: []string{"idor", "auth-bypass"}}},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:          "tag key, whole tag only",
+			query:         `req.tag = "auth"`,
+			requestLog:    reqlog.RequestLog{Annotations: reqlog.Annotations{Tags: []string{"idor", "auth-bypass"}}},
+			expectedMatch: false,
+			expectedError: nil,
+		},
+		{
+			name:          "note key, match",
+			query:         `req.note =~ "(?i)check later"`,
+			requestLog:    reqlog.RequestLog{Annotations: reqlog.Annotations{Note: "Check later: leaks user IDs"}},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:          "highlight color key, match",
+			query:         `req.highlightColor = red`,
+			requestLog:    reqlog.RequestLog{Annotations: reqlog.Annotations{HighlightColor: reqlog.HighlightColorRed}},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:          "request body JSON key, nested number, match",
+			query:         `req.body.json.user.id = "42"`,
+			requestLog:    reqlog.RequestLog{Body: []byte(`{"user": {"id": 42, "name": "foo"}}`)},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:          "request body JSON key, nested string, match",
+			query:         `req.body.json.user.name =~ "^f"`,
+			requestLog:    reqlog.RequestLog{Body: []byte(`{"user": {"id": 42, "name": "foo"}}`)},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:          "request body JSON key, array index, match",
+			query:         `req.body.json.items.1.id = 2 AND req.body.json.items[1].id = 2`,
+			requestLog:    reqlog.RequestLog{Body: []byte(`{"items": [{"id": 1}, {"id": 2}]}`)},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:          "request body JSON key, object value, match",
+			query:         `req.body.json.user =~ "^\{.id.:42\}$"`,
+			requestLog:    reqlog.RequestLog{Body: []byte(`{"user": {"id": 42}}`)},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:          "request body JSON key, missing key, no match",
+			query:         `req.body.json.user.email = "foo@example.com"`,
+			requestLog:    reqlog.RequestLog{Body: []byte(`{"user": {"id": 42}}`)},
+			expectedMatch: false,
+			expectedError: nil,
+		},
+		{
+			name:          "request body JSON key, invalid JSON, no match",
+			query:         `req.body.json.user.id = "42"`,
+			requestLog:    reqlog.RequestLog{Body: []byte(`user.id=42`)},
+			expectedMatch: false,
+			expectedError: nil,
+		},
+		{
+			name:  "response body JSON key, match",
+			query: `res.body.json.ok = true`,
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{Body: []byte(`{"ok": true}`)},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:          "response body JSON key, without response, no match",
+			query:         `res.body.json.ok = true`,
+			requestLog:    reqlog.RequestLog{},
+			expectedMatch: false,
+			expectedError: nil,
+		},
+		{
+			name:  "response body length, large body, match",
+			query: "res.bodyLength >= 1024",
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{Body: bytes.Repeat([]byte("a"), 100000)},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "response body length, small body, no match",
+			query: "res.bodyLength >= 1024",
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{Body: []byte("foobar")},
+			},
+			expectedMatch: false,
+			expectedError: nil,
+		},
+		{
+			name:          "response body length, without response",
+			query:         "res.bodyLength = 0",
+			requestLog:    reqlog.RequestLog{},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:          "response body size and its alias, without response",
+			query:         "res.bodySize = 0 AND res.bodyLength = 0",
+			requestLog:    reqlog.RequestLog{},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:          "response body size alias, equal to body size",
+			query:         "res.bodyLength = 6 AND res.bodySize = 6",
+			requestLog:    reqlog.RequestLog{Response: &reqlog.ResponseLog{Body: []byte("foobar")}},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:          "request body length, empty body, match",
+			query:         "req.bodyLength = 0",
+			requestLog:    reqlog.RequestLog{},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:          "request body length, numeric comparison, match",
+			query:         "req.bodyLength > 9",
+			requestLog:    reqlog.RequestLog{Body: []byte("foo=bar&baz=qux")},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "infix expression, request header length, greater than",
+			query: "req.headers.Authorization.length > 10",
+			requestLog: reqlog.RequestLog{
+				Header: http.Header{"Authorization": []string{"Bearer foobar"}},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "infix expression, request header length, equal",
+			query: "req.headers.authorization.length = 13",
+			requestLog: reqlog.RequestLog{
+				Header: http.Header{"Authorization": []string{"Bearer foobar"}},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:          "infix expression, absent request header length is 0",
+			query:         "req.headers.Authorization.length = 0",
+			requestLog:    reqlog.RequestLog{},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "infix expression, response header length, oversized",
+			query: "res.headers.Set-Cookie.length > 4096",
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{
+					Header: http.Header{"Set-Cookie": []string{"session=" + strings.Repeat("a", 4096)}},
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "infix expression, response header length, not oversized",
+			query: "res.headers.Set-Cookie.length > 4096",
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{
+					Header: http.Header{"Set-Cookie": []string{"session=foobar"}},
+				},
+			},
+			expectedMatch: false,
+			expectedError: nil,
+		},
+		{
+			name:  "infix expression, absent response header length is 0",
+			query: "res.headers.Set-Cookie.length = 0",
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:          "infix expression, response header length without response is 0",
+			query:         "res.headers.Set-Cookie.length = 0",
+			requestLog:    reqlog.RequestLog{},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "cross-site key, same origin",
+			query: "req.crossSite = true",
+			requestLog: reqlog.RequestLog{
+				URL:    mustParseURL(t, "https://example.com/transfer"),
+				Header: http.Header{"Origin": []string{"https://example.com"}},
+			},
+			expectedMatch: false,
+			expectedError: nil,
+		},
+		{
+			name:  "cross-site key, same site with different subdomain and port",
+			query: "req.crossSite = true",
+			requestLog: reqlog.RequestLog{
+				URL:    mustParseURL(t, "https://api.example.com/transfer"),
+				Header: http.Header{"Origin": []string{"https://www.example.com:8443"}},
+			},
+			expectedMatch: false,
+			expectedError: nil,
+		},
+		{
+			name:  "cross-site key, different registrable domain",
+			query: "req.crossSite = true",
+			requestLog: reqlog.RequestLog{
+				URL:    mustParseURL(t, "https://example.com/transfer"),
+				Header: http.Header{"Origin": []string{"https://evil.example.org"}},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "cross-site key, sibling domains under public suffix",
+			query: "req.crossSite = true",
+			requestLog: reqlog.RequestLog{
+				URL:    mustParseURL(t, "https://foo.github.io/"),
+				Header: http.Header{"Origin": []string{"https://bar.github.io"}},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "cross-site key, different scheme",
+			query: "req.crossSite = true",
+			requestLog: reqlog.RequestLog{
+				URL:    mustParseURL(t, "https://example.com/transfer"),
+				Header: http.Header{"Origin": []string{"http://example.com"}},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "cross-site key, null origin",
+			query: "req.crossSite = true",
+			requestLog: reqlog.RequestLog{
+				URL:    mustParseURL(t, "https://example.com/transfer"),
+				Header: http.Header{"Origin": []string{"null"}},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "cross-site key, referer without origin",
+			query: "req.crossSite = true",
+			requestLog: reqlog.RequestLog{
+				URL:    mustParseURL(t, "https://example.com/"),
+				Header: http.Header{"Referer": []string{"https://evil.example.org/page"}},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:          "cross-site key, without origin or referer",
+			query:         "req.crossSite = false",
+			requestLog:    reqlog.RequestLog{URL: mustParseURL(t, "https://example.com/")},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:          "infix expression, query parameter equal",
+			query:         "req.query.id = 42",
+			requestLog:    reqlog.RequestLog{URL: mustParseURL(t, "https://example.com/?id=42&foo=bar")},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:          "infix expression, query parameter regexp",
+			query:         `req.query.redirect_uri =~ "evil\.com"`,
+			requestLog:    reqlog.RequestLog{URL: mustParseURL(t, "https://example.com/auth?redirect_uri=https://evil.com/cb")},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:          "infix expression, missing query parameter is empty",
+			query:         `req.query.foo = ""`,
+			requestLog:    reqlog.RequestLog{URL: mustParseURL(t, "https://example.com/?bar=baz")},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:          "infix expression, missing query parameter doesn't match regexp",
+			query:         `req.query.foo =~ "."`,
+			requestLog:    reqlog.RequestLog{URL: mustParseURL(t, "https://example.com/?bar=baz")},
+			expectedMatch: false,
+			expectedError: nil,
+		},
+		{
+			name:          "infix expression, query parameter with empty value",
+			query:         `req.query.foo = ""`,
+			requestLog:    reqlog.RequestLog{URL: mustParseURL(t, "https://example.com/?foo=")},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:          "infix expression, query parameter without URL is empty",
+			query:         `req.query.foo = ""`,
+			requestLog:    reqlog.RequestLog{},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:          "infix expression, query parameter is URL decoded",
+			query:         `req.query.q = "a b&c"`,
+			requestLog:    reqlog.RequestLog{URL: mustParseURL(t, "https://example.com/?q=a+b%26c")},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:          "infix expression, repeated query parameter equal uses first value",
+			query:         "req.query.id = 2",
+			requestLog:    reqlog.RequestLog{URL: mustParseURL(t, "https://example.com/?id=1&id=2")},
+			expectedMatch: false,
+			expectedError: nil,
+		},
+		{
+			name:          "infix expression, repeated query parameter regexp searches all values",
+			query:         `req.query.id =~ "^2$|, 2$"`,
+			requestLog:    reqlog.RequestLog{URL: mustParseURL(t, "https://example.com/?id=1&id=2")},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:          "extract function, captured value compared",
+			query:         `extract(req.url, /id=(\d+)/) > 100`,
+			requestLog:    reqlog.RequestLog{URL: mustParseURL(t, "https://example.com/?id=123")},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:          "extract function, failed capture is empty",
+			query:         `extract(req.url, /id=(\d+)/) = ""`,
+			requestLog:    reqlog.RequestLog{URL: mustParseURL(t, "https://example.com/?user=foo")},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:          "extract function on its own, successful capture",
+			query:         `extract(res.headers.Location, /code=([^&]+)/)`,
+			requestLog:    reqlog.RequestLog{Response: &reqlog.ResponseLog{Header: http.Header{"Location": []string{"/cb?code=abc"}}}},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:          "extract function on its own, failed capture",
+			query:         `extract(res.headers.Location, /code=([^&]+)/)`,
+			requestLog:    reqlog.RequestLog{Response: &reqlog.ResponseLog{Header: http.Header{"Location": []string{"/cb?error=denied"}}}},
+			expectedMatch: false,
+			expectedError: nil,
+		},
+		{
+			name:          "extract function with string literal as regular expression",
+			query:         `extract(req.url, "id")`,
+			requestLog:    reqlog.RequestLog{URL: mustParseURL(t, "https://example.com/?id=123")},
+			expectedMatch: false,
+			expectedError: errors.New("second argument of extract must be a regular expression"),
+		},
+		{
+			name:  "infix expression, request parse warnings",
+			query: `req.parseWarnings =~ "line folding"`,
+			requestLog: reqlog.RequestLog{
+				ParseWarnings: []string{`obsolete line folding in header "X-Foo"`, "bare LF line ending"},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:          "infix expression, request without parse warnings",
+			query:         `req.parseWarnings = ""`,
+			requestLog:    reqlog.RequestLog{},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "infix expression, response parse warnings",
+			query: `res.parseWarnings =~ "Transfer-Encoding and Content-Length"`,
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{
+					ParseWarnings: []string{"both Transfer-Encoding and Content-Length headers"},
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "response latency, greater than",
+			query: "res.latency > 1000",
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{Latency: 1500 * time.Millisecond},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "response latency, not greater than",
+			query: "res.latency > 2000",
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{Latency: 1500 * time.Millisecond},
+			},
+			expectedMatch: false,
+			expectedError: nil,
+		},
+		{
+			name:          "response latency, without response",
+			query:         "res.latency = 0",
+			requestLog:    reqlog.RequestLog{},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "response duration, greater than",
+			query: "res.duration > 1000",
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{Latency: 200 * time.Millisecond, Duration: 1500 * time.Millisecond},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:          "response duration, without response",
+			query:         "res.duration = 0",
+			requestLog:    reqlog.RequestLog{},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "response size, as received",
+			query: "res.size = 42 AND res.bodySize = 128",
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{Body: make([]byte, 128), Size: 42},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "response size, not recorded",
+			query: "res.size = 128",
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{Body: make([]byte, 128)},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "all request headers, regexp",
+			query: `req.headers =~ "(?m)^X-Api-Key: \w+"`,
+			requestLog: reqlog.RequestLog{
+				Header: http.Header{
+					"Accept":    []string{"*/*"},
+					"X-Api-Key": []string{"s3cr3t"},
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "all request headers, no match",
+			query: `req.headers =~ "(?i)authorization"`,
+			requestLog: reqlog.RequestLog{
+				Header: http.Header{"Accept": []string{"*/*"}},
+			},
+			expectedMatch: false,
+			expectedError: nil,
+		},
+		{
+			// Headers are sorted by name.
+			name:  "all response headers",
+			query: `res.headers =~ "^Content-Type: text/html\r\nServer: nginx\r\n$"`,
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{
+					Header: http.Header{
+						"Server":       []string{"nginx"},
+						"Content-Type": []string{"text/html"},
+					},
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "greater than operator, numbers of different length",
+			query: `req.headers.Content-Length > 999`,
+			requestLog: reqlog.RequestLog{
+				Header: http.Header{"Content-Length": []string{"1000"}},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			// Lexically, the header value is greater.
+			name:  "less than operator, datetimes with different offsets",
+			query: `res.headers.X-Expires < "2023-06-01T00:30:00Z"`,
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{
+					Header: http.Header{"X-Expires": []string{"2023-06-01T01:00:00+02:00"}},
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "greater than operator, datetime and string",
+			query: `res.headers.X-Expires > "never"`,
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{
+					Header: http.Header{"X-Expires": []string{"2023-06-01"}},
+				},
+			},
+			expectedMatch: false,
+			expectedError: nil,
+		},
+		{
+			name:  "string literal expression, computed keys are not searched",
+			query: "false",
+			requestLog: reqlog.RequestLog{
+				Method: "GET",
+			},
+			expectedMatch: false,
+			expectedError: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			searchExpr, err := search.ParseQuery(tt.query)
+			assertError(t, nil, err)
+
+			got, err := tt.requestLog.Matches(searchExpr)
+			assertError(t, tt.expectedError, err)
+
+			if tt.expectedMatch != got {
+				t.Errorf("expected match result: %v, got: %v", tt.expectedMatch, got)
+			}
+		})
+	}
+}
+
+func assertError(t *testing.T, exp, got error) {
+	t.Helper()
+
+	switch {
+	case exp == nil && got != nil:
+		t.Fatalf("expected: nil, got: %v", got)
+	case exp != nil && got == nil:
+		t.Fatalf("expected: %v, got: nil", exp.Error())
+	case exp != nil && got != nil && exp.Error() != got.Error():
+		t.Fatalf("expected: %v, got: %v", exp.Error(), got.Error())
+	}
+}
+
+func TestRequestLogSignature(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		a, b     reqlog.RequestLog
+		expEqual bool
+	}{
+		{
+			name:     "different query parameter values",
+			a:        reqlog.RequestLog{Method: "GET", URL: mustParseURL(t, "https://example.com/users?id=1")},
+			b:        reqlog.RequestLog{Method: "GET", URL: mustParseURL(t, "https://example.com/users?id=2")},
+			expEqual: true,
+		},
+		{
+			name:     "different query parameter order",
+			a:        reqlog.RequestLog{Method: "GET", URL: mustParseURL(t, "https://example.com/users?id=1&sort=asc")},
+			b:        reqlog.RequestLog{Method: "GET", URL: mustParseURL(t, "https://example.com/users?sort=desc&id=2")},
+			expEqual: true,
+		},
+		{
+			name:     "different paths",
+			a:        reqlog.RequestLog{Method: "GET", URL: mustParseURL(t, "https://example.com/users?id=1")},
+			b:        reqlog.RequestLog{Method: "GET", URL: mustParseURL(t, "https://example.com/groups?id=1")},
+			expEqual: false,
+		},
+		{
+			name:     "different methods",
+			a:        reqlog.RequestLog{Method: "GET", URL: mustParseURL(t, "https://example.com/users?id=1")},
+			b:        reqlog.RequestLog{Method: "DELETE", URL: mustParseURL(t, "https://example.com/users?id=1")},
+			expEqual: false,
+		},
+		{
+			name:     "different query parameter names",
+			a:        reqlog.RequestLog{Method: "GET", URL: mustParseURL(t, "https://example.com/users?id=1")},
+			b:        reqlog.RequestLog{Method: "GET", URL: mustParseURL(t, "https://example.com/users?name=1")},
+			expEqual: false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			a, b := tt.a.Signature(), tt.b.Signature()
+			if got := a == b; got != tt.expEqual {
+				t.Errorf("expected signatures equal: %v, got: %v (%q, %q)", tt.expEqual, got, a, b)
+			}
+		})
+	}
+}
+
+func TestRequestLogSignatureSearchKey(t *testing.T) {
+	t.Parallel()
+
+	searchExpr, err := search.ParseQuery(`req.signature = "GET /users?id&sort"`)
+	assertError(t, nil, err)
+
+	reqLog := reqlog.RequestLog{
+		Method: "GET",
+		URL:    mustParseURL(t, "https://example.com/users?sort=asc&id=42"),
+	}
+
+	got, err := reqLog.Matches(searchExpr)
+	assertError(t, nil, err)
+
+	if !got {
+		t.Errorf("expected match for signature %q", reqLog.Signature())
+	}
+}
+
+func mustParseURL(t *testing.T, s string) *url.URL {
+	t.Helper()
+
+	u, err := url.Parse(s)
+	if err != nil {
+		t.Fatalf("failed to parse URL: %v", err)
+	}
+
+	return u
+}
+
+func TestRequestLogMatchScope(t *testing.T) {
+	t.Parallel()
+
+	rules := []scope.Rule{
+		{URL: regexp.MustCompile("^https://example\\.com/")},
+		{Header: scope.Header{Key: regexp.MustCompile("^X-Foo$")}},
+	}
+	methodRules := []scope.Rule{
+		{Method: regexp.MustCompile("^POST$")},
+	}
+	statusCodeRules := []scope.Rule{
+		{StatusCode: scope.StatusCodeRange{Min: 500, Max: 599}},
+	}
+	urlAndMethodRules := []scope.Rule{
+		{URL: regexp.MustCompile("^https://example\\.com/"), Method: regexp.MustCompile("^POST$")},
+	}
+	excludeRules := []scope.Rule{
+		{URL: regexp.MustCompile("^https://example\\.com/")},
+		{URL: regexp.MustCompile("^https://example\\.com/logout"), Exclude: true},
+		{Header: scope.Header{Key: regexp.MustCompile("^X-Skip$")}, Exclude: true},
+		{Body: regexp.MustCompile("csrf"), Exclude: true},
+	}
+
+	tests := []struct {
+		name       string
+		mode       scope.MatchMode
+		rules      []scope.Rule
+		requestLog reqlog.RequestLog
+		expMatch   bool
+	}{
+		{
+			name:       "match any mode, one rule matches",
+			mode:       scope.MatchAny,
+			rules:      rules,
+			requestLog: reqlog.RequestLog{URL: mustParseURL(t, "https://example.com/foo")},
+			expMatch:   true,
+		},
+		{
+			name:       "match any mode, no rules match",
+			mode:       scope.MatchAny,
+			rules:      rules,
+			requestLog: reqlog.RequestLog{URL: mustParseURL(t, "https://example.org/foo")},
+			expMatch:   false,
+		},
+		{
+			name:       "match all mode, one rule matches",
+			mode:       scope.MatchAll,
+			rules:      rules,
+			requestLog: reqlog.RequestLog{URL: mustParseURL(t, "https://example.com/foo")},
+			expMatch:   false,
+		},
+		{
+			name:  "match all mode, both rules match",
+			mode:  scope.MatchAll,
+			rules: rules,
+			requestLog: reqlog.RequestLog{
+				URL:    mustParseURL(t, "https://example.com/foo"),
+				Header: http.Header{"X-Foo": []string{"bar"}},
+			},
+			expMatch: true,
+		},
+		{
+			name:       "method rule, match",
+			mode:       scope.MatchAny,
+			rules:      methodRules,
+			requestLog: reqlog.RequestLog{Method: "POST", URL: mustParseURL(t, "https://example.org/")},
+			expMatch:   true,
+		},
+		{
+			name:       "method rule, no match",
+			mode:       scope.MatchAny,
+			rules:      methodRules,
+			requestLog: reqlog.RequestLog{Method: "GET", URL: mustParseURL(t, "https://example.org/")},
+			expMatch:   false,
+		},
+		{
+			name:  "status code rule, match",
+			mode:  scope.MatchAny,
+			rules: statusCodeRules,
+			requestLog: reqlog.RequestLog{
+				Method:   "GET",
+				Response: &reqlog.ResponseLog{StatusCode: 503},
+			},
+			expMatch: true,
+		},
+		{
+			name:  "status code rule, no match",
+			mode:  scope.MatchAny,
+			rules: statusCodeRules,
+			requestLog: reqlog.RequestLog{
+				Method:   "GET",
+				Response: &reqlog.ResponseLog{StatusCode: 404},
+			},
+			expMatch: false,
+		},
+		{
+			name:       "status code rule, without response",
+			mode:       scope.MatchAny,
+			rules:      statusCodeRules,
+			requestLog: reqlog.RequestLog{Method: "GET"},
+			expMatch:   false,
+		},
+		{
+			name:  "status code rule without upper bound, match",
+			mode:  scope.MatchAny,
+			rules: []scope.Rule{{StatusCode: scope.StatusCodeRange{Min: 400}}},
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{StatusCode: 599},
+			},
+			expMatch: true,
+		},
+		{
+			name:       "port rule, explicit port matches",
+			mode:       scope.MatchAny,
+			rules:      []scope.Rule{{Port: scope.PortRange{Min: 8000, Max: 8999}}},
+			requestLog: reqlog.RequestLog{URL: mustParseURL(t, "http://example.com:8080/")},
+			expMatch:   true,
+		},
+		{
+			name:       "port rule, default port of scheme doesn't match",
+			mode:       scope.MatchAny,
+			rules:      []scope.Rule{{Port: scope.PortRange{Min: 8000, Max: 8999}}},
+			requestLog: reqlog.RequestLog{URL: mustParseURL(t, "https://example.com/")},
+			expMatch:   false,
+		},
+		{
+			name: "exclude rule with port and method",
+			mode: scope.MatchAny,
+			rules: []scope.Rule{
+				{URL: regexp.MustCompile("^https://example\\.com")},
+				{Port: scope.PortRange{Min: 8443, Max: 8443}, Method: regexp.MustCompile("^DELETE$"), Exclude: true},
+			},
+			requestLog: reqlog.RequestLog{Method: "DELETE", URL: mustParseURL(t, "https://example.com:8443/")},
+			expMatch:   false,
+		},
+		{
+			name:       "URL and method rule, both match",
+			mode:       scope.MatchAny,
+			rules:      urlAndMethodRules,
+			requestLog: reqlog.RequestLog{Method: "POST", URL: mustParseURL(t, "https://example.com/foo")},
+			expMatch:   true,
+		},
+		{
+			name:       "URL and method rule, only URL matches",
+			mode:       scope.MatchAny,
+			rules:      urlAndMethodRules,
+			requestLog: reqlog.RequestLog{Method: "GET", URL: mustParseURL(t, "https://example.com/foo")},
+			expMatch:   false,
+		},
+		{
+			name:       "URL and method rule, only method matches",
+			mode:       scope.MatchAny,
+			rules:      urlAndMethodRules,
+			requestLog: reqlog.RequestLog{Method: "POST", URL: mustParseURL(t, "https://example.org/foo")},
+			expMatch:   false,
+		},
+		{
+			name:       "include and exclude rules, included",
+			mode:       scope.MatchAny,
+			rules:      excludeRules,
+			requestLog: reqlog.RequestLog{URL: mustParseURL(t, "https://example.com/foo")},
+			expMatch:   true,
+		},
+		{
+			name:       "include and exclude rules, excluded by URL",
+			mode:       scope.MatchAny,
+			rules:      excludeRules,
+			requestLog: reqlog.RequestLog{URL: mustParseURL(t, "https://example.com/logout")},
+			expMatch:   false,
+		},
+		{
+			name:  "include and exclude rules, excluded by header",
+			mode:  scope.MatchAny,
+			rules: excludeRules,
+			requestLog: reqlog.RequestLog{
+				URL:    mustParseURL(t, "https://example.com/foo"),
+				Header: http.Header{"X-Skip": []string{"1"}},
+			},
+			expMatch: false,
+		},
+		{
+			name:  "include and exclude rules, excluded by body",
+			mode:  scope.MatchAny,
+			rules: excludeRules,
+			requestLog: reqlog.RequestLog{
+				URL:  mustParseURL(t, "https://example.com/foo"),
+				Body: []byte("csrf=foo"),
+			},
+			expMatch: false,
+		},
+		{
+			name:       "include and exclude rules, not included",
+			mode:       scope.MatchAny,
+			rules:      excludeRules,
+			requestLog: reqlog.RequestLog{URL: mustParseURL(t, "https://example.org/foo")},
+			expMatch:   false,
+		},
+		{
+			name:       "match all mode, include and exclude rules, included",
+			mode:       scope.MatchAll,
+			rules:      excludeRules,
+			requestLog: reqlog.RequestLog{URL: mustParseURL(t, "https://example.com/foo")},
+			expMatch:   true,
+		},
+		{
+			name:       "only exclude rules, not excluded",
+			mode:       scope.MatchAny,
+			rules:      excludeRules[1:],
+			requestLog: reqlog.RequestLog{URL: mustParseURL(t, "https://example.org/foo")},
+			expMatch:   true,
+		},
+		{
+			name:       "only exclude rules, excluded",
+			mode:       scope.MatchAny,
+			rules:      excludeRules[1:],
+			requestLog: reqlog.RequestLog{URL: mustParseURL(t, "https://example.com/logout")},
+			expMatch:   false,
+		},
+		{
+			name:  "exclude rule with status code, excluded",
+			mode:  scope.MatchAny,
+			rules: []scope.Rule{{StatusCode: scope.StatusCodeRange{Min: 500}, Exclude: true}},
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{StatusCode: 503},
+			},
+			expMatch: false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := &scope.Scope{}
+			s.SetRules(tt.rules)
+			s.SetMatchMode(tt.mode)
+
+			if got := tt.requestLog.MatchScope(s); tt.expMatch != got {
+				t.Errorf("expected match result: %v, got: %v", tt.expMatch, got)
+			}
+		})
+	}
+}
+
+func TestRequestLogMatchesWithConfig(t *testing.T) {
+	t.Parallel()
+
+	// A cookie that is `Secure`, but not `HttpOnly`.
+	cookieRequestLog := reqlog.RequestLog{
+		Response: &reqlog.ResponseLog{
+			Header: http.Header{"Set-Cookie": []string{"session=foo; Secure"}},
+		},
+	}
+	tokenRequestLog := reqlog.RequestLog{
+		URL: mustParseURL(t, "https://example.com/?token=s3cr3t"),
+	}
+
+	tests := []struct {
+		name          string
+		query         string
+		requestLog    reqlog.RequestLog
+		cfg           reqlog.MatchConfig
+		expectedMatch bool
+	}{
+		{
+			name:          "insecure cookie key, default config",
+			query:         "res.hasInsecureCookie = true",
+			requestLog:    cookieRequestLog,
+			cfg:           reqlog.DefaultMatchConfig(),
+			expectedMatch: true,
+		},
+		{
+			name:       "insecure cookie key, config that doesn't require HttpOnly",
+			query:      "res.hasInsecureCookie = true",
+			requestLog: cookieRequestLog,
+			cfg: reqlog.MatchConfig{
+				SecureCookieAttrs: reqlog.CookieAttrs{Secure: true},
+			},
+			expectedMatch: false,
+		},
+		{
+			name:          "API key key, default config",
+			query:         "req.hasApiKey = true",
+			requestLog:    tokenRequestLog,
+			cfg:           reqlog.DefaultMatchConfig(),
+			expectedMatch: false,
+		},
+		{
+			name:       "API key key, config with custom query param",
+			query:      "req.hasApiKey = true",
+			requestLog: tokenRequestLog,
+			cfg: reqlog.MatchConfig{
+				APIKeyLocations: reqlog.APIKeyLocations{QueryParams: []string{"token"}},
+			},
+			expectedMatch: true,
+		},
+		{
+			name:          "tracker key, default config",
+			query:         "req.isTracker = true",
+			requestLog:    reqlog.RequestLog{URL: mustParseURL(t, "https://metrics.example.com/v1/events")},
+			cfg:           reqlog.DefaultMatchConfig(),
+			expectedMatch: false,
+		},
+		{
+			name:       "tracker key, config with custom host",
+			query:      "req.isTracker = true",
+			requestLog: reqlog.RequestLog{URL: mustParseURL(t, "https://metrics.example.com/v1/events")},
+			cfg: reqlog.MatchConfig{
+				Trackers: reqlog.Trackers{Hosts: []string{"metrics.example.com"}},
+			},
+			expectedMatch: true,
+		},
+		{
+			name:  "CSRF candidate key, config with custom token pattern",
+			query: "req.csrfCandidate = true",
+			requestLog: reqlog.RequestLog{
+				Method: http.MethodPost,
+				URL:    mustParseURL(t, "https://example.com/?nonce=foo"),
+				Header: http.Header{"Cookie": []string{"session=foo"}},
+			},
+			cfg: reqlog.MatchConfig{
+				CSRFTokenPatterns: []*regexp.Regexp{regexp.MustCompile("^nonce$")},
+			},
+			expectedMatch: false,
+		},
+		{
+			name:          "private IP key, private IPv4 host",
+			query:         "req.targetsPrivateIP = true",
+			requestLog:    reqlog.RequestLog{URL: mustParseURL(t, "http://10.0.0.1:8080/admin")},
+			cfg:           reqlog.DefaultMatchConfig(),
+			expectedMatch: true,
+		},
+		{
+			name:          "private IP key, loopback IPv6 host",
+			query:         "req.targetsPrivateIP = true",
+			requestLog:    reqlog.RequestLog{URL: mustParseURL(t, "http://[::1]/")},
+			cfg:           reqlog.DefaultMatchConfig(),
+			expectedMatch: true,
+		},
+		{
+			name:          "private IP key, link-local host",
+			query:         "req.targetsPrivateIP = true",
+			requestLog:    reqlog.RequestLog{URL: mustParseURL(t, "http://169.254.169.254/latest/meta-data/")},
+			cfg:           reqlog.DefaultMatchConfig(),
+			expectedMatch: true,
+		},
+		{
+			name:          "private IP key, public IP host",
+			query:         "req.targetsPrivateIP = true",
+			requestLog:    reqlog.RequestLog{URL: mustParseURL(t, "https://93.184.216.34/")},
+			cfg:           reqlog.DefaultMatchConfig(),
+			expectedMatch: false,
+		},
+		{
+			name:          "private IP key, host name isn't resolved by default",
+			query:         "req.targetsPrivateIP = true",
+			requestLog:    reqlog.RequestLog{URL: mustParseURL(t, "http://localhost/")},
+			cfg:           reqlog.DefaultMatchConfig(),
+			expectedMatch: false,
+		},
+		{
+			name:       "private IP key, host name resolves to private IP",
+			query:      "req.targetsPrivateIP = true",
+			requestLog: reqlog.RequestLog{URL: mustParseURL(t, "http://intranet.example.com/")},
+			cfg: reqlog.MatchConfig{
+				LookupIP: func(host string) ([]net.IP, error) {
+					return []net.IP{net.ParseIP("93.184.216.34"), net.ParseIP("192.168.1.10")}, nil
+				},
+			},
+			expectedMatch: true,
+		},
+		{
+			name:       "private IP key, host name resolves to public IP",
+			query:      "req.targetsPrivateIP = true",
+			requestLog: reqlog.RequestLog{URL: mustParseURL(t, "https://example.com/")},
+			cfg: reqlog.MatchConfig{
+				LookupIP: func(host string) ([]net.IP, error) {
+					return []net.IP{net.ParseIP("93.184.216.34")}, nil
+				},
+			},
+			expectedMatch: false,
+		},
+		{
+			name:  "free text, case-insensitive by default",
+			query: "invalid token",
+			requestLog: reqlog.RequestLog{
+				URL:      mustParseURL(t, "https://example.com/"),
+				Response: &reqlog.ResponseLog{Body: []byte(`{"error": "Invalid Token"}`)},
+			},
+			cfg:           reqlog.DefaultMatchConfig(),
+			expectedMatch: true,
+		},
+		{
+			name:  "free text, case-sensitive",
+			query: "invalid token",
+			requestLog: reqlog.RequestLog{
+				URL:      mustParseURL(t, "https://example.com/"),
+				Response: &reqlog.ResponseLog{Body: []byte(`{"error": "Invalid Token"}`)},
+			},
+			cfg:           reqlog.MatchConfig{CaseSensitive: true},
+			expectedMatch: false,
+		},
+		{
+			name:  "free text, case-sensitive with matching case",
+			query: "Invalid Token",
+			requestLog: reqlog.RequestLog{
+				URL:      mustParseURL(t, "https://example.com/"),
+				Response: &reqlog.ResponseLog{Body: []byte(`{"error": "Invalid Token"}`)},
+			},
+			cfg:           reqlog.MatchConfig{CaseSensitive: true},
+			expectedMatch: true,
+		},
+		{
+			name:  "free text in header value, case-sensitive",
+			query: "bearer",
+			requestLog: reqlog.RequestLog{
+				URL:    mustParseURL(t, "https://example.com/"),
+				Header: http.Header{"Authorization": []string{"Bearer foobar"}},
+			},
+			cfg:           reqlog.MatchConfig{CaseSensitive: true},
+			expectedMatch: false,
+		},
+		{
+			name:  "equal operator, case-insensitive by default",
+			query: `res.body = "ok"`,
+			requestLog: reqlog.RequestLog{
+				URL:      mustParseURL(t, "https://example.com/"),
+				Response: &reqlog.ResponseLog{Body: []byte("OK")},
+			},
+			cfg:           reqlog.DefaultMatchConfig(),
+			expectedMatch: true,
+		},
+		{
+			name:  "equal operator, case-sensitive",
+			query: `res.body = "ok"`,
+			requestLog: reqlog.RequestLog{
+				URL:      mustParseURL(t, "https://example.com/"),
+				Response: &reqlog.ResponseLog{Body: []byte("OK")},
+			},
+			cfg:           reqlog.MatchConfig{CaseSensitive: true},
+			expectedMatch: false,
+		},
+		{
+			name:  "not equal operator, case-insensitive by default",
+			query: `res.body != "ok"`,
+			requestLog: reqlog.RequestLog{
+				URL:      mustParseURL(t, "https://example.com/"),
+				Response: &reqlog.ResponseLog{Body: []byte("OK")},
+			},
+			cfg:           reqlog.DefaultMatchConfig(),
+			expectedMatch: false,
+		},
+		{
+			name:  "not equal operator, case-sensitive",
+			query: `res.body != "ok"`,
+			requestLog: reqlog.RequestLog{
+				URL:      mustParseURL(t, "https://example.com/"),
+				Response: &reqlog.ResponseLog{Body: []byte("OK")},
+			},
+			cfg:           reqlog.MatchConfig{CaseSensitive: true},
+			expectedMatch: true,
+		},
+		{
+			name:  "error page key, config without signatures",
+			query: "res.isErrorPage = true",
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{
+					Body: []byte("Traceback (most recent call last):"),
+				},
+			},
+			cfg:           reqlog.MatchConfig{},
+			expectedMatch: false,
+		},
+		{
+			name:  "detected language key, config with custom signature",
+			query: "res.detectedLang = go",
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{
+					Body: []byte("goroutine 1 [running]:\nmain.main()"),
+				},
+			},
+			cfg: reqlog.MatchConfig{
+				LangSignatures: []reqlog.LangSignature{
+					{Lang: "go", BodyPattern: regexp.MustCompile(`goroutine \d+ \[running\]`)},
+				},
+			},
+			expectedMatch: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			searchExpr, err := search.ParseQuery(tt.query)
+			assertError(t, nil, err)
+
+			got, err := tt.requestLog.MatchesWithConfig(searchExpr, tt.cfg)
+			assertError(t, nil, err)
+
+			if tt.expectedMatch != got {
+				t.Errorf("expected match result: %v, got: %v", tt.expectedMatch, got)
+			}
+		})
+	}
+}
+
+func TestRequestLogMatchesAfterLogin(t *testing.T) {
+	t.Parallel()
+
+	loginTime := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	loginID := ulid.MustNew(ulid.Timestamp(loginTime), nil)
+	beforeID := ulid.MustNew(ulid.Timestamp(loginTime.Add(-time.Minute)), nil)
+	afterID := ulid.MustNew(ulid.Timestamp(loginTime.Add(time.Minute)), nil)
+
+	tests := []struct {
+		name          string
+		query         string
+		id            ulid.ULID
+		boundary      ulid.ULID
+		expectedMatch bool
+	}{
+		{
+			name:          "request after boundary",
+			query:         "req.afterLogin = true",
+			id:            afterID,
+			boundary:      loginID,
+			expectedMatch: true,
+		},
+		{
+			name:          "request before boundary",
+			query:         "req.afterLogin = true",
+			id:            beforeID,
+			boundary:      loginID,
+			expectedMatch: false,
+		},
+		{
+			name:          "request before boundary, negated",
+			query:         "req.afterLogin = false",
+			id:            beforeID,
+			boundary:      loginID,
+			expectedMatch: true,
+		},
+		{
+			name:          "login request itself",
+			query:         "req.afterLogin = true",
+			id:            loginID,
+			boundary:      loginID,
+			expectedMatch: false,
+		},
+		{
+			name:          "no boundary set",
+			query:         `req.afterLogin = ""`,
+			id:            afterID,
+			expectedMatch: true,
+		},
+		{
+			name:          "no boundary set, request isn't after login",
+			query:         "req.afterLogin = true",
+			id:            afterID,
+			expectedMatch: false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			searchExpr, err := search.ParseQuery(tt.query)
+			assertError(t, nil, err)
+
+			cfg := reqlog.DefaultMatchConfig()
+			cfg.LoginBoundary = tt.boundary
+
+			got, err := reqlog.RequestLog{ID: tt.id}.MatchesWithConfig(searchExpr, cfg)
+			assertError(t, nil, err)
+
+			if tt.expectedMatch != got {
+				t.Errorf("expected match result: %v, got: %v", tt.expectedMatch, got)
+			}
+		})
+	}
+}
+
+func TestRequestLogFreeTextMatchKey(t *testing.T) {
+	t.Parallel()
+
+	// The term is present in the URL, a request header, the request body,
+	// a response header and the response body.
+	reqLog := reqlog.RequestLog{
+		Method: "POST",
+		URL:    mustParseURL(t, "https://example.com/token"),
+		Header: http.Header{
+			"X-Trace":  []string{"token-1"},
+			"X-Client": []string{"token-2"},
+		},
+		Body: []byte("grant_type=token"),
+		Response: &reqlog.ResponseLog{
+			Header: http.Header{"X-Token": []string{"token-3"}},
+			Body:   []byte(`{"token": "foo"}`),
+		},
+	}
+
+	tests := []struct {
+		name         string
+		term         string
+		freeTextKeys []string
+		expected     string
+	}{
+		{
+			name:     "default order, URL first",
+			term:     "token",
+			expected: "req.url",
+		},
+		{
+			name:         "headers in order of name",
+			term:         "token",
+			freeTextKeys: []string{"req.headers.", "req.body"},
+			expected:     "req.headers.X-Client",
+		},
+		{
+			name:         "configured order",
+			term:         "token",
+			freeTextKeys: []string{"res.body", "req.url"},
+			expected:     "res.body",
+		},
+		{
+			name:         "configured keys limit the search",
+			term:         "token",
+			freeTextKeys: []string{"req.method", "res.statusReason"},
+			expected:     "",
+		},
+		{
+			name:         "unknown and computed keys are ignored",
+			term:         "token",
+			freeTextKeys: []string{"req.foo", "res.bodyLength", "res.headers."},
+			expected:     "res.headers.X-Token",
+		},
+		{
+			name:     "no match",
+			term:     "bar",
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := reqlog.DefaultMatchConfig()
+			cfg.FreeTextKeys = tt.freeTextKeys
+
+			// Repeated, because map iteration order is random.
+			for i := 0; i < 10; i++ {
+				if got := reqLog.FreeTextMatchKey(tt.term, cfg); got != tt.expected {
+					t.Fatalf("expected match key: %q, got: %q", tt.expected, got)
+				}
+			}
+		})
+	}
+}
+
+func TestResponseLogMatches(t *testing.T) {
+	t.Parallel()
+
+	resLog := reqlog.ResponseLog{
+		Proto:      "HTTP/1.1",
+		StatusCode: 404,
+		Status:     "404 Not Found",
+		Header: http.Header{
+			"Content-Type":     []string{"text/plain"},
+			"Content-Encoding": []string{"gzip"},
+		},
+		Body: gzipBytes(t, "page does not exist"),
+	}
+
+	tests := []struct {
+		name          string
+		query         string
+		expectedMatch bool
+	}{
+		{
+			name:          "status code",
+			query:         "res.statusCode = 404",
+			expectedMatch: true,
+		},
+		{
+			name:          "status code comparison",
+			query:         "res.statusCode >= 500",
+			expectedMatch: false,
+		},
+		{
+			name:          "header key",
+			query:         `res.headers.content-type =~ "^text/"`,
+			expectedMatch: true,
+		},
+		{
+			name:          "computed key",
+			query:         "res.bodyLength = 19",
+			expectedMatch: true,
+		},
+		{
+			name:          "request key resolves to empty string",
+			query:         `req.method = ""`,
+			expectedMatch: true,
+		},
+		{
+			name:          "request key doesn't match a value",
+			query:         "req.method = GET",
+			expectedMatch: false,
+		},
+		{
+			name:          "key that depends on the request resolves to empty string",
+			query:         `res.contentTypeMismatch = ""`,
+			expectedMatch: true,
+		},
+		{
+			name:          "free text in decoded body",
+			query:         "does not exist",
+			expectedMatch: true,
+		},
+		{
+			name:          "free text in header value",
+			query:         "text/plain",
+			expectedMatch: true,
+		},
+		{
+			name:          "logical operators",
+			query:         `res.statusCode = 404 AND NOT (res.proto = "HTTP/2.0" OR foobar)`,
+			expectedMatch: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			searchExpr, err := search.ParseQuery(tt.query)
+			assertError(t, nil, err)
+
+			got, err := resLog.Matches(searchExpr)
+			assertError(t, nil, err)
+
+			if tt.expectedMatch != got {
+				t.Errorf("expected match result: %v, got: %v", tt.expectedMatch, got)
+			}
+		})
+	}
+}
+
+func TestRequestLogMatchesWithFields(t *testing.T) {
+	t.Parallel()
+
+	reqLog := reqlog.RequestLog{
+		Method: "POST",
+		URL:    mustParseURL(t, "https://example.com/api"),
+		Header: http.Header{"Content-Type": []string{"application/json"}},
+		Body:   []byte(`{"user": "admin"}`),
+		Response: &reqlog.ResponseLog{
+			StatusCode: 200,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       []byte(`{"user": "admin", "role": "superuser"}`),
+		},
+	}
+
+	tests := []struct {
+		name           string
+		query          string
+		expectedMatch  bool
+		expectedFields []string
+	}{
+		{
+			name:           "term in request and response body",
+			query:          "admin",
+			expectedMatch:  true,
+			expectedFields: []string{"req.body", "res.body"},
+		},
+		{
+			name:           "term in headers",
+			query:          "json",
+			expectedMatch:  true,
+			expectedFields: []string{"req.headers.Content-Type", "res.headers.Content-Type"},
+		},
+		{
+			name:           "multiple terms",
+			query:          "superuser AND admin",
+			expectedMatch:  true,
+			expectedFields: []string{"res.body", "req.body"},
+		},
+		{
+			name:           "key comparison doesn't report fields",
+			query:          "res.statusCode = 200",
+			expectedMatch:  true,
+			expectedFields: nil,
+		},
+		{
+			name:           "no match",
+			query:          "admin AND foobar",
+			expectedMatch:  false,
+			expectedFields: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			searchExpr, err := search.ParseQuery(tt.query)
+			assertError(t, nil, err)
+
+			// Repeated, because map iteration order is random.
+			for i := 0; i < 10; i++ {
+				got, fields, err := reqLog.MatchesWithFields(searchExpr, reqlog.DefaultMatchConfig())
+				assertError(t, nil, err)
+
+				if tt.expectedMatch != got {
+					t.Fatalf("expected match result: %v, got: %v", tt.expectedMatch, got)
+				}
+
+				if diff := cmp.Diff(tt.expectedFields, fields); diff != "" {
+					t.Fatalf("fields not equal (-exp, +got):\n%v", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestRequestLogEvalCall(t *testing.T) {
+	t.Parallel()
+
+	reqLog := reqlog.RequestLog{
+		URL:  mustParseURL(t, "https://example.com/users?id=42"),
+		Body: []byte(`{"token": "s3cr3t"}`),
+	}
+
+	tests := []struct {
+		name     string
+		query    string
+		expected string
+	}{
+		{
+			name:     "capture group",
+			query:    `extract(req.url, /id=(\d+)/)`,
+			expected: "42",
+		},
+		{
+			name:     "without capture group, whole match",
+			query:    `extract(req.body, /s3cr3t/)`,
+			expected: "s3cr3t",
+		},
+		{
+			name:     "failed capture",
+			query:    `extract(req.url, /page=(\d+)/)`,
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			searchExpr, err := search.ParseQuery(tt.query)
+			assertError(t, nil, err)
+
+			call, ok := searchExpr.(search.CallExpression)
+			if !ok {
+				t.Fatalf("expected call expression, got: %T", searchExpr)
+			}
+
+			got, err := reqLog.EvalCall(call, reqlog.DefaultMatchConfig())
+			assertError(t, nil, err)
+
+			if got != tt.expected {
+				t.Errorf("expected value: %q, got: %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func gzipBytes(t *testing.T, s string) []byte {
+	t.Helper()
+
+	buf := &bytes.Buffer{}
+	gw := gzip.NewWriter(buf)
+
+	if _, err := gw.Write([]byte(s)); err != nil {
+		t.Fatalf("unexpected error writing gzip data: %v", err)
+	}
+
+	if err := gw.Close(); err != nil {
+		t.Fatalf("unexpected error closing gzip writer: %v", err)
+	}
+
+	return buf.Bytes()
+}
+
+func zlibBytes(t *testing.T, s string) []byte {
+	t.Helper()
+
+	buf := &bytes.Buffer{}
+	zw := zlib.NewWriter(buf)
+
+	if _, err := zw.Write([]byte(s)); err != nil {
+		t.Fatalf("unexpected error writing zlib data: %v", err)
+	}
+
+	if err := zw.Close(); err != nil {
+		t.Fatalf("unexpected error closing zlib writer: %v", err)
+	}
+
+	return buf.Bytes()
+}
+
+func flateBytes(t *testing.T, s string) []byte {
+	t.Helper()
+
+	buf := &bytes.Buffer{}
+
+	fw, err := flate.NewWriter(buf, flate.DefaultCompression)
+	if err != nil {
+		t.Fatalf("unexpected error creating flate writer: %v", err)
+	}
+
+	if _, err := fw.Write([]byte(s)); err != nil {
+		t.Fatalf("unexpected error writing flate data: %v", err)
+	}
+
+	if err := fw.Close(); err != nil {
+		t.Fatalf("unexpected error closing flate writer: %v", err)
+	}
+
+	return buf.Bytes()
+}
+
+func brotliBytes(t *testing.T, s string) []byte {
+	t.Helper()
+
+	buf := &bytes.Buffer{}
+	bw := brotli.NewWriter(buf)
+
+	if _, err := bw.Write([]byte(s)); err != nil {
+		t.Fatalf("unexpected error writing brotli data: %v", err)
+	}
+
+	if err := bw.Close(); err != nil {
+		t.Fatalf("unexpected error closing brotli writer: %v", err)
+	}
+
+	return buf.Bytes()
 }
@@ -0,0 +1,65 @@
+package reqlog
+
+import "sync"
+
+// defaultSearchHistorySize is the number of search expressions kept when
+// `Config.SearchHistorySize` isn't set.
+const defaultSearchHistorySize = 50
+
+// searchHistory holds the most recently executed search expressions, in memory.
+type searchHistory struct {
+	mu      sync.Mutex
+	queries []string
+	size    int
+}
+
+func (h *searchHistory) record(query string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	// Consecutive identical queries are recorded once.
+	if n := len(h.queries); n > 0 && h.queries[n-1] == query {
+		return
+	}
+
+	h.queries = append(h.queries, query)
+
+	if len(h.queries) > h.size {
+		h.queries = h.queries[len(h.queries)-h.size:]
+	}
+}
+
+func (h *searchHistory) recent(n int) []string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if n > len(h.queries) {
+		n = len(h.queries)
+	}
+
+	recent := make([]string, 0, n)
+	for i := len(h.queries) - 1; i >= len(h.queries)-n; i-- {
+		recent = append(recent, h.queries[i])
+	}
+
+	return recent
+}
+
+// RecordSearch adds a search expression to the search history.
+func (svc *Service) RecordSearch(query string) {
+	if query == "" {
+		return
+	}
+
+	svc.searchHistory.record(query)
+}
+
+// RecentSearches returns up to n of the most recently recorded search
+// expressions, most recent first.
+func (svc *Service) RecentSearches(n int) []string {
+	if n <= 0 {
+		return []string{}
+	}
+
+	return svc.searchHistory.recent(n)
+}
@@ -0,0 +1,52 @@
+package reqlog_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+func TestRecentSearches(t *testing.T) {
+	t.Parallel()
+
+	svc := reqlog.NewService(reqlog.Config{SearchHistorySize: 3})
+
+	for _, query := range []string{"foo", "bar", "bar", "baz", "foo", "foo", "qux"} {
+		svc.RecordSearch(query)
+	}
+
+	tests := []struct {
+		name string
+		n    int
+		exp  []string
+	}{
+		{
+			name: "most recent first, consecutive duplicates recorded once",
+			n:    2,
+			exp:  []string{"qux", "foo"},
+		},
+		{
+			name: "limited to history size",
+			n:    10,
+			exp:  []string{"qux", "foo", "baz"},
+		},
+		{
+			name: "zero",
+			n:    0,
+			exp:  []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if diff := cmp.Diff(tt.exp, svc.RecentSearches(tt.n)); diff != "" {
+				t.Fatalf("recent searches not equal (-exp, +got):\n%v", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package reqlog
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// semverRegexp matches a semantic version (see: https://semver.org), with an
+// optional `v` prefix.
+var semverRegexp = regexp.MustCompile(`^v?(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)` +
+	`(?:-([0-9A-Za-z-]+(?:\.[0-9A-Za-z-]+)*))?(?:\+[0-9A-Za-z-]+(?:\.[0-9A-Za-z-]+)*)?$`)
+
+type semver struct {
+	core       [3]int
+	prerelease []string
+}
+
+func parseSemver(s string) (semver, bool) {
+	match := semverRegexp.FindStringSubmatch(s)
+	if match == nil {
+		return semver{}, false
+	}
+
+	var v semver
+
+	for i := range v.core {
+		n, err := strconv.Atoi(match[i+1])
+		if err != nil {
+			return semver{}, false
+		}
+
+		v.core[i] = n
+	}
+
+	if match[4] != "" {
+		v.prerelease = strings.Split(match[4], ".")
+	}
+
+	return v, true
+}
+
+// compareSemver compares two semantic versions by precedence. Build metadata is
+// ignored. It returns -1 if a < b, 0 if a == b and 1 if a > b.
+func compareSemver(a, b semver) int {
+	for i := range a.core {
+		if c := compareInts(a.core[i], b.core[i]); c != 0 {
+			return c
+		}
+	}
+
+	// A pre-release version has lower precedence than the normal version.
+	switch {
+	case len(a.prerelease) == 0 && len(b.prerelease) == 0:
+		return 0
+	case len(a.prerelease) == 0:
+		return 1
+	case len(b.prerelease) == 0:
+		return -1
+	}
+
+	for i := 0; i < len(a.prerelease) && i < len(b.prerelease); i++ {
+		if c := comparePrereleaseIdentifiers(a.prerelease[i], b.prerelease[i]); c != 0 {
+			return c
+		}
+	}
+
+	return compareInts(len(a.prerelease), len(b.prerelease))
+}
+
+// comparePrereleaseIdentifiers compares numeric identifiers numerically, and
+// others lexically. Numeric identifiers have lower precedence than others.
+func comparePrereleaseIdentifiers(a, b string) int {
+	aNum, aErr := strconv.Atoi(a)
+	bNum, bErr := strconv.Atoi(b)
+
+	switch {
+	case aErr == nil && bErr == nil:
+		return compareInts(aNum, bNum)
+	case aErr == nil:
+		return -1
+	case bErr == nil:
+		return 1
+	default:
+		return strings.Compare(a, b)
+	}
+}
+
+func compareInts(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
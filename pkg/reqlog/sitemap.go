@@ -0,0 +1,320 @@
+package reqlog
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/oklog/ulid"
+)
+
+// SiteMapNode is a host, or a path below a host, in the site map of a project.
+type SiteMapNode struct {
+	// Host is the host of the node, including the port, if any.
+	Host string
+	// Path is the URL path of the node, e.g. `/api/users`. It's `/` for hosts.
+	// Paths with and without trailing slash are the same node.
+	Path string
+	// Hits is the number of requests to Path or paths below it.
+	Hits int
+	// Statuses counts the responses per status code, for requests to Path or
+	// paths below it.
+	Statuses map[int]int
+	// Methods summarize the requests to exactly Path, by method. They're sorted
+	// by method.
+	Methods []SiteMapMethod
+	// ChildCount is the number of nodes directly below the node.
+	ChildCount int
+}
+
+// SiteMapMethod summarizes the requests with a method to a path.
+type SiteMapMethod struct {
+	Method   string
+	Hits     int
+	Statuses map[int]int
+}
+
+// siteMapKey identifies the node and method a request log is counted for, from
+// its URL as requested by the client. The query is ignored.
+type siteMapKey struct {
+	host     string
+	segments []string
+	method   string
+}
+
+func newSiteMapKey(reqLog RequestLog) (siteMapKey, bool) {
+	// Logs of failed TLS handshakes only hold the CONNECT request, and aren't
+	// requests to a path of the host.
+	if reqLog.URL == nil || reqLog.TLSError != "" {
+		return siteMapKey{}, false
+	}
+
+	return siteMapKey{
+		host:     reqLog.URL.Host,
+		segments: splitPath(reqLog.URL.Path),
+		method:   reqLog.Method,
+	}, true
+}
+
+// splitPath returns the non-empty segments of a URL path.
+func splitPath(path string) []string {
+	var segments []string
+
+	for _, segment := range strings.Split(path, "/") {
+		if segment != "" {
+			segments = append(segments, segment)
+		}
+	}
+
+	return segments
+}
+
+type siteMapNode struct {
+	hits     int
+	statuses map[int]int
+	methods  map[string]*SiteMapMethod
+	children map[string]*siteMapNode
+}
+
+func newSiteMapNode() *siteMapNode {
+	return &siteMapNode{
+		statuses: make(map[int]int),
+		methods:  make(map[string]*SiteMapMethod),
+		children: make(map[string]*siteMapNode),
+	}
+}
+
+// siteMap is the site map of a single project, which is kept in memory. It's
+// built from the stored request logs when it's first needed, and updated as
+// requests and responses are logged.
+type siteMap struct {
+	mu        sync.Mutex
+	projectID ulid.ULID
+	loaded    bool
+	hosts     map[string]*siteMapNode
+}
+
+// addRequest counts a logged request. Requests of a project other than the
+// loaded one are ignored; they're counted when the site map of their project is
+// built.
+func (sm *siteMap) addRequest(projectID ulid.ULID, key siteMapKey) {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+
+	if sm.loaded && sm.projectID.Compare(projectID) == 0 {
+		sm.addLocked(key, true, 0)
+	}
+}
+
+// addResponse counts the status code of a logged response, for a request that
+// was counted before.
+func (sm *siteMap) addResponse(projectID ulid.ULID, key siteMapKey, statusCode int) {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+
+	if sm.loaded && sm.projectID.Compare(projectID) == 0 {
+		sm.addLocked(key, false, statusCode)
+	}
+}
+
+// addLocked counts a request for key if hit is true, and a status code if it's
+// not zero. The caller must hold the lock.
+func (sm *siteMap) addLocked(key siteMapKey, hit bool, statusCode int) {
+	node, ok := sm.hosts[key.host]
+	if !ok {
+		node = newSiteMapNode()
+		sm.hosts[key.host] = node
+	}
+
+	count := func(node *siteMapNode) {
+		if hit {
+			node.hits++
+		}
+
+		if statusCode != 0 {
+			node.statuses[statusCode]++
+		}
+	}
+
+	count(node)
+
+	for _, segment := range key.segments {
+		child, ok := node.children[segment]
+		if !ok {
+			child = newSiteMapNode()
+			node.children[segment] = child
+		}
+
+		node = child
+		count(node)
+	}
+
+	method, ok := node.methods[key.method]
+	if !ok {
+		method = &SiteMapMethod{Method: key.method, Statuses: make(map[int]int)}
+		node.methods[key.method] = method
+	}
+
+	if hit {
+		method.Hits++
+	}
+
+	if statusCode != 0 {
+		method.Statuses[statusCode]++
+	}
+}
+
+// reset discards the site map, so it's built again when it's next needed.
+func (sm *siteMap) reset() {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+
+	sm.loaded = false
+	sm.hosts = nil
+}
+
+// SiteMapHosts returns the hosts of the site map of the active project, sorted.
+func (svc *Service) SiteMapHosts(ctx context.Context) ([]SiteMapNode, error) {
+	return svc.SiteMapChildren(ctx, "", "")
+}
+
+// SiteMapChildren returns the nodes directly below a path of a host in the site
+// map of the active project, sorted by path. If host is empty, the hosts are
+// returned. Unknown hosts or paths have no children.
+func (svc *Service) SiteMapChildren(ctx context.Context, host, path string) ([]SiteMapNode, error) {
+	if err := svc.loadSiteMap(ctx); err != nil {
+		return nil, err
+	}
+
+	sm := svc.siteMap
+
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+
+	if host == "" {
+		nodes := make([]SiteMapNode, 0, len(sm.hosts))
+		for h, node := range sm.hosts {
+			nodes = append(nodes, node.export(h, "/"))
+		}
+
+		sort.Slice(nodes, func(i, j int) bool { return nodes[i].Host < nodes[j].Host })
+
+		return nodes, nil
+	}
+
+	node, ok := sm.hosts[host]
+	segments := splitPath(path)
+
+	for _, segment := range segments {
+		if !ok {
+			break
+		}
+
+		node, ok = node.children[segment]
+	}
+
+	if !ok {
+		return []SiteMapNode{}, nil
+	}
+
+	nodes := make([]SiteMapNode, 0, len(node.children))
+	for segment, child := range node.children {
+		nodes = append(nodes, child.export(host, "/"+strings.Join(append(segments, segment), "/")))
+	}
+
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].Path < nodes[j].Path })
+
+	return nodes, nil
+}
+
+// loadSiteMap builds the site map of the active project from its stored
+// request logs, unless it's already built.
+func (svc *Service) loadSiteMap(ctx context.Context) error {
+	projectID := svc.ActiveProjectID
+	if projectID.Compare(ulid.ULID{}) == 0 {
+		return ErrProjectIDMustBeSet
+	}
+
+	sm := svc.siteMap
+
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+
+	if sm.loaded && sm.projectID.Compare(projectID) == 0 {
+		return nil
+	}
+
+	reqLogs, err := svc.repo.FindRequestLogs(ctx, FindRequestsFilter{ProjectID: projectID}, nil)
+	if err != nil {
+		return fmt.Errorf("reqlog: could not find requests: %w", err)
+	}
+
+	sm.projectID = projectID
+	sm.hosts = make(map[string]*siteMapNode)
+
+	for _, reqLog := range reqLogs {
+		if key, ok := newSiteMapKey(reqLog); ok {
+			sm.addLocked(key, true, statusCode(reqLog))
+		}
+	}
+
+	sm.loaded = true
+
+	return nil
+}
+
+func (node *siteMapNode) export(host, path string) SiteMapNode {
+	exported := SiteMapNode{
+		Host:       host,
+		Path:       path,
+		Hits:       node.hits,
+		Statuses:   copyStatuses(node.statuses),
+		Methods:    make([]SiteMapMethod, 0, len(node.methods)),
+		ChildCount: len(node.children),
+	}
+
+	for _, method := range node.methods {
+		exported.Methods = append(exported.Methods, SiteMapMethod{
+			Method:   method.Method,
+			Hits:     method.Hits,
+			Statuses: copyStatuses(method.Statuses),
+		})
+	}
+
+	sort.Slice(exported.Methods, func(i, j int) bool { return exported.Methods[i].Method < exported.Methods[j].Method })
+
+	return exported
+}
+
+func copyStatuses(statuses map[int]int) map[int]int {
+	c := make(map[int]int, len(statuses))
+	for status, count := range statuses {
+		c[status] = count
+	}
+
+	return c
+}
+
+func statusCode(reqLog RequestLog) int {
+	if reqLog.Response == nil {
+		return 0
+	}
+
+	return reqLog.Response.StatusCode
+}
+
+// addSiteMapResponse counts the status code of a stored response in the site
+// map, using the key that was stored in the context of its request when the
+// request was logged.
+func (svc *Service) addSiteMapResponse(projectID ulid.ULID, res *http.Response) {
+	if res.Request == nil {
+		return
+	}
+
+	if key, ok := res.Request.Context().Value(siteMapKeyKey).(siteMapKey); ok {
+		svc.siteMap.addResponse(projectID, key, res.StatusCode)
+	}
+}
@@ -0,0 +1,216 @@
+package reqlog_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/oklog/ulid"
+
+	"github.com/dstotijn/hetty/pkg/reqlog"
+	"github.com/dstotijn/hetty/pkg/scope"
+)
+
+func TestSiteMap(t *testing.T) {
+	t.Parallel()
+
+	reqLogs := []reqlog.RequestLog{
+		{
+			Method:   http.MethodGet,
+			URL:      mustParseURL(t, "https://example.com/"),
+			Response: &reqlog.ResponseLog{StatusCode: 200},
+		},
+		{
+			Method:   http.MethodGet,
+			URL:      mustParseURL(t, "https://example.com/api/users?page=2"),
+			Response: &reqlog.ResponseLog{StatusCode: 200},
+		},
+		{
+			Method:   http.MethodPost,
+			URL:      mustParseURL(t, "https://example.com/api/users/"),
+			Response: &reqlog.ResponseLog{StatusCode: 403},
+		},
+		{
+			// In-flight requests count as hits, without status.
+			Method: http.MethodDelete,
+			URL:    mustParseURL(t, "https://example.com/api/users/42"),
+		},
+		{
+			// Failed TLS handshakes aren't part of the site map.
+			Method:   http.MethodConnect,
+			URL:      mustParseURL(t, "https://pinned.example.com"),
+			TLSError: "remote error: tls: bad certificate",
+		},
+		{
+			Method:   http.MethodGet,
+			URL:      mustParseURL(t, "http://api.example.org:8080/health"),
+			Response: &reqlog.ResponseLog{StatusCode: 500},
+		},
+	}
+
+	var finds int32
+
+	repoMock := &RepoMock{
+		FindRequestLogsFunc: func(_ context.Context, _ reqlog.FindRequestsFilter, _ *scope.Scope) ([]reqlog.RequestLog, error) {
+			atomic.AddInt32(&finds, 1)
+			return reqLogs, nil
+		},
+		StoreRequestLogFunc: func(context.Context, reqlog.RequestLog) error {
+			return nil
+		},
+		StoreResponseLogFunc: func(context.Context, ulid.ULID, reqlog.ResponseLog) error {
+			return nil
+		},
+		ClearRequestLogsFunc: func(context.Context, ulid.ULID) error {
+			return nil
+		},
+	}
+	svc := reqlog.NewService(reqlog.Config{
+		Repository: repoMock,
+		Scope:      &scope.Scope{},
+	})
+	svc.ActiveProjectID = ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+
+	t.Run("hosts", func(t *testing.T) {
+		got, err := svc.SiteMapHosts(context.Background())
+		if err != nil {
+			t.Fatalf("unexpected error (expected: nil, got: %v)", err)
+		}
+
+		exp := []reqlog.SiteMapNode{
+			{
+				Host:       "api.example.org:8080",
+				Path:       "/",
+				Hits:       1,
+				Statuses:   map[int]int{500: 1},
+				Methods:    []reqlog.SiteMapMethod{},
+				ChildCount: 1,
+			},
+			{
+				Host:     "example.com",
+				Path:     "/",
+				Hits:     4,
+				Statuses: map[int]int{200: 2, 403: 1},
+				Methods: []reqlog.SiteMapMethod{
+					{Method: http.MethodGet, Hits: 1, Statuses: map[int]int{200: 1}},
+				},
+				ChildCount: 1,
+			},
+		}
+		if diff := cmp.Diff(exp, got); diff != "" {
+			t.Fatalf("site map hosts not equal (-exp, +got):\n%v", diff)
+		}
+	})
+
+	t.Run("paths", func(t *testing.T) {
+		got, err := svc.SiteMapChildren(context.Background(), "example.com", "/api")
+		if err != nil {
+			t.Fatalf("unexpected error (expected: nil, got: %v)", err)
+		}
+
+		exp := []reqlog.SiteMapNode{
+			{
+				Host:     "example.com",
+				Path:     "/api/users",
+				Hits:     3,
+				Statuses: map[int]int{200: 1, 403: 1},
+				Methods: []reqlog.SiteMapMethod{
+					{Method: http.MethodGet, Hits: 1, Statuses: map[int]int{200: 1}},
+					{Method: http.MethodPost, Hits: 1, Statuses: map[int]int{403: 1}},
+				},
+				ChildCount: 1,
+			},
+		}
+		if diff := cmp.Diff(exp, got); diff != "" {
+			t.Fatalf("site map paths not equal (-exp, +got):\n%v", diff)
+		}
+
+		got, err = svc.SiteMapChildren(context.Background(), "example.com", "/unknown")
+		if err != nil || len(got) != 0 {
+			t.Fatalf("expected no children for unknown path (got: %v, error: %v)", got, err)
+		}
+	})
+
+	t.Run("updated as traffic is logged", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPut, "https://example.com/api/users/42", nil)
+		svc.RequestModifier(func(*http.Request) {})(req)
+
+		res := &http.Response{
+			Request:    req,
+			StatusCode: http.StatusNoContent,
+			Header:     http.Header{},
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+
+		if err := svc.ResponseModifier(func(*http.Response) error { return nil })(res); err != nil {
+			t.Fatalf("unexpected error (expected: nil, got: %v)", err)
+		}
+
+		// The response is stored asynchronously.
+		var got []reqlog.SiteMapNode
+
+		for deadline := time.Now().Add(time.Second); time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+			var err error
+
+			got, err = svc.SiteMapChildren(context.Background(), "example.com", "/api/users")
+			if err != nil {
+				t.Fatalf("unexpected error (expected: nil, got: %v)", err)
+			}
+
+			if len(got) == 1 && got[0].Statuses[http.StatusNoContent] == 1 {
+				break
+			}
+		}
+
+		exp := []reqlog.SiteMapNode{
+			{
+				Host:     "example.com",
+				Path:     "/api/users/42",
+				Hits:     2,
+				Statuses: map[int]int{http.StatusNoContent: 1},
+				Methods: []reqlog.SiteMapMethod{
+					{Method: http.MethodDelete, Hits: 1, Statuses: map[int]int{}},
+					{Method: http.MethodPut, Hits: 1, Statuses: map[int]int{http.StatusNoContent: 1}},
+				},
+			},
+		}
+		if diff := cmp.Diff(exp, got); diff != "" {
+			t.Fatalf("site map paths not equal (-exp, +got):\n%v", diff)
+		}
+
+		if n := atomic.LoadInt32(&finds); n != 1 {
+			t.Fatalf("expected site map to be built once (got: %v)", n)
+		}
+	})
+
+	t.Run("rebuilt after clearing", func(t *testing.T) {
+		if err := svc.ClearRequests(context.Background(), svc.ActiveProjectID); err != nil {
+			t.Fatalf("unexpected error (expected: nil, got: %v)", err)
+		}
+
+		if _, err := svc.SiteMapHosts(context.Background()); err != nil {
+			t.Fatalf("unexpected error (expected: nil, got: %v)", err)
+		}
+
+		if n := atomic.LoadInt32(&finds); n != 2 {
+			t.Fatalf("expected site map to be built again (got: %v)", n)
+		}
+	})
+}
+
+func TestSiteMapWithoutActiveProject(t *testing.T) {
+	t.Parallel()
+
+	svc := reqlog.NewService(reqlog.Config{Repository: &RepoMock{}})
+
+	if _, err := svc.SiteMapHosts(context.Background()); !errors.Is(err, reqlog.ErrProjectIDMustBeSet) {
+		t.Fatalf("expected `reqlog.ErrProjectIDMustBeSet`, got: %v", err)
+	}
+}
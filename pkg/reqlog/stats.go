@@ -0,0 +1,79 @@
+package reqlog
+
+import (
+	"context"
+	"fmt"
+	"sort"
+)
+
+// HostStat summarizes the request logs for a single host.
+type HostStat struct {
+	Host         string
+	RequestCount int
+	// ErrorCount is the number of requests with a 4xx or 5xx response.
+	ErrorCount int
+	// AvgResponseSize is the average response body size in bytes, for the
+	// requests that have a response.
+	AvgResponseSize float64
+}
+
+// HostStats returns per-host summaries of the request logs that match the
+// service's find filter, sorted by host.
+func (svc *Service) HostStats(ctx context.Context) ([]HostStat, error) {
+	reqLogs, err := svc.FindRequests(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("reqlog: could not find requests: %w", err)
+	}
+
+	return hostStats(reqLogs), nil
+}
+
+func hostStats(reqLogs []RequestLog) []HostStat {
+	type hostTotals struct {
+		stat          HostStat
+		responseCount int
+		responseSize  int64
+	}
+
+	totals := make(map[string]*hostTotals)
+
+	for _, reqLog := range reqLogs {
+		host := ""
+		if reqLog.URL != nil {
+			host = reqLog.URL.Host
+		}
+
+		t, ok := totals[host]
+		if !ok {
+			t = &hostTotals{stat: HostStat{Host: host}}
+			totals[host] = t
+		}
+
+		t.stat.RequestCount++
+
+		if reqLog.Response == nil {
+			continue
+		}
+
+		if reqLog.Response.StatusCode >= 400 {
+			t.stat.ErrorCount++
+		}
+
+		t.responseCount++
+		t.responseSize += reqLog.Response.bodySize()
+	}
+
+	stats := make([]HostStat, 0, len(totals))
+
+	for _, t := range totals {
+		if t.responseCount > 0 {
+			t.stat.AvgResponseSize = float64(t.responseSize) / float64(t.responseCount)
+		}
+
+		stats = append(stats, t.stat)
+	}
+
+	sort.Slice(stats, func(i, j int) bool { return stats[i].Host < stats[j].Host })
+
+	return stats
+}
@@ -0,0 +1,69 @@
+package reqlog_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/dstotijn/hetty/pkg/reqlog"
+	"github.com/dstotijn/hetty/pkg/scope"
+)
+
+func TestHostStats(t *testing.T) {
+	t.Parallel()
+
+	reqLogs := []reqlog.RequestLog{
+		{
+			URL:      mustParseURL(t, "https://example.com/foo"),
+			Response: &reqlog.ResponseLog{StatusCode: 200, Body: []byte("foobar")},
+		},
+		{
+			URL:      mustParseURL(t, "https://example.com/bar"),
+			Response: &reqlog.ResponseLog{StatusCode: 500, Body: []byte("baz")},
+		},
+		{
+			// In-flight requests count as requests, but not towards the
+			// average response size.
+			URL: mustParseURL(t, "https://example.com/baz"),
+		},
+		{
+			URL:      mustParseURL(t, "http://api.example.org:8080/"),
+			Response: &reqlog.ResponseLog{StatusCode: 404},
+		},
+	}
+
+	repoMock := &RepoMock{
+		FindRequestLogsFunc: func(_ context.Context, _ reqlog.FindRequestsFilter, _ *scope.Scope) ([]reqlog.RequestLog, error) {
+			return reqLogs, nil
+		},
+	}
+	svc := reqlog.NewService(reqlog.Config{
+		Repository: repoMock,
+		Scope:      &scope.Scope{},
+	})
+
+	got, err := svc.HostStats(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error (expected: nil, got: %v)", err)
+	}
+
+	exp := []reqlog.HostStat{
+		{
+			Host:            "api.example.org:8080",
+			RequestCount:    1,
+			ErrorCount:      1,
+			AvgResponseSize: 0,
+		},
+		{
+			Host:            "example.com",
+			RequestCount:    3,
+			ErrorCount:      1,
+			AvgResponseSize: 4.5,
+		},
+	}
+
+	if diff := cmp.Diff(exp, got); diff != "" {
+		t.Fatalf("host stats not equal (-exp, +got):\n%v", diff)
+	}
+}
@@ -0,0 +1,117 @@
+package reqlog
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/oklog/ulid"
+
+	"github.com/dstotijn/hetty/pkg/search"
+)
+
+// subscriberBufferSize is the number of request logs that are buffered for
+// each subscriber. Request logs are dropped for subscribers that fall behind.
+const subscriberBufferSize = 64
+
+// Subscribe returns a channel that receives request logs as they're logged,
+// until ctx is done. A request log is sent once it's stored, and again, with
+// its response, once the response is stored. If expr isn't nil, only request
+// logs matching it are sent; until the response is stored, response search
+// keys resolve to empty strings (like for `ShouldLog`).
+func (svc *Service) Subscribe(ctx context.Context, expr search.Expression) (<-chan RequestLog, error) {
+	match := func(RequestLog) bool { return true }
+
+	if expr != nil {
+		matchCfg := svc.matchConfig
+		matchCfg.LoginBoundary = svc.LoginBoundary
+
+		var err error
+
+		match, err = CompileMatcher(expr, matchCfg)
+		if err != nil {
+			return nil, fmt.Errorf("reqlog: could not compile search expression: %w", err)
+		}
+	}
+
+	ch := make(chan RequestLog, subscriberBufferSize)
+
+	svc.subscribersMu.Lock()
+	svc.subscribers[ch] = match
+	svc.subscribersMu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		svc.subscribersMu.Lock()
+		delete(svc.subscribers, ch)
+		svc.subscribersMu.Unlock()
+
+		close(ch)
+	}()
+
+	return ch, nil
+}
+
+// OnResponseStoredFn is called with a request log, including its response,
+// after the response is stored.
+type OnResponseStoredFn func(reqLog RequestLog)
+
+// OnResponseStored registers fn to be called for every response that's stored.
+// Unlike subscribers, functions don't miss request logs if they fall behind:
+// they're called on the goroutine that stored the response, so they should
+// return quickly.
+func (svc *Service) OnResponseStored(fn OnResponseStoredFn) {
+	svc.subscribersMu.Lock()
+	defer svc.subscribersMu.Unlock()
+
+	svc.onResponseStoredFns = append(svc.onResponseStoredFns, fn)
+}
+
+func (svc *Service) hasSubscribers() bool {
+	svc.subscribersMu.Lock()
+	defer svc.subscribersMu.Unlock()
+
+	return len(svc.subscribers) > 0 || len(svc.onResponseStoredFns) > 0
+}
+
+func (svc *Service) publish(reqLog RequestLog) {
+	svc.subscribersMu.Lock()
+	defer svc.subscribersMu.Unlock()
+
+	for ch, match := range svc.subscribers {
+		if !match(reqLog) {
+			continue
+		}
+
+		select {
+		case ch <- reqLog:
+		default:
+		}
+	}
+}
+
+// publishResponse publishes a request log after its response was stored, and
+// calls the `OnResponseStored` functions. The request log is only looked up if
+// there are subscribers or functions.
+func (svc *Service) publishResponse(ctx context.Context, reqLogID ulid.ULID) {
+	if !svc.hasSubscribers() {
+		return
+	}
+
+	reqLog, err := svc.repo.FindRequestLogByID(ctx, reqLogID)
+	if err != nil {
+		log.Printf("[ERROR] Could not find request log for publishing its response: %v", err)
+		return
+	}
+
+	svc.publish(reqLog)
+
+	svc.subscribersMu.Lock()
+	fns := svc.onResponseStoredFns
+	svc.subscribersMu.Unlock()
+
+	for _, fn := range fns {
+		fn(reqLog)
+	}
+}
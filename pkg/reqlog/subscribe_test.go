@@ -0,0 +1,192 @@
+package reqlog_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid"
+
+	"github.com/dstotijn/hetty/pkg/reqlog"
+	"github.com/dstotijn/hetty/pkg/scope"
+	"github.com/dstotijn/hetty/pkg/search"
+)
+
+func TestSubscribe(t *testing.T) {
+	t.Parallel()
+
+	mu := sync.Mutex{}
+	stored := make(map[ulid.ULID]reqlog.RequestLog)
+
+	repoMock := &RepoMock{
+		StoreRequestLogFunc: func(_ context.Context, reqLog reqlog.RequestLog) error {
+			mu.Lock()
+			defer mu.Unlock()
+			stored[reqLog.ID] = reqLog
+
+			return nil
+		},
+		StoreResponseLogFunc: func(_ context.Context, reqLogID ulid.ULID, resLog reqlog.ResponseLog) error {
+			mu.Lock()
+			defer mu.Unlock()
+			reqLog := stored[reqLogID]
+			reqLog.Response = &resLog
+			stored[reqLogID] = reqLog
+
+			return nil
+		},
+		FindRequestLogByIDFunc: func(_ context.Context, id ulid.ULID) (reqlog.RequestLog, error) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			return stored[id], nil
+		},
+	}
+	svc := reqlog.NewService(reqlog.Config{
+		Repository: repoMock,
+		Scope:      &scope.Scope{},
+	})
+	svc.ActiveProjectID = ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+
+	expr, err := search.ParseQuery(`req.method = "POST"`)
+	if err != nil {
+		t.Fatalf("unexpected error parsing query: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	reqLogs, err := svc.Subscribe(ctx, expr)
+	if err != nil {
+		t.Fatalf("unexpected error (expected: nil, got: %v)", err)
+	}
+
+	reqModFn := svc.RequestModifier(func(*http.Request) {})
+	resModFn := svc.ResponseModifier(func(*http.Response) error { return nil })
+
+	for _, method := range []string{http.MethodGet, http.MethodPost} {
+		req := httptest.NewRequest(method, "https://example.com/", nil)
+		reqModFn(req)
+
+		res := &http.Response{
+			Request:    req,
+			StatusCode: http.StatusOK,
+			Header:     http.Header{},
+			Body:       io.NopCloser(strings.NewReader("foobar")),
+		}
+
+		if err := resModFn(res); err != nil {
+			t.Fatalf("unexpected error (expected: nil, got: %v)", err)
+		}
+	}
+
+	receive := func() reqlog.RequestLog {
+		select {
+		case reqLog := <-reqLogs:
+			return reqLog
+		case <-time.After(time.Second):
+			t.Fatal("timed out waiting for request log")
+		}
+
+		return reqlog.RequestLog{}
+	}
+
+	// The request log is published when it's stored, and again with its
+	// response. Only the request log matching the filter is published.
+	if reqLog := receive(); reqLog.Method != http.MethodPost || reqLog.Response != nil {
+		t.Fatalf("expected POST request log without response (got: %v, response: %v)", reqLog.Method, reqLog.Response)
+	}
+
+	if reqLog := receive(); reqLog.Method != http.MethodPost || reqLog.Response == nil {
+		t.Fatalf("expected POST request log with response (got: %v, response: %v)", reqLog.Method, reqLog.Response)
+	}
+
+	cancel()
+
+	if _, ok := <-reqLogs; ok {
+		t.Fatal("expected channel to be closed once context is done")
+	}
+}
+
+func TestOnResponseStored(t *testing.T) {
+	t.Parallel()
+
+	mu := sync.Mutex{}
+	stored := make(map[ulid.ULID]reqlog.RequestLog)
+
+	repoMock := &RepoMock{
+		StoreRequestLogFunc: func(_ context.Context, reqLog reqlog.RequestLog) error {
+			mu.Lock()
+			defer mu.Unlock()
+			stored[reqLog.ID] = reqLog
+
+			return nil
+		},
+		StoreResponseLogFunc: func(_ context.Context, reqLogID ulid.ULID, resLog reqlog.ResponseLog) error {
+			mu.Lock()
+			defer mu.Unlock()
+			reqLog := stored[reqLogID]
+			reqLog.Response = &resLog
+			stored[reqLogID] = reqLog
+
+			return nil
+		},
+		FindRequestLogByIDFunc: func(_ context.Context, id ulid.ULID) (reqlog.RequestLog, error) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			return stored[id], nil
+		},
+	}
+	svc := reqlog.NewService(reqlog.Config{
+		Repository: repoMock,
+		Scope:      &scope.Scope{},
+	})
+	svc.ActiveProjectID = ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+
+	reqLogs := make(chan reqlog.RequestLog, 1)
+
+	svc.OnResponseStored(func(reqLog reqlog.RequestLog) {
+		reqLogs <- reqLog
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "https://example.com/", nil)
+	svc.RequestModifier(func(*http.Request) {})(req)
+
+	res := &http.Response{
+		Request:    req,
+		StatusCode: http.StatusOK,
+		Header:     http.Header{},
+		Body:       io.NopCloser(strings.NewReader("foobar")),
+	}
+
+	if err := svc.ResponseModifier(func(*http.Response) error { return nil })(res); err != nil {
+		t.Fatalf("unexpected error (expected: nil, got: %v)", err)
+	}
+
+	select {
+	case reqLog := <-reqLogs:
+		if reqLog.Response == nil || string(reqLog.Response.Body) != "foobar" {
+			t.Fatalf("expected request log with response (got: %+v)", reqLog.Response)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for request log")
+	}
+}
+
+func TestSubscribeInvalidExpression(t *testing.T) {
+	t.Parallel()
+
+	svc := reqlog.NewService(reqlog.Config{})
+
+	expr := search.CallExpression{Name: "foo"}
+
+	if _, err := svc.Subscribe(context.Background(), expr); err == nil {
+		t.Fatal("expected error for unsupported function, got: nil")
+	}
+}
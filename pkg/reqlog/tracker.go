@@ -0,0 +1,59 @@
+package reqlog
+
+import "strings"
+
+// Trackers holds the hosts and paths of analytics and tracking endpoints.
+type Trackers struct {
+	// Hosts are matched against the request host and its parent domains, so
+	// `example.com` also matches `stats.example.com`.
+	Hosts []string
+	// Paths are matched against the request path and its parent paths, on any
+	// host, so `/collect` also matches `/collect/foo`, but not `/collections`.
+	Paths []string
+}
+
+func defaultTrackers() Trackers {
+	return Trackers{
+		Hosts: []string{
+			"google-analytics.com",
+			"googletagmanager.com",
+			"doubleclick.net",
+			"connect.facebook.net",
+			"bat.bing.com",
+			"analytics.tiktok.com",
+			"hotjar.com",
+			"segment.io",
+			"mixpanel.com",
+		},
+		Paths: []string{
+			"/collect",
+			"/g/collect",
+			"/j/collect",
+		},
+	}
+}
+
+// isTracker returns true if the request is sent to any of the tracker hosts or
+// paths.
+func isTracker(rl RequestLog, trackers Trackers) bool {
+	if rl.URL == nil {
+		return false
+	}
+
+	hostname := strings.ToLower(rl.URL.Hostname())
+
+	for _, host := range trackers.Hosts {
+		host = strings.ToLower(host)
+		if hostname == host || strings.HasSuffix(hostname, "."+host) {
+			return true
+		}
+	}
+
+	for _, path := range trackers.Paths {
+		if rl.URL.Path == path || strings.HasPrefix(rl.URL.Path, strings.TrimSuffix(path, "/")+"/") {
+			return true
+		}
+	}
+
+	return false
+}
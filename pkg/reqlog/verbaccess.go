@@ -0,0 +1,106 @@
+package reqlog
+
+import (
+	"net/http"
+	"net/url"
+	"sort"
+
+	"github.com/oklog/ulid"
+)
+
+// VerbAccessFinding reports a URL for which a GET request and a request with
+// another method got conflicting outcomes: one succeeded (2xx), while the
+// other failed (4xx or 5xx). This can point to access control that's only
+// enforced for some methods.
+type VerbAccessFinding struct {
+	// URL is the URL both requests were sent to, without query string.
+	URL string
+
+	GetReqLogID     ulid.ULID
+	GetStatusCode   int
+	OtherReqLogID   ulid.ULID
+	OtherMethod     string
+	OtherStatusCode int
+}
+
+// VerbAccessReport correlates request logs by URL and returns a finding for
+// each pair of a GET and a non-GET request with conflicting outcomes. Requests
+// without a response are ignored. Findings are sorted by URL; findings for the
+// same URL keep the order of reqLogs.
+func VerbAccessReport(reqLogs []RequestLog) []VerbAccessFinding {
+	type urlLogs struct {
+		get    []RequestLog
+		others []RequestLog
+	}
+
+	byURL := make(map[string]*urlLogs)
+
+	for _, reqLog := range reqLogs {
+		if reqLog.URL == nil || reqLog.Response == nil {
+			continue
+		}
+
+		u := verbAccessURL(reqLog.URL)
+
+		logs, ok := byURL[u]
+		if !ok {
+			logs = &urlLogs{}
+			byURL[u] = logs
+		}
+
+		if reqLog.Method == http.MethodGet {
+			logs.get = append(logs.get, reqLog)
+		} else {
+			logs.others = append(logs.others, reqLog)
+		}
+	}
+
+	urls := make([]string, 0, len(byURL))
+	for u := range byURL {
+		urls = append(urls, u)
+	}
+
+	sort.Strings(urls)
+
+	var findings []VerbAccessFinding
+
+	for _, u := range urls {
+		logs := byURL[u]
+
+		for _, get := range logs.get {
+			for _, other := range logs.others {
+				getOK, getErr := statusOutcome(get.Response.StatusCode)
+				otherOK, otherErr := statusOutcome(other.Response.StatusCode)
+
+				if !(getOK && otherErr) && !(getErr && otherOK) {
+					continue
+				}
+
+				findings = append(findings, VerbAccessFinding{
+					URL:             u,
+					GetReqLogID:     get.ID,
+					GetStatusCode:   get.Response.StatusCode,
+					OtherReqLogID:   other.ID,
+					OtherMethod:     other.Method,
+					OtherStatusCode: other.Response.StatusCode,
+				})
+			}
+		}
+	}
+
+	return findings
+}
+
+func verbAccessURL(u *url.URL) string {
+	clone := *u
+	clone.RawQuery = ""
+	clone.Fragment = ""
+
+	return clone.String()
+}
+
+// statusOutcome returns whether a status code indicates success (2xx) or an
+// error (4xx or 5xx). Other status codes are neither.
+func statusOutcome(statusCode int) (success, failure bool) {
+	return statusCode >= 200 && statusCode < 300, statusCode >= 400
+}
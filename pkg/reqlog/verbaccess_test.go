@@ -0,0 +1,69 @@
+package reqlog_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/oklog/ulid"
+
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+func TestVerbAccessReport(t *testing.T) {
+	t.Parallel()
+
+	newID := func() ulid.ULID {
+		return ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+	}
+
+	getAdmin := reqlog.RequestLog{
+		ID:       newID(),
+		Method:   "GET",
+		URL:      mustParseURL(t, "https://example.com/admin?page=1"),
+		Response: &reqlog.ResponseLog{StatusCode: 403},
+	}
+	postAdmin := reqlog.RequestLog{
+		ID:       newID(),
+		Method:   "POST",
+		URL:      mustParseURL(t, "https://example.com/admin"),
+		Response: &reqlog.ResponseLog{StatusCode: 200},
+	}
+	// Both methods fail, so this isn't a finding.
+	deleteAdmin := reqlog.RequestLog{
+		ID:       newID(),
+		Method:   "DELETE",
+		URL:      mustParseURL(t, "https://example.com/admin"),
+		Response: &reqlog.ResponseLog{StatusCode: 401},
+	}
+	// Without response, so ignored.
+	putAdmin := reqlog.RequestLog{
+		ID:     newID(),
+		Method: "PUT",
+		URL:    mustParseURL(t, "https://example.com/admin"),
+	}
+	// Different URL, so not correlated with the GET above.
+	postUsers := reqlog.RequestLog{
+		ID:       newID(),
+		Method:   "POST",
+		URL:      mustParseURL(t, "https://example.com/users"),
+		Response: &reqlog.ResponseLog{StatusCode: 201},
+	}
+
+	got := reqlog.VerbAccessReport([]reqlog.RequestLog{getAdmin, postAdmin, deleteAdmin, putAdmin, postUsers})
+
+	exp := []reqlog.VerbAccessFinding{
+		{
+			URL:             "https://example.com/admin",
+			GetReqLogID:     getAdmin.ID,
+			GetStatusCode:   403,
+			OtherReqLogID:   postAdmin.ID,
+			OtherMethod:     "POST",
+			OtherStatusCode: 200,
+		},
+	}
+
+	if diff := cmp.Diff(exp, got); diff != "" {
+		t.Fatalf("findings not equal (-exp, +got):\n%v", diff)
+	}
+}
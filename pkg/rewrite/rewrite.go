@@ -0,0 +1,283 @@
+package rewrite
+
+import (
+	"bytes"
+	"encoding/gob"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/dstotijn/hetty/pkg/proxy"
+)
+
+// Target is the part of a request or response that a rule rewrites.
+type Target int
+
+const (
+	TargetRequestURL Target = iota
+	// TargetRequestHeader rewrites each request header field, formatted as
+	// `Key: Value`. A field that is replaced by an empty string is removed.
+	TargetRequestHeader
+	TargetRequestBody
+	// TargetResponseHeader rewrites each response header field, like
+	// `TargetRequestHeader`.
+	TargetResponseHeader
+	TargetResponseBody
+)
+
+// Rule replaces the matches of a regular expression in a request or response.
+// Replace can refer to submatches, e.g. `$1` (see `regexp.Regexp.Expand`).
+type Rule struct {
+	Target  Target
+	Match   *regexp.Regexp
+	Replace string
+}
+
+// Rewriter applies rules to proxied requests and responses, in order, before
+// they are forwarded.
+type Rewriter struct {
+	rules []Rule
+	mu    sync.RWMutex
+}
+
+func (rw *Rewriter) Rules() []Rule {
+	rw.mu.RLock()
+	defer rw.mu.RUnlock()
+
+	return rw.rules
+}
+
+func (rw *Rewriter) SetRules(rules []Rule) {
+	rw.mu.Lock()
+	defer rw.mu.Unlock()
+
+	rw.rules = rules
+}
+
+// RequestModifier rewrites requests, after other request modifiers ran.
+func (rw *Rewriter) RequestModifier(next proxy.RequestModifyFunc) proxy.RequestModifyFunc {
+	return func(req *http.Request) {
+		next(req)
+
+		if err := rw.RewriteRequest(req); err != nil {
+			// The request is forwarded as-is, so a faulty rule doesn't drop
+			// traffic.
+			log.Printf("[ERROR] Could not rewrite request: %v", err)
+		}
+	}
+}
+
+// ResponseModifier rewrites responses, after other response modifiers ran.
+func (rw *Rewriter) ResponseModifier(next proxy.ResponseModifyFunc) proxy.ResponseModifyFunc {
+	return func(res *http.Response) error {
+		if err := next(res); err != nil {
+			return err
+		}
+
+		return rw.RewriteResponse(res)
+	}
+}
+
+// RewriteRequest applies the request rules to req. Bodies with a
+// `Content-Encoding` are left as-is.
+func (rw *Rewriter) RewriteRequest(req *http.Request) error {
+	rules := rw.Rules()
+
+	var body []byte
+
+	rewriteBody := hasTarget(rules, TargetRequestBody) && isPlainBody(req.Header) && req.Body != nil
+	if rewriteBody {
+		var err error
+
+		body, err = ioutil.ReadAll(req.Body)
+		if err != nil {
+			return fmt.Errorf("rewrite: could not read request body: %w", err)
+		}
+
+		// The body is set even if a rule fails, as it's been read.
+		defer func() {
+			req.Body = ioutil.NopCloser(bytes.NewReader(body))
+			req.ContentLength = int64(len(body))
+			req.TransferEncoding = nil
+		}()
+	}
+
+	for _, rule := range rules {
+		if rule.Match == nil {
+			continue
+		}
+
+		switch rule.Target {
+		case TargetRequestURL:
+			u, err := url.Parse(rule.Match.ReplaceAllString(req.URL.String(), rule.Replace))
+			if err != nil {
+				return fmt.Errorf("rewrite: invalid URL after rewrite: %w", err)
+			}
+
+			req.URL = u
+			req.Host = u.Host
+		case TargetRequestHeader:
+			req.Header = rewriteHeader(req.Header, rule)
+		case TargetRequestBody:
+			if rewriteBody {
+				body = rule.Match.ReplaceAll(body, []byte(rule.Replace))
+			}
+		}
+	}
+
+	return nil
+}
+
+// RewriteResponse applies the response rules to res. Bodies with a
+// `Content-Encoding`, and the body of an upgraded response, are left as-is.
+func (rw *Rewriter) RewriteResponse(res *http.Response) error {
+	rules := rw.Rules()
+
+	var body []byte
+
+	rewriteBody := hasTarget(rules, TargetResponseBody) && isPlainBody(res.Header) &&
+		res.StatusCode != http.StatusSwitchingProtocols
+	if rewriteBody {
+		var err error
+
+		body, err = ioutil.ReadAll(res.Body)
+		if err != nil {
+			return fmt.Errorf("rewrite: could not read response body: %w", err)
+		}
+	}
+
+	for _, rule := range rules {
+		if rule.Match == nil {
+			continue
+		}
+
+		switch rule.Target {
+		case TargetResponseHeader:
+			res.Header = rewriteHeader(res.Header, rule)
+		case TargetResponseBody:
+			if rewriteBody {
+				body = rule.Match.ReplaceAll(body, []byte(rule.Replace))
+			}
+		}
+	}
+
+	if rewriteBody {
+		res.Body = ioutil.NopCloser(bytes.NewReader(body))
+		res.ContentLength = int64(len(body))
+		res.TransferEncoding = nil
+
+		// Headers are written to the client as-is, so a `Content-Length`
+		// header must match the rewritten body.
+		if res.Header.Get("Content-Length") != "" {
+			res.Header.Set("Content-Length", strconv.Itoa(len(body)))
+		}
+	}
+
+	return nil
+}
+
+func hasTarget(rules []Rule, target Target) bool {
+	for _, rule := range rules {
+		if rule.Target == target {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isPlainBody(header http.Header) bool {
+	encoding := header.Get("Content-Encoding")
+	return encoding == "" || strings.EqualFold(encoding, "identity")
+}
+
+// rewriteHeader applies a rule to each header field, formatted as `Key: Value`.
+// Fields are rewritten in order of key, so the result doesn't depend on map
+// iteration order.
+func rewriteHeader(header http.Header, rule Rule) http.Header {
+	rewritten := make(http.Header, len(header))
+
+	keys := make([]string, 0, len(header))
+	for key := range header {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		for _, value := range header[key] {
+			field := rule.Match.ReplaceAllString(key+": "+value, rule.Replace)
+			if field == "" {
+				continue
+			}
+
+			k, v := field, ""
+			if i := strings.Index(field, ":"); i >= 0 {
+				k, v = field[:i], strings.TrimSpace(field[i+1:])
+			}
+
+			rewritten.Add(strings.TrimSpace(k), v)
+		}
+	}
+
+	return rewritten
+}
+
+type ruleDTO struct {
+	Target  Target
+	Match   string
+	Replace string
+}
+
+func (r Rule) MarshalBinary() ([]byte, error) {
+	dto := ruleDTO{
+		Target:  r.Target,
+		Replace: r.Replace,
+	}
+
+	if r.Match != nil {
+		dto.Match = r.Match.String()
+	}
+
+	buf := bytes.Buffer{}
+
+	err := gob.NewEncoder(&buf).Encode(dto)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (r *Rule) UnmarshalBinary(data []byte) error {
+	dto := ruleDTO{}
+
+	err := gob.NewDecoder(bytes.NewReader(data)).Decode(&dto)
+	if err != nil {
+		return err
+	}
+
+	var match *regexp.Regexp
+
+	if dto.Match != "" {
+		match, err = regexp.Compile(dto.Match)
+		if err != nil {
+			return err
+		}
+	}
+
+	*r = Rule{
+		Target:  dto.Target,
+		Match:   match,
+		Replace: dto.Replace,
+	}
+
+	return nil
+}
@@ -0,0 +1,195 @@
+package rewrite_test
+
+import (
+	"bytes"
+	"encoding/gob"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/dstotijn/hetty/pkg/rewrite"
+)
+
+func TestRewriteRequest(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		rules          []rewrite.Rule
+		header         http.Header
+		expectedURL    string
+		expectedHost   string
+		expectedHeader http.Header
+		expectedBody   string
+	}{
+		{
+			name: "URL",
+			rules: []rewrite.Rule{
+				{Target: rewrite.TargetRequestURL, Match: regexp.MustCompile(`example\.com/(\w+)`), Replace: "example.org/v2/$1"},
+			},
+			expectedURL:    "https://example.org/v2/foo?bar=baz",
+			expectedHost:   "example.org",
+			expectedHeader: http.Header{"X-Foo": []string{"bar"}},
+			expectedBody:   "secret=foo",
+		},
+		{
+			name: "header value",
+			rules: []rewrite.Rule{
+				{Target: rewrite.TargetRequestHeader, Match: regexp.MustCompile(`^X-Foo: .*$`), Replace: "X-Foo: baz"},
+			},
+			expectedURL:    "https://example.com/foo?bar=baz",
+			expectedHost:   "example.com",
+			expectedHeader: http.Header{"X-Foo": []string{"baz"}},
+			expectedBody:   "secret=foo",
+		},
+		{
+			name: "header removed",
+			rules: []rewrite.Rule{
+				{Target: rewrite.TargetRequestHeader, Match: regexp.MustCompile(`^X-Foo: .*$`), Replace: ""},
+			},
+			expectedURL:    "https://example.com/foo?bar=baz",
+			expectedHost:   "example.com",
+			expectedHeader: http.Header{},
+			expectedBody:   "secret=foo",
+		},
+		{
+			name: "body, rules applied in order",
+			rules: []rewrite.Rule{
+				{Target: rewrite.TargetRequestBody, Match: regexp.MustCompile(`secret=(\w+)`), Replace: "secret=${1}bar"},
+				{Target: rewrite.TargetRequestBody, Match: regexp.MustCompile(`foobar`), Replace: "baz"},
+				// Response rules don't apply to requests.
+				{Target: rewrite.TargetResponseBody, Match: regexp.MustCompile(`baz`), Replace: "qux"},
+			},
+			expectedURL:    "https://example.com/foo?bar=baz",
+			expectedHost:   "example.com",
+			expectedHeader: http.Header{"X-Foo": []string{"bar"}},
+			expectedBody:   "secret=baz",
+		},
+		{
+			name: "encoded body is left as-is",
+			rules: []rewrite.Rule{
+				{Target: rewrite.TargetRequestBody, Match: regexp.MustCompile(`secret`), Replace: "public"},
+			},
+			header:       http.Header{"Content-Encoding": []string{"gzip"}},
+			expectedURL:  "https://example.com/foo?bar=baz",
+			expectedHost: "example.com",
+			expectedHeader: http.Header{
+				"X-Foo":            []string{"bar"},
+				"Content-Encoding": []string{"gzip"},
+			},
+			expectedBody: "secret=foo",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rw := &rewrite.Rewriter{}
+			rw.SetRules(tt.rules)
+
+			req := httptest.NewRequest(http.MethodPost, "https://example.com/foo?bar=baz", strings.NewReader("secret=foo"))
+			req.Header.Set("X-Foo", "bar")
+
+			for key, values := range tt.header {
+				req.Header[key] = values
+			}
+
+			if err := rw.RewriteRequest(req); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got := req.URL.String(); got != tt.expectedURL {
+				t.Errorf("incorrect URL (expected: %q, got: %q)", tt.expectedURL, got)
+			}
+
+			if req.Host != tt.expectedHost {
+				t.Errorf("incorrect host (expected: %q, got: %q)", tt.expectedHost, req.Host)
+			}
+
+			if diff := cmp.Diff(tt.expectedHeader, req.Header); diff != "" {
+				t.Errorf("header not equal (-exp, +got):\n%v", diff)
+			}
+
+			body, err := io.ReadAll(req.Body)
+			if err != nil {
+				t.Fatalf("unexpected error reading body: %v", err)
+			}
+
+			if string(body) != tt.expectedBody {
+				t.Errorf("incorrect body (expected: %q, got: %q)", tt.expectedBody, body)
+			}
+
+			if req.ContentLength != int64(len(tt.expectedBody)) {
+				t.Errorf("incorrect content length (expected: %v, got: %v)", len(tt.expectedBody), req.ContentLength)
+			}
+		})
+	}
+}
+
+func TestRewriteResponse(t *testing.T) {
+	t.Parallel()
+
+	rw := &rewrite.Rewriter{}
+	rw.SetRules([]rewrite.Rule{
+		{Target: rewrite.TargetResponseHeader, Match: regexp.MustCompile(`^Server: .*$`), Replace: ""},
+		{Target: rewrite.TargetResponseBody, Match: regexp.MustCompile(`"admin":false`), Replace: `"admin":true`},
+	})
+
+	res := &http.Response{
+		StatusCode: http.StatusOK,
+		Header: http.Header{
+			"Content-Length": []string{"15"},
+			"Server":         []string{"nginx"},
+		},
+		Body: io.NopCloser(strings.NewReader(`{"admin":false}`)),
+	}
+
+	if err := rw.ResponseModifier(func(*http.Response) error { return nil })(res); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The `Content-Length` header matches the rewritten body.
+	expHeader := http.Header{"Content-Length": []string{"14"}}
+	if diff := cmp.Diff(expHeader, res.Header); diff != "" {
+		t.Errorf("header not equal (-exp, +got):\n%v", diff)
+	}
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		t.Fatalf("unexpected error reading body: %v", err)
+	}
+
+	if exp := `{"admin":true}`; string(body) != exp {
+		t.Errorf("incorrect body (expected: %q, got: %q)", exp, body)
+	}
+}
+
+func TestRuleMarshalBinary(t *testing.T) {
+	t.Parallel()
+
+	exp := []rewrite.Rule{
+		{Target: rewrite.TargetResponseBody, Match: regexp.MustCompile(`foo(\d+)`), Replace: "bar$1"},
+	}
+
+	buf := bytes.Buffer{}
+	if err := gob.NewEncoder(&buf).Encode(exp); err != nil {
+		t.Fatalf("unexpected error encoding rules: %v", err)
+	}
+
+	var got []rewrite.Rule
+	if err := gob.NewDecoder(&buf).Decode(&got); err != nil {
+		t.Fatalf("unexpected error decoding rules: %v", err)
+	}
+
+	if len(got) != 1 || got[0].Target != exp[0].Target || got[0].Match.String() != exp[0].Match.String() ||
+		got[0].Replace != exp[0].Replace {
+		t.Fatalf("rules not equal (expected: %v, got: %v)", exp, got)
+	}
+}
@@ -0,0 +1,206 @@
+package scan
+
+import (
+	"bytes"
+	"fmt"
+	"mime"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+// minReflectedValueLen is the minimum length of parameter values that are
+// checked for reflection. Shorter values, like `1` or `en`, occur in most
+// response bodies by chance.
+const minReflectedValueLen = 4
+
+// maxEvidenceLen is the maximum length of evidence, in bytes.
+const maxEvidenceLen = 100
+
+// DefaultChecks returns the checks that are run when none are configured.
+func DefaultChecks() []Check {
+	return []Check{
+		{Name: "security-headers", Func: checkSecurityHeaders},
+		{Name: "reflected-params", Func: checkReflectedParams},
+		{Name: "verbose-errors", Func: newVerboseErrorsCheck(reqlog.DefaultMatchConfig().ErrorPageSignatures)},
+		{Name: "secrets", Func: checkSecrets},
+	}
+}
+
+// checkSecurityHeaders flags HTML responses without headers that mitigate
+// XSS, clickjacking and MIME sniffing, and HTTPS responses without HSTS.
+func checkSecurityHeaders(reqLog reqlog.RequestLog, _ []byte) []Issue {
+	header := reqLog.Response.Header
+
+	var issues []Issue
+
+	if isHTML(header) {
+		csp := header.Get("Content-Security-Policy")
+
+		if csp == "" {
+			issues = append(issues, Issue{Title: "Missing Content-Security-Policy header", Severity: SeverityLow})
+		}
+
+		// The `frame-ancestors` directive supersedes `X-Frame-Options`.
+		if header.Get("X-Frame-Options") == "" && !strings.Contains(strings.ToLower(csp), "frame-ancestors") {
+			issues = append(issues, Issue{Title: "Missing X-Frame-Options header", Severity: SeverityLow})
+		}
+
+		if !strings.EqualFold(header.Get("X-Content-Type-Options"), "nosniff") {
+			issues = append(issues, Issue{Title: "Missing X-Content-Type-Options header", Severity: SeverityInfo})
+		}
+	}
+
+	if reqLog.URL.Scheme == "https" && header.Get("Strict-Transport-Security") == "" {
+		issues = append(issues, Issue{Title: "Missing Strict-Transport-Security header", Severity: SeverityLow})
+	}
+
+	return issues
+}
+
+// checkReflectedParams flags query and URL encoded form parameters whose value
+// occurs verbatim in the response body, which are candidates for XSS. Values
+// with HTML special characters that are reflected in HTML responses aren't
+// encoded, so they're of a higher severity.
+func checkReflectedParams(reqLog reqlog.RequestLog, body []byte) []Issue {
+	params := reqLog.URL.Query()
+
+	mediaType, _, _ := mime.ParseMediaType(reqLog.Header.Get("Content-Type"))
+	if mediaType == "application/x-www-form-urlencoded" {
+		if form, err := url.ParseQuery(string(reqLog.Body)); err == nil {
+			for name, values := range form {
+				params[name] = append(params[name], values...)
+			}
+		}
+	}
+
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	html := isHTML(reqLog.Response.Header)
+
+	var issues []Issue
+
+	for _, name := range names {
+		for _, value := range params[name] {
+			if len(value) < minReflectedValueLen {
+				continue
+			}
+
+			i := bytes.Index(body, []byte(value))
+			if i == -1 {
+				continue
+			}
+
+			severity := SeverityInfo
+			if html && strings.ContainsAny(value, `<>"'`) {
+				severity = SeverityMedium
+			}
+
+			issues = append(issues, Issue{
+				Title:    fmt.Sprintf("Reflected parameter %q", name),
+				Severity: severity,
+				Evidence: snippet(body, i, i+len(value)),
+			})
+
+			break
+		}
+	}
+
+	return issues
+}
+
+// newVerboseErrorsCheck returns a check that flags response bodies with error
+// messages or stack traces, which can disclose implementation details.
+func newVerboseErrorsCheck(signatures []*regexp.Regexp) func(reqlog.RequestLog, []byte) []Issue {
+	return func(_ reqlog.RequestLog, body []byte) []Issue {
+		for _, sig := range signatures {
+			if loc := sig.FindIndex(body); loc != nil {
+				return []Issue{{
+					Title:    "Verbose error message",
+					Severity: SeverityMedium,
+					Evidence: truncate(body[loc[0]:loc[1]]),
+				}}
+			}
+		}
+
+		return nil
+	}
+}
+
+type secretPattern struct {
+	name     string
+	severity Severity
+	re       *regexp.Regexp
+}
+
+var secretPatterns = []secretPattern{
+	{name: "AWS access key ID", severity: SeverityHigh, re: regexp.MustCompile(`\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`)},
+	{name: "private key", severity: SeverityHigh, re: regexp.MustCompile(`-----BEGIN (?:[A-Z]+ )*PRIVATE KEY-----`)},
+	{name: "GitHub token", severity: SeverityHigh, re: regexp.MustCompile(`\bgh[pousr]_[A-Za-z0-9]{36}\b`)},
+	{name: "Slack token", severity: SeverityHigh, re: regexp.MustCompile(`\bxox[abposr]-[A-Za-z0-9-]{10,}`)},
+	{name: "Google API key", severity: SeverityMedium, re: regexp.MustCompile(`\bAIza[0-9A-Za-z_-]{35}`)},
+	{
+		name:     "JSON Web Token",
+		severity: SeverityLow,
+		re:       regexp.MustCompile(`\beyJ[A-Za-z0-9_-]{8,}\.eyJ[A-Za-z0-9_-]{8,}\.[A-Za-z0-9_-]*`),
+	},
+}
+
+// checkSecrets flags response bodies with credentials, keys or tokens. Each
+// kind of secret is flagged once per response.
+func checkSecrets(_ reqlog.RequestLog, body []byte) []Issue {
+	var issues []Issue
+
+	for _, pattern := range secretPatterns {
+		if match := pattern.re.Find(body); match != nil {
+			issues = append(issues, Issue{
+				Title:    "Exposed " + pattern.name,
+				Severity: pattern.severity,
+				Evidence: truncate(match),
+			})
+		}
+	}
+
+	return issues
+}
+
+func isHTML(header http.Header) bool {
+	mediaType, _, err := mime.ParseMediaType(header.Get("Content-Type"))
+
+	return err == nil && (mediaType == "text/html" || mediaType == "application/xhtml+xml")
+}
+
+// snippet returns the part of body between start and end, with some context on
+// either side.
+func snippet(body []byte, start, end int) string {
+	const context = 30
+
+	if start -= context; start < 0 {
+		start = 0
+	}
+
+	if end += context; end > len(body) {
+		end = len(body)
+	}
+
+	return truncate(body[start:end])
+}
+
+// truncate returns b as a string of at most `maxEvidenceLen` bytes, without
+// invalid UTF-8.
+func truncate(b []byte) string {
+	if len(b) > maxEvidenceLen {
+		b = b[:maxEvidenceLen]
+	}
+
+	return strings.ToValidUTF8(string(b), "")
+}
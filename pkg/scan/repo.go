@@ -0,0 +1,14 @@
+package scan
+
+import (
+	"context"
+
+	"github.com/oklog/ulid"
+)
+
+type Repository interface {
+	FindFindingByID(ctx context.Context, id ulid.ULID) (Finding, error)
+	FindFindings(ctx context.Context, projectID ulid.ULID) ([]Finding, error)
+	StoreFinding(ctx context.Context, finding Finding) error
+	ClearFindings(ctx context.Context, projectID ulid.ULID) error
+}
@@ -0,0 +1,240 @@
+package scan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/oklog/ulid"
+
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+var (
+	ErrFindingNotFound    = errors.New("scan: finding not found")
+	ErrProjectIDMustBeSet = errors.New("scan: project ID must be set")
+)
+
+//nolint:gosec
+var ulidEntropy = rand.New(rand.NewSource(time.Now().UnixNano()))
+
+// maxBodySize is the number of bytes of a response body that are scanned.
+// Larger bodies are truncated, so a single large download doesn't stall the
+// scanning of other responses.
+const maxBodySize = 1 << 20
+
+// Severity is the severity of a finding.
+type Severity int
+
+const (
+	SeverityInfo Severity = iota
+	SeverityLow
+	SeverityMedium
+	SeverityHigh
+)
+
+func (s Severity) String() string {
+	switch s {
+	case SeverityLow:
+		return "low"
+	case SeverityMedium:
+		return "medium"
+	case SeverityHigh:
+		return "high"
+	default:
+		return "info"
+	}
+}
+
+// Finding is an issue that a check flagged on a logged request and response.
+type Finding struct {
+	// ID is the ID of the finding. Its timestamp is the time it was found.
+	ID        ulid.ULID
+	ProjectID ulid.ULID
+	// ReqLogID is the ID of the request log the finding was flagged on.
+	ReqLogID ulid.ULID
+	// Check is the name of the check that flagged the finding.
+	Check    string
+	Title    string
+	Severity Severity
+	// Evidence is the part of the request or response that the finding is
+	// based on, e.g. a matching snippet of the response body. It's empty for
+	// findings about something that's missing, like a header.
+	Evidence string
+	// Dismissed is true for findings that the user marked as not relevant,
+	// e.g. false positives.
+	Dismissed bool
+}
+
+// Issue is an issue that a check flagged. It's stored as a finding.
+type Issue struct {
+	Title    string
+	Severity Severity
+	Evidence string
+}
+
+// Check is a passive check: it only inspects logged traffic, and doesn't send
+// requests of its own.
+type Check struct {
+	Name string
+	// Func returns the issues of a request log, which always has a response.
+	// The response body is passed as body, so it's only read once for all
+	// checks; it's truncated to the first MiB.
+	Func func(reqLog reqlog.RequestLog, body []byte) []Issue
+}
+
+// FindingsFilter filters the findings of a project.
+type FindingsFilter struct {
+	// IncludeDismissed includes dismissed findings. By default, they're
+	// omitted.
+	IncludeDismissed bool
+	// MinSeverity omits findings of a lower severity.
+	MinSeverity Severity
+}
+
+type Service struct {
+	repo      Repository
+	reqLogSvc *reqlog.Service
+	checks    []Check
+}
+
+type Config struct {
+	Repository Repository
+	// ReqLogService is used for the active project ID.
+	ReqLogService *reqlog.Service
+	// Checks are run for every request log with a response. Defaults to
+	// `DefaultChecks()`.
+	Checks []Check
+}
+
+func NewService(cfg Config) *Service {
+	svc := &Service{
+		repo:      cfg.Repository,
+		reqLogSvc: cfg.ReqLogService,
+		checks:    cfg.Checks,
+	}
+
+	if svc.checks == nil {
+		svc.checks = DefaultChecks()
+	}
+
+	return svc
+}
+
+func (svc *Service) activeProjectID() (ulid.ULID, error) {
+	projectID := svc.reqLogSvc.ActiveProjectID
+	if projectID.Compare(ulid.ULID{}) == 0 {
+		return ulid.ULID{}, ErrProjectIDMustBeSet
+	}
+
+	return projectID, nil
+}
+
+// Scan runs the checks on a request log, and stores the issues they flag as
+// findings of the request log's project. Request logs without a response or
+// project are skipped.
+func (svc *Service) Scan(ctx context.Context, reqLog reqlog.RequestLog) ([]Finding, error) {
+	if reqLog.Response == nil || reqLog.ProjectID.Compare(ulid.ULID{}) == 0 {
+		return nil, nil
+	}
+
+	body, err := reqLog.Response.ReadBody()
+	if err != nil {
+		return nil, fmt.Errorf("scan: could not read response body: %w", err)
+	}
+
+	if len(body) > maxBodySize {
+		body = body[:maxBodySize]
+	}
+
+	var findings []Finding
+
+	for _, check := range svc.checks {
+		for _, issue := range check.Func(reqLog, body) {
+			finding := Finding{
+				ID:        ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy),
+				ProjectID: reqLog.ProjectID,
+				ReqLogID:  reqLog.ID,
+				Check:     check.Name,
+				Title:     issue.Title,
+				Severity:  issue.Severity,
+				Evidence:  issue.Evidence,
+			}
+
+			if err := svc.repo.StoreFinding(ctx, finding); err != nil {
+				return nil, fmt.Errorf("scan: could not store finding: %w", err)
+			}
+
+			findings = append(findings, finding)
+		}
+	}
+
+	return findings, nil
+}
+
+func (svc *Service) FindFindingByID(ctx context.Context, id ulid.ULID) (Finding, error) {
+	finding, err := svc.repo.FindFindingByID(ctx, id)
+	if err != nil {
+		return Finding{}, fmt.Errorf("scan: could not find finding: %w", err)
+	}
+
+	return finding, nil
+}
+
+// FindFindings returns the findings of the active project, oldest first.
+func (svc *Service) FindFindings(ctx context.Context, filter FindingsFilter) ([]Finding, error) {
+	projectID, err := svc.activeProjectID()
+	if err != nil {
+		return nil, err
+	}
+
+	findings, err := svc.repo.FindFindings(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("scan: could not find findings: %w", err)
+	}
+
+	filtered := make([]Finding, 0, len(findings))
+
+	for _, finding := range findings {
+		if finding.Dismissed && !filter.IncludeDismissed || finding.Severity < filter.MinSeverity {
+			continue
+		}
+
+		filtered = append(filtered, finding)
+	}
+
+	return filtered, nil
+}
+
+// DismissFinding marks a finding as dismissed, or undoes that if dismissed is
+// false.
+func (svc *Service) DismissFinding(ctx context.Context, id ulid.ULID, dismissed bool) (Finding, error) {
+	finding, err := svc.FindFindingByID(ctx, id)
+	if err != nil {
+		return Finding{}, err
+	}
+
+	finding.Dismissed = dismissed
+
+	if err := svc.repo.StoreFinding(ctx, finding); err != nil {
+		return Finding{}, fmt.Errorf("scan: could not store finding: %w", err)
+	}
+
+	return finding, nil
+}
+
+// ClearFindings deletes the findings of the active project.
+func (svc *Service) ClearFindings(ctx context.Context) error {
+	projectID, err := svc.activeProjectID()
+	if err != nil {
+		return err
+	}
+
+	if err := svc.repo.ClearFindings(ctx, projectID); err != nil {
+		return fmt.Errorf("scan: could not clear findings: %w", err)
+	}
+
+	return nil
+}
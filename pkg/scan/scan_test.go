@@ -0,0 +1,167 @@
+package scan_test
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	badgerdb "github.com/dgraph-io/badger/v3"
+	"github.com/oklog/ulid"
+
+	"github.com/dstotijn/hetty/pkg/db/badger"
+	"github.com/dstotijn/hetty/pkg/reqlog"
+	"github.com/dstotijn/hetty/pkg/scan"
+	"github.com/dstotijn/hetty/pkg/scope"
+)
+
+//nolint:gosec
+var ulidEntropy = rand.New(rand.NewSource(time.Now().UnixNano()))
+
+// testChecks flag a low and a high severity issue on every response.
+var testChecks = []scan.Check{
+	{
+		Name: "low",
+		Func: func(reqlog.RequestLog, []byte) []scan.Issue {
+			return []scan.Issue{{Title: "Low", Severity: scan.SeverityLow}}
+		},
+	},
+	{
+		Name: "body",
+		Func: func(_ reqlog.RequestLog, body []byte) []scan.Issue {
+			return []scan.Issue{{Title: "High", Severity: scan.SeverityHigh, Evidence: string(body)}}
+		},
+	},
+}
+
+func newTestService(t *testing.T) (*scan.Service, *reqlog.Service) {
+	t.Helper()
+
+	database, err := badger.OpenDatabase(badgerdb.DefaultOptions("").WithInMemory(true))
+	if err != nil {
+		t.Fatalf("failed to open badger database: %v", err)
+	}
+
+	t.Cleanup(func() { database.Close() })
+
+	reqLogSvc := reqlog.NewService(reqlog.Config{
+		Repository: database,
+		Scope:      &scope.Scope{},
+	})
+	reqLogSvc.ActiveProjectID = ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+
+	svc := scan.NewService(scan.Config{
+		Repository:    database,
+		ReqLogService: reqLogSvc,
+		Checks:        testChecks,
+	})
+
+	return svc, reqLogSvc
+}
+
+func TestScan(t *testing.T) {
+	t.Parallel()
+
+	svc, reqLogSvc := newTestService(t)
+	ctx := context.Background()
+
+	reqLog := reqlog.RequestLog{
+		ID:        ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy),
+		ProjectID: reqLogSvc.ActiveProjectID,
+		URL:       &url.URL{Scheme: "https", Host: "example.com", Path: "/"},
+		Method:    http.MethodGet,
+		Response: &reqlog.ResponseLog{
+			StatusCode: http.StatusOK,
+			Body:       []byte("foobar"),
+		},
+	}
+
+	findings, err := svc.Scan(ctx, reqLog)
+	if err != nil {
+		t.Fatalf("unexpected error (expected: nil, got: %v)", err)
+	}
+
+	if len(findings) != 2 {
+		t.Fatalf("expected 2 findings, got: %v", len(findings))
+	}
+
+	high := findings[1]
+	if high.ProjectID != reqLog.ProjectID || high.ReqLogID != reqLog.ID || high.Check != "body" ||
+		high.Severity != scan.SeverityHigh || high.Evidence != "foobar" {
+		t.Fatalf("incorrect finding: %+v", high)
+	}
+
+	// Request logs without a response aren't scanned.
+	reqLog.Response = nil
+
+	findings, err = svc.Scan(ctx, reqLog)
+	if err != nil || len(findings) != 0 {
+		t.Fatalf("expected no findings for request log without response (got: %v, error: %v)", len(findings), err)
+	}
+
+	got, err := svc.FindFindings(ctx, scan.FindingsFilter{MinSeverity: scan.SeverityMedium})
+	if err != nil {
+		t.Fatalf("unexpected error finding findings: %v", err)
+	}
+
+	if len(got) != 1 || got[0].ID != high.ID {
+		t.Fatalf("expected only high severity finding (got: %+v)", got)
+	}
+
+	if _, err := svc.DismissFinding(ctx, high.ID, true); err != nil {
+		t.Fatalf("unexpected error dismissing finding: %v", err)
+	}
+
+	got, err = svc.FindFindings(ctx, scan.FindingsFilter{})
+	if err != nil {
+		t.Fatalf("unexpected error finding findings: %v", err)
+	}
+
+	if len(got) != 1 || got[0].Check != "low" {
+		t.Fatalf("expected dismissed finding to be omitted (got: %+v)", got)
+	}
+
+	got, err = svc.FindFindings(ctx, scan.FindingsFilter{IncludeDismissed: true})
+	if err != nil {
+		t.Fatalf("unexpected error finding findings: %v", err)
+	}
+
+	if len(got) != 2 || !got[1].Dismissed {
+		t.Fatalf("expected dismissed finding to be included (got: %+v)", got)
+	}
+
+	if err := svc.ClearFindings(ctx); err != nil {
+		t.Fatalf("unexpected error clearing findings: %v", err)
+	}
+
+	got, err = svc.FindFindings(ctx, scan.FindingsFilter{IncludeDismissed: true})
+	if err != nil || len(got) != 0 {
+		t.Fatalf("expected no findings after clearing (got: %v, error: %v)", len(got), err)
+	}
+}
+
+func TestDismissFindingNotFound(t *testing.T) {
+	t.Parallel()
+
+	svc, _ := newTestService(t)
+
+	_, err := svc.DismissFinding(context.Background(), ulid.MustNew(1, ulidEntropy), true)
+	if !errors.Is(err, scan.ErrFindingNotFound) {
+		t.Fatalf("expected `scan.ErrFindingNotFound`, got: %v", err)
+	}
+}
+
+func TestFindFindingsWithoutActiveProject(t *testing.T) {
+	t.Parallel()
+
+	svc, reqLogSvc := newTestService(t)
+	reqLogSvc.ActiveProjectID = ulid.ULID{}
+
+	_, err := svc.FindFindings(context.Background(), scan.FindingsFilter{})
+	if !errors.Is(err, scan.ErrProjectIDMustBeSet) {
+		t.Fatalf("expected `scan.ErrProjectIDMustBeSet`, got: %v", err)
+	}
+}
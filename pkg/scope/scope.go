@@ -4,19 +4,44 @@ import (
 	"bytes"
 	"encoding/gob"
 	"net/http"
+	"net/url"
 	"regexp"
+	"strconv"
 	"sync"
 )
 
 type Scope struct {
-	rules []Rule
-	mu    sync.RWMutex
+	rules     []Rule
+	matchMode MatchMode
+	mu        sync.RWMutex
 }
 
+// MatchMode defines how the rules of a scope are combined.
+type MatchMode int
+
+const (
+	// MatchAny puts a request in scope when it matches any of the rules.
+	MatchAny MatchMode = iota
+	// MatchAll puts a request in scope when it matches all of the rules.
+	MatchAll
+)
+
 type Rule struct {
 	URL    *regexp.Regexp
 	Header Header
 	Body   *regexp.Regexp
+	// Method, if set, must match the request method. When URL, header or body
+	// are set too, one of these must match as well.
+	Method *regexp.Regexp
+	// StatusCode, if set, must include the response status code. When URL,
+	// header or body are set too, one of these must match as well.
+	StatusCode StatusCodeRange
+	// Port, if set, must include the port of the request URL (see `URLPort`).
+	// When URL, header or body are set too, one of these must match as well.
+	Port PortRange
+	// Exclude makes the rule take requests that match it out of scope, rather
+	// than put them in scope.
+	Exclude bool
 }
 
 type Header struct {
@@ -24,6 +49,63 @@ type Header struct {
 	Value *regexp.Regexp
 }
 
+// StatusCodeRange is an inclusive range of status codes. A zero Max means there
+// is no upper bound. The zero value is unset.
+type StatusCodeRange struct {
+	Min int
+	Max int
+}
+
+// IsSet returns true if the range isn't a zero value.
+func (r StatusCodeRange) IsSet() bool {
+	return r != StatusCodeRange{}
+}
+
+// Contains returns true if the status code is in the range.
+func (r StatusCodeRange) Contains(statusCode int) bool {
+	return statusCode >= r.Min && (r.Max == 0 || statusCode <= r.Max)
+}
+
+// PortRange is an inclusive range of ports. A zero Max means there is no upper
+// bound. The zero value is unset.
+type PortRange struct {
+	Min int
+	Max int
+}
+
+// IsSet returns true if the range isn't a zero value.
+func (r PortRange) IsSet() bool {
+	return r != PortRange{}
+}
+
+// Contains returns true if the port is in the range.
+func (r PortRange) Contains(port int) bool {
+	return port >= r.Min && (r.Max == 0 || port <= r.Max)
+}
+
+// URLPort returns the port of a URL. If the URL has no explicit port, the
+// default port of its scheme is returned, or 0 if the scheme is unknown.
+func URLPort(u *url.URL) int {
+	if port, err := strconv.Atoi(u.Port()); err == nil {
+		return port
+	}
+
+	switch u.Scheme {
+	case "http", "ws":
+		return 80
+	case "https", "wss":
+		return 443
+	default:
+		return 0
+	}
+}
+
+// HasRequestMatcher returns true if the URL, header or body of the rule are
+// set.
+func (r Rule) HasRequestMatcher() bool {
+	return r.URL != nil || r.Header.Key != nil || r.Header.Value != nil || r.Body != nil
+}
+
 func (s *Scope) Rules() []Rule {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
@@ -38,20 +120,107 @@ func (s *Scope) SetRules(rules []Rule) {
 	s.rules = rules
 }
 
+func (s *Scope) MatchMode() MatchMode {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.matchMode
+}
+
+func (s *Scope) SetMatchMode(mode MatchMode) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.matchMode = mode
+}
+
+// Match returns true if the request is in scope, according to the scope's
+// rules and match mode (see `MatchMode.CombineRules`). A scope without rules
+// never matches. Because a request has no response yet, exclude rules with a
+// status code can't take it out of scope.
 func (s *Scope) Match(req *http.Request, body []byte) bool {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
-	for _, rule := range s.rules {
-		if matches := rule.Match(req, body); matches {
+	return s.matchMode.CombineRules(s.rules, func(i int) bool {
+		if s.rules[i].Exclude && s.rules[i].StatusCode.IsSet() {
+			return false
+		}
+
+		return s.rules[i].Match(req, body)
+	})
+}
+
+// Combine returns the combined result of matching n rules, where `match`
+// returns the result for rule i. Rules are evaluated lazily. When n is zero,
+// the result is false.
+func (mode MatchMode) Combine(n int, match func(i int) bool) bool {
+	if n == 0 {
+		return false
+	}
+
+	for i := 0; i < n; i++ {
+		matches := match(i)
+
+		switch {
+		case mode == MatchAll && !matches:
+			return false
+		case mode != MatchAll && matches:
 			return true
 		}
 	}
 
-	return false
+	return mode == MatchAll
+}
+
+// CombineRules returns whether a request is in scope, where `match` returns
+// whether it matches rule i. The include rules are combined using the match
+// mode, and the request must match none of the exclude rules. When there are
+// only exclude rules, requests are in scope unless excluded. When there are no
+// rules, the result is false.
+func (mode MatchMode) CombineRules(rules []Rule, match func(i int) bool) bool {
+	if len(rules) == 0 {
+		return false
+	}
+
+	var includes, excludes []int
+
+	for i, rule := range rules {
+		if rule.Exclude {
+			excludes = append(excludes, i)
+		} else {
+			includes = append(includes, i)
+		}
+	}
+
+	if len(includes) > 0 && !mode.Combine(len(includes), func(i int) bool { return match(includes[i]) }) {
+		return false
+	}
+
+	for _, i := range excludes {
+		if match(i) {
+			return false
+		}
+	}
+
+	return true
 }
 
+// Match returns true if the request matches the rule. Because a request has no
+// response yet, the rule's status code isn't evaluated.
 func (r Rule) Match(req *http.Request, body []byte) bool {
+	if r.Method != nil && !r.Method.MatchString(req.Method) {
+		return false
+	}
+
+	if r.Port.IsSet() && !r.Port.Contains(URLPort(req.URL)) {
+		return false
+	}
+
+	if !r.HasRequestMatcher() {
+		return r.Method != nil || r.StatusCode.IsSet() || r.Port.IsSet()
+	}
+
 	if r.URL != nil {
 		if matches := r.URL.MatchString(req.URL.String()); matches {
 			return true
@@ -118,13 +287,21 @@ type ruleDTO struct {
 		Key   string
 		Value string
 	}
-	Body string
+	Body       string
+	Method     string
+	StatusCode StatusCodeRange
+	Port       PortRange
+	Exclude    bool
 }
 
 func (r Rule) MarshalBinary() ([]byte, error) {
 	dto := ruleDTO{
-		URL:  regexpToString(r.URL),
-		Body: regexpToString(r.Body),
+		URL:        regexpToString(r.URL),
+		Body:       regexpToString(r.Body),
+		Method:     regexpToString(r.Method),
+		StatusCode: r.StatusCode,
+		Port:       r.Port,
+		Exclude:    r.Exclude,
 	}
 	dto.Header.Key = regexpToString(r.Header.Key)
 	dto.Header.Value = regexpToString(r.Header.Value)
@@ -167,13 +344,22 @@ func (r *Rule) UnmarshalBinary(data []byte) error {
 		return err
 	}
 
+	method, err := stringToRegexp(dto.Method)
+	if err != nil {
+		return err
+	}
+
 	*r = Rule{
 		URL: url,
 		Header: Header{
 			Key:   headerKey,
 			Value: headerValue,
 		},
-		Body: body,
+		Body:       body,
+		Method:     method,
+		StatusCode: dto.StatusCode,
+		Port:       dto.Port,
+		Exclude:    dto.Exclude,
 	}
 
 	return nil
@@ -0,0 +1,270 @@
+package scope_test
+
+import (
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"testing"
+
+	"github.com/dstotijn/hetty/pkg/scope"
+)
+
+func TestScopeMatch(t *testing.T) {
+	t.Parallel()
+
+	rules := []scope.Rule{
+		{URL: regexp.MustCompile("^https://example\\.com/")},
+		{Body: regexp.MustCompile("foo")},
+	}
+
+	tests := []struct {
+		name     string
+		mode     scope.MatchMode
+		rules    []scope.Rule
+		body     string
+		expMatch bool
+	}{
+		{
+			name:     "match any mode, one rule matches",
+			mode:     scope.MatchAny,
+			rules:    rules,
+			body:     "bar",
+			expMatch: true,
+		},
+		{
+			name:     "match all mode, one rule matches",
+			mode:     scope.MatchAll,
+			rules:    rules,
+			body:     "bar",
+			expMatch: false,
+		},
+		{
+			name:     "match all mode, all rules match",
+			mode:     scope.MatchAll,
+			rules:    rules,
+			body:     "foo",
+			expMatch: true,
+		},
+		{
+			name:     "match all mode, no rules",
+			mode:     scope.MatchAll,
+			rules:    nil,
+			body:     "foo",
+			expMatch: false,
+		},
+		{
+			name: "include and exclude rule, excluded",
+			mode: scope.MatchAny,
+			rules: []scope.Rule{
+				{URL: regexp.MustCompile("^https://example\\.com/")},
+				{Body: regexp.MustCompile("foo"), Exclude: true},
+			},
+			body:     "foo",
+			expMatch: false,
+		},
+		{
+			name: "include and exclude rule, not excluded",
+			mode: scope.MatchAny,
+			rules: []scope.Rule{
+				{URL: regexp.MustCompile("^https://example\\.com/")},
+				{Body: regexp.MustCompile("foo"), Exclude: true},
+			},
+			body:     "bar",
+			expMatch: true,
+		},
+		{
+			name:     "only exclude rules, not excluded",
+			mode:     scope.MatchAll,
+			rules:    []scope.Rule{{Body: regexp.MustCompile("foo"), Exclude: true}},
+			body:     "bar",
+			expMatch: true,
+		},
+		{
+			name:     "only exclude rules, excluded",
+			mode:     scope.MatchAll,
+			rules:    []scope.Rule{{Body: regexp.MustCompile("foo"), Exclude: true}},
+			body:     "foo",
+			expMatch: false,
+		},
+		{
+			// Requests have no response yet, so the status code can't rule
+			// them out.
+			name:     "exclude rule with status code",
+			mode:     scope.MatchAny,
+			rules:    []scope.Rule{{StatusCode: scope.StatusCodeRange{Min: 500}, Exclude: true}},
+			body:     "foo",
+			expMatch: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := &scope.Scope{}
+			s.SetRules(tt.rules)
+			s.SetMatchMode(tt.mode)
+
+			req := httptest.NewRequest("POST", "https://example.com/", nil)
+
+			if got := s.Match(req, []byte(tt.body)); tt.expMatch != got {
+				t.Errorf("expected match result: %v, got: %v", tt.expMatch, got)
+			}
+		})
+	}
+}
+
+func TestRuleMatch(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		rule     scope.Rule
+		method   string
+		expMatch bool
+	}{
+		{
+			name:     "method rule, match",
+			rule:     scope.Rule{Method: regexp.MustCompile("^POST$")},
+			method:   "POST",
+			expMatch: true,
+		},
+		{
+			name:     "method rule, no match",
+			rule:     scope.Rule{Method: regexp.MustCompile("^POST$")},
+			method:   "GET",
+			expMatch: false,
+		},
+		{
+			name: "URL and method rule, only URL matches",
+			rule: scope.Rule{
+				URL:    regexp.MustCompile("^https://example\\.com/"),
+				Method: regexp.MustCompile("^POST$"),
+			},
+			method:   "GET",
+			expMatch: false,
+		},
+		{
+			// Requests have no response yet, so the status code can't rule
+			// them out.
+			name:     "status code rule",
+			rule:     scope.Rule{StatusCode: scope.StatusCodeRange{Min: 500, Max: 599}},
+			method:   "GET",
+			expMatch: true,
+		},
+		{
+			name:     "port rule, default port of scheme matches",
+			rule:     scope.Rule{Port: scope.PortRange{Min: 443, Max: 443}},
+			method:   "GET",
+			expMatch: true,
+		},
+		{
+			name:     "port rule, no match",
+			rule:     scope.Rule{Port: scope.PortRange{Min: 8000, Max: 8999}},
+			method:   "GET",
+			expMatch: false,
+		},
+		{
+			name: "URL and port rule, only URL matches",
+			rule: scope.Rule{
+				URL:  regexp.MustCompile("^https://example\\.com/"),
+				Port: scope.PortRange{Min: 8000},
+			},
+			method:   "GET",
+			expMatch: false,
+		},
+		{
+			name:     "empty rule",
+			rule:     scope.Rule{},
+			method:   "GET",
+			expMatch: false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(tt.method, "https://example.com/", nil)
+
+			if got := tt.rule.Match(req, nil); tt.expMatch != got {
+				t.Errorf("expected match result: %v, got: %v", tt.expMatch, got)
+			}
+		})
+	}
+}
+
+func TestRuleMarshalBinary(t *testing.T) {
+	t.Parallel()
+
+	rule := scope.Rule{
+		URL:        regexp.MustCompile("^https://example\\.com/"),
+		Method:     regexp.MustCompile("^(POST|PUT)$"),
+		StatusCode: scope.StatusCodeRange{Min: 500, Max: 599},
+		Port:       scope.PortRange{Min: 8000, Max: 8999},
+		Exclude:    true,
+	}
+
+	data, err := rule.MarshalBinary()
+	if err != nil {
+		t.Fatalf("unexpected error marshaling rule: %v", err)
+	}
+
+	var got scope.Rule
+	if err := got.UnmarshalBinary(data); err != nil {
+		t.Fatalf("unexpected error unmarshaling rule: %v", err)
+	}
+
+	if got.URL.String() != rule.URL.String() {
+		t.Errorf("expected URL: %v, got: %v", rule.URL, got.URL)
+	}
+
+	if got.Method.String() != rule.Method.String() {
+		t.Errorf("expected method: %v, got: %v", rule.Method, got.Method)
+	}
+
+	if got.StatusCode != rule.StatusCode {
+		t.Errorf("expected status code: %v, got: %v", rule.StatusCode, got.StatusCode)
+	}
+
+	if got.Port != rule.Port {
+		t.Errorf("expected port: %v, got: %v", rule.Port, got.Port)
+	}
+
+	if got.Exclude != rule.Exclude {
+		t.Errorf("expected exclude: %v, got: %v", rule.Exclude, got.Exclude)
+	}
+}
+
+func TestURLPort(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		url     string
+		expPort int
+	}{
+		{url: "https://example.com:8443/", expPort: 8443},
+		{url: "https://example.com/", expPort: 443},
+		{url: "http://example.com/", expPort: 80},
+		{url: "wss://example.com/", expPort: 443},
+		{url: "ftp://example.com/", expPort: 0},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.url, func(t *testing.T) {
+			t.Parallel()
+
+			u, err := url.Parse(tt.url)
+			if err != nil {
+				t.Fatalf("unexpected error parsing URL: %v", err)
+			}
+
+			if got := scope.URLPort(u); tt.expPort != got {
+				t.Errorf("expected port: %v, got: %v", tt.expPort, got)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package search
 import (
 	"encoding/gob"
 	"regexp"
+	"strconv"
 	"strings"
 )
 
@@ -53,6 +54,92 @@ func (sl StringLiteral) String() string {
 	return sl.Value
 }
 
+// ToleranceLiteral is a numeric value with a tolerance, expressed as a
+// percentage of the value, e.g. `1024 ± 10%`. It's used as the right operand
+// of the approximately equal (`~=`) operator.
+type ToleranceLiteral struct {
+	Value   float64
+	Percent float64
+}
+
+func (tl ToleranceLiteral) String() string {
+	b := strings.Builder{}
+	b.WriteString(strconv.FormatFloat(tl.Value, 'f', -1, 64))
+	b.WriteString(" ± ")
+	b.WriteString(strconv.FormatFloat(tl.Percent, 'f', -1, 64))
+	b.WriteString("%")
+
+	return b.String()
+}
+
+// Contains returns true if v is within the tolerance of the value.
+func (tl ToleranceLiteral) Contains(v float64) bool {
+	delta := tl.Value * tl.Percent / 100
+	if delta < 0 {
+		delta = -delta
+	}
+
+	return v >= tl.Value-delta && v <= tl.Value+delta
+}
+
+// ListLiteral is a list of values, e.g. `[200, 301, 302]`. It's used as the
+// right operand of the `IN` operator.
+type ListLiteral struct {
+	Values []StringLiteral
+}
+
+func (ll ListLiteral) String() string {
+	b := strings.Builder{}
+	b.WriteString("[")
+
+	for i, v := range ll.Values {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+
+		b.WriteString(v.String())
+	}
+
+	b.WriteString("]")
+
+	return b.String()
+}
+
+// CallExpression is a call of a function with arguments, e.g.
+// `extract(req.url, /id=(\d+)/)`. Arguments are string literals or regular
+// expressions. It resolves to a value, so it can be used as the left operand of
+// a comparison.
+type CallExpression struct {
+	Name string
+	Args []Expression
+}
+
+func (ce CallExpression) String() string {
+	b := strings.Builder{}
+	b.WriteString(ce.Name)
+	b.WriteString("(")
+
+	for i, arg := range ce.Args {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+
+		if re, ok := arg.(*regexp.Regexp); ok {
+			b.WriteString("/")
+			b.WriteString(re.String())
+			b.WriteString("/")
+
+			continue
+		}
+
+		b.WriteString(arg.String())
+	}
+
+	b.WriteString(")")
+
+	return b.String()
+}
+
 type RegexpLiteral struct {
 	*regexp.Regexp
 }
@@ -77,4 +164,7 @@ func init() {
 	gob.Register(InfixExpression{})
 	gob.Register(StringLiteral{})
 	gob.Register(RegexpLiteral{})
+	gob.Register(ToleranceLiteral{})
+	gob.Register(ListLiteral{})
+	gob.Register(CallExpression{})
 }
@@ -0,0 +1,57 @@
+package search
+
+import "sort"
+
+// Operator describes an operator of the search query language.
+type Operator struct {
+	Symbol string `json:"symbol"`
+	// Prefix is true for operators that precede a single operand (e.g. `NOT`),
+	// and false for infix operators.
+	Prefix bool `json:"prefix"`
+	// Precedence is the binding power of the operator; a higher value binds
+	// tighter.
+	Precedence int `json:"precedence"`
+}
+
+// Operators returns the operators supported by the parser, in order of token
+// type.
+func Operators() []Operator {
+	var tokTypes []TokenType
+
+	for tokType := range infixParsers {
+		tokTypes = append(tokTypes, tokType)
+	}
+
+	for tokType := range prefixParsers {
+		if _, ok := tokenPrecedences[tokType]; ok && tokType != TokParenOpen {
+			tokTypes = append(tokTypes, tokType)
+		}
+	}
+
+	sort.Slice(tokTypes, func(i, j int) bool { return tokTypes[i] < tokTypes[j] })
+
+	ops := make([]Operator, 0, len(tokTypes))
+
+	for _, tokType := range tokTypes {
+		_, prefix := prefixParsers[tokType]
+		ops = append(ops, Operator{
+			Symbol:     tokType.String(),
+			Prefix:     prefix,
+			Precedence: int(tokenPrecedences[tokType]),
+		})
+	}
+
+	return ops
+}
+
+// Functions returns the names of the supported functions, sorted.
+func Functions() []string {
+	names := make([]string, 0, len(functions))
+	for name := range functions {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
@@ -2,6 +2,7 @@ package search
 
 import (
 	"fmt"
+	"strings"
 	"unicode"
 	"unicode/utf8"
 )
@@ -22,9 +23,15 @@ const (
 	TokEOF
 	TokParenOpen
 	TokParenClose
+	TokBracketOpen
+	TokBracketClose
+	TokComma
 
 	// Literals.
 	TokString
+	TokMacro
+	TokFunc
+	TokRegexp
 
 	// Boolean operators.
 	TokOpNot
@@ -40,6 +47,11 @@ const (
 	TokOpLtEq
 	TokOpRe
 	TokOpNotRe
+	TokOpApprox
+	TokOpIn
+
+	// Operands of the approximately equal operator.
+	TokPlusMinus
 )
 
 var (
@@ -47,25 +59,40 @@ var (
 		"NOT": TokOpNot,
 		"AND": TokOpAnd,
 		"OR":  TokOpOr,
+		"IN":  TokOpIn,
+	}
+	// functions holds the names of the supported functions, and the number of
+	// arguments they take.
+	functions = map[string]int{
+		"extract": 2,
 	}
-	reservedRunes    = []rune{'=', '!', '<', '>', '(', ')'}
+	reservedRunes    = []rune{'=', '!', '<', '>', '(', ')', '±'}
 	tokenTypeStrings = map[TokenType]string{
-		TokInvalid:    "INVALID",
-		TokEOF:        "EOF",
-		TokParenOpen:  "(",
-		TokParenClose: ")",
-		TokString:     "STRING",
-		TokOpNot:      "NOT",
-		TokOpAnd:      "AND",
-		TokOpOr:       "OR",
-		TokOpEq:       "=",
-		TokOpNotEq:    "!=",
-		TokOpGt:       ">",
-		TokOpLt:       "<",
-		TokOpGtEq:     ">=",
-		TokOpLtEq:     "<=",
-		TokOpRe:       "=~",
-		TokOpNotRe:    "!~",
+		TokInvalid:      "INVALID",
+		TokEOF:          "EOF",
+		TokParenOpen:    "(",
+		TokParenClose:   ")",
+		TokBracketOpen:  "[",
+		TokBracketClose: "]",
+		TokComma:        ",",
+		TokString:       "STRING",
+		TokMacro:        "MACRO",
+		TokFunc:         "FUNC",
+		TokRegexp:       "REGEXP",
+		TokOpNot:        "NOT",
+		TokOpAnd:        "AND",
+		TokOpOr:         "OR",
+		TokOpEq:         "=",
+		TokOpNotEq:      "!=",
+		TokOpGt:         ">",
+		TokOpLt:         "<",
+		TokOpGtEq:       ">=",
+		TokOpLtEq:       "<=",
+		TokOpRe:         "=~",
+		TokOpNotRe:      "!~",
+		TokOpApprox:     "~=",
+		TokOpIn:         "IN",
+		TokPlusMinus:    "±",
 	}
 )
 
@@ -77,6 +104,14 @@ type Lexer struct {
 	start  int
 	width  int
 	tokens chan Token
+
+	// prevType is the type of the last emitted token.
+	prevType TokenType
+	// inList is true while lexing the members of a list, e.g. `[foo, bar]`.
+	inList bool
+	// inCall is true while lexing the arguments of a function call, e.g.
+	// `extract(req.url, /id=(\d+)/)`.
+	inCall bool
 }
 
 func NewLexer(input string) *Lexer {
@@ -128,6 +163,7 @@ func (l *Lexer) emit(tokenType TokenType) {
 	}
 
 	l.start = l.pos
+	l.prevType = tokenType
 }
 
 func (l *Lexer) ignore() {
@@ -192,12 +228,64 @@ func begin(l *Lexer) stateFn {
 			l.emit(TokOpGt)
 		}
 
+		return begin
+	case '~':
+		if next := l.read(); next == '=' {
+			l.emit(TokOpApprox)
+			return begin
+		}
+
+		l.backup()
+
+		return unquotedString
+	case '±':
+		l.emit(TokPlusMinus)
 		return begin
 	case '(':
+		l.inCall = l.prevType == TokFunc
 		l.emit(TokParenOpen)
+
 		return begin
 	case ')':
 		l.emit(TokParenClose)
+		l.inCall = false
+
+		return begin
+	case '/':
+		// Slashes only delimit a regular expression in function arguments,
+		// so unquoted strings (e.g. paths) can still start with them.
+		if !l.inCall {
+			return unquotedString
+		}
+
+		return l.regexpString()
+	case '[':
+		// Brackets only delimit a list after the `IN` operator, so unquoted
+		// strings (e.g. regular expressions) can still contain them.
+		if l.prevType != TokOpIn {
+			return unquotedString
+		}
+
+		l.emit(TokBracketOpen)
+		l.inList = true
+
+		return begin
+	case ']':
+		if !l.inList {
+			return unquotedString
+		}
+
+		l.emit(TokBracketClose)
+		l.inList = false
+
+		return begin
+	case ',':
+		if !l.inList && !l.inCall {
+			return unquotedString
+		}
+
+		l.emit(TokComma)
+
 		return begin
 	case '"':
 		return l.delimString(r)
@@ -232,6 +320,31 @@ func (l *Lexer) delimString(delim rune) stateFn {
 	return begin
 }
 
+// regexpString lexes a regular expression delimited by slashes, e.g.
+// `/id=(\d+)/`. Slashes inside the expression are escaped with a backslash.
+func (l *Lexer) regexpString() stateFn {
+	// Ignore the start delimiter rune.
+	l.ignore()
+
+	for r := l.read(); r != '/'; r = l.read() {
+		switch r {
+		case eof:
+			return l.errorf("unexpected EOF, unclosed regular expression")
+		case '\\':
+			if l.read() == eof {
+				return l.errorf("unexpected EOF, unclosed regular expression")
+			}
+		}
+	}
+	// Don't include the end delimiter in emitted token.
+	l.backup()
+	l.emit(TokRegexp)
+	// Skip end delimiter.
+	l.skip()
+
+	return begin
+}
+
 func unquotedString(l *Lexer) stateFn {
 	for r := l.read(); ; r = l.read() {
 		switch {
@@ -246,7 +359,7 @@ func unquotedString(l *Lexer) stateFn {
 			l.skip()
 
 			return begin
-		case isReserved(r):
+		case isReserved(r), l.inList && (r == ',' || r == ']'), l.inCall && r == ',':
 			l.backup()
 			l.emitUnquotedString()
 
@@ -262,6 +375,25 @@ func (l *Lexer) emitUnquotedString() {
 		return
 	}
 
+	// Lowercase `in` is only an operator if it's followed by a list, so free
+	// text such as `sign in` can still contain it.
+	if str == "in" && strings.HasPrefix(strings.TrimLeftFunc(l.input[l.pos:], unicode.IsSpace), "[") {
+		l.emit(TokOpIn)
+		return
+	}
+
+	// A function name is only a function if it's directly followed by an
+	// opening parenthesis, e.g. `extract(`.
+	if _, ok := functions[str]; ok && strings.HasPrefix(l.input[l.pos:], "(") {
+		l.emit(TokFunc)
+		return
+	}
+
+	if len(str) > 1 && str[0] == '@' {
+		l.emit(TokMacro)
+		return
+	}
+
 	l.emit(TokString)
 }
 
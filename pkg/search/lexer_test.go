@@ -53,6 +53,109 @@ func TestNextToken(t *testing.T) {
 				{TokEOF, ""},
 			},
 		},
+		{
+			name:  "approximately equal operator with tolerance",
+			input: "foo ~= 1024 ± 10% ~bar",
+			expected: []Token{
+				{TokString, "foo"},
+				{TokOpApprox, "~="},
+				{TokString, "1024"},
+				{TokPlusMinus, "±"},
+				{TokString, "10%"},
+				{TokString, "~bar"},
+				{TokEOF, ""},
+			},
+		},
+		{
+			name:  "set membership operator with list",
+			input: `foo IN [200, "bar baz",qux] in []`,
+			expected: []Token{
+				{TokString, "foo"},
+				{TokOpIn, "IN"},
+				{TokBracketOpen, "["},
+				{TokString, "200"},
+				{TokComma, ","},
+				{TokString, "bar baz"},
+				{TokComma, ","},
+				{TokString, "qux"},
+				{TokBracketClose, "]"},
+				{TokOpIn, "in"},
+				{TokBracketOpen, "["},
+				{TokBracketClose, "]"},
+				{TokEOF, ""},
+			},
+		},
+		{
+			name:  "lowercase in without list",
+			input: `login in progress AND sign in`,
+			expected: []Token{
+				{TokString, "login"},
+				{TokString, "in"},
+				{TokString, "progress"},
+				{TokOpAnd, "AND"},
+				{TokString, "sign"},
+				{TokString, "in"},
+				{TokEOF, ""},
+			},
+		},
+		{
+			name:  "brackets and commas outside of list",
+			input: `[a-z]+,foo bar]`,
+			expected: []Token{
+				{TokString, "[a-z]+,foo"},
+				{TokString, "bar]"},
+				{TokEOF, ""},
+			},
+		},
+		{
+			name:  "macros",
+			input: `@foo AND @ "@bar"`,
+			expected: []Token{
+				{TokMacro, "@foo"},
+				{TokOpAnd, "AND"},
+				{TokString, "@"},
+				{TokString, "@bar"},
+				{TokEOF, ""},
+			},
+		},
+		{
+			name:  "function call",
+			input: `extract(req.url, /id=(\d+)\/x/) = 42`,
+			expected: []Token{
+				{TokFunc, "extract"},
+				{TokParenOpen, "("},
+				{TokString, "req.url"},
+				{TokComma, ","},
+				{TokRegexp, `id=(\d+)\/x`},
+				{TokParenClose, ")"},
+				{TokOpEq, "="},
+				{TokString, "42"},
+				{TokEOF, ""},
+			},
+		},
+		{
+			name:  "function name without parenthesis",
+			input: "extract (foo) /bar,",
+			expected: []Token{
+				{TokString, "extract"},
+				{TokParenOpen, "("},
+				{TokString, "foo"},
+				{TokParenClose, ")"},
+				{TokString, "/bar,"},
+				{TokEOF, ""},
+			},
+		},
+		{
+			name:  "unclosed regular expression",
+			input: "extract(req.url, /foo",
+			expected: []Token{
+				{TokFunc, "extract"},
+				{TokParenOpen, "("},
+				{TokString, "req.url"},
+				{TokComma, ","},
+				{TokInvalid, "unexpected EOF, unclosed regular expression"},
+			},
+		},
 		{
 			name:  "with parentheses",
 			input: "(foo AND bar) OR baz",
@@ -0,0 +1,100 @@
+package search
+
+import (
+	"strconv"
+	"time"
+)
+
+// LiteralType is the type of value a string literal is interpreted as when it's
+// compared, e.g. using `>`.
+type LiteralType int
+
+const (
+	LiteralTypeString LiteralType = iota
+	// LiteralTypeNumber is an integer or decimal number, e.g. `299` or `1.5`.
+	LiteralTypeNumber
+	// LiteralTypeDatetime is a date or time in one of `DatetimeLayouts`, e.g.
+	// `2023-01-01` or `2023-01-01T12:00:00Z`.
+	LiteralTypeDatetime
+)
+
+// DatetimeLayouts are the time formats that string literals are parsed as for
+// datetime comparisons. Date-only values are in UTC.
+var DatetimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05",
+	"2006-01-02",
+	// The format of `time.Time.String`.
+	"2006-01-02 15:04:05.999999999 -0700 MST",
+}
+
+// TypedLiteral is a string literal with the typed value it was parsed as.
+type TypedLiteral struct {
+	Type   LiteralType
+	Value  string
+	Number float64
+	Time   time.Time
+}
+
+// ParseLiteral parses a string literal as a number or datetime, in that order.
+// If it's neither, the literal is a string.
+func ParseLiteral(s string) TypedLiteral {
+	if n, err := strconv.ParseFloat(s, 64); err == nil {
+		return TypedLiteral{Type: LiteralTypeNumber, Value: s, Number: n}
+	}
+
+	if t, ok := ParseDatetime(s); ok {
+		return TypedLiteral{Type: LiteralTypeDatetime, Value: s, Time: t}
+	}
+
+	return TypedLiteral{Type: LiteralTypeString, Value: s}
+}
+
+// ParseDatetime parses s using the first matching layout of `DatetimeLayouts`.
+func ParseDatetime(s string) (time.Time, bool) {
+	for _, layout := range DatetimeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// Typed returns the typed value of the string literal (see `ParseLiteral`).
+func (sl StringLiteral) Typed() TypedLiteral {
+	return ParseLiteral(sl.Value)
+}
+
+// Compare compares two numbers numerically, or two datetimes chronologically.
+// It returns -1 if tl < other, 0 if they're equal and 1 if tl > other. It
+// returns false for ok if the literals are strings or their types differ, so
+// the caller can fall back to comparing them as strings.
+func (tl TypedLiteral) Compare(other TypedLiteral) (cmp int, ok bool) {
+	if tl.Type != other.Type {
+		return 0, false
+	}
+
+	switch tl.Type {
+	case LiteralTypeNumber:
+		switch {
+		case tl.Number < other.Number:
+			return -1, true
+		case tl.Number > other.Number:
+			return 1, true
+		}
+
+		return 0, true
+	case LiteralTypeDatetime:
+		switch {
+		case tl.Time.Before(other.Time):
+			return -1, true
+		case tl.Time.After(other.Time):
+			return 1, true
+		}
+
+		return 0, true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,87 @@
+package search
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseLiteral(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input    string
+		expected TypedLiteral
+	}{
+		{
+			input:    "299",
+			expected: TypedLiteral{Type: LiteralTypeNumber, Value: "299", Number: 299},
+		},
+		{
+			input:    "1.5",
+			expected: TypedLiteral{Type: LiteralTypeNumber, Value: "1.5", Number: 1.5},
+		},
+		{
+			input: "2023-01-01",
+			expected: TypedLiteral{
+				Type:  LiteralTypeDatetime,
+				Value: "2023-01-01",
+				Time:  time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			input: "2023-01-01T12:00:00Z",
+			expected: TypedLiteral{
+				Type:  LiteralTypeDatetime,
+				Value: "2023-01-01T12:00:00Z",
+				Time:  time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			input:    "2.10.0",
+			expected: TypedLiteral{Type: LiteralTypeString, Value: "2.10.0"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.input, func(t *testing.T) {
+			t.Parallel()
+
+			got := ParseLiteral(tt.input)
+
+			if got.Type != tt.expected.Type || got.Value != tt.expected.Value ||
+				got.Number != tt.expected.Number || !got.Time.Equal(tt.expected.Time) {
+				t.Errorf("expected: %+v, got: %+v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestTypedLiteralCompare(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		a, b   string
+		expCmp int
+		expOK  bool
+	}{
+		{name: "numbers", a: "1000", b: "999", expCmp: 1, expOK: true},
+		{name: "equal numbers", a: "200", b: "200.0", expCmp: 0, expOK: true},
+		{name: "datetimes", a: "2023-06-01T01:00:00+02:00", b: "2023-06-01T00:30:00Z", expCmp: -1, expOK: true},
+		{name: "number and datetime", a: "2023", b: "2023-01-01", expCmp: 0, expOK: false},
+		{name: "strings", a: "foo", b: "bar", expCmp: 0, expOK: false},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cmp, ok := ParseLiteral(tt.a).Compare(ParseLiteral(tt.b))
+			if cmp != tt.expCmp || ok != tt.expOK {
+				t.Errorf("expected: (%v, %v), got: (%v, %v)", tt.expCmp, tt.expOK, cmp, ok)
+			}
+		})
+	}
+}
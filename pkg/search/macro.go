@@ -0,0 +1,65 @@
+package search
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+var (
+	macrosMu sync.RWMutex
+	macros   = map[string]Expression{}
+)
+
+// RegisterMacro registers a named search expression, which can then be used
+// in queries as `@name`. The query is parsed when registering, so a macro can
+// only reference macros that were registered before it. Registering a name
+// again replaces the macro. An `@name` that isn't registered is parsed as a
+// string literal.
+func RegisterMacro(name, query string) error {
+	name = strings.TrimPrefix(name, "@")
+	if name == "" {
+		return fmt.Errorf("search: macro name cannot be empty")
+	}
+
+	expr, err := ParseQuery(query)
+	if err != nil {
+		return fmt.Errorf("search: could not parse macro %q: %w", name, err)
+	}
+
+	macrosMu.Lock()
+	defer macrosMu.Unlock()
+
+	macros[name] = expr
+
+	return nil
+}
+
+// Macros returns the names of all registered macros, without `@` prefix.
+func Macros() []string {
+	macrosMu.RLock()
+	defer macrosMu.RUnlock()
+
+	names := make([]string, 0, len(macros))
+	for name := range macros {
+		names = append(names, name)
+	}
+
+	return names
+}
+
+func parseMacro(p *Parser) (Expression, error) {
+	name := strings.TrimPrefix(p.cur.Literal, "@")
+
+	macrosMu.RLock()
+	expr, ok := macros[name]
+	macrosMu.RUnlock()
+
+	// An unregistered name is a regular string literal, so values that
+	// happen to start with `@` (e.g. `@example.com`) can still be searched.
+	if !ok {
+		return StringLiteral{Value: p.cur.Literal}, nil
+	}
+
+	return expr, nil
+}
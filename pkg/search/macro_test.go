@@ -0,0 +1,98 @@
+package search
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+//nolint:paralleltest
+func TestMacro(t *testing.T) {
+	if err := RegisterMacro("fooIsBar", "foo = bar"); err != nil {
+		t.Fatalf("unexpected error registering macro: %v", err)
+	}
+
+	if err := RegisterMacro("@nested", "@fooIsBar OR baz"); err != nil {
+		t.Fatalf("unexpected error registering macro: %v", err)
+	}
+
+	tests := []struct {
+		name               string
+		input              string
+		expectedExpression Expression
+		expectedError      error
+	}{
+		{
+			name:  "macro is expanded",
+			input: "@fooIsBar AND NOT qux",
+			expectedExpression: InfixExpression{
+				Operator: TokOpAnd,
+				Left: InfixExpression{
+					Operator: TokOpEq,
+					Left:     StringLiteral{Value: "foo"},
+					Right:    StringLiteral{Value: "bar"},
+				},
+				Right: PrefixExpression{
+					Operator: TokOpNot,
+					Right:    StringLiteral{Value: "qux"},
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			name:  "nested macro is expanded",
+			input: "@nested",
+			expectedExpression: InfixExpression{
+				Operator: TokOpOr,
+				Left: InfixExpression{
+					Operator: TokOpEq,
+					Left:     StringLiteral{Value: "foo"},
+					Right:    StringLiteral{Value: "bar"},
+				},
+				Right: StringLiteral{Value: "baz"},
+			},
+			expectedError: nil,
+		},
+		{
+			name:               "quoted macro name is a string literal",
+			input:              `"@fooIsBar"`,
+			expectedExpression: StringLiteral{Value: "@fooIsBar"},
+			expectedError:      nil,
+		},
+		{
+			name:               "unknown macro is a string literal",
+			input:              "@example.com",
+			expectedExpression: StringLiteral{Value: "@example.com"},
+			expectedError:      nil,
+		},
+		{
+			name:  "unknown macro in infix expression",
+			input: "req.body = @foo",
+			expectedExpression: InfixExpression{
+				Operator: TokOpEq,
+				Left:     StringLiteral{Value: "req.body"},
+				Right:    StringLiteral{Value: "@foo"},
+			},
+			expectedError: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseQuery(tt.input)
+			assertError(t, tt.expectedError, err)
+			if !reflect.DeepEqual(tt.expectedExpression, got) {
+				t.Errorf("expected: %v, got: %v", tt.expectedExpression, got)
+			}
+		})
+	}
+}
+
+func TestRegisterMacroInvalidQuery(t *testing.T) {
+	t.Parallel()
+
+	err := RegisterMacro("invalid", "(foo")
+	assertError(t, errors.New(`search: could not parse macro "invalid": search: could not parse expression: `+
+		"could not parse expression prefix: unexpected EOF: unmatched parentheses"), err)
+}
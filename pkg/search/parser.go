@@ -3,6 +3,8 @@ package search
 import (
 	"fmt"
 	"regexp"
+	"strconv"
+	"strings"
 )
 
 type precedence int
@@ -42,6 +44,8 @@ var tokenPrecedences = map[TokenType]precedence{
 	TokOpLtEq:    precLessGreater,
 	TokOpRe:      precEq,
 	TokOpNotRe:   precEq,
+	TokOpApprox:  precEq,
+	TokOpIn:      precEq,
 }
 
 func init() {
@@ -63,8 +67,13 @@ func init() {
 		infixParsers[op] = parseInfixExpression
 	}
 
+	infixParsers[TokOpApprox] = parseApproxExpression
+	infixParsers[TokOpIn] = parseInExpression
+
 	prefixParsers[TokOpNot] = parsePrefixExpression
 	prefixParsers[TokString] = parseStringLiteral
+	prefixParsers[TokMacro] = parseMacro
+	prefixParsers[TokFunc] = parseCallExpression
 	prefixParsers[TokParenOpen] = parseGroupedExpression
 }
 
@@ -217,6 +226,127 @@ func parseInfixExpression(p *Parser, left Expression) (Expression, error) {
 	return expr, nil
 }
 
+// parseApproxExpression parses an approximately equal expression, where the
+// right operand is a number with a percentage tolerance, e.g.
+// `res.bodySize ~= 1024 ± 10%`.
+func parseApproxExpression(p *Parser, left Expression) (Expression, error) {
+	expr := InfixExpression{
+		Operator: p.cur.Type,
+		Left:     left,
+	}
+
+	p.nextToken()
+
+	if !p.curTokenIs(TokString) {
+		return nil, fmt.Errorf("expected number for right operand, got %v", p.cur.Type)
+	}
+
+	value, err := strconv.ParseFloat(p.cur.Literal, 64)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse number %q: %w", p.cur.Literal, err)
+	}
+
+	if !p.peekTokenIs(TokPlusMinus) {
+		return nil, fmt.Errorf("expected %v after number, got %v", TokPlusMinus, p.peek.Type)
+	}
+
+	p.nextToken()
+	p.nextToken()
+
+	if !p.curTokenIs(TokString) {
+		return nil, fmt.Errorf("expected percentage for tolerance, got %v", p.cur.Type)
+	}
+
+	percent, err := strconv.ParseFloat(strings.TrimSuffix(p.cur.Literal, "%"), 64)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse percentage %q: %w", p.cur.Literal, err)
+	}
+
+	expr.Right = ToleranceLiteral{
+		Value:   value,
+		Percent: percent,
+	}
+
+	return expr, nil
+}
+
+// parseInExpression parses a set membership expression, where the right
+// operand is a list of values, e.g. `res.statusCode IN [200, 301, 302]`.
+func parseInExpression(p *Parser, left Expression) (Expression, error) {
+	expr := InfixExpression{
+		Operator: p.cur.Type,
+		Left:     left,
+	}
+
+	p.nextToken()
+
+	if !p.curTokenIs(TokBracketOpen) {
+		return nil, fmt.Errorf("expected %v for right operand, got %v", TokBracketOpen, p.cur.Type)
+	}
+
+	list := ListLiteral{}
+
+	for p.nextToken(); !p.curTokenIs(TokBracketClose); p.nextToken() {
+		if !p.curTokenIs(TokString) {
+			return nil, fmt.Errorf("expected list value, got %v", p.cur.Type)
+		}
+
+		list.Values = append(list.Values, StringLiteral{Value: p.cur.Literal})
+
+		switch {
+		case p.peekTokenIs(TokComma):
+			p.nextToken()
+		case !p.peekTokenIs(TokBracketClose):
+			return nil, fmt.Errorf("expected %v or %v after list value, got %v", TokComma, TokBracketClose, p.peek.Type)
+		}
+	}
+
+	expr.Right = list
+
+	return expr, nil
+}
+
+// parseCallExpression parses a function call, where the arguments are string
+// literals or regular expressions, e.g. `extract(req.url, /id=(\d+)/)`.
+func parseCallExpression(p *Parser) (Expression, error) {
+	call := CallExpression{Name: p.cur.Literal}
+
+	p.nextToken()
+
+	if !p.curTokenIs(TokParenOpen) {
+		return nil, fmt.Errorf("expected %v after function name, got %v", TokParenOpen, p.cur.Type)
+	}
+
+	for p.nextToken(); !p.curTokenIs(TokParenClose); p.nextToken() {
+		switch p.cur.Type {
+		case TokString:
+			call.Args = append(call.Args, StringLiteral{Value: p.cur.Literal})
+		case TokRegexp:
+			re, err := regexp.Compile(p.cur.Literal)
+			if err != nil {
+				return nil, fmt.Errorf("could not compile regular expression %q: %w", p.cur.Literal, err)
+			}
+
+			call.Args = append(call.Args, re)
+		default:
+			return nil, fmt.Errorf("expected function argument, got %v", p.cur.Type)
+		}
+
+		switch {
+		case p.peekTokenIs(TokComma):
+			p.nextToken()
+		case !p.peekTokenIs(TokParenClose):
+			return nil, fmt.Errorf("expected %v or %v after function argument, got %v", TokComma, TokParenClose, p.peek.Type)
+		}
+	}
+
+	if argCount := functions[call.Name]; len(call.Args) != argCount {
+		return nil, fmt.Errorf("function %v expects %v arguments, got %v", call.Name, argCount, len(call.Args))
+	}
+
+	return call, nil
+}
+
 func parseStringLiteral(p *Parser) (Expression, error) {
 	return StringLiteral{Value: p.cur.Literal}, nil
 }
@@ -108,6 +108,77 @@ func TestParseQuery(t *testing.T) {
 			},
 			expectedError: nil,
 		},
+		{
+			name:  "boolean expression with approximately equal operator",
+			input: "foo ~= 1024 ± 10%",
+			expectedExpression: InfixExpression{
+				Operator: TokOpApprox,
+				Left:     StringLiteral{Value: "foo"},
+				Right:    ToleranceLiteral{Value: 1024, Percent: 10},
+			},
+			expectedError: nil,
+		},
+		{
+			name:  "set membership operator with list",
+			input: `foo IN [200, "bar baz"]`,
+			expectedExpression: InfixExpression{
+				Operator: TokOpIn,
+				Left:     StringLiteral{Value: "foo"},
+				Right: ListLiteral{
+					Values: []StringLiteral{{Value: "200"}, {Value: "bar baz"}},
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			name:  "set membership operator with empty list, combined with AND",
+			input: "foo in [] AND bar = baz",
+			expectedExpression: InfixExpression{
+				Operator: TokOpAnd,
+				Left: InfixExpression{
+					Operator: TokOpIn,
+					Left:     StringLiteral{Value: "foo"},
+					Right:    ListLiteral{},
+				},
+				Right: InfixExpression{
+					Operator: TokOpEq,
+					Left:     StringLiteral{Value: "bar"},
+					Right:    StringLiteral{Value: "baz"},
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			name:  "free text with lowercase in",
+			input: "sign in",
+			expectedExpression: InfixExpression{
+				Operator: TokOpAnd,
+				Left:     StringLiteral{Value: "sign"},
+				Right:    StringLiteral{Value: "in"},
+			},
+			expectedError: nil,
+		},
+		{
+			name:               "set membership operator without list",
+			input:              "foo IN bar",
+			expectedExpression: nil,
+			expectedError: errors.New("search: could not parse expression: could not parse infix expression: " +
+				"expected [ for right operand, got STRING"),
+		},
+		{
+			name:               "set membership operator with unclosed list",
+			input:              "foo IN [bar, baz",
+			expectedExpression: nil,
+			expectedError: errors.New("search: could not parse expression: could not parse infix expression: " +
+				"expected , or ] after list value, got EOF"),
+		},
+		{
+			name:               "approximately equal operator without tolerance",
+			input:              "foo ~= 1024",
+			expectedExpression: nil,
+			expectedError: errors.New("search: could not parse expression: could not parse infix expression: " +
+				"expected ± after number, got EOF"),
+		},
 		{
 			name:  "boolean expression with AND, OR and NOT operators",
 			input: "foo AND bar OR NOT baz",
@@ -202,6 +273,36 @@ func TestParseQuery(t *testing.T) {
 			},
 			expectedError: nil,
 		},
+		{
+			name:  "function call",
+			input: `extract(req.url, /id=(\d+)/) > 100`,
+			expectedExpression: InfixExpression{
+				Operator: TokOpGt,
+				Left: CallExpression{
+					Name: "extract",
+					Args: []Expression{
+						StringLiteral{Value: "req.url"},
+						regexp.MustCompile(`id=(\d+)`),
+					},
+				},
+				Right: StringLiteral{Value: "100"},
+			},
+			expectedError: nil,
+		},
+		{
+			name:               "function call with wrong number of arguments",
+			input:              "extract(req.url)",
+			expectedExpression: nil,
+			expectedError: errors.New("search: could not parse expression: could not parse expression prefix: " +
+				"function extract expects 2 arguments, got 1"),
+		},
+		{
+			name:               "function call with invalid argument",
+			input:              "extract(req.url, NOT)",
+			expectedExpression: nil,
+			expectedError: errors.New("search: could not parse expression: could not parse expression prefix: " +
+				"expected function argument, got NOT"),
+		},
 		{
 			name:  "eq operator takes precedence over boolean ops",
 			input: "foo=bar OR baz=yolo",
@@ -0,0 +1,14 @@
+package sender
+
+import (
+	"context"
+
+	"github.com/oklog/ulid"
+)
+
+type Repository interface {
+	FindSenderRequestByID(ctx context.Context, id ulid.ULID) (Request, error)
+	FindSenderRequests(ctx context.Context, projectID ulid.ULID) ([]Request, error)
+	StoreSenderRequest(ctx context.Context, req Request) error
+	DeleteSenderRequests(ctx context.Context, projectID ulid.ULID) error
+}
@@ -0,0 +1,291 @@
+package sender
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/oklog/ulid"
+
+	"github.com/dstotijn/hetty/pkg/proxy"
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+var (
+	ErrRequestNotFound    = errors.New("sender: request not found")
+	ErrProjectIDMustBeSet = errors.New("sender: project ID must be set")
+)
+
+//nolint:gosec
+var ulidEntropy = rand.New(rand.NewSource(time.Now().UnixNano()))
+
+// Request is a request crafted by the user, which can be edited and sent
+// repeatedly.
+type Request struct {
+	ID        ulid.ULID
+	ProjectID ulid.ULID
+	// SourceRequestLogID is the ID of the request log the request was cloned
+	// from. It's zero for requests created from scratch.
+	SourceRequestLogID ulid.ULID
+
+	URL    *url.URL
+	Method string
+	Proto  string
+	Header http.Header
+	Body   []byte
+
+	// Attempts are the times the request was sent, oldest first.
+	Attempts []Attempt
+}
+
+// Attempt is a request as it was sent, with its response. Requests can be
+// edited between attempts, so each attempt holds a copy.
+type Attempt struct {
+	// ID is the ID of the attempt. Its timestamp is the time it was sent.
+	ID ulid.ULID
+
+	URL    *url.URL
+	Method string
+	Proto  string
+	Header http.Header
+	Body   []byte
+
+	// Response is nil if the request failed, in which case Error is set.
+	Response *reqlog.ResponseLog
+	Error    string
+}
+
+// Response returns the response of the last attempt, or nil if the request
+// wasn't sent yet, or its last attempt failed.
+func (req Request) Response() *reqlog.ResponseLog {
+	if len(req.Attempts) == 0 {
+		return nil
+	}
+
+	return req.Attempts[len(req.Attempts)-1].Response
+}
+
+type Service struct {
+	repo       Repository
+	reqLogSvc  *reqlog.Service
+	httpClient *http.Client
+}
+
+type Config struct {
+	Repository Repository
+	// ReqLogService is used for cloning request logs, and for the active
+	// project ID.
+	ReqLogService *reqlog.Service
+	// HTTPClient is used for sending requests. Typically, this is the client
+	// of the proxy (see `proxy.Proxy.HTTPClient`). Defaults to a client that
+	// doesn't follow redirects.
+	HTTPClient *http.Client
+}
+
+func NewService(cfg Config) *Service {
+	svc := &Service{
+		repo:       cfg.Repository,
+		reqLogSvc:  cfg.ReqLogService,
+		httpClient: cfg.HTTPClient,
+	}
+
+	if svc.httpClient == nil {
+		svc.httpClient = &http.Client{
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		}
+	}
+
+	return svc
+}
+
+func (svc *Service) activeProjectID() (ulid.ULID, error) {
+	projectID := svc.reqLogSvc.ActiveProjectID
+	if projectID.Compare(ulid.ULID{}) == 0 {
+		return ulid.ULID{}, ErrProjectIDMustBeSet
+	}
+
+	return projectID, nil
+}
+
+// CreateOrUpdateRequest stores a request in the active project. If req has no
+// ID, a new request is created. Otherwise, the method, URL, protocol, header
+// and body of the existing request are updated; its attempts are kept.
+func (svc *Service) CreateOrUpdateRequest(ctx context.Context, req Request) (Request, error) {
+	projectID, err := svc.activeProjectID()
+	if err != nil {
+		return Request{}, err
+	}
+
+	if err := validateRequest(req); err != nil {
+		return Request{}, err
+	}
+
+	if req.ID.Compare(ulid.ULID{}) == 0 {
+		req.ID = ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+		req.ProjectID = projectID
+		req.Attempts = nil
+	} else {
+		existing, err := svc.FindRequestByID(ctx, req.ID)
+		if err != nil {
+			return Request{}, err
+		}
+
+		req.ProjectID = existing.ProjectID
+		req.SourceRequestLogID = existing.SourceRequestLogID
+		req.Attempts = existing.Attempts
+	}
+
+	if err := svc.repo.StoreSenderRequest(ctx, req); err != nil {
+		return Request{}, fmt.Errorf("sender: could not store request: %w", err)
+	}
+
+	return req, nil
+}
+
+// CloneFromRequestLog creates a request in the active project from a request
+// log of that project, as requested by the client.
+func (svc *Service) CloneFromRequestLog(ctx context.Context, reqLogID ulid.ULID) (Request, error) {
+	projectID, err := svc.activeProjectID()
+	if err != nil {
+		return Request{}, err
+	}
+
+	reqLog, err := svc.reqLogSvc.FindRequestLogByID(ctx, reqLogID)
+	if err != nil {
+		return Request{}, fmt.Errorf("sender: could not find request log: %w", err)
+	}
+
+	if reqLog.ProjectID.Compare(projectID) != 0 {
+		return Request{}, fmt.Errorf("sender: request log (%v) doesn't belong to the active project", reqLogID)
+	}
+
+	req := Request{
+		ID:                 ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy),
+		ProjectID:          projectID,
+		SourceRequestLogID: reqLog.ID,
+		URL:                reqLog.URL,
+		Method:             reqLog.Method,
+		Proto:              reqLog.Proto,
+		Header:             reqLog.Header.Clone(),
+		Body:               reqLog.Body,
+	}
+
+	if err := svc.repo.StoreSenderRequest(ctx, req); err != nil {
+		return Request{}, fmt.Errorf("sender: could not store request: %w", err)
+	}
+
+	return req, nil
+}
+
+func (svc *Service) FindRequestByID(ctx context.Context, id ulid.ULID) (Request, error) {
+	req, err := svc.repo.FindSenderRequestByID(ctx, id)
+	if err != nil {
+		return Request{}, fmt.Errorf("sender: could not find request: %w", err)
+	}
+
+	return req, nil
+}
+
+// FindRequests returns the requests of the active project, oldest first.
+func (svc *Service) FindRequests(ctx context.Context) ([]Request, error) {
+	projectID, err := svc.activeProjectID()
+	if err != nil {
+		return nil, err
+	}
+
+	return svc.repo.FindSenderRequests(ctx, projectID)
+}
+
+// SendRequest sends a request, and stores the attempt. A request that fails
+// (e.g. because the host can't be reached) is stored as an attempt with an
+// error, and isn't returned as an error.
+func (svc *Service) SendRequest(ctx context.Context, id ulid.ULID) (Request, error) {
+	req, err := svc.FindRequestByID(ctx, id)
+	if err != nil {
+		return Request{}, err
+	}
+
+	attempt := Attempt{
+		ID:     ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy),
+		URL:    req.URL,
+		Method: req.Method,
+		Proto:  req.Proto,
+		Header: req.Header.Clone(),
+		Body:   req.Body,
+	}
+
+	resLog, err := svc.Do(ctx, req)
+	if err != nil {
+		attempt.Error = err.Error()
+	} else {
+		attempt.Response = &resLog
+	}
+
+	req.Attempts = append(req.Attempts, attempt)
+
+	if err := svc.repo.StoreSenderRequest(ctx, req); err != nil {
+		return Request{}, fmt.Errorf("sender: could not store request: %w", err)
+	}
+
+	return req, nil
+}
+
+// Do sends a request and returns its response, without storing an attempt.
+func (svc *Service) Do(ctx context.Context, req Request) (reqlog.ResponseLog, error) {
+	// The timing is stored with the response, like for proxied requests.
+	httpReq, err := http.NewRequestWithContext(proxy.WithTimingTrace(ctx), req.Method, req.URL.String(),
+		bytes.NewReader(req.Body))
+	if err != nil {
+		return reqlog.ResponseLog{}, fmt.Errorf("sender: could not create HTTP request: %w", err)
+	}
+
+	if req.Header != nil {
+		httpReq.Header = req.Header.Clone()
+	}
+
+	// A `Host` header is only sent via `http.Request.Host`.
+	if host := httpReq.Header.Get("Host"); host != "" {
+		httpReq.Host = host
+		httpReq.Header.Del("Host")
+	}
+
+	res, err := svc.httpClient.Do(httpReq)
+	if err != nil {
+		return reqlog.ResponseLog{}, fmt.Errorf("sender: could not send HTTP request: %w", err)
+	}
+
+	resLog, err := reqlog.ParseHTTPResponse(res)
+	if err != nil {
+		return reqlog.ResponseLog{}, fmt.Errorf("sender: could not parse HTTP response: %w", err)
+	}
+
+	return resLog, nil
+}
+
+// DeleteRequests deletes the requests of a project.
+func (svc *Service) DeleteRequests(ctx context.Context, projectID ulid.ULID) error {
+	if err := svc.repo.DeleteSenderRequests(ctx, projectID); err != nil {
+		return fmt.Errorf("sender: could not delete requests: %w", err)
+	}
+
+	return nil
+}
+
+func validateRequest(req Request) error {
+	if req.URL == nil || (req.URL.Scheme != "http" && req.URL.Scheme != "https") || req.URL.Host == "" {
+		return errors.New("sender: URL must be absolute, with scheme `http` or `https`")
+	}
+
+	if req.Method == "" {
+		return errors.New("sender: method must be set")
+	}
+
+	return nil
+}
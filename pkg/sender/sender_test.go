@@ -0,0 +1,283 @@
+package sender_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	badgerdb "github.com/dgraph-io/badger/v3"
+	"github.com/oklog/ulid"
+
+	"github.com/dstotijn/hetty/pkg/db/badger"
+	"github.com/dstotijn/hetty/pkg/reqlog"
+	"github.com/dstotijn/hetty/pkg/scope"
+	"github.com/dstotijn/hetty/pkg/sender"
+)
+
+//nolint:gosec
+var ulidEntropy = rand.New(rand.NewSource(time.Now().UnixNano()))
+
+func newTestService(t *testing.T) (*sender.Service, *reqlog.Service, *badger.Database) {
+	t.Helper()
+
+	database, err := badger.OpenDatabase(badgerdb.DefaultOptions("").WithInMemory(true))
+	if err != nil {
+		t.Fatalf("failed to open badger database: %v", err)
+	}
+
+	t.Cleanup(func() { database.Close() })
+
+	reqLogSvc := reqlog.NewService(reqlog.Config{
+		Repository: database,
+		Scope:      &scope.Scope{},
+	})
+	reqLogSvc.ActiveProjectID = ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+
+	svc := sender.NewService(sender.Config{
+		Repository:    database,
+		ReqLogService: reqLogSvc,
+	})
+
+	return svc, reqLogSvc, database
+}
+
+func mustParseURL(t *testing.T, s string) *url.URL {
+	t.Helper()
+
+	u, err := url.Parse(s)
+	if err != nil {
+		t.Fatalf("failed to parse URL: %v", err)
+	}
+
+	return u
+}
+
+func TestCreateOrUpdateRequest(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		req           sender.Request
+		noProject     bool
+		expectedError error
+	}{
+		{
+			name:          "without active project",
+			req:           sender.Request{Method: http.MethodGet, URL: mustParseURL(t, "https://example.com/")},
+			noProject:     true,
+			expectedError: sender.ErrProjectIDMustBeSet,
+		},
+		{
+			name:          "relative URL",
+			req:           sender.Request{Method: http.MethodGet, URL: mustParseURL(t, "/foo")},
+			expectedError: errors.New("sender: URL must be absolute, with scheme `http` or `https`"),
+		},
+		{
+			name:          "without method",
+			req:           sender.Request{URL: mustParseURL(t, "https://example.com/")},
+			expectedError: errors.New("sender: method must be set"),
+		},
+		{
+			name: "unknown ID",
+			req: sender.Request{
+				ID:     ulid.MustNew(1, ulidEntropy),
+				Method: http.MethodGet,
+				URL:    mustParseURL(t, "https://example.com/"),
+			},
+			expectedError: errors.New("sender: could not find request: sender: request not found"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc, reqLogSvc, _ := newTestService(t)
+			if tt.noProject {
+				reqLogSvc.ActiveProjectID = ulid.ULID{}
+			}
+
+			_, err := svc.CreateOrUpdateRequest(context.Background(), tt.req)
+			assertError(t, tt.expectedError, err)
+		})
+	}
+}
+
+func TestSendRequest(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		w.Header().Set("X-Host", r.Host)
+		w.Header().Set("X-Foo", r.Header.Get("X-Foo"))
+		w.WriteHeader(http.StatusCreated)
+		w.Write(append([]byte(r.Method+" "), body...)) //nolint:errcheck
+	}))
+	defer ts.Close()
+
+	svc, reqLogSvc, _ := newTestService(t)
+
+	req, err := svc.CreateOrUpdateRequest(context.Background(), sender.Request{
+		Method: http.MethodPost,
+		URL:    mustParseURL(t, ts.URL+"/foo"),
+		Header: http.Header{"X-Foo": []string{"bar"}, "Host": []string{"example.com"}},
+		Body:   []byte("foo"),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error creating request: %v", err)
+	}
+
+	if req.ProjectID != reqLogSvc.ActiveProjectID {
+		t.Errorf("incorrect project ID (expected: %v, got: %v)", reqLogSvc.ActiveProjectID, req.ProjectID)
+	}
+
+	req, err = svc.SendRequest(context.Background(), req.ID)
+	if err != nil {
+		t.Fatalf("unexpected error sending request: %v", err)
+	}
+
+	res := req.Response()
+	if res == nil {
+		t.Fatalf("expected response, got attempts: %+v", req.Attempts)
+	}
+
+	if res.StatusCode != http.StatusCreated || string(res.Body) != "POST foo" {
+		t.Errorf("incorrect response (status: %v, body: %q)", res.StatusCode, res.Body)
+	}
+
+	// A `Host` header sets the request's host.
+	if got := res.Header.Get("X-Host"); got != "example.com" {
+		t.Errorf("incorrect host (expected: %q, got: %q)", "example.com", got)
+	}
+
+	if res.Timing.Total == 0 {
+		t.Error("expected timing to be recorded")
+	}
+
+	// Editing a request keeps its attempts, which hold the request as sent.
+	req.Method = http.MethodPut
+	req.Header.Set("X-Foo", "baz")
+
+	req, err = svc.CreateOrUpdateRequest(context.Background(), req)
+	if err != nil {
+		t.Fatalf("unexpected error updating request: %v", err)
+	}
+
+	req, err = svc.SendRequest(context.Background(), req.ID)
+	if err != nil {
+		t.Fatalf("unexpected error sending request: %v", err)
+	}
+
+	if len(req.Attempts) != 2 {
+		t.Fatalf("incorrect number of attempts (expected: 2, got: %v)", len(req.Attempts))
+	}
+
+	if first := req.Attempts[0]; first.Method != http.MethodPost || first.Header.Get("X-Foo") != "bar" {
+		t.Errorf("incorrect first attempt (method: %v, header: %v)", first.Method, first.Header)
+	}
+
+	if got := req.Response().Header.Get("X-Foo"); got != "baz" || string(req.Response().Body) != "PUT foo" {
+		t.Errorf("incorrect response of second attempt (X-Foo: %q, body: %q)", got, req.Response().Body)
+	}
+
+	reqs, err := svc.FindRequests(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error finding requests: %v", err)
+	}
+
+	if len(reqs) != 1 || len(reqs[0].Attempts) != 2 {
+		t.Fatalf("incorrect stored requests: %+v", reqs)
+	}
+}
+
+func TestSendRequestFailure(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.NotFoundHandler())
+	ts.Close()
+
+	svc, _, _ := newTestService(t)
+
+	req, err := svc.CreateOrUpdateRequest(context.Background(), sender.Request{
+		Method: http.MethodGet,
+		URL:    mustParseURL(t, ts.URL),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error creating request: %v", err)
+	}
+
+	// The failed attempt is stored, rather than returned as an error.
+	req, err = svc.SendRequest(context.Background(), req.ID)
+	if err != nil {
+		t.Fatalf("unexpected error sending request: %v", err)
+	}
+
+	if len(req.Attempts) != 1 || req.Attempts[0].Error == "" || req.Response() != nil {
+		t.Fatalf("expected failed attempt, got: %+v", req.Attempts)
+	}
+}
+
+func TestCloneFromRequestLog(t *testing.T) {
+	t.Parallel()
+
+	svc, reqLogSvc, database := newTestService(t)
+
+	reqLog := reqlog.RequestLog{
+		ID:        ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy),
+		ProjectID: reqLogSvc.ActiveProjectID,
+		URL:       mustParseURL(t, "https://example.com/foo"),
+		Method:    http.MethodPost,
+		Proto:     "HTTP/1.1",
+		Header:    http.Header{"X-Foo": []string{"bar"}},
+		Body:      []byte("foo"),
+	}
+
+	if err := database.StoreRequestLog(context.Background(), reqLog); err != nil {
+		t.Fatalf("unexpected error storing request log: %v", err)
+	}
+
+	req, err := svc.CloneFromRequestLog(context.Background(), reqLog.ID)
+	if err != nil {
+		t.Fatalf("unexpected error cloning request log: %v", err)
+	}
+
+	if req.SourceRequestLogID != reqLog.ID || req.URL.String() != reqLog.URL.String() || req.Method != reqLog.Method ||
+		req.Header.Get("X-Foo") != "bar" || string(req.Body) != "foo" {
+		t.Fatalf("incorrect cloned request: %+v", req)
+	}
+
+	t.Run("request log of other project", func(t *testing.T) {
+		otherReqLog := reqLog
+		otherReqLog.ID = ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+		otherReqLog.ProjectID = ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+
+		if err := database.StoreRequestLog(context.Background(), otherReqLog); err != nil {
+			t.Fatalf("unexpected error storing request log: %v", err)
+		}
+
+		_, err := svc.CloneFromRequestLog(context.Background(), otherReqLog.ID)
+		assertError(t, fmt.Errorf("sender: request log (%v) doesn't belong to the active project", otherReqLog.ID), err)
+	})
+}
+
+func assertError(t *testing.T, exp, got error) {
+	t.Helper()
+
+	switch {
+	case exp == nil && got != nil:
+		t.Fatalf("expected: nil, got: %v", got)
+	case exp != nil && got == nil:
+		t.Fatalf("expected: %v, got: nil", exp.Error())
+	case exp != nil && got != nil && exp.Error() != got.Error():
+		t.Fatalf("expected: %v, got: %v", exp.Error(), got.Error())
+	}
+}
@@ -0,0 +1,257 @@
+package wslog
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"errors"
+	"io"
+	"io/ioutil"
+)
+
+// maxPayloadSize is the maximum size of a logged message payload. Larger
+// payloads are truncated.
+const maxPayloadSize = 1 << 20
+
+// deflateWindowSize is the size of the LZ77 sliding window of
+// permessage-deflate, which is kept between messages (RFC 7692, section 7.2.3).
+const deflateWindowSize = 32 << 10
+
+// deflateTail is the empty stored block that is removed from the end of each
+// compressed message (RFC 7692, section 7.2.1).
+var deflateTail = []byte{0x00, 0x00, 0xff, 0xff}
+
+// frameParser parses the WebSocket frames of one direction of a connection, as
+// they are relayed. It buffers fragmented messages, and calls emit for each
+// complete message or control frame.
+type frameParser struct {
+	// inflater decompresses messages if permessage-deflate was negotiated.
+	inflater *inflater
+	emit     func(msg Message)
+
+	header    []byte
+	inPayload bool
+	remaining uint64
+	fin       bool
+	opcode    Opcode
+	mask      []byte
+	maskPos   int
+
+	// The data message that's being parsed, which can span multiple frames.
+	msgOpcode     Opcode
+	msgCompressed bool
+	msg           []byte
+	msgTruncated  bool
+
+	// Control frames can be interleaved with the frames of a data message.
+	ctrl []byte
+}
+
+func (p *frameParser) write(b []byte) {
+	for len(b) > 0 {
+		if !p.inPayload {
+			b = b[p.readHeader(b):]
+
+			if !p.inPayload {
+				return
+			}
+
+			if p.remaining == 0 {
+				p.endFrame()
+			}
+
+			continue
+		}
+
+		n := uint64(len(b))
+		if n > p.remaining {
+			n = p.remaining
+		}
+
+		p.appendPayload(b[:n])
+		p.remaining -= n
+		b = b[n:]
+
+		if p.remaining == 0 {
+			p.endFrame()
+		}
+	}
+}
+
+// readHeader consumes the bytes of b that are part of the current frame header,
+// and starts the frame once the header is complete. It returns the number of
+// consumed bytes.
+func (p *frameParser) readHeader(b []byte) int {
+	consumed := 0
+
+	for {
+		need := 2
+		if len(p.header) >= 2 {
+			need = frameHeaderSize(p.header[1])
+		}
+
+		if len(p.header) == need {
+			p.startFrame()
+			return consumed
+		}
+
+		if consumed == len(b) {
+			return consumed
+		}
+
+		n := need - len(p.header)
+		if n > len(b)-consumed {
+			n = len(b) - consumed
+		}
+
+		p.header = append(p.header, b[consumed:consumed+n]...)
+		consumed += n
+	}
+}
+
+// frameHeaderSize returns the size of a frame header, given its second byte.
+func frameHeaderSize(b byte) int {
+	size := 2
+
+	switch b & 0x7f {
+	case 126:
+		size += 2
+	case 127:
+		size += 8
+	}
+
+	if b&0x80 != 0 {
+		size += 4
+	}
+
+	return size
+}
+
+func (p *frameParser) startFrame() {
+	h := p.header
+	p.fin = h[0]&0x80 != 0
+	p.opcode = Opcode(h[0] & 0x0f)
+
+	rest := h[2:]
+
+	switch length := h[1] & 0x7f; length {
+	case 126:
+		p.remaining = uint64(binary.BigEndian.Uint16(rest))
+		rest = rest[2:]
+	case 127:
+		p.remaining = binary.BigEndian.Uint64(rest)
+		rest = rest[8:]
+	default:
+		p.remaining = uint64(length)
+	}
+
+	p.mask = nil
+	if h[1]&0x80 != 0 {
+		p.mask = append([]byte(nil), rest[:4]...)
+	}
+
+	p.maskPos = 0
+	p.header = p.header[:0]
+	p.inPayload = true
+
+	switch {
+	case p.opcode.IsControl():
+		p.ctrl = nil
+	case p.opcode != OpcodeContinuation:
+		p.msgOpcode = p.opcode
+		// RSV1 is set on the first frame of a compressed message.
+		p.msgCompressed = h[0]&0x40 != 0
+		p.msg = nil
+		p.msgTruncated = false
+	}
+}
+
+func (p *frameParser) appendPayload(b []byte) {
+	buf, truncated := &p.msg, &p.msgTruncated
+	if p.opcode.IsControl() {
+		// Control frames have a payload of at most 125 bytes.
+		buf, truncated = &p.ctrl, new(bool)
+	}
+
+	n := len(b)
+	if space := maxPayloadSize - len(*buf); n > space {
+		n = space
+		*truncated = true
+	}
+
+	start := len(*buf)
+	*buf = append(*buf, b[:n]...)
+
+	if p.mask != nil {
+		for i := start; i < len(*buf); i++ {
+			(*buf)[i] ^= p.mask[(p.maskPos+i-start)%4]
+		}
+	}
+
+	p.maskPos += len(b)
+}
+
+func (p *frameParser) endFrame() {
+	p.inPayload = false
+
+	if p.opcode.IsControl() {
+		p.emit(Message{Opcode: p.opcode, Payload: p.ctrl})
+		return
+	}
+
+	if !p.fin {
+		return
+	}
+
+	msg := Message{
+		Opcode:    p.msgOpcode,
+		Payload:   p.msg,
+		Truncated: p.msgTruncated,
+	}
+
+	if p.msgCompressed {
+		msg.Compressed = true
+
+		if p.inflater != nil && !p.msgTruncated {
+			if payload, truncated, err := p.inflater.inflate(p.msg); err == nil {
+				msg.Payload, msg.Truncated, msg.Compressed = payload, truncated, false
+			}
+		}
+	}
+
+	p.emit(msg)
+	p.msg = nil
+}
+
+// inflater decompresses the messages of one direction of a connection that
+// uses permessage-deflate. Unless context takeover is disabled, messages can
+// refer to data of previous ones, so these are kept as the dictionary.
+type inflater struct {
+	dict []byte
+}
+
+func (inf *inflater) inflate(payload []byte) (out []byte, truncated bool, err error) {
+	r := flate.NewReaderDict(io.MultiReader(bytes.NewReader(payload), bytes.NewReader(deflateTail)), inf.dict)
+	defer r.Close()
+
+	out, err = ioutil.ReadAll(io.LimitReader(r, maxPayloadSize+1))
+	// The message doesn't end with a final block, so the reader runs out of
+	// input after the empty stored block.
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return nil, false, err
+	}
+
+	if len(out) > maxPayloadSize {
+		// The window can't be kept up to date, so later messages can't be
+		// decompressed reliably.
+		inf.dict = nil
+		return out[:maxPayloadSize], true, nil
+	}
+
+	inf.dict = append(inf.dict, out...)
+	if over := len(inf.dict) - deflateWindowSize; over > 0 {
+		inf.dict = inf.dict[:copy(inf.dict, inf.dict[over:])]
+	}
+
+	return out, false, nil
+}
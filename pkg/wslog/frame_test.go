@@ -0,0 +1,207 @@
+package wslog
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// frame returns a WebSocket frame. If mask is set, the payload is masked.
+func frame(fin, rsv1 bool, opcode Opcode, payload []byte, mask []byte) []byte {
+	b0 := byte(opcode)
+	if fin {
+		b0 |= 0x80
+	}
+
+	if rsv1 {
+		b0 |= 0x40
+	}
+
+	var maskBit byte
+	if mask != nil {
+		maskBit = 0x80
+	}
+
+	f := []byte{b0}
+
+	switch n := len(payload); {
+	case n < 126:
+		f = append(f, maskBit|byte(n))
+	case n <= 0xffff:
+		f = append(f, maskBit|126, 0, 0)
+		binary.BigEndian.PutUint16(f[2:], uint16(n))
+	default:
+		f = append(f, maskBit|127, 0, 0, 0, 0, 0, 0, 0, 0)
+		binary.BigEndian.PutUint64(f[2:], uint64(n))
+	}
+
+	f = append(f, mask...)
+
+	for i, c := range payload {
+		if mask != nil {
+			c ^= mask[i%4]
+		}
+
+		f = append(f, c)
+	}
+
+	return f
+}
+
+// deflateMessages compresses messages like permessage-deflate with context
+// takeover: each is flushed, without the trailing empty stored block.
+func deflateMessages(t *testing.T, msgs ...string) [][]byte {
+	t.Helper()
+
+	buf := &bytes.Buffer{}
+
+	w, err := flate.NewWriter(buf, flate.BestCompression)
+	if err != nil {
+		t.Fatalf("unexpected error creating flate writer: %v", err)
+	}
+
+	compressed := make([][]byte, len(msgs))
+	start := 0
+
+	for i, msg := range msgs {
+		if _, err := w.Write([]byte(msg)); err != nil {
+			t.Fatalf("unexpected error compressing message: %v", err)
+		}
+
+		if err := w.Flush(); err != nil {
+			t.Fatalf("unexpected error flushing flate writer: %v", err)
+		}
+
+		compressed[i] = bytes.TrimSuffix(append([]byte(nil), buf.Bytes()[start:]...), deflateTail)
+		start = buf.Len()
+	}
+
+	return compressed
+}
+
+func TestFrameParser(t *testing.T) {
+	t.Parallel()
+
+	mask := []byte{0x01, 0x02, 0x03, 0x04}
+	longPayload := []byte(strings.Repeat("a", 300))
+
+	tests := []struct {
+		name     string
+		stream   [][]byte
+		deflate  bool
+		expected []Message
+	}{
+		{
+			name:     "unmasked text frame",
+			stream:   [][]byte{frame(true, false, OpcodeText, []byte("hello"), nil)},
+			expected: []Message{{Opcode: OpcodeText, Payload: []byte("hello")}},
+		},
+		{
+			name:     "masked frame with 16-bit length",
+			stream:   [][]byte{frame(true, false, OpcodeBinary, longPayload, mask)},
+			expected: []Message{{Opcode: OpcodeBinary, Payload: longPayload}},
+		},
+		{
+			name: "fragmented message with interleaved ping",
+			stream: [][]byte{
+				frame(false, false, OpcodeText, []byte("foo"), mask),
+				frame(true, false, OpcodePing, []byte("ping"), mask),
+				frame(true, false, OpcodeContinuation, []byte("bar"), mask),
+			},
+			expected: []Message{
+				{Opcode: OpcodePing, Payload: []byte("ping")},
+				{Opcode: OpcodeText, Payload: []byte("foobar")},
+			},
+		},
+		{
+			name: "frames split across writes",
+			stream: func() [][]byte {
+				b := append(frame(true, false, OpcodeText, []byte("hello"), mask),
+					frame(true, false, OpcodeClose, []byte{0x03, 0xe8}, mask)...)
+
+				// Write one byte at a time.
+				stream := make([][]byte, len(b))
+				for i := range b {
+					stream[i] = b[i : i+1]
+				}
+
+				return stream
+			}(),
+			expected: []Message{
+				{Opcode: OpcodeText, Payload: []byte("hello")},
+				{Opcode: OpcodeClose, Payload: []byte{0x03, 0xe8}},
+			},
+		},
+		{
+			name: "compressed messages with context takeover",
+			stream: func() [][]byte {
+				compressed := deflateMessages(t, "hello hello hello", "hello hello hello")
+
+				return [][]byte{
+					frame(true, true, OpcodeText, compressed[0], nil),
+					frame(true, true, OpcodeText, compressed[1], nil),
+				}
+			}(),
+			deflate: true,
+			expected: []Message{
+				{Opcode: OpcodeText, Payload: []byte("hello hello hello")},
+				{Opcode: OpcodeText, Payload: []byte("hello hello hello")},
+			},
+		},
+		{
+			name:     "compressed message without negotiated extension",
+			stream:   [][]byte{frame(true, true, OpcodeText, deflateMessages(t, "hello")[0], nil)},
+			expected: []Message{{Opcode: OpcodeText, Payload: deflateMessages(t, "hello")[0], Compressed: true}},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []Message
+
+			p := &frameParser{emit: func(msg Message) { got = append(got, msg) }}
+			if tt.deflate {
+				p.inflater = &inflater{}
+			}
+
+			for _, b := range tt.stream {
+				p.write(b)
+			}
+
+			if diff := cmp.Diff(tt.expected, got); diff != "" {
+				t.Fatalf("messages not equal (-exp, +got):\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestFrameParserTruncatesLargePayload(t *testing.T) {
+	t.Parallel()
+
+	var got []Message
+
+	p := &frameParser{emit: func(msg Message) { got = append(got, msg) }}
+	p.write(frame(true, false, OpcodeBinary, make([]byte, maxPayloadSize+10), []byte{1, 2, 3, 4}))
+	// The next frame is still parsed.
+	p.write(frame(true, false, OpcodeText, []byte("foo"), nil))
+
+	if len(got) != 2 {
+		t.Fatalf("expected 2 messages, got: %v", len(got))
+	}
+
+	if !got[0].Truncated || len(got[0].Payload) != maxPayloadSize {
+		t.Errorf("expected truncated payload of %v bytes, got: %v bytes (truncated: %v)",
+			maxPayloadSize, len(got[0].Payload), got[0].Truncated)
+	}
+
+	if string(got[1].Payload) != "foo" {
+		t.Errorf("incorrect payload of next message (expected: %q, got: %q)", "foo", got[1].Payload)
+	}
+}
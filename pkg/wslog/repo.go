@@ -0,0 +1,13 @@
+package wslog
+
+import (
+	"context"
+
+	"github.com/oklog/ulid"
+)
+
+type Repository interface {
+	FindWebSocketMessages(ctx context.Context, filter FindMessagesFilter) ([]Message, error)
+	StoreWebSocketMessage(ctx context.Context, msg Message) error
+	ClearWebSocketMessages(ctx context.Context, projectID ulid.ULID) error
+}
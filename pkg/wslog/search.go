@@ -0,0 +1,129 @@
+package wslog
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/dstotijn/hetty/pkg/search"
+)
+
+var messageSearchKeyFns = map[string]func(msg Message) string{
+	"ws.id":           func(msg Message) string { return msg.ID.String() },
+	"ws.connectionId": func(msg Message) string { return msg.ConnectionID.String() },
+	"ws.direction":    func(msg Message) string { return msg.Direction.String() },
+	"ws.opcode":       func(msg Message) string { return msg.Opcode.String() },
+	"ws.payload":      func(msg Message) string { return string(msg.Payload) },
+	"ws.length":       func(msg Message) string { return strconv.Itoa(len(msg.Payload)) },
+}
+
+// Matches returns true if the message matches the search expression, e.g.
+// `ws.direction = server AND ws.payload =~ "error"`. A string literal that
+// isn't compared matches messages with a payload that contains it, ignoring
+// case.
+func (msg Message) Matches(expr search.Expression) (bool, error) {
+	switch e := expr.(type) {
+	case search.PrefixExpression:
+		return msg.matchPrefixExpr(e)
+	case search.InfixExpression:
+		return msg.matchInfixExpr(e)
+	case search.StringLiteral:
+		return strings.Contains(strings.ToLower(string(msg.Payload)), strings.ToLower(e.Value)), nil
+	default:
+		return false, fmt.Errorf("expression type (%T) not supported", expr)
+	}
+}
+
+func (msg Message) matchPrefixExpr(expr search.PrefixExpression) (bool, error) {
+	if expr.Operator != search.TokOpNot {
+		return false, errors.New("operator is not supported")
+	}
+
+	match, err := msg.Matches(expr.Right)
+	if err != nil {
+		return false, err
+	}
+
+	return !match, nil
+}
+
+func (msg Message) matchInfixExpr(expr search.InfixExpression) (bool, error) {
+	switch expr.Operator {
+	case search.TokOpAnd, search.TokOpOr:
+		left, err := msg.Matches(expr.Left)
+		if err != nil {
+			return false, err
+		}
+
+		right, err := msg.Matches(expr.Right)
+		if err != nil {
+			return false, err
+		}
+
+		if expr.Operator == search.TokOpAnd {
+			return left && right, nil
+		}
+
+		return left || right, nil
+	}
+
+	left, ok := expr.Left.(search.StringLiteral)
+	if !ok {
+		return false, errors.New("left operand must be a string literal")
+	}
+
+	leftVal := msg.getMappedStringLiteral(left.Value)
+
+	if expr.Operator == search.TokOpRe || expr.Operator == search.TokOpNotRe {
+		re, ok := expr.Right.(*regexp.Regexp)
+		if !ok {
+			return false, errors.New("right operand must be a regular expression")
+		}
+
+		return re.MatchString(leftVal) == (expr.Operator == search.TokOpRe), nil
+	}
+
+	right, ok := expr.Right.(search.StringLiteral)
+	if !ok {
+		return false, errors.New("right operand must be a string literal")
+	}
+
+	rightVal := msg.getMappedStringLiteral(right.Value)
+
+	switch expr.Operator {
+	case search.TokOpEq:
+		return leftVal == rightVal, nil
+	case search.TokOpNotEq:
+		return leftVal != rightVal, nil
+	case search.TokOpGt:
+		return compareValues(leftVal, rightVal) > 0, nil
+	case search.TokOpLt:
+		return compareValues(leftVal, rightVal) < 0, nil
+	case search.TokOpGtEq:
+		return compareValues(leftVal, rightVal) >= 0, nil
+	case search.TokOpLtEq:
+		return compareValues(leftVal, rightVal) <= 0, nil
+	default:
+		return false, errors.New("unsupported operator")
+	}
+}
+
+func (msg Message) getMappedStringLiteral(s string) string {
+	if fn, ok := messageSearchKeyFns[s]; ok {
+		return fn(msg)
+	}
+
+	return s
+}
+
+// compareValues compares numbers and datetimes by value (see
+// `search.ParseLiteral`), and other values as strings.
+func compareValues(a, b string) int {
+	if cmp, ok := search.ParseLiteral(a).Compare(search.ParseLiteral(b)); ok {
+		return cmp
+	}
+
+	return strings.Compare(a, b)
+}
@@ -0,0 +1,114 @@
+package wslog_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dstotijn/hetty/pkg/search"
+	"github.com/dstotijn/hetty/pkg/wslog"
+)
+
+func TestMessageMatch(t *testing.T) {
+	t.Parallel()
+
+	msg := wslog.Message{
+		Direction: wslog.DirectionServerToClient,
+		Opcode:    wslog.OpcodeText,
+		Payload:   []byte(`{"type":"Error","code":500}`),
+	}
+
+	tests := []struct {
+		name          string
+		query         string
+		expectedMatch bool
+	}{
+		{
+			name:          "free text, case insensitive",
+			query:         "error",
+			expectedMatch: true,
+		},
+		{
+			name:          "free text, no match",
+			query:         "foobar",
+			expectedMatch: false,
+		},
+		{
+			name:          "direction and opcode",
+			query:         "ws.direction = server AND ws.opcode = text",
+			expectedMatch: true,
+		},
+		{
+			name:          "not equal",
+			query:         "ws.direction != server",
+			expectedMatch: false,
+		},
+		{
+			name:          "regular expression on payload",
+			query:         `ws.payload =~ "code.:5[0-9]{2}"`,
+			expectedMatch: true,
+		},
+		{
+			name:          "negated regular expression on payload",
+			query:         `ws.payload !~ "Error"`,
+			expectedMatch: false,
+		},
+		{
+			name:          "payload length compared numerically",
+			query:         "ws.length > 9",
+			expectedMatch: true,
+		},
+		{
+			name:          "prefix expression",
+			query:         "NOT (ws.opcode = binary)",
+			expectedMatch: true,
+		},
+		{
+			name:          "OR expression",
+			query:         "ws.opcode = ping OR ws.opcode = text",
+			expectedMatch: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			searchExpr, err := search.ParseQuery(tt.query)
+			assertError(t, nil, err)
+
+			got, err := msg.Matches(searchExpr)
+			assertError(t, nil, err)
+
+			if tt.expectedMatch != got {
+				t.Errorf("expected match result: %v, got: %v", tt.expectedMatch, got)
+			}
+		})
+	}
+}
+
+func TestMessageMatchInvalidExpression(t *testing.T) {
+	t.Parallel()
+
+	expr := search.InfixExpression{
+		Operator: search.TokOpRe,
+		Left:     search.StringLiteral{Value: "ws.payload"},
+		Right:    search.StringLiteral{Value: "foo"},
+	}
+
+	_, err := wslog.Message{}.Matches(expr)
+	assertError(t, errors.New("right operand must be a regular expression"), err)
+}
+
+func assertError(t *testing.T, exp, got error) {
+	t.Helper()
+
+	switch {
+	case exp == nil && got != nil:
+		t.Fatalf("expected: nil, got: %v", got)
+	case exp != nil && got == nil:
+		t.Fatalf("expected: %v, got: nil", exp.Error())
+	case exp != nil && got != nil && exp.Error() != got.Error():
+		t.Fatalf("expected: %v, got: %v", exp.Error(), got.Error())
+	}
+}
@@ -0,0 +1,285 @@
+package wslog
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"math/rand"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/oklog/ulid"
+
+	"github.com/dstotijn/hetty/pkg/proxy"
+	"github.com/dstotijn/hetty/pkg/reqlog"
+	"github.com/dstotijn/hetty/pkg/search"
+)
+
+var ErrProjectIDMustBeSet = errors.New("wslog: project ID must be set")
+
+// subscriberBufferSize is the number of messages that are buffered for a
+// subscriber. Messages are dropped for subscribers that fall behind.
+const subscriberBufferSize = 64
+
+// Direction is the direction a WebSocket message was sent in.
+type Direction int
+
+const (
+	DirectionClientToServer Direction = iota
+	DirectionServerToClient
+)
+
+func (d Direction) String() string {
+	if d == DirectionServerToClient {
+		return "server"
+	}
+
+	return "client"
+}
+
+// Opcode is the opcode of a WebSocket frame (RFC 6455, section 5.2).
+type Opcode byte
+
+const (
+	OpcodeContinuation Opcode = 0x0
+	OpcodeText         Opcode = 0x1
+	OpcodeBinary       Opcode = 0x2
+	OpcodeClose        Opcode = 0x8
+	OpcodePing         Opcode = 0x9
+	OpcodePong         Opcode = 0xa
+)
+
+// IsControl returns true for the opcodes of control frames, including reserved
+// ones.
+func (op Opcode) IsControl() bool {
+	return op&0x8 != 0
+}
+
+func (op Opcode) String() string {
+	switch op {
+	case OpcodeContinuation:
+		return "continuation"
+	case OpcodeText:
+		return "text"
+	case OpcodeBinary:
+		return "binary"
+	case OpcodeClose:
+		return "close"
+	case OpcodePing:
+		return "ping"
+	case OpcodePong:
+		return "pong"
+	default:
+		return "reserved"
+	}
+}
+
+// Message is a WebSocket data message or control frame, relayed on the
+// connection that was set up by the request log with ID `ConnectionID`.
+type Message struct {
+	ID           ulid.ULID
+	ProjectID    ulid.ULID
+	ConnectionID ulid.ULID
+	Direction    Direction
+	Opcode       Opcode
+	// Payload is unmasked, and decompressed if permessage-deflate is used.
+	Payload []byte
+	// Compressed is true if the payload couldn't be decompressed.
+	Compressed bool
+	// Truncated is true if the payload exceeded the maximum size that's
+	// logged.
+	Truncated bool
+}
+
+type FindMessagesFilter struct {
+	ProjectID ulid.ULID
+	// ConnectionID, if set, limits messages to those of one connection.
+	ConnectionID ulid.ULID
+	SearchExpr   search.Expression
+}
+
+// Service logs the messages of proxied WebSocket connections, and notifies
+// subscribers of them.
+type Service struct {
+	repo Repository
+
+	mu sync.Mutex
+	// entropy is monotonic, so messages are ordered by ID.
+	entropy     io.Reader
+	subscribers map[chan Message]struct{}
+}
+
+type Config struct {
+	Repository Repository
+}
+
+func NewService(cfg Config) *Service {
+	return &Service{
+		repo: cfg.Repository,
+		//nolint:gosec
+		entropy:     ulid.Monotonic(rand.New(rand.NewSource(time.Now().UnixNano())), 0),
+		subscribers: make(map[chan Message]struct{}),
+	}
+}
+
+func (svc *Service) FindMessages(ctx context.Context, filter FindMessagesFilter) ([]Message, error) {
+	if filter.ProjectID.Compare(ulid.ULID{}) == 0 {
+		return nil, ErrProjectIDMustBeSet
+	}
+
+	return svc.repo.FindWebSocketMessages(ctx, filter)
+}
+
+func (svc *Service) ClearMessages(ctx context.Context, projectID ulid.ULID) error {
+	return svc.repo.ClearWebSocketMessages(ctx, projectID)
+}
+
+// Subscribe returns a channel that receives messages as they're logged, until
+// ctx is done.
+func (svc *Service) Subscribe(ctx context.Context) <-chan Message {
+	ch := make(chan Message, subscriberBufferSize)
+
+	svc.mu.Lock()
+	svc.subscribers[ch] = struct{}{}
+	svc.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		svc.mu.Lock()
+		delete(svc.subscribers, ch)
+		svc.mu.Unlock()
+
+		close(ch)
+	}()
+
+	return ch
+}
+
+// ResponseModifier logs the messages of WebSocket connections, for handshake
+// requests that were logged by `reqlog.Service.RequestModifier`. It wraps the
+// connection to the server, so messages are logged as they're relayed.
+func (svc *Service) ResponseModifier(next proxy.ResponseModifyFunc) proxy.ResponseModifyFunc {
+	return func(res *http.Response) error {
+		if err := next(res); err != nil {
+			return err
+		}
+
+		if !IsHandshake(res) {
+			return nil
+		}
+
+		connID, ok := res.Request.Context().Value(proxy.ReqLogIDKey).(ulid.ULID)
+		if !ok {
+			return nil
+		}
+
+		projectID, _ := reqlog.ProjectIDFromContext(res.Request.Context())
+
+		serverConn, ok := res.Body.(io.ReadWriteCloser)
+		if !ok {
+			return nil
+		}
+
+		res.Body = svc.newConn(serverConn, projectID, connID, usesDeflate(res.Header))
+
+		return nil
+	}
+}
+
+// IsHandshake returns true if res completes a WebSocket opening handshake.
+func IsHandshake(res *http.Response) bool {
+	return res.StatusCode == http.StatusSwitchingProtocols && strings.EqualFold(res.Header.Get("Upgrade"), "websocket")
+}
+
+// usesDeflate returns true if the server accepted the permessage-deflate
+// extension.
+func usesDeflate(header http.Header) bool {
+	for _, v := range header.Values("Sec-WebSocket-Extensions") {
+		for _, ext := range strings.Split(v, ",") {
+			name := strings.TrimSpace(strings.SplitN(ext, ";", 2)[0])
+			if strings.EqualFold(name, "permessage-deflate") {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// conn wraps the connection to the server of an upgraded response. Written
+// bytes are sent by the client, and read bytes by the server.
+type conn struct {
+	io.ReadWriteCloser
+
+	client *frameParser
+	server *frameParser
+}
+
+func (svc *Service) newConn(serverConn io.ReadWriteCloser, projectID, connID ulid.ULID, deflate bool) *conn {
+	newParser := func(dir Direction) *frameParser {
+		p := &frameParser{
+			emit: func(msg Message) {
+				msg.ProjectID = projectID
+				msg.ConnectionID = connID
+				msg.Direction = dir
+
+				svc.storeMessage(msg)
+			},
+		}
+
+		if deflate {
+			p.inflater = &inflater{}
+		}
+
+		return p
+	}
+
+	return &conn{
+		ReadWriteCloser: serverConn,
+		client:          newParser(DirectionClientToServer),
+		server:          newParser(DirectionServerToClient),
+	}
+}
+
+func (c *conn) Read(b []byte) (int, error) {
+	n, err := c.ReadWriteCloser.Read(b)
+	if n > 0 {
+		c.server.write(b[:n])
+	}
+
+	return n, err
+}
+
+func (c *conn) Write(b []byte) (int, error) {
+	n, err := c.ReadWriteCloser.Write(b)
+	if n > 0 {
+		c.client.write(b[:n])
+	}
+
+	return n, err
+}
+
+func (svc *Service) storeMessage(msg Message) {
+	svc.mu.Lock()
+	msg.ID = ulid.MustNew(ulid.Timestamp(time.Now()), svc.entropy)
+	svc.mu.Unlock()
+
+	if err := svc.repo.StoreWebSocketMessage(context.Background(), msg); err != nil {
+		log.Printf("[ERROR] Could not store WebSocket message: %v", err)
+		return
+	}
+
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+
+	for ch := range svc.subscribers {
+		select {
+		case ch <- msg:
+		default:
+		}
+	}
+}
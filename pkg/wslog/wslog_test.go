@@ -0,0 +1,184 @@
+package wslog_test
+
+import (
+	"context"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	badgerdb "github.com/dgraph-io/badger/v3"
+	"github.com/oklog/ulid"
+
+	"github.com/dstotijn/hetty/pkg/db/badger"
+	"github.com/dstotijn/hetty/pkg/reqlog"
+	"github.com/dstotijn/hetty/pkg/scope"
+	"github.com/dstotijn/hetty/pkg/search"
+	"github.com/dstotijn/hetty/pkg/wslog"
+)
+
+//nolint:gosec
+var ulidEntropy = rand.New(rand.NewSource(time.Now().UnixNano()))
+
+// textFrame returns an unfragmented text frame. Frames sent by clients are
+// masked.
+func textFrame(payload string, masked bool) []byte {
+	f := []byte{0x81, byte(len(payload))}
+	if !masked {
+		return append(f, payload...)
+	}
+
+	mask := []byte{0x0a, 0x0b, 0x0c, 0x0d}
+	f[1] |= 0x80
+	f = append(f, mask...)
+
+	for i := 0; i < len(payload); i++ {
+		f = append(f, payload[i]^mask[i%4])
+	}
+
+	return f
+}
+
+func TestResponseModifier(t *testing.T) {
+	t.Parallel()
+
+	database, err := badger.OpenDatabase(badgerdb.DefaultOptions("").WithInMemory(true))
+	if err != nil {
+		t.Fatalf("failed to open badger database: %v", err)
+	}
+	defer database.Close()
+
+	reqLogSvc := reqlog.NewService(reqlog.Config{
+		Repository: database,
+		Scope:      &scope.Scope{},
+	})
+	reqLogSvc.ActiveProjectID = ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+
+	svc := wslog.NewService(wslog.Config{Repository: database})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	logged := svc.Subscribe(ctx)
+
+	// The handshake request is logged first, so the messages can refer to it.
+	req := httptest.NewRequest(http.MethodGet, "http://example.com/ws", nil)
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Upgrade", "websocket")
+	reqLogSvc.RequestModifier(func(*http.Request) {})(req)
+
+	server, upstream := net.Pipe()
+	defer server.Close()
+
+	res := &http.Response{
+		StatusCode: http.StatusSwitchingProtocols,
+		Header:     http.Header{"Upgrade": []string{"websocket"}, "Connection": []string{"Upgrade"}},
+		Body:       upstream,
+		Request:    req,
+	}
+
+	if err := svc.ResponseModifier(func(*http.Response) error { return nil })(res); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	conn, ok := res.Body.(io.ReadWriteCloser)
+	if !ok {
+		t.Fatalf("expected response body to be an io.ReadWriteCloser, got: %T", res.Body)
+	}
+
+	// Relay a message from the server, and then one from the client.
+	serverFrame := textFrame("hello", false)
+
+	go server.Write(serverFrame) //nolint:errcheck
+
+	if _, err := io.ReadFull(conn, make([]byte, len(serverFrame))); err != nil {
+		t.Fatalf("unexpected error reading from connection: %v", err)
+	}
+
+	clientFrame := textFrame("hi", true)
+
+	go io.ReadFull(server, make([]byte, len(clientFrame))) //nolint:errcheck
+
+	if _, err := conn.Write(clientFrame); err != nil {
+		t.Fatalf("unexpected error writing to connection: %v", err)
+	}
+
+	expected := []struct {
+		direction wslog.Direction
+		payload   string
+	}{
+		{wslog.DirectionServerToClient, "hello"},
+		{wslog.DirectionClientToServer, "hi"},
+	}
+
+	for _, exp := range expected {
+		select {
+		case msg := <-logged:
+			if msg.Direction != exp.direction || string(msg.Payload) != exp.payload {
+				t.Errorf("incorrect message (expected: %v %q, got: %v %q)",
+					exp.direction, exp.payload, msg.Direction, msg.Payload)
+			}
+
+			if msg.ProjectID != reqLogSvc.ActiveProjectID {
+				t.Errorf("incorrect project ID (expected: %v, got: %v)", reqLogSvc.ActiveProjectID, msg.ProjectID)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for logged message")
+		}
+	}
+
+	t.Run("messages are stored", func(t *testing.T) {
+		msgs, err := svc.FindMessages(context.Background(), wslog.FindMessagesFilter{
+			ProjectID: reqLogSvc.ActiveProjectID,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(msgs) != 2 || string(msgs[0].Payload) != "hello" || string(msgs[1].Payload) != "hi" {
+			t.Fatalf("incorrect stored messages: %+v", msgs)
+		}
+	})
+
+	t.Run("search expression filters messages", func(t *testing.T) {
+		expr, err := search.ParseQuery("ws.direction = client")
+		if err != nil {
+			t.Fatalf("unexpected error parsing query: %v", err)
+		}
+
+		msgs, err := svc.FindMessages(context.Background(), wslog.FindMessagesFilter{
+			ProjectID:  reqLogSvc.ActiveProjectID,
+			SearchExpr: expr,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(msgs) != 1 || string(msgs[0].Payload) != "hi" {
+			t.Fatalf("incorrect filtered messages: %+v", msgs)
+		}
+	})
+}
+
+func TestResponseModifierIgnoresOtherResponses(t *testing.T) {
+	t.Parallel()
+
+	svc := wslog.NewService(wslog.Config{})
+	body := io.NopCloser(nil)
+	res := &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       body,
+		Request:    httptest.NewRequest(http.MethodGet, "http://example.com/", nil),
+	}
+
+	if err := svc.ResponseModifier(func(*http.Response) error { return nil })(res); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if res.Body != body {
+		t.Fatal("expected response body to be unchanged")
+	}
+}